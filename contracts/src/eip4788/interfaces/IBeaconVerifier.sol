@@ -18,17 +18,20 @@ interface IBeaconVerifier {
     /// @notice Generalized Index of the pubkey of the first validator
     /// (validator index of 0) in the registry of the beacon state in the
     /// beacon block.
-    /// @dev In the Deneb beacon chain fork, this should be 3254554418216960.
+    /// @dev In the Deneb beacon chain fork, this should be 3254554418216960,
+    /// and from the Deneb+ fork onwards 6350779162034176.
     function zeroValidatorPubkeyGIndex() external view returns (uint256);
 
     /// @notice Generalized Index of the block number in the latest execution
     /// payload header in the beacon state in the beacon block.
-    /// @dev In the Deneb beacon chain fork, this should be 5894.
+    /// @dev In the Deneb beacon chain fork, this should be 5894, and from
+    /// the Deneb+ fork onwards 11526.
     function executionNumberGIndex() external view returns (uint256);
 
     /// @notice Generalized Index of the fee recipient in the latest execution
     /// payload header in the beacon state in the beacon block.
-    /// @dev In the Deneb beacon chain fork, this should be 5889.
+    /// @dev In the Deneb beacon chain fork, this should be 5889, and from
+    /// the Deneb+ fork onwards 11521.
     function executionFeeRecipientGIndex() external view returns (uint256);
 
     /// @notice Get the parent beacon block root from the given timestamp.
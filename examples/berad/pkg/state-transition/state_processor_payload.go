@@ -116,6 +116,9 @@ func (sp *StateProcessor[
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			// Execution layer requests are not supported by berad.
+			nil,
+			sp.cs.ActiveForkVersionForSlot(blk.GetSlot().Unwrap()),
 			optimisticEngine,
 		),
 	); err != nil {
@@ -22,28 +22,56 @@ package broker
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// Broker broadcasts msgs to registered clients.
+// Broker broadcasts msgs to registered clients. Every published msg is
+// assigned a sequence number and queued for each client according to the
+// policy the client subscribed with. The most recent msgs can optionally be
+// retained, so that clients can resume from the last sequence number they
+// have seen.
 type Broker[T any] struct {
 	// name of the message broker.
 	name string
-	// clients is a map of registered clients.
-	clients map[chan T]struct{}
+	// clients is a map of registered clients, keyed by the channel handed
+	// out to the client.
+	clients map[any]*client[T]
+	// mu protects the clients map, the sequence number, the replay ring and
+	// the closed flag, as clients may subscribe and unsubscribe concurrently
+	// with the broadcast loop.
+	mu sync.Mutex
 	// msgs is the channel for publishing new messages.
 	msgs chan T
-	// timeout is the timeout for sending a msg to a client.
-	timeout time.Duration
+	// seq is the sequence number of the last broadcast msg.
+	seq uint64
+	// replay retains the most recently broadcast msgs, if enabled.
+	replay *ring[T]
+	// closed is set once the broker loop has shut down.
+	closed bool
+	// dropped is the number of msgs dropped for slow clients.
+	dropped atomic.Uint64
+	// sendTimeout bounds how long a broadcast waits for room in the queue
+	// of a blocking client before dropping the msg for it.
+	sendTimeout time.Duration
+	// sink is the telemetry sink of the broker.
+	sink TelemetrySink
 }
 
-// New creates a new b.
-func New[T any](name string) *Broker[T] {
+// New creates a new broker.
+func New[T any](name string, opts ...Option) *Broker[T] {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
 	return &Broker[T]{
-		clients: make(map[chan T]struct{}),
-		msgs:    make(chan T, defaultBufferSize),
-		timeout: defaultTimeout,
-		name:    name,
+		name:        name,
+		clients:     make(map[any]*client[T]),
+		msgs:        make(chan T, o.bufferSize),
+		replay:      newRing[T](o.replaySize),
+		sendTimeout: o.sendTimeout,
+		sink:        o.sink,
 	}
 }
 
@@ -64,25 +92,69 @@ func (b *Broker[T]) start(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			// close all leftover clients and break the broker loop
-			for client := range b.clients {
-				b.Unsubscribe(client)
+			b.mu.Lock()
+			b.closed = true
+			clients := b.clients
+			b.clients = make(map[any]*client[T])
+			b.mu.Unlock()
+			for _, c := range clients {
+				c.close()
 			}
 			return
 		case msg := <-b.msgs:
-			// broadcast published msg to all clients
-			for client := range b.clients {
-				// send msg to client (or discard msg after timeout)
-				select {
-				case client <- msg:
-				case <-time.After(b.timeout):
-				}
+			b.broadcast(ctx, msg)
+		}
+	}
+}
+
+// broadcast assigns the next sequence number to the msg and delivers it to
+// all clients registered at the time. Clients are delivered to independently,
+// so that a blocking client only ever delays the broadcast by at most the
+// send timeout, regardless of how many other clients are slow.
+func (b *Broker[T]) broadcast(ctx context.Context, data T) {
+	b.mu.Lock()
+	b.seq++
+	msg := Message[T]{Seq: b.seq, Data: data}
+	b.replay.push(msg)
+	clients := make([]*client[T], 0, len(b.clients))
+	for _, c := range b.clients {
+		clients = append(clients, c)
+	}
+	b.mu.Unlock()
+
+	var (
+		wg      sync.WaitGroup
+		dropped atomic.Uint64
+	)
+	for _, c := range clients {
+		if c.policy != PolicyBlock {
+			if !c.deliver(ctx, msg) {
+				dropped.Add(1)
 			}
+			continue
 		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sendCtx, cancel := context.WithTimeout(ctx, b.sendTimeout)
+			defer cancel()
+			if !c.deliver(sendCtx, msg) {
+				dropped.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for range dropped.Load() {
+		b.dropped.Add(1)
+		b.sink.IncrementCounter(
+			"beacon_kit.async.broker.dropped_messages",
+			"broker", b.name,
+		)
 	}
 }
 
-// Publish publishes a msg to the b.
-// Returns ErrTimeout on timeout.
+// Publish publishes a msg to the broker and returns immediately.
 func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
 	select {
 	case b.msgs <- msg:
@@ -92,19 +164,115 @@ func (b *Broker[T]) Publish(ctx context.Context, msg T) error {
 	}
 }
 
-// Subscribe registers a new client to the broker and returns it to the caller.
-// Returns ErrTimeout on timeout.
-func (b *Broker[T]) Subscribe() (chan T, error) {
-	client := make(chan T)
-	b.clients[client] = struct{}{}
-	return client, nil
+// Subscribe registers a new client to the broker and returns a channel
+// over which the client receives the data of every msg.
+func (b *Broker[T]) Subscribe(opts ...SubscribeOption) (chan T, error) {
+	o := defaultSubscribeOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	var (
+		ch = make(chan T, b.queueSize(o))
+		c  = newClient(ch, o.policy, func(msg Message[T]) T {
+			return msg.Data
+		})
+	)
+	if err := b.subscribe(ch, c, o); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// SubscribeWithSequence registers a new client to the broker and returns a
+// channel over which the client receives every msg along with its sequence
+// number.
+func (b *Broker[T]) SubscribeWithSequence(
+	opts ...SubscribeOption,
+) (chan Message[T], error) {
+	o := defaultSubscribeOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	var (
+		ch = make(chan Message[T], b.queueSize(o))
+		c  = newClient(ch, o.policy, func(msg Message[T]) Message[T] {
+			return msg
+		})
+	)
+	if err := b.subscribe(ch, c, o); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// queueSize returns the size of the queue of a client, leaving room for
+// all retained msgs if the client resumes from a sequence number.
+func (b *Broker[T]) queueSize(o *subscribeOptions) int {
+	if o.resume {
+		return o.queueSize + b.replay.size()
+	}
+	return o.queueSize
+}
+
+// subscribe registers the client under the given key, after queueing the
+// retained msgs the client resumes from.
+func (b *Broker[T]) subscribe(
+	key any,
+	c *client[T],
+	o *subscribeOptions,
+) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrBrokerClosed
+	}
+
+	if o.resume {
+		if o.resumeAfter > b.seq {
+			return ErrSequenceUnavailable
+		}
+		missed := b.replay.since(o.resumeAfter)
+		if uint64(len(missed)) != b.seq-o.resumeAfter {
+			return ErrSequenceUnavailable
+		}
+		c.backlog(missed)
+	}
+
+	b.clients[key] = c
+	return nil
+}
+
+// Unsubscribe removes a client from the broker and closes its channel.
+func (b *Broker[T]) Unsubscribe(ch chan T) {
+	b.unsubscribe(ch)
+}
+
+// UnsubscribeWithSequence removes a client subscribed with
+// SubscribeWithSequence from the broker and closes its channel.
+func (b *Broker[T]) UnsubscribeWithSequence(ch chan Message[T]) {
+	b.unsubscribe(ch)
+}
+
+// unsubscribe removes the client registered under the given key.
+func (b *Broker[T]) unsubscribe(key any) {
+	b.mu.Lock()
+	// The client may have already been removed when the broker shut down.
+	c, ok := b.clients[key]
+	delete(b.clients, key)
+	b.mu.Unlock()
+	if ok {
+		c.close()
+	}
+}
+
+// Sequence returns the sequence number of the last broadcast msg.
+func (b *Broker[T]) Sequence() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.seq
 }
 
-// Unsubscribe removes a client from the b.
-// Returns ErrTimeout on timeout.
-func (b *Broker[T]) Unsubscribe(client chan T) {
-	// Remove the client from the broker
-	delete(b.clients, client)
-	// close the client channel
-	close(client)
+// Dropped returns the number of msgs dropped for slow clients.
+func (b *Broker[T]) Dropped() uint64 {
+	return b.dropped.Load()
 }
@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package broker_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// waitFor is how long a test waits for the broker to catch up.
+	waitFor = 2 * time.Second
+	// tick is how often a test polls the broker while waiting.
+	tick = time.Millisecond
+)
+
+// countingSink counts the metrics it receives.
+type countingSink struct {
+	count atomic.Uint64
+}
+
+func (s *countingSink) IncrementCounter(string, ...string) {
+	s.count.Add(1)
+}
+
+func startBroker(
+	t *testing.T,
+	opts ...broker.Option,
+) *broker.Broker[int] {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	b := broker.New[int]("test", opts...)
+	require.NoError(t, b.Start(ctx))
+	return b
+}
+
+func publish(t *testing.T, b *broker.Broker[int], msgs ...int) {
+	t.Helper()
+	for _, msg := range msgs {
+		require.NoError(t, b.Publish(context.Background(), msg))
+	}
+}
+
+func waitForSequence(t *testing.T, b *broker.Broker[int], seq uint64) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return b.Sequence() == seq
+	}, waitFor, tick)
+}
+
+func receive[M any](t *testing.T, ch <-chan M) M {
+	t.Helper()
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(waitFor):
+		t.Fatal("timed out waiting for msg")
+	}
+	var zero M
+	return zero
+}
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	b := startBroker(t)
+	ch, err := b.SubscribeWithSequence()
+	require.NoError(t, err)
+
+	publish(t, b, 10, 20, 30)
+	for i, want := range []int{10, 20, 30} {
+		msg := receive(t, ch)
+		require.Equal(t, uint64(i+1), msg.Seq)
+		require.Equal(t, want, msg.Data)
+	}
+	require.Zero(t, b.Dropped())
+}
+
+func TestBroker_DropCounting(t *testing.T) {
+	sink := &countingSink{}
+	b := startBroker(t, broker.WithTelemetrySink(sink))
+	ch, err := b.Subscribe(broker.WithQueueSize(1))
+	require.NoError(t, err)
+
+	publish(t, b, 1, 2, 3)
+	waitForSequence(t, b, 3)
+
+	require.Equal(t, uint64(2), b.Dropped())
+	require.Equal(t, uint64(2), sink.count.Load())
+	require.Equal(t, 1, receive(t, ch))
+}
+
+func TestBroker_ResumeAfterRingWraparound(t *testing.T) {
+	b := startBroker(t, broker.WithReplaySize(3))
+	publish(t, b, 1, 2, 3, 4, 5)
+	waitForSequence(t, b, 5)
+
+	// The ring has wrapped around and retains msgs 3 to 5.
+	ch, err := b.SubscribeWithSequence(broker.WithResumeAfter(2))
+	require.NoError(t, err)
+	for _, want := range []uint64{3, 4, 5} {
+		require.Equal(t, want, receive(t, ch).Seq)
+	}
+
+	// Live msgs follow the replayed ones.
+	publish(t, b, 6)
+	require.Equal(t, uint64(6), receive(t, ch).Seq)
+
+	// Resuming at the head replays nothing.
+	ch, err = b.SubscribeWithSequence(broker.WithResumeAfter(6))
+	require.NoError(t, err)
+	publish(t, b, 7)
+	require.Equal(t, uint64(7), receive(t, ch).Seq)
+}
+
+func TestBroker_ResumeUnavailable(t *testing.T) {
+	b := startBroker(t, broker.WithReplaySize(3))
+	publish(t, b, 1, 2, 3, 4, 5)
+	waitForSequence(t, b, 5)
+
+	// Msg 2 has been evicted from the ring.
+	_, err := b.SubscribeWithSequence(broker.WithResumeAfter(1))
+	require.ErrorIs(t, err, broker.ErrSequenceUnavailable)
+
+	// Msg 6 has not been broadcast yet.
+	_, err = b.SubscribeWithSequence(broker.WithResumeAfter(6))
+	require.ErrorIs(t, err, broker.ErrSequenceUnavailable)
+
+	// Without a replay ring, only resuming at the head is possible.
+	b = startBroker(t)
+	publish(t, b, 1)
+	waitForSequence(t, b, 1)
+	_, err = b.SubscribeWithSequence(broker.WithResumeAfter(0))
+	require.ErrorIs(t, err, broker.ErrSequenceUnavailable)
+	_, err = b.SubscribeWithSequence(broker.WithResumeAfter(1))
+	require.NoError(t, err)
+}
+
+func TestBroker_BlockingClientTimesOut(t *testing.T) {
+	const timeout = 50 * time.Millisecond
+	b := startBroker(t, broker.WithSendTimeout(timeout))
+
+	// Neither blocking client ever reads, so every msg past the first one
+	// times out for both of them.
+	for range 2 {
+		_, err := b.Subscribe(
+			broker.WithQueueSize(1),
+			broker.WithPolicy(broker.PolicyBlock),
+		)
+		require.NoError(t, err)
+	}
+	fast, err := b.Subscribe()
+	require.NoError(t, err)
+
+	start := time.Now()
+	publish(t, b, 1, 2, 3)
+	for _, want := range []int{1, 2, 3} {
+		require.Equal(t, want, receive(t, fast))
+	}
+	waitForSequence(t, b, 3)
+	require.Eventually(t, func() bool {
+		return b.Dropped() == 4
+	}, waitFor, tick)
+
+	// The blocking clients are waited on in parallel, so each broadcast is
+	// delayed by a single timeout rather than one per blocking client.
+	require.Less(t, time.Since(start), 4*timeout)
+}
+
+func TestBroker_UnsubscribeReleasesBlockedSend(t *testing.T) {
+	b := startBroker(t, broker.WithSendTimeout(time.Hour))
+	blocked, err := b.Subscribe(
+		broker.WithQueueSize(1),
+		broker.WithPolicy(broker.PolicyBlock),
+	)
+	require.NoError(t, err)
+	fast, err := b.Subscribe()
+	require.NoError(t, err)
+
+	publish(t, b, 1, 2, 3)
+	require.Equal(t, 1, receive(t, fast))
+	require.Equal(t, 2, receive(t, fast))
+
+	// The broadcast of msg 2 is stuck on the blocked client until it
+	// unsubscribes, which holds up msg 3 for everyone else.
+	select {
+	case <-fast:
+		t.Fatal("broadcast was not blocked")
+	case <-time.After(10 * time.Millisecond):
+	}
+	b.Unsubscribe(blocked)
+	require.Equal(t, 3, receive(t, fast))
+	require.Equal(t, 1, <-blocked)
+	_, ok := <-blocked
+	require.False(t, ok)
+}
+
+func TestBroker_SubscribeUnsubscribeDuringBroadcast(t *testing.T) {
+	const (
+		publishers  = 4
+		subscribers = 8
+		msgs        = 200
+	)
+	b := startBroker(t, broker.WithReplaySize(16))
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := range subscribers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			policy := broker.PolicyDrop
+			if i%2 == 0 {
+				policy = broker.PolicyBlock
+			}
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				ch, err := b.SubscribeWithSequence(
+					broker.WithQueueSize(1),
+					broker.WithPolicy(policy),
+				)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				// Read a msg or two, checking msgs arrive in order.
+				var last uint64
+				for range 2 {
+					select {
+					case msg := <-ch:
+						if msg.Seq <= last {
+							t.Errorf("seq %d after %d", msg.Seq, last)
+						}
+						last = msg.Seq
+					case <-time.After(time.Millisecond):
+					}
+				}
+				b.UnsubscribeWithSequence(ch)
+			}
+		}()
+	}
+
+	var pubs sync.WaitGroup
+	for range publishers {
+		pubs.Add(1)
+		go func() {
+			defer pubs.Done()
+			publish(t, b, make([]int, msgs)...)
+		}()
+	}
+	pubs.Wait()
+	waitForSequence(t, b, publishers*msgs)
+	close(done)
+	wg.Wait()
+}
+
+func TestBroker_Shutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := broker.New[int]("test")
+	require.NoError(t, b.Start(ctx))
+	ch, err := b.Subscribe()
+	require.NoError(t, err)
+
+	cancel()
+	_, ok := <-ch
+	require.False(t, ok)
+	_, err = b.Subscribe()
+	require.ErrorIs(t, err, broker.ErrBrokerClosed)
+
+	// Unsubscribing after shutdown is a no-op.
+	b.Unsubscribe(ch)
+}
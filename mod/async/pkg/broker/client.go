@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// client is a client registered to the broker.
+type client[T any] struct {
+	// policy is the policy applied when the queue of the client is full.
+	policy Policy
+	// send sends a msg over the channel of the client, either waiting for
+	// room in the queue or not.
+	send func(ctx context.Context, msg Message[T], wait bool) bool
+	// closeCh closes the channel of the client.
+	closeCh func()
+	// done is closed when the client unsubscribes, releasing a blocked send.
+	done chan struct{}
+	// mu serializes sends with closing the channel of the client.
+	mu sync.Mutex
+	// closed is set once the channel of the client is closed.
+	closed bool
+	// once guards closing done.
+	once sync.Once
+}
+
+// newClient creates a new client sending msgs over the given channel,
+// converted by the given function.
+func newClient[T, M any](
+	ch chan M,
+	policy Policy,
+	convert func(Message[T]) M,
+) *client[T] {
+	c := &client[T]{
+		policy:  policy,
+		closeCh: func() { close(ch) },
+		done:    make(chan struct{}),
+	}
+	c.send = func(ctx context.Context, msg Message[T], wait bool) bool {
+		if !wait {
+			select {
+			case ch <- convert(msg):
+				return true
+			default:
+				return false
+			}
+		}
+		select {
+		case ch <- convert(msg):
+			return true
+		case <-c.done:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return c
+}
+
+// backlog queues the given msgs for the client. It must be called before
+// the client is registered, while its queue is still empty.
+func (c *client[T]) backlog(msgs []Message[T]) {
+	for _, msg := range msgs {
+		c.send(context.Background(), msg, false)
+	}
+}
+
+// deliver queues the msg for the client according to its policy and
+// reports whether it was delivered.
+func (c *client[T]) deliver(ctx context.Context, msg Message[T]) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return true
+	}
+	return c.send(ctx, msg, c.policy == PolicyBlock)
+}
+
+// close releases a send blocked on the client and closes its channel.
+func (c *client[T]) close() {
+	c.once.Do(func() { close(c.done) })
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		c.closeCh()
+	}
+}
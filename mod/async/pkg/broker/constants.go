@@ -23,10 +23,12 @@ package broker
 import "time"
 
 const (
-	// defaultTimeout specifies the default timeout when the broker
-	// tries to send a message to a client, a message is published to the
-	// broker, or a client subscribes or unsubscribes.
-	defaultTimeout = time.Second
-	// defaultBufferSize specifies the default size of the message buffer.
+	// defaultBufferSize specifies the default size of the buffer of
+	// published msgs awaiting broadcast.
 	defaultBufferSize = 10
+	// defaultQueueSize specifies the default size of the queue of a client.
+	defaultQueueSize = 64
+	// defaultSendTimeout specifies the default time a broadcast waits for
+	// room in the queue of a blocking client.
+	defaultSendTimeout = time.Second
 )
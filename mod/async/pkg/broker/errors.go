@@ -20,9 +20,13 @@
 
 package broker
 
-import (
-	"errors"
-)
+import "errors"
 
-// ErrTimeout is the error returned when a broker operation timed out.
-var ErrTimeout = errors.New("timeout")
+var (
+	// ErrBrokerClosed is returned when subscribing to a broker that has shut
+	// down.
+	ErrBrokerClosed = errors.New("broker closed")
+	// ErrSequenceUnavailable is returned when a client resumes from a
+	// sequence number whose subsequent msgs are no longer retained.
+	ErrSequenceUnavailable = errors.New("sequence number unavailable")
+)
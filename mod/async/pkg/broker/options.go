@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+import "time"
+
+// Option is an option for the broker.
+type Option func(*options)
+
+// options are the options of the broker.
+type options struct {
+	bufferSize  int
+	replaySize  int
+	sendTimeout time.Duration
+	sink        TelemetrySink
+}
+
+// defaultOptions returns the default broker options.
+func defaultOptions() *options {
+	return &options{
+		bufferSize:  defaultBufferSize,
+		sendTimeout: defaultSendTimeout,
+		sink:        noopSink{},
+	}
+}
+
+// WithBufferSize sets the size of the buffer of published msgs awaiting
+// broadcast.
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		o.bufferSize = size
+	}
+}
+
+// WithReplaySize sets the number of most recent msgs retained for clients
+// resuming from a sequence number. Replay is disabled if size is zero.
+func WithReplaySize(size int) Option {
+	return func(o *options) {
+		o.replaySize = size
+	}
+}
+
+// WithSendTimeout sets how long a broadcast waits for room in the queue of
+// a blocking client before the msg is dropped for that client.
+func WithSendTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.sendTimeout = timeout
+	}
+}
+
+// WithTelemetrySink sets the telemetry sink of the broker.
+func WithTelemetrySink(sink TelemetrySink) Option {
+	return func(o *options) {
+		o.sink = sink
+	}
+}
+
+// SubscribeOption is an option for a client subscribing to the broker.
+type SubscribeOption func(*subscribeOptions)
+
+// subscribeOptions are the options of a client.
+type subscribeOptions struct {
+	queueSize   int
+	policy      Policy
+	resume      bool
+	resumeAfter uint64
+}
+
+// defaultSubscribeOptions returns the default client options.
+func defaultSubscribeOptions() *subscribeOptions {
+	return &subscribeOptions{
+		queueSize: defaultQueueSize,
+		policy:    PolicyDrop,
+	}
+}
+
+// WithQueueSize sets the number of msgs queued for the client.
+func WithQueueSize(size int) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.queueSize = size
+	}
+}
+
+// WithPolicy sets the policy applied when the queue of the client is full.
+func WithPolicy(policy Policy) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.policy = policy
+	}
+}
+
+// WithResumeAfter resumes the client after the msg with the given sequence
+// number, queueing all retained msgs broadcast since. Subscribing fails
+// with ErrSequenceUnavailable if any of them is no longer retained.
+func WithResumeAfter(seq uint64) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.resume = true
+		o.resumeAfter = seq
+	}
+}
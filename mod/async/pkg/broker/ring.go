@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+// ring is a bounded buffer retaining the most recently broadcast msgs.
+type ring[T any] struct {
+	// msgs is the backing buffer of the ring.
+	msgs []Message[T]
+	// next is the index the next msg is written to.
+	next int
+	// full is set once the ring has wrapped around.
+	full bool
+}
+
+// newRing creates a new ring retaining up to size msgs.
+func newRing[T any](size int) *ring[T] {
+	return &ring[T]{msgs: make([]Message[T], size)}
+}
+
+// push adds a msg to the ring, evicting the oldest msg if it is full.
+func (r *ring[T]) push(msg Message[T]) {
+	if len(r.msgs) == 0 {
+		return
+	}
+	r.msgs[r.next] = msg
+	r.next = (r.next + 1) % len(r.msgs)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// size returns the number of msgs the ring retains.
+func (r *ring[T]) size() int {
+	return len(r.msgs)
+}
+
+// since returns the retained msgs with a sequence number greater than seq,
+// oldest first.
+func (r *ring[T]) since(seq uint64) []Message[T] {
+	var msgs []Message[T]
+	if r.full {
+		msgs = append(msgs, r.msgs[r.next:]...)
+	}
+	msgs = append(msgs, r.msgs[:r.next]...)
+	for i, msg := range msgs {
+		if msg.Seq > seq {
+			return msgs[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package broker
+
+// Message is a msg broadcast by the broker along with its sequence number.
+type Message[T any] struct {
+	// Seq is the sequence number of the msg, starting at 1.
+	Seq uint64
+	// Data is the published data.
+	Data T
+}
+
+// Policy is the policy applied when the queue of a client is full.
+type Policy uint8
+
+const (
+	// PolicyDrop drops msgs for the client while its queue is full.
+	PolicyDrop Policy = iota
+	// PolicyBlock blocks the broadcast until there is room in the queue of
+	// the client, or until the send timeout of the broker elapses, in which
+	// case the msg is dropped for the client.
+	PolicyBlock
+)
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the
+	// provided keys.
+	IncrementCounter(key string, args ...string)
+}
+
+// noopSink is a telemetry sink discarding all metrics.
+type noopSink struct{}
+
+// IncrementCounter does nothing.
+func (noopSink) IncrementCounter(string, ...string) {}
@@ -20,25 +20,18 @@
 
 package blockstore
 
-const (
-	DefaultAvailabilityWindow = 8192
-)
-
 // Config is the configuration for the block service.
 type Config struct {
 	// Enabled enables the block service.
 	Enabled bool `mapstructure:"enabled"`
 	// PrunerEnabled enables the block pruner.
 	PrunerEnabled bool `mapstructure:"pruner-enabled"`
-	// AvailabilityWindow is the number of slots to keep in the store.
-	AvailabilityWindow uint64 `mapstructure:"availability-window"`
 }
 
 // DefaultConfig returns the default configuration for the block service.
 func DefaultConfig() Config {
 	return Config{
-		Enabled:            false,
-		PrunerEnabled:      false,
-		AvailabilityWindow: DefaultAvailabilityWindow,
+		Enabled:       false,
+		PrunerEnabled: false,
 	}
 }
@@ -22,16 +22,13 @@ package blockstore
 
 import asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 
-// BuildPruneRangeFn builds a function that returns the range of blocks to
-// prune.
-func BuildPruneRangeFn[BeaconBlockT BeaconBlock](
-	cfg Config,
-) func(*asynctypes.Event[BeaconBlockT]) (uint64, uint64) {
+// BuildPruneRangeFn builds a function that returns the range of blocks that
+// can be pruned once the given block leaves the retention window, which are
+// the blocks before it.
+func BuildPruneRangeFn[
+	BeaconBlockT BeaconBlock,
+]() func(*asynctypes.Event[BeaconBlockT]) (uint64, uint64) {
 	return func(event *asynctypes.Event[BeaconBlockT]) (uint64, uint64) {
-		blk := event.Data()
-		if blk.GetSlot().Unwrap() < cfg.AvailabilityWindow {
-			return 1, 1
-		}
-		return 1, blk.GetSlot().Unwrap() - cfg.AvailabilityWindow
+		return 1, max(event.Data().GetSlot().Unwrap(), 1)
 	}
 }
@@ -72,7 +72,9 @@ func (s *Service[_, _]) Start(ctx context.Context) error {
 		s.logger.Warn("block service is disabled, skipping storing blocks")
 		return nil
 	}
-	subBlkCh, err := s.blkBroker.Subscribe()
+	subBlkCh, err := s.blkBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		s.logger.Error("failed to subscribe to block events", "error", err)
 		return err
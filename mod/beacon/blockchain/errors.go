@@ -27,4 +27,7 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable indicates that the required data is not available.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrDepositRootMismatch indicates that the deposit root of a block does
+	// not match the root of the local deposit tree.
+	ErrDepositRootMismatch = errors.New("deposit root mismatch")
 )
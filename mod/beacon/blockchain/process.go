@@ -32,23 +32,39 @@ import (
 // ProcessGenesisData processes the genesis state and initializes the beacon
 // state.
 func (s *Service[
-	_, _, _, _, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _, _, _, _, _, GenesisT, _,
 ]) ProcessGenesisData(
 	ctx context.Context,
 	genesisData GenesisT,
 ) (transition.ValidatorUpdates, error) {
-	return s.stateProcessor.InitializePreminedBeaconStateFromEth1(
-		s.storageBackend.StateFromContext(ctx),
-		genesisData.GetDeposits(),
+	// The genesis deposits are not emitted by the deposit contract, so they
+	// are stored here to seed the deposit tree that later deposits extend.
+	deposits := genesisData.GetDeposits()
+	if err := s.storageBackend.DepositStore().EnqueueDeposits(
+		deposits,
+	); err != nil {
+		return nil, err
+	}
+
+	st := s.storageBackend.StateFromContext(ctx)
+	valUpdates, err := s.stateProcessor.InitializePreminedBeaconStateFromEth1(
+		st,
+		deposits,
 		genesisData.GetExecutionPayloadHeader(),
 		genesisData.GetForkVersion(),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.archiveState(st)
+	return valUpdates, nil
 }
 
 // ProcessBeaconBlock receives an incoming beacon block, it first validates
 // and then processes the block.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
@@ -78,6 +94,8 @@ func (s *Service[
 		return nil, ErrDataNotAvailable
 	}
 
+	s.archiveState(st)
+
 	// If required, we want to forkchoice at the end of post
 	// block processing.
 	// TODO: this is hood as fuck.
@@ -98,7 +116,7 @@ func (s *Service[
 
 // executeStateTransition runs the stf.
 func (s *Service[
-	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
 ]) executeStateTransition(
 	ctx context.Context,
 	st BeaconStateT,
@@ -124,9 +142,21 @@ func (s *Service[
 			// the "verification aspect" of this NewPayload call is
 			// actually irrelevant at this point.
 			SkipPayloadVerification: false,
+			Misbehaviors:            transition.MisbehaviorsFromContext(ctx),
+			Votes:                   transition.VotesFromContext(ctx),
 		},
 		st,
 		blk,
 	)
 	return valUpdates, err
 }
+
+// archiveState hands the given post-state to the state archive. Failing to
+// archive a state must not halt the chain, so errors are only logged.
+func (s *Service[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _,
+]) archiveState(st BeaconStateT) {
+	if err := s.stateArchive.Snapshot(st); err != nil {
+		s.logger.Error("failed to archive beacon state", "error", err)
+	}
+}
@@ -32,7 +32,7 @@ import (
 // VerifyIncomingBlock verifies the state root of an incoming block
 // and logs the process.
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
 ]) VerifyIncomingBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
@@ -59,6 +59,20 @@ func (s *Service[
 		"state_root", blk.GetStateRoot(), "slot", blk.GetSlot(),
 	)
 
+	// Verify the eth1 data of the incoming block matches our own view of the
+	// deposit contract. From Deneb+ onwards it is only a vote, which the
+	// state transition adopts once a majority of the voting period agrees.
+	if err := s.verifyDepositRoot(blk); err != nil {
+		s.logger.Error(
+			"Rejecting incoming beacon block ❌ ",
+			"state_root",
+			blk.GetStateRoot(),
+			"reason",
+			err,
+		)
+		return err
+	}
+
 	// We purposefully make a copy of the BeaconState in orer
 	// to avoid modifying the underlying state, for the event in which
 	// we have to rebuild a payload for this slot again, if we do not agree
@@ -99,7 +113,7 @@ func (s *Service[
 
 // verifyStateRoot verifies the state root of an incoming block.
 func (s *Service[
-	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
 ]) verifyStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -116,6 +130,8 @@ func (s *Service[
 			SkipPayloadVerification: false,
 			SkipValidateResult:      false,
 			SkipValidateRandao:      false,
+			Misbehaviors:            transition.MisbehaviorsFromContext(ctx),
+			Votes:                   transition.VotesFromContext(ctx),
 		},
 		st, blk,
 	); errors.Is(err, engineerrors.ErrAcceptedPayloadStatus) {
@@ -132,10 +148,34 @@ func (s *Service[
 	return nil
 }
 
+// verifyDepositRoot verifies that the deposit root of the eth1 data of an
+// incoming block matches the root of the local deposit tree at the same
+// deposit count, so that validators only accept votes for deposit roots they
+// can confirm.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
+]) verifyDepositRoot(blk BeaconBlockT) error {
+	eth1Data := blk.GetBody().GetEth1Data()
+	depositRoot, err := s.storageBackend.DepositStore().GetDepositRoot(
+		eth1Data.GetDepositCount().Unwrap(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if depositRoot != eth1Data.GetDepositRoot() {
+		return errors.Wrapf(
+			ErrDepositRootMismatch,
+			"expected %s, got %s", depositRoot, eth1Data.GetDepositRoot(),
+		)
+	}
+	return nil
+}
+
 // shouldBuildOptimisticPayloads returns true if optimistic
 // payload builds are enabled.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) shouldBuildOptimisticPayloads() bool {
 	return s.optimisticPayloadBuilds && s.localBuilder.Enabled()
 }
@@ -36,12 +36,14 @@ import (
 type Service[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
-	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[Eth1DataT, ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
 		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT,
 	],
 	DepositT any,
+	DepositStoreT DepositStore[DepositT],
+	Eth1DataT Eth1Data,
 	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
@@ -52,6 +54,7 @@ type Service[
 	storageBackend StorageBackend[
 		AvailabilityStoreT,
 		BeaconStateT,
+		DepositStoreT,
 	]
 	// logger is used for logging messages in the service.
 	logger log.Logger[any]
@@ -70,6 +73,8 @@ type Service[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	]
+	// stateArchive archives the post-states of the finalized blocks.
+	stateArchive StateArchive[BeaconStateT]
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
 	// genesisBroker is the event feed for genesis data.
@@ -90,13 +95,15 @@ type Service[
 func NewService[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
-	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[Eth1DataT, ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
 		BeaconStateT, BeaconBlockHeaderT,
 		ExecutionPayloadHeaderT,
 	],
 	DepositT any,
+	DepositStoreT DepositStore[DepositT],
+	Eth1DataT Eth1Data,
 	ExecutionPayloadT ExecutionPayload,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	GenesisT Genesis[DepositT, ExecutionPayloadHeaderT],
@@ -105,6 +112,7 @@ func NewService[
 	storageBackend StorageBackend[
 		AvailabilityStoreT,
 		BeaconStateT,
+		DepositStoreT,
 	],
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
@@ -117,6 +125,7 @@ func NewService[
 		DepositT,
 		ExecutionPayloadHeaderT,
 	],
+	stateArchive StateArchive[BeaconStateT],
 	telemetrySink TelemetrySink,
 	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]],
 	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]],
@@ -124,13 +133,13 @@ func NewService[
 	optimisticPayloadBuilds bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-	GenesisT, PayloadAttributesT,
+	BeaconStateT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, GenesisT, PayloadAttributesT,
 ] {
 	return &Service[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-		BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
-		GenesisT, PayloadAttributesT,
+		BeaconStateT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
+		ExecutionPayloadHeaderT, GenesisT, PayloadAttributesT,
 	]{
 		storageBackend:          storageBackend,
 		logger:                  logger,
@@ -138,6 +147,7 @@ func NewService[
 		executionEngine:         executionEngine,
 		localBuilder:            localBuilder,
 		stateProcessor:          stateProcessor,
+		stateArchive:            stateArchive,
 		metrics:                 newChainMetrics(telemetrySink),
 		genesisBroker:           genesisBroker,
 		blkBroker:               blkBroker,
@@ -149,19 +159,23 @@ func NewService[
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "blockchain"
 }
 
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(ctx context.Context) error {
-	subBlkCh, err := s.blkBroker.Subscribe()
+	subBlkCh, err := s.blkBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		return err
 	}
-	subGenCh, err := s.genesisBroker.Subscribe()
+	subGenCh, err := s.genesisBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		return err
 	}
@@ -170,7 +184,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, GenesisT, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, GenesisT, _,
 ]) start(
 	ctx context.Context,
 	subBlkCh chan *asynctypes.Event[BeaconBlockT],
@@ -196,7 +210,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, _, _, _, _, _, _, _, GenesisT, _,
+	_, _, _, _, _, _, _, _, _, _, GenesisT, _,
 ]) handleProcessGenesisDataRequest(msg *asynctypes.Event[GenesisT]) {
 	if msg.Error() != nil {
 		s.logger.Error("Error processing genesis data", "error", msg.Error())
@@ -228,7 +242,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
 ]) handleBeaconBlockReceived(
 	msg *asynctypes.Event[BeaconBlockT],
 ) {
@@ -253,7 +267,7 @@ func (s *Service[
 }
 
 func (s *Service[
-	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _,
 ]) handleBeaconBlockFinalization(
 	msg *asynctypes.Event[BeaconBlockT],
 ) {
@@ -55,9 +55,11 @@ type BeaconBlock[BeaconBlockBodyT any] interface {
 }
 
 // BeaconBlockBody represents the interface for the beacon block body.
-type BeaconBlockBody[ExecutionPayloadT any] interface {
+type BeaconBlockBody[Eth1DataT, ExecutionPayloadT any] interface {
 	constraints.SSZMarshallableRootable
 	constraints.Nillable
+	// GetEth1Data returns the eth1 data of the beacon block body.
+	GetEth1Data() Eth1DataT
 	// GetExecutionPayload returns the execution payload of the beacon block
 	// body.
 	GetExecutionPayload() ExecutionPayloadT
@@ -80,6 +82,23 @@ type BlobSidecars interface {
 	Len() int
 }
 
+// DepositStore defines the interface for deposit storage.
+type DepositStore[DepositT any] interface {
+	// EnqueueDeposits adds a list of deposits to the deposit store.
+	EnqueueDeposits(deposits []DepositT) error
+	// GetDepositRoot returns the root of the deposit tree over the first
+	// depositCount deposits.
+	GetDepositRoot(depositCount uint64) (common.Root, error)
+}
+
+// Eth1Data is the interface for the eth1 data of a block.
+type Eth1Data interface {
+	// GetDepositRoot returns the deposit root.
+	GetDepositRoot() common.Root
+	// GetDepositCount returns the deposit count.
+	GetDepositCount() math.U64
+}
+
 // ExecutionEngine is the interface for the execution engine.
 type ExecutionEngine[PayloadAttributesT any] interface {
 	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
@@ -199,14 +218,23 @@ type StateProcessor[
 	) (transition.ValidatorUpdates, error)
 }
 
+// StateArchive is the interface for the archive of historical beacon states.
+type StateArchive[BeaconStateT any] interface {
+	// Snapshot archives the given post-state if the archive is due for one.
+	Snapshot(st BeaconStateT) error
+}
+
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
 type StorageBackend[
 	AvailabilityStoreT any,
 	BeaconStateT any,
+	DepositStoreT any,
 ] interface {
 	// AvailabilityStore returns the availability store for the given context.
 	AvailabilityStore() AvailabilityStoreT
+	// DepositStore returns the deposit store.
+	DepositStore() DepositStoreT
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
 }
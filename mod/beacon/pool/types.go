@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// VoluntaryExit is the interface for a signed voluntary exit.
+type VoluntaryExit interface {
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+}
+
+// VoluntaryExitVerifier verifies voluntary exits against a beacon state.
+type VoluntaryExitVerifier[BeaconStateT, VoluntaryExitT any] interface {
+	// VerifyVoluntaryExit verifies that the voluntary exit can be processed
+	// on top of the given state.
+	VerifyVoluntaryExit(st BeaconStateT, exit VoluntaryExitT) error
+}
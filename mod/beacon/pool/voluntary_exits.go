@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pool
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// VoluntaryExitPool holds the voluntary exits submitted to the node until
+// they are included in a block.
+type VoluntaryExitPool[
+	BeaconStateT any,
+	VoluntaryExitT VoluntaryExit,
+] struct {
+	// verifier verifies the exits against a beacon state.
+	verifier VoluntaryExitVerifier[BeaconStateT, VoluntaryExitT]
+	// mu protects exits.
+	mu sync.RWMutex
+	// exits holds the pending exit of each validator.
+	exits map[math.ValidatorIndex]VoluntaryExitT
+}
+
+// NewVoluntaryExitPool creates a new voluntary exit pool.
+func NewVoluntaryExitPool[
+	BeaconStateT any,
+	VoluntaryExitT VoluntaryExit,
+](
+	verifier VoluntaryExitVerifier[BeaconStateT, VoluntaryExitT],
+) *VoluntaryExitPool[BeaconStateT, VoluntaryExitT] {
+	return &VoluntaryExitPool[BeaconStateT, VoluntaryExitT]{
+		verifier: verifier,
+		exits:    make(map[math.ValidatorIndex]VoluntaryExitT),
+	}
+}
+
+// Add verifies the voluntary exit against the given state and adds it to the
+// pool. Only the first exit of a validator is kept.
+func (p *VoluntaryExitPool[BeaconStateT, VoluntaryExitT]) Add(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	if err := p.verifier.VerifyVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.exits[exit.GetValidatorIndex()]; !ok {
+		p.exits[exit.GetValidatorIndex()] = exit
+	}
+	return nil
+}
+
+// Get returns the voluntary exits in the pool, ordered by validator index.
+func (p *VoluntaryExitPool[_, VoluntaryExitT]) Get() []VoluntaryExitT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	exits := make([]VoluntaryExitT, 0, len(p.exits))
+	for _, idx := range p.sortedIndices() {
+		exits = append(exits, p.exits[idx])
+	}
+	return exits
+}
+
+// Pending returns up to limit voluntary exits, ordered by validator index,
+// that can be included in a block on top of the given state. Exits that are
+// no longer valid, e.g. because they were included in a previous block, are
+// removed from the pool.
+func (p *VoluntaryExitPool[BeaconStateT, VoluntaryExitT]) Pending(
+	st BeaconStateT,
+	limit uint64,
+) []VoluntaryExitT {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	exits := make([]VoluntaryExitT, 0, min(uint64(len(p.exits)), limit))
+	for _, idx := range p.sortedIndices() {
+		if uint64(len(exits)) == limit {
+			break
+		}
+
+		// An exit that was valid once stays valid until the validator
+		// initiates its exit, so the invalid ones can be dropped.
+		exit := p.exits[idx]
+		if err := p.verifier.VerifyVoluntaryExit(st, exit); err != nil {
+			delete(p.exits, idx)
+			continue
+		}
+		exits = append(exits, exit)
+	}
+	return exits
+}
+
+// sortedIndices returns the validator indices of the pending exits in
+// ascending order. The caller must hold the lock.
+func (p *VoluntaryExitPool[_, _]) sortedIndices() []math.ValidatorIndex {
+	indices := make([]math.ValidatorIndex, 0, len(p.exits))
+	for idx := range p.exits {
+		indices = append(indices, idx)
+	}
+	slices.Sort(indices)
+	return indices
+}
@@ -27,6 +27,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -36,8 +37,8 @@ import (
 
 // buildBlockAndSidecars builds a new beacon block.
 func (s *Service[
-	AttestationDataT, BeaconBlockT, _, _,
-	BlobSidecarsT, _, _, _, _, _, _, SlashingInfoT, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, _, BlobSidecarsT, _, _, _, _, _, _, _,
+	SlashingInfoT, SlotDataT, _,
 ]) buildBlockAndSidecars(
 	ctx context.Context,
 	slotData SlotDataT,
@@ -69,7 +70,7 @@ func (s *Service[
 
 	// Build the reveal for the current slot.
 	// TODO: We can optimize to pre-compute this in parallel?
-	reveal, err := s.buildRandaoReveal(st, slotData.GetSlot())
+	reveal, err := s.buildRandaoReveal(ctx, st, slotData.GetSlot())
 	if err != nil {
 		return blk, sidecars, err
 	}
@@ -133,7 +134,7 @@ func (s *Service[
 
 // getEmptyBeaconBlockForSlot creates a new empty block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) getEmptyBeaconBlockForSlot(
 	st BeaconStateT, requestedSlot math.Slot,
 ) (BeaconBlockT, error) {
@@ -165,8 +166,9 @@ func (s *Service[
 
 // buildRandaoReveal builds a randao reveal for the given slot.
 func (s *Service[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, ForkDataT, _, _, _,
 ]) buildRandaoReveal(
+	ctx context.Context,
 	st BeaconStateT,
 	slot math.Slot,
 ) (crypto.BLSSignature, error) {
@@ -180,27 +182,74 @@ func (s *Service[
 		return crypto.BLSSignature{}, err
 	}
 
+	forkVersion := version.FromUint32[common.Version](
+		s.chainSpec.ActiveForkVersionForEpoch(epoch),
+	)
 	signingRoot := forkData.New(
-		version.FromUint32[common.Version](
-			s.chainSpec.ActiveForkVersionForEpoch(epoch),
-		), genesisValidatorsRoot,
+		forkVersion, genesisValidatorsRoot,
 	).ComputeRandaoSigningRoot(
 		s.chainSpec.DomainTypeRandao(),
 		epoch,
 	)
+
+	// Signers that need the context of the message get a typed request.
+	if signer, ok := s.signer.(RandaoSigner); ok {
+		return signer.SignRandaoReveal(
+			ctx, forkVersion, genesisValidatorsRoot, epoch, signingRoot,
+		)
+	}
 	return s.signer.Sign(signingRoot[:])
 }
 
 // retrieveExecutionPayload retrieves the execution payload for the block.
+// If an external builder is configured, its payload is proposed instead of
+// the local payload whenever its bid beats the value of the local payload.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _,
-	ExecutionPayloadT, ExecutionPayloadHeaderT, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, _, _, _, _, _,
 ]) retrieveExecutionPayload(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	//
-	// TODO: Add external block builders to this flow.
-	//
+	envelope, err := s.retrieveLocalPayload(ctx, st, blk)
+	if s.externalBuilder == nil {
+		return envelope, err
+	}
+
+	// The bid of the external builder is compared against the local payload,
+	// if there is one.
+	local := envelope
+	if err != nil {
+		local = nil
+	}
+	builderEnvelope, builderErr := s.externalBuilder.GetPayload(
+		ctx, st, blk, local,
+	)
+	if builderErr != nil {
+		s.metrics.failedToRetrieveBuilderPayload(blk.GetSlot(), builderErr)
+		s.logger.Info(
+			"Proposing local payload instead of external builder payload",
+			"slot", blk.GetSlot().Base10(),
+			"reason", builderErr,
+		)
+		return envelope, err
+	}
+
+	s.logger.Info(
+		"Proposing external builder payload",
+		"slot", blk.GetSlot().Base10(),
+		"value", builderEnvelope.GetValue(),
+	)
+	return builderEnvelope, nil
+}
+
+// retrieveLocalPayload retrieves the execution payload for the block from
+// the local payload builder.
+func (s *Service[
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, ExecutionPayloadT,
+	ExecutionPayloadHeaderT, _, _, _, _, _,
+]) retrieveLocalPayload(
+	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
 	// Get the payload for the block.
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
@@ -250,8 +299,8 @@ func (s *Service[
 
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
-	AttestationDataT, BeaconBlockT, _, BeaconStateT, _,
-	_, _, Eth1DataT, ExecutionPayloadT, _, _, SlashingInfoT, SlotDataT,
+	AttestationDataT, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT,
+	ExecutionPayloadT, _, ExecutionRequestsT, _, SlashingInfoT, SlotDataT, _,
 ]) buildBlockBody(
 	_ context.Context,
 	st BeaconStateT,
@@ -283,10 +332,30 @@ func (s *Service[
 		return ErrNilDepositIndexStart
 	}
 
-	// Dequeue deposits from the state.
-	deposits, err := s.sb.DepositStore().GetDepositsByIndex(
-		depositIndex,
-		s.chainSpec.MaxDepositsPerBlock(),
+	// Deposits from the first deposit request onwards are processed as
+	// execution layer requests instead.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	var numDeposits uint64
+	if depositIndex < startIndex {
+		numDeposits = min(
+			s.chainSpec.MaxDepositsPerBlock(), startIndex-depositIndex,
+		)
+	}
+
+	// Set the eth1 data the block votes for.
+	vote, adopted, err := s.buildEth1Data(st, blk.GetSlot())
+	if err != nil {
+		return err
+	}
+	body.SetEth1Data(vote)
+
+	// Dequeue deposits from the store, along with their proofs against the
+	// eth1 data adopted by the state once the block is applied.
+	deposits, _, err := s.sb.DepositStore().GetDepositsWithProofs(
+		depositIndex, numDeposits, adopted.GetDepositCount().Unwrap(),
 	)
 	if err != nil {
 		return err
@@ -295,14 +364,6 @@ func (s *Service[
 	// Set the deposits on the block body.
 	body.SetDeposits(deposits)
 
-	var eth1Data Eth1DataT
-	// TODO: assemble real eth1data.
-	body.SetEth1Data(eth1Data.New(
-		common.Root{},
-		0,
-		common.ExecutionHash{},
-	))
-
 	// Set the graffiti on the block body.
 	body.SetGraffiti(bytes.ToBytes32([]byte(s.cfg.Graffiti)))
 
@@ -312,21 +373,129 @@ func (s *Service[
 		epoch,
 	)
 	if activeForkVersion >= version.DenebPlus {
-		// Set the attestations on the block body.
+		// Set the attestations on the block body. They are checked against
+		// the votes the consensus engine commits in the block by every
+		// validator.
 		body.SetAttestations(slotData.GetAttestationData())
 
-		// Set the slashing info on the block body.
-		body.SetSlashingInfo(slotData.GetSlashingInfo())
+		// Set the slashing info on the block body. It is checked against
+		// the misbehavior evidence of the block by every validator.
+		slashingInfo := slotData.GetSlashingInfo()
+		body.SetSlashingInfo(slashingInfo)
+
+		// Include the pending voluntary exits. The validators slashed by
+		// the block already initiate their exit, so their voluntary exits
+		// are left out.
+		body.SetVoluntaryExits(
+			s.pendingVoluntaryExits(st, slashingInfo),
+		)
+	}
+	if activeForkVersion >= version.Electra {
+		// Set the execution layer requests that were collected by the
+		// execution client along with the payload.
+		var requests ExecutionRequestsT
+		requests, err = requests.NewFromEncodedRequests(
+			envelope.GetExecutionRequests(),
+		)
+		if err != nil {
+			return err
+		}
+		body.SetExecutionRequests(requests)
 	}
 
 	body.SetExecutionPayload(envelope.GetExecutionPayload())
 	return nil
 }
 
+// buildEth1Data returns the eth1 data the block votes for, which is the one
+// of the local deposit tree, along with the eth1 data adopted by the state
+// once the block is applied. Before the Deneb+ fork the eth1 data of the block
+// is adopted as is.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, Eth1DataT, _, _, _, _, _, _, _,
+]) buildEth1Data(
+	st BeaconStateT,
+	slot math.Slot,
+) (Eth1DataT, Eth1DataT, error) {
+	var vote Eth1DataT
+	adopted, err := st.GetEth1Data()
+	if err != nil {
+		return vote, adopted, err
+	}
+	depositCount, err := s.sb.DepositStore().GetDepositCount()
+	if err != nil {
+		return vote, adopted, err
+	}
+	depositRoot, err := s.sb.DepositStore().GetDepositRoot(depositCount)
+	if err != nil {
+		return vote, adopted, err
+	}
+	vote = vote.New(
+		depositRoot, math.U64(depositCount), common.ExecutionHash{},
+	)
+	if s.chainSpec.ActiveForkVersionForSlot(slot) < version.DenebPlus {
+		return vote, vote, nil
+	}
+
+	// A deposit tree that is behind the state keeps voting for the adopted
+	// eth1 data.
+	if depositCount < adopted.GetDepositCount().Unwrap() {
+		return adopted, adopted, nil
+	}
+
+	// The vote is adopted once more than half of the slots of the voting
+	// period, including this one, voted for it.
+	votes, err := st.GetEth1DataVotes()
+	if err != nil {
+		return vote, adopted, err
+	}
+	var (
+		root  = vote.HashTreeRoot()
+		count = uint64(1)
+	)
+	for _, v := range votes {
+		if v.HashTreeRoot() == root {
+			count++
+		}
+	}
+	if count*2 > s.chainSpec.EpochsPerEth1VotingPeriod()*
+		s.chainSpec.SlotsPerEpoch() {
+		return vote, vote, nil
+	}
+	return vote, adopted, nil
+}
+
+// pendingVoluntaryExits returns the pending voluntary exits of the pool that
+// can be included in a block on top of the state, except for the ones of the
+// given slashed validators.
+func (s *Service[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, SlashingInfoT, _,
+	VoluntaryExitT,
+]) pendingVoluntaryExits(
+	st BeaconStateT,
+	slashingInfo []SlashingInfoT,
+) []VoluntaryExitT {
+	slashed := make(map[math.ValidatorIndex]struct{}, len(slashingInfo))
+	for _, info := range slashingInfo {
+		slashed[info.GetIndex()] = struct{}{}
+	}
+
+	pending := s.voluntaryExitPool.Pending(
+		st, constants.MaxVoluntaryExitsPerBlock,
+	)
+	exits := make([]VoluntaryExitT, 0, len(pending))
+	for _, exit := range pending {
+		if _, ok := slashed[exit.GetValidatorIndex()]; !ok {
+			exits = append(exits, exit)
+		}
+	}
+	return exits
+}
+
 // computeAndSetStateRoot computes the state root of an outgoing block
 // and sets it in the block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) computeAndSetStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -347,7 +516,7 @@ func (s *Service[
 
 // computeStateRoot computes the state root of an outgoing block.
 func (s *Service[
-	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _,
+	_, BeaconBlockT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
 ]) computeStateRoot(
 	ctx context.Context,
 	st BeaconStateT,
@@ -365,6 +534,11 @@ func (s *Service[
 			SkipPayloadVerification: true,
 			SkipValidateResult:      true,
 			SkipValidateRandao:      true,
+			// The slashing info and the attestations were taken from
+			// the misbehaviors and the votes of the slot being built,
+			// so there is nothing to check.
+			SkipValidateMisbehaviors: true,
+			SkipValidateVotes:        true,
 		},
 		st, blk,
 	); err != nil {
@@ -20,6 +20,8 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -27,6 +29,18 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// DefaultRemoteSignerTimeout is the default timeout for requests to the
+	// remote signer.
+	DefaultRemoteSignerTimeout = 2 * time.Second
+
+	// DefaultExternalBuilderTimeout is the default timeout for requests to
+	// the external builder.
+	DefaultExternalBuilderTimeout = time.Second
+
+	// defaultExternalBuilderGasLimit is the default gas limit registered with
+	// the external builder.
+	defaultExternalBuilderGasLimit = 30_000_000
 )
 
 // Config is the validator configuration.
@@ -39,6 +53,52 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// RemoteSigner is the configuration for signing with a remote signer.
+	RemoteSigner RemoteSignerConfig `mapstructure:"remote-signer"`
+
+	// ExternalBuilder is the configuration for proposing payloads built by an
+	// external block builder.
+	ExternalBuilder ExternalBuilderConfig `mapstructure:"external-builder"`
+}
+
+// RemoteSignerConfig is the configuration for a remote signer implementing
+// the Web3Signer ETH2 API.
+type RemoteSignerConfig struct {
+	// Enabled determines if the remote signer is used instead of a local key.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the base url of the remote signer.
+	URL string `mapstructure:"url"`
+	// PublicKey is the public key of the validator key held by the remote
+	// signer. If empty, the remote signer must hold exactly one key.
+	PublicKey string `mapstructure:"public-key"`
+	// Timeout is the timeout for requests to the remote signer.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// TLSCertPath is the path to the TLS client certificate.
+	TLSCertPath string `mapstructure:"tls-cert-path"`
+	// TLSKeyPath is the path to the key of the TLS client certificate.
+	TLSKeyPath string `mapstructure:"tls-key-path"`
+	// TLSCAPath is the path to the CA certificate the remote signer's
+	// certificate is verified against. The system roots are used if empty.
+	TLSCAPath string `mapstructure:"tls-ca-path"`
+}
+
+// ExternalBuilderConfig is the configuration for an external block builder
+// implementing the builder API, such as an MEV-boost relay.
+type ExternalBuilderConfig struct {
+	// Enabled determines if payloads are requested from the external builder.
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the base url of the external builder.
+	URL string `mapstructure:"url"`
+	// Timeout is the timeout for requests to the external builder. The local
+	// payload is proposed if the builder does not respond in time.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// MinBidGwei is the minimum value in gwei a bid of the external builder
+	// must have to be proposed instead of the local payload.
+	MinBidGwei uint64 `mapstructure:"min-bid-gwei"`
+	// GasLimit is the gas limit the validator registers with the external
+	// builder.
+	GasLimit uint64 `mapstructure:"gas-limit"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +106,12 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		RemoteSigner: RemoteSignerConfig{
+			Timeout: DefaultRemoteSignerTimeout,
+		},
+		ExternalBuilder: ExternalBuilderConfig{
+			Timeout:  DefaultExternalBuilderTimeout,
+			GasLimit: defaultExternalBuilderGasLimit,
+		},
 	}
 }
@@ -74,3 +74,18 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// failedToRetrieveBuilderPayload increments the counter for the number of
+// times the validator proposed the local payload instead of the payload of
+// the external builder.
+func (cm *validatorMetrics) failedToRetrieveBuilderPayload(
+	slot math.Slot, err error,
+) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.failed_to_retrieve_builder_payload",
+		"slot",
+		slot.Base10(),
+		"error",
+		err.Error(),
+	)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -36,18 +37,21 @@ type Service[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ExecutionRequestsT, SlashingInfoT, VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ExecutionRequestsT ExecutionRequests[ExecutionRequestsT],
 	ForkDataT ForkData[ForkDataT],
-	SlashingInfoT any,
+	SlashingInfoT SlashingInfo,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	VoluntaryExitT VoluntaryExit,
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
@@ -61,6 +65,8 @@ type Service[
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT]
 	// sb is the beacon state backend.
 	sb StorageBackend[BeaconStateT, DepositStoreT]
+	// voluntaryExitPool holds the voluntary exits to include in blocks.
+	voluntaryExitPool VoluntaryExitPool[BeaconStateT, VoluntaryExitT]
 	// stateProcessor is responsible for processing the state.
 	stateProcessor StateProcessor[
 		BeaconBlockT,
@@ -76,6 +82,14 @@ type Service[
 	// remotePayloadBuilders represents a list of remote block builders, these
 	// builders are connected to other execution clients via the EngineAPI.
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// externalBuilder is the external block builder the payload is requested
+	// from when proposing, nil if no external builder is configured.
+	externalBuilder ExternalBuilder[
+		BeaconBlockT, BeaconStateT, ExecutionPayloadT,
+	]
+	// nextRegistrationEpoch is the epoch from which the validator is next
+	// registered with the external builder.
+	nextRegistrationEpoch math.Epoch
 	// metrics is a metrics collector.
 	metrics *validatorMetrics
 	// blkBroker is a publisher for blocks.
@@ -91,23 +105,27 @@ func NewService[
 	AttestationDataT any,
 	BeaconBlockT BeaconBlock[BeaconBlockT, BeaconBlockBodyT],
 	BeaconBlockBodyT BeaconBlockBody[
-		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT,
+		AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+		ExecutionRequestsT, SlashingInfoT, VoluntaryExitT,
 	],
-	BeaconStateT BeaconState[ExecutionPayloadHeaderT],
+	BeaconStateT BeaconState[Eth1DataT, ExecutionPayloadHeaderT],
 	BlobSidecarsT any,
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
 	ExecutionPayloadT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ExecutionRequestsT ExecutionRequests[ExecutionRequestsT],
 	ForkDataT ForkData[ForkDataT],
-	SlashingInfoT any,
+	SlashingInfoT SlashingInfo,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
+	VoluntaryExitT VoluntaryExit,
 ](
 	cfg *Config,
 	logger log.Logger[any],
 	chainSpec common.ChainSpec,
 	sb StorageBackend[BeaconStateT, DepositStoreT],
+	voluntaryExitPool VoluntaryExitPool[BeaconStateT, VoluntaryExitT],
 	stateProcessor StateProcessor[
 		BeaconBlockT,
 		BeaconStateT,
@@ -118,6 +136,9 @@ func NewService[
 	blobFactory BlobFactory[BeaconBlockT, BlobSidecarsT],
 	localPayloadBuilder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
 	remotePayloadBuilders []PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	externalBuilder ExternalBuilder[
+		BeaconBlockT, BeaconStateT, ExecutionPayloadT,
+	],
 	ts TelemetrySink,
 	blkBroker EventPublisher[*asynctypes.Event[BeaconBlockT]],
 	sidecarBroker EventPublisher[*asynctypes.Event[BlobSidecarsT]],
@@ -125,22 +146,26 @@ func NewService[
 ) *Service[
 	AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+	ExecutionPayloadHeaderT, ExecutionRequestsT, ForkDataT, SlashingInfoT,
+	SlotDataT, VoluntaryExitT,
 ] {
 	return &Service[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 		BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
+		ExecutionPayloadHeaderT, ExecutionRequestsT, ForkDataT, SlashingInfoT,
+		SlotDataT, VoluntaryExitT,
 	]{
 		cfg:                   cfg,
 		logger:                logger,
 		sb:                    sb,
+		voluntaryExitPool:     voluntaryExitPool,
 		chainSpec:             chainSpec,
 		signer:                signer,
 		stateProcessor:        stateProcessor,
 		blobFactory:           blobFactory,
 		localPayloadBuilder:   localPayloadBuilder,
 		remotePayloadBuilders: remotePayloadBuilders,
+		externalBuilder:       externalBuilder,
 		metrics:               newValidatorMetrics(ts),
 		blkBroker:             blkBroker,
 		sidecarBroker:         sidecarBroker,
@@ -150,14 +175,14 @@ func NewService[
 
 // Name returns the name of the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Name() string {
 	return "validator"
 }
 
 // Start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) Start(
 	ctx context.Context,
 ) error {
@@ -167,25 +192,49 @@ func (s *Service[
 
 // start starts the service.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) start(
 	ctx context.Context,
 ) {
+	s.registerValidator(ctx, 0)
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case req := <-s.newSlotSub:
 			if req.Type() == events.NewSlot {
+				s.registerValidator(ctx, req.Data().GetSlot())
 				s.handleNewSlot(req)
 			}
 		}
 	}
 }
 
+// registerValidator registers the validator with the external builder in
+// the background, at most once per epoch.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) registerValidator(ctx context.Context, slot math.Slot) {
+	epoch := s.chainSpec.SlotToEpoch(slot)
+	if s.externalBuilder == nil || epoch < s.nextRegistrationEpoch {
+		return
+	}
+	s.nextRegistrationEpoch = epoch + 1
+
+	go func() {
+		if err := s.externalBuilder.RegisterValidator(ctx); err != nil {
+			s.logger.Error(
+				"failed to register validator with external builder",
+				"epoch", epoch.Base10(),
+				"err", err,
+			)
+		}
+	}()
+}
+
 // handleBlockRequest handles a block request.
 func (s *Service[
-	_, _, _, _, _, _, _, _, _, _, _, _, SlotDataT,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, SlotDataT, _,
 ]) handleNewSlot(msg *asynctypes.Event[SlotDataT]) {
 	blk, sidecars, err := s.buildBlockAndSidecars(
 		msg.Context(), msg.Data(),
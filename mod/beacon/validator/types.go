@@ -60,7 +60,8 @@ type BeaconBlock[
 
 // BeaconBlockBody represents a beacon block body interface.
 type BeaconBlockBody[
-	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT, SlashingInfoT any,
+	AttestationDataT, DepositT, Eth1DataT, ExecutionPayloadT,
+	ExecutionRequestsT, SlashingInfoT, VoluntaryExitT any,
 ] interface {
 	constraints.SSZMarshallable
 	constraints.Nillable
@@ -78,13 +79,18 @@ type BeaconBlockBody[
 	SetAttestations([]AttestationDataT)
 	// SetSlashingInfo sets the slashing info of the beacon block body.
 	SetSlashingInfo([]SlashingInfoT)
+	// SetVoluntaryExits sets the voluntary exits of the beacon block body.
+	SetVoluntaryExits([]VoluntaryExitT)
 	// SetBlobKzgCommitments sets the blob KZG commitments of the beacon block
 	// body.
 	SetBlobKzgCommitments(eip4844.KZGCommitments[common.ExecutionHash])
+	// SetExecutionRequests sets the execution layer requests of the beacon
+	// block body.
+	SetExecutionRequests(ExecutionRequestsT)
 }
 
 // BeaconState represents a beacon state interface.
-type BeaconState[ExecutionPayloadHeaderT any] interface {
+type BeaconState[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	// GetBlockRootAtIndex returns the block root at the given index.
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	// GetLatestExecutionPayloadHeader returns the latest execution payload
@@ -98,9 +104,17 @@ type BeaconState[ExecutionPayloadHeaderT any] interface {
 	HashTreeRoot() common.Root
 	// ValidatorIndexByPubkey returns the validator index by public key.
 	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
+	// GetEth1Data returns the eth1 data adopted by the beacon state.
+	GetEth1Data() (Eth1DataT, error)
+	// GetEth1DataVotes returns the eth1 data votes of the current voting
+	// period.
+	GetEth1DataVotes() ([]Eth1DataT, error)
 	// GetEth1DepositIndex returns the latest deposit index from the beacon
 	// state.
 	GetEth1DepositIndex() (uint64, error)
+	// GetDepositRequestsStartIndex returns the index of the first deposit
+	// processed as an execution layer request.
+	GetDepositRequestsStartIndex() (uint64, error)
 	// GetGenesisValidatorsRoot returns the genesis validators root.
 	GetGenesisValidatorsRoot() (common.Root, error)
 }
@@ -119,11 +133,19 @@ type BlobFactory[
 
 // DepositStore defines the interface for deposit storage.
 type DepositStore[DepositT any] interface {
-	// GetDepositsByIndex returns `numView` expected deposits.
-	GetDepositsByIndex(
+	// GetDepositsWithProofs returns `numView` expected deposits with their
+	// merkle proofs against the deposit tree over the first `depositCount`
+	// deposits, along with the root of that tree.
+	GetDepositsWithProofs(
 		startIndex uint64,
 		numView uint64,
-	) ([]DepositT, error)
+		depositCount uint64,
+	) ([]DepositT, common.Root, error)
+	// GetDepositCount returns the number of deposits in the deposit tree.
+	GetDepositCount() (uint64, error)
+	// GetDepositRoot returns the root of the deposit tree over the first
+	// `depositCount` deposits.
+	GetDepositRoot(depositCount uint64) (common.Root, error)
 }
 
 // Eth1Data represents the eth1 data interface.
@@ -134,6 +156,12 @@ type Eth1Data[T any] interface {
 		depositCount math.U64,
 		blockHash common.ExecutionHash,
 	) T
+	// GetDepositRoot returns the deposit root.
+	GetDepositRoot() common.Root
+	// GetDepositCount returns the deposit count.
+	GetDepositCount() math.U64
+	// HashTreeRoot returns the hash tree root of the eth1 data.
+	HashTreeRoot() common.Root
 }
 
 // ExecutionPayloadHeader represents the execution payload header interface.
@@ -146,12 +174,39 @@ type ExecutionPayloadHeader interface {
 	GetParentHash() common.ExecutionHash
 }
 
+// ExecutionRequests represents the execution layer requests of a block.
+type ExecutionRequests[T any] interface {
+	// NewFromEncodedRequests decodes the requests returned by the execution
+	// client, as per EIP-7685.
+	NewFromEncodedRequests([][]byte) (T, error)
+}
+
 // EventPublisher represents the event publisher interface.
 type EventPublisher[T any] interface {
 	// PublishEvent publishes an event.
 	Publish(context.Context, T) error
 }
 
+// ExternalBuilder represents a client of an external block builder
+// implementing the builder API, such as an MEV-boost relay.
+type ExternalBuilder[
+	BeaconBlockT, BeaconStateT, ExecutionPayloadT any,
+] interface {
+	// RegisterValidator registers the fee recipient and gas limit of the
+	// validator with the external builder.
+	RegisterValidator(ctx context.Context) error
+	// GetPayload requests a bid for the payload of the given block. If the
+	// bid beats the value of the local payload, which is nil if it is not
+	// available, the blinded block is signed and submitted to the builder
+	// and the payload revealed by the builder is returned.
+	GetPayload(
+		ctx context.Context,
+		st BeaconStateT,
+		blk BeaconBlockT,
+		local engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
+}
+
 // ForkData represents the fork data interface.
 type ForkData[T any] interface {
 	// New creates a new fork data with the given parameters.
@@ -188,6 +243,27 @@ type PayloadBuilder[BeaconStateT, ExecutionPayloadT any] interface {
 	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error)
 }
 
+// RandaoSigner is implemented by signers that sign typed randao reveal
+// requests rather than raw signing roots, such as remote signers.
+type RandaoSigner interface {
+	// SignRandaoReveal signs the randao reveal for the given epoch, whose
+	// signing root is computed with the given fork version and genesis
+	// validators root.
+	SignRandaoReveal(
+		ctx context.Context,
+		forkVersion common.Version,
+		genesisValidatorsRoot common.Root,
+		epoch math.Epoch,
+		signingRoot common.Root,
+	) (crypto.BLSSignature, error)
+}
+
+// SlashingInfo represents the slashing info interface.
+type SlashingInfo interface {
+	// GetIndex returns the index of the misbehaving validator.
+	GetIndex() math.ValidatorIndex
+}
+
 // SlotData represents the slot data interface.
 type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	// GetSlot returns the slot of the incoming slot.
@@ -198,6 +274,19 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	GetSlashingInfo() []SlashingInfoT
 }
 
+// VoluntaryExit represents the voluntary exit interface.
+type VoluntaryExit interface {
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+}
+
+// VoluntaryExitPool provides the voluntary exits to include in blocks.
+type VoluntaryExitPool[BeaconStateT, VoluntaryExitT any] interface {
+	// Pending returns up to limit voluntary exits that can be included in a
+	// block on top of the given state.
+	Pending(st BeaconStateT, limit uint64) []VoluntaryExitT
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
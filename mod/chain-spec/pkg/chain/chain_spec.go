@@ -45,6 +45,18 @@ type Spec[
 	// calculations.
 	EffectiveBalanceIncrement() uint64
 
+	// HysteresisQuotient returns the divisor of the effective balance
+	// increment that yields the hysteresis increment.
+	HysteresisQuotient() uint64
+
+	// HysteresisDownwardMultiplier returns the number of hysteresis
+	// increments a balance must fall below the effective balance to lower it.
+	HysteresisDownwardMultiplier() uint64
+
+	// HysteresisUpwardMultiplier returns the number of hysteresis increments
+	// a balance must rise above the effective balance to raise it.
+	HysteresisUpwardMultiplier() uint64
+
 	// Time parameters constants.
 
 	// SlotsPerEpoch returns the number of slots in an epoch.
@@ -57,6 +69,36 @@ type Spec[
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
 
+	// EpochsPerEth1VotingPeriod returns the number of epochs in an eth1 data
+	// voting period.
+	EpochsPerEth1VotingPeriod() uint64
+
+	// MaxSeedLookahead returns the number of epochs after the current one at
+	// which queued activations and exits take effect.
+	MaxSeedLookahead() uint64
+
+	// MinValidatorWithdrawabilityDelay returns the number of epochs between
+	// the exit of a validator and the withdrawal of its stake.
+	MinValidatorWithdrawabilityDelay() uint64
+
+	// ShardCommitteePeriod returns the number of epochs a validator has to
+	// be active for before it can voluntarily exit.
+	ShardCommitteePeriod() uint64
+
+	// Validator Registry
+
+	// MinPerEpochChurnLimit returns the minimum number of validators that can
+	// be activated or exited per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the divisor of the active validator count
+	// that yields the number of validators activated or exited per epoch.
+	ChurnLimitQuotient() uint64
+
+	// ValidatorSetCap returns the maximum number of validators in the active
+	// set.
+	ValidatorSetCap() uint64
+
 	// Signature Domains
 
 	// DomainTypeProposer returns the domain for proposer signatures.
@@ -127,6 +169,10 @@ type Spec[
 
 	// Rewards and Penalties
 
+	// BaseRewardFactor returns the multiplier of the base reward a validator
+	// earns per epoch for voting in every block.
+	BaseRewardFactor() uint64
+
 	// InactivityPenaltyQuotient returns the inactivity penalty quotient.
 	InactivityPenaltyQuotient() uint64
 
@@ -134,6 +180,18 @@ type Spec[
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// MinSlashingPenaltyQuotient returns the quotient used to compute the
+	// initial penalty of a slashed validator.
+	MinSlashingPenaltyQuotient() uint64
+
+	// WhistleblowerRewardQuotient returns the quotient used to compute the
+	// whistleblower reward for a slashing.
+	WhistleblowerRewardQuotient() uint64
+
+	// ProposerRewardQuotient returns the quotient used to compute the share
+	// of the whistleblower reward paid out to the proposer.
+	ProposerRewardQuotient() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -245,6 +303,27 @@ func (c chainSpec[
 	return c.Data.EffectiveBalanceIncrement
 }
 
+// HysteresisQuotient returns the hysteresis quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisQuotient() uint64 {
+	return c.Data.HysteresisQuotient
+}
+
+// HysteresisDownwardMultiplier returns the hysteresis downward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisDownwardMultiplier() uint64 {
+	return c.Data.HysteresisDownwardMultiplier
+}
+
+// HysteresisUpwardMultiplier returns the hysteresis upward multiplier.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) HysteresisUpwardMultiplier() uint64 {
+	return c.Data.HysteresisUpwardMultiplier
+}
+
 // SlotsPerEpoch returns the number of slots per epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -267,6 +346,57 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// EpochsPerEth1VotingPeriod returns the number of epochs in an eth1 data
+// voting period.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EpochsPerEth1VotingPeriod() uint64 {
+	return c.Data.EpochsPerEth1VotingPeriod
+}
+
+// MaxSeedLookahead returns the maximum seed lookahead.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MaxSeedLookahead() uint64 {
+	return c.Data.MaxSeedLookahead
+}
+
+// MinValidatorWithdrawabilityDelay returns the minimum validator
+// withdrawability delay.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinValidatorWithdrawabilityDelay() uint64 {
+	return c.Data.MinValidatorWithdrawabilityDelay
+}
+
+// ShardCommitteePeriod returns the shard committee period.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ShardCommitteePeriod() uint64 {
+	return c.Data.ShardCommitteePeriod
+}
+
+// MinPerEpochChurnLimit returns the minimum per epoch churn limit.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the churn limit quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
+// ValidatorSetCap returns the maximum number of active validators.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ValidatorSetCap() uint64 {
+	return c.Data.ValidatorSetCap
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -402,6 +532,13 @@ func (c chainSpec[
 	return c.Data.ValidatorRegistryLimit
 }
 
+// BaseRewardFactor returns the base reward factor.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) BaseRewardFactor() uint64 {
+	return c.Data.BaseRewardFactor
+}
+
 // InactivityPenaltyQuotient returns the inactivity penalty quotient.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -416,6 +553,27 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinSlashingPenaltyQuotient returns the minimum slashing penalty quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinSlashingPenaltyQuotient() uint64 {
+	return c.Data.MinSlashingPenaltyQuotient
+}
+
+// WhistleblowerRewardQuotient returns the whistleblower reward quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) WhistleblowerRewardQuotient() uint64 {
+	return c.Data.WhistleblowerRewardQuotient
+}
+
+// ProposerRewardQuotient returns the proposer reward quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ProposerRewardQuotient() uint64 {
+	return c.Data.ProposerRewardQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
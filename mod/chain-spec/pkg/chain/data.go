@@ -42,6 +42,15 @@ type SpecData[
 	EjectionBalance uint64 `mapstructure:"ejection-balance"`
 	// EffectiveBalanceIncrement is the effective balance increment.
 	EffectiveBalanceIncrement uint64 `mapstructure:"effective-balance-increment"`
+	// HysteresisQuotient is the divisor of the effective balance increment
+	// that yields the hysteresis increment.
+	HysteresisQuotient uint64 `mapstructure:"hysteresis-quotient"`
+	// HysteresisDownwardMultiplier is the number of hysteresis increments a
+	// balance must fall below the effective balance to lower it.
+	HysteresisDownwardMultiplier uint64 `mapstructure:"hysteresis-downward-multiplier"`
+	// HysteresisUpwardMultiplier is the number of hysteresis increments a
+	// balance must rise above the effective balance to raise it.
+	HysteresisUpwardMultiplier uint64 `mapstructure:"hysteresis-upward-multiplier"`
 
 	// Time parameters constants.
 	//
@@ -52,6 +61,29 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// EpochsPerEth1VotingPeriod is the number of epochs in an eth1 data
+	// voting period.
+	EpochsPerEth1VotingPeriod uint64 `mapstructure:"epochs-per-eth1-voting-period"`
+	// MaxSeedLookahead is the number of epochs after the current one at which
+	// queued activations and exits take effect.
+	MaxSeedLookahead uint64 `mapstructure:"max-seed-lookahead"`
+	// MinValidatorWithdrawabilityDelay is the number of epochs between the
+	// exit of a validator and the withdrawal of its stake.
+	MinValidatorWithdrawabilityDelay uint64 `mapstructure:"min-validator-withdrawability-delay"`
+	// ShardCommitteePeriod is the number of epochs a validator has to be
+	// active for before it can voluntarily exit.
+	ShardCommitteePeriod uint64 `mapstructure:"shard-committee-period"`
+
+	// Validator registry values.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that can be
+	// activated or exited per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the divisor of the active validator count that
+	// yields the number of validators activated or exited per epoch.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+	// ValidatorSetCap is the maximum number of validators in the active set.
+	ValidatorSetCap uint64 `mapstructure:"validator-set-cap"`
 
 	// Signature domains.
 	//
@@ -111,11 +143,23 @@ type SpecData[
 
 	// Rewards and penalties constants.
 	//
+	// BaseRewardFactor is the multiplier of the base reward a validator
+	// earns per epoch for voting in every block.
+	BaseRewardFactor uint64 `mapstructure:"base-reward-factor"`
 	// InactivityPenaltyQuotient is the inactivity penalty quotient.
 	InactivityPenaltyQuotient uint64 `mapstructure:"inactivity-penalty-quotient"`
 	// ProportionalSlashingMultiplier is the slashing multiplier relative to the
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
+	// MinSlashingPenaltyQuotient is the divisor of the effective balance used
+	// to compute the initial penalty of a slashed validator.
+	MinSlashingPenaltyQuotient uint64 `mapstructure:"min-slashing-penalty-quotient"`
+	// WhistleblowerRewardQuotient is the divisor of the effective balance of a
+	// slashed validator used to compute the whistleblower reward.
+	WhistleblowerRewardQuotient uint64 `mapstructure:"whistleblower-reward-quotient"`
+	// ProposerRewardQuotient is the divisor of the whistleblower reward that
+	// is paid out to the proposer including the slashing.
+	ProposerRewardQuotient uint64 `mapstructure:"proposer-reward-quotient"`
 
 	// Capella Values
 	//
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagURL       = "url"
+	flagStateID   = "state-id"
+	flagStateFile = "state-file"
+	flagBlockFile = "block-file"
+	flagStateRoot = "state-root"
+
+	flagRPCServers = "rpc-servers"
+
+	defaultStateID = "finalized"
+)
+
+// Commands creates a new command for checkpoint sync related actions.
+func Commands(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "checkpoint",
+		Short:                      "checkpoint subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewSyncCommand(chainSpec),
+	)
+
+	return cmd
+}
+
+// NewSyncCommand creates a new command for bootstrapping a node from a trusted
+// checkpoint state.
+func NewSyncCommand(chainSpec common.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Bootstraps a fresh node from a trusted finalized beacon state",
+		Long: `Bootstraps a fresh node from a trusted finalized beacon state.
+The state and its block are fetched as SSZ from a trusted node's beacon API
+with --url, or read from --state-file and --block-file. The hash tree root of
+the state must equal --state-root and the block must commit to it.
+
+The CometBFT block at the height of the checkpoint is then fetched from the
+first of --rpc-servers, and must carry the checkpoint block. CometBFT state
+sync is enabled in the node's config, trusting that CometBFT block. On start,
+the node restores a state sync snapshot taken at or after the checkpoint by a
+peer, which must have state sync snapshots enabled in its app.toml. The
+restored application store reproduces the application hash of the network
+exactly, and the light client verifies it from the trusted block.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rootHex, err := cmd.Flags().GetString(flagStateRoot)
+			if err != nil {
+				return err
+			}
+			stateRoot, err := common.NewRootFromHex(rootHex)
+			if err != nil {
+				return err
+			}
+
+			cp, err := loadCheckpoint(cmd, chainSpec)
+			if err != nil {
+				return err
+			}
+			if err = cp.verify(stateRoot); err != nil {
+				return err
+			}
+			return configureStateSync(cmd, cp)
+		},
+	}
+
+	cmd.Flags().String(
+		flagURL, "", "Beacon API URL of the trusted node to fetch from",
+	)
+	cmd.Flags().String(
+		flagStateID, defaultStateID, "ID of the state to fetch from the node",
+	)
+	cmd.Flags().String(
+		flagStateFile, "", "Path to the SSZ encoded checkpoint beacon state",
+	)
+	cmd.Flags().String(
+		flagBlockFile, "", "Path to the SSZ encoded checkpoint block",
+	)
+	cmd.Flags().String(
+		flagStateRoot, "", "Trusted hash tree root of the checkpoint state",
+	)
+	cmd.Flags().StringSlice(
+		flagRPCServers, nil,
+		"CometBFT RPC servers of trusted nodes to verify the snapshot with",
+	)
+	//nolint:errcheck // the flags are defined above.
+	cmd.MarkFlagRequired(flagStateRoot)
+	//nolint:errcheck // the flags are defined above.
+	cmd.MarkFlagRequired(flagRPCServers)
+
+	return cmd
+}
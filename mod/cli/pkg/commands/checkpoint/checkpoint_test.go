@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package checkpoint_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/checkpoint"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+const testSlot = 5
+
+var testTrustHash = common.Root{0xaa, 0xbb}
+
+// writeCheckpoint writes the SSZ encodings of a checkpoint state at testSlot
+// and of its block to the given directory, and returns the state root and
+// the encoding of the block.
+func writeCheckpoint(t *testing.T, dir string) (common.Root, []byte) {
+	t.Helper()
+	header, err := types.DefaultGenesisExecutionPayloadHeaderDeneb()
+	require.NoError(t, err)
+	state := &components.BeaconStateMarshallable{
+		Slot: testSlot,
+		Fork: &types.Fork{},
+		LatestBlockHeader: types.NewBeaconBlockHeader(
+			testSlot, 0, common.Root{}, common.Root{}, common.Root{},
+		),
+		Eth1Data:                     &types.Eth1Data{},
+		LatestExecutionPayloadHeader: header,
+	}
+	stateRoot := state.HashTreeRoot()
+
+	blk, err := new(components.BeaconBlock).NewWithVersion(
+		testSlot, 0, common.Root{}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blk.Body.Eth1Data = &types.Eth1Data{}
+	blk.Body.ExecutionPayload = &types.ExecutionPayload{
+		ExtraData:     []byte{},
+		BaseFeePerGas: math.NewU256(0),
+	}
+	blk.SetStateRoot(stateRoot)
+
+	stateBz, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	blockBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "state.ssz"), stateBz, 0o600,
+	))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "block.ssz"), blockBz, 0o600,
+	))
+	return stateRoot, blockBz
+}
+
+// newCometServer returns a CometBFT RPC server serving a block at testSlot
+// with the given transactions.
+func newCometServer(t *testing.T, txs ...[]byte) *httptest.Server {
+	t.Helper()
+	encodedTxs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		encodedTxs = append(encodedTxs, base64.StdEncoding.EncodeToString(tx))
+	}
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID json.RawMessage `json:"id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			//nolint:errcheck // test server.
+			json.NewEncoder(w).Encode(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+				"result": map[string]any{
+					"block_id": map[string]any{
+						"hash": testTrustHash.Hex()[2:],
+						"parts": map[string]any{
+							"total": 0, "hash": "",
+						},
+					},
+					"block": map[string]any{
+						"header": map[string]any{
+							"height": "5",
+							"time":   "2024-01-01T00:00:00Z",
+						},
+						"data": map[string]any{"txs": encodedTxs},
+					},
+				},
+			})
+		},
+	))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// runSync runs the sync command in a fresh home directory with the given
+// arguments, and returns the CometBFT config written by it.
+func runSync(
+	t *testing.T, home string, args ...string,
+) (*cmtcfg.Config, error) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o700))
+	serverCtx := server.NewContext(
+		viper.New(), cmtcfg.DefaultConfig(), nil,
+	)
+	serverCtx.Config.SetRoot(home)
+
+	cmd := checkpoint.NewSyncCommand(spec.DevnetChainSpec())
+	require.NoError(t, server.SetCmdServerContext(cmd, serverCtx))
+	cmd.SetArgs(args)
+	cmd.SetOut(new(strings.Builder))
+	if err := cmd.Execute(); err != nil {
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(home, "config", "config.toml"))
+	require.NoError(t, v.ReadInConfig())
+	cfg := cmtcfg.DefaultConfig()
+	require.NoError(t, v.Unmarshal(cfg))
+	return cfg, nil
+}
+
+func TestSyncCommand(t *testing.T) {
+	home := t.TempDir()
+	stateRoot, blockBz := writeCheckpoint(t, home)
+	srv := newCometServer(t, blockBz, []byte("sidecars"))
+
+	cfg, err := runSync(t, home,
+		"--state-file", filepath.Join(home, "state.ssz"),
+		"--block-file", filepath.Join(home, "block.ssz"),
+		"--state-root", stateRoot.Hex(),
+		"--rpc-servers", srv.URL,
+	)
+	require.NoError(t, err)
+	require.True(t, cfg.StateSync.Enable)
+	require.Equal(t, int64(testSlot), cfg.StateSync.TrustHeight)
+	require.Equal(
+		t, strings.ToUpper(testTrustHash.Hex()[2:]), cfg.StateSync.TrustHash,
+	)
+	require.Equal(t, []string{srv.URL, srv.URL}, cfg.StateSync.RPCServers)
+}
+
+func TestSyncCommandErrors(t *testing.T) {
+	home := t.TempDir()
+	stateRoot, blockBz := writeCheckpoint(t, home)
+	args := func(stateRoot common.Root, rpcServer string) []string {
+		return []string{
+			"--state-file", filepath.Join(home, "state.ssz"),
+			"--block-file", filepath.Join(home, "block.ssz"),
+			"--state-root", stateRoot.Hex(),
+			"--rpc-servers", rpcServer,
+		}
+	}
+
+	// The state must have the trusted root.
+	srv := newCometServer(t, blockBz)
+	_, err := runSync(t, home, args(common.Root{0x01}, srv.URL)...)
+	require.ErrorIs(t, err, checkpoint.ErrStateRootMismatch)
+
+	// The trusted CometBFT block must carry the checkpoint block.
+	srv = newCometServer(t, []byte("other block"))
+	_, err = runSync(t, home, args(stateRoot, srv.URL)...)
+	require.ErrorIs(t, err, checkpoint.ErrBlockMismatch)
+
+	srv = newCometServer(t)
+	_, err = runSync(t, home, args(stateRoot, srv.URL)...)
+	require.ErrorIs(t, err, checkpoint.ErrBlockMismatch)
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import "errors"
+
+var (
+	// ErrNoCheckpointSource is returned when neither a trusted node URL nor
+	// local state and block files are provided.
+	ErrNoCheckpointSource = errors.New(
+		"either a trusted node url or state and block files are required",
+	)
+
+	// ErrFetchFailed is returned when the trusted node does not serve a
+	// requested checkpoint object.
+	ErrFetchFailed = errors.New("failed to fetch checkpoint from trusted node")
+
+	// ErrStateRootMismatch is returned when the hash tree root of the
+	// checkpoint state does not match the trusted state root.
+	ErrStateRootMismatch = errors.New("checkpoint state root mismatch")
+
+	// ErrBlockMismatch is returned when the checkpoint block does not commit
+	// to the checkpoint state.
+	ErrBlockMismatch = errors.New("checkpoint block does not match state")
+
+	// ErrNoRPCServers is returned when no CometBFT RPC server is given to
+	// state sync from.
+	ErrNoRPCServers = errors.New(
+		"at least one trusted cometbft rpc server is required",
+	)
+)
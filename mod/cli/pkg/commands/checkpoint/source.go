@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package checkpoint
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// statePath is the beacon API path serving beacon states.
+	statePath = "/eth/v2/debug/beacon/states/"
+	// blockPath is the beacon API path serving beacon blocks.
+	blockPath = "/eth/v2/beacon/blocks/"
+)
+
+// checkpoint is a beacon state together with the block that committed it.
+type checkpoint struct {
+	state *components.BeaconStateMarshallable
+	block *components.BeaconBlock
+}
+
+// loadCheckpoint reads the checkpoint from the trusted node or the local files
+// given on the command line.
+func loadCheckpoint(
+	cmd *cobra.Command,
+	cs common.ChainSpec,
+) (*checkpoint, error) {
+	url, err := cmd.Flags().GetString(flagURL)
+	if err != nil {
+		return nil, err
+	}
+	stateFile, err := cmd.Flags().GetString(flagStateFile)
+	if err != nil {
+		return nil, err
+	}
+	blockFile, err := cmd.Flags().GetString(flagBlockFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var stateBz, blockBz []byte
+	switch {
+	case url != "":
+		stateID, idErr := cmd.Flags().GetString(flagStateID)
+		if idErr != nil {
+			return nil, idErr
+		}
+		url = strings.TrimSuffix(url, "/")
+		if stateBz, err = fetch(cmd.Context(), url+statePath+stateID); err != nil {
+			return nil, err
+		}
+	case stateFile != "" && blockFile != "":
+		fs := afero.NewOsFs()
+		if stateBz, err = afero.ReadFile(fs, stateFile); err != nil {
+			return nil, errors.Wrap(err, "failed to read state file")
+		}
+		if blockBz, err = afero.ReadFile(fs, blockFile); err != nil {
+			return nil, errors.Wrap(err, "failed to read block file")
+		}
+	default:
+		return nil, ErrNoCheckpointSource
+	}
+
+	cp := &checkpoint{state: new(components.BeaconStateMarshallable)}
+	if err = cp.state.UnmarshalSSZ(stateBz); err != nil {
+		return nil, errors.Wrap(err, "failed to decode checkpoint state")
+	}
+
+	// The block is fetched once the slot of the state is known.
+	if blockBz == nil {
+		blockBz, err = fetch(
+			cmd.Context(),
+			url+blockPath+strconv.FormatUint(cp.state.Slot.Unwrap(), 10),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	cp.block, err = decodeBlock(blockBz, cs, cp.state.Slot)
+	return cp, err
+}
+
+// verify checks that the checkpoint state has the trusted root and that the
+// checkpoint block commits to it.
+func (cp *checkpoint) verify(stateRoot common.Root) error {
+	if root := cp.state.HashTreeRoot(); root != stateRoot {
+		return errors.Wrapf(
+			ErrStateRootMismatch, "expected %s, got %s", stateRoot, root,
+		)
+	}
+	if cp.block.GetSlot() != cp.state.Slot {
+		return errors.Wrapf(
+			ErrBlockMismatch, "block slot %d, state slot %d",
+			cp.block.GetSlot(), cp.state.Slot,
+		)
+	}
+	if cp.block.GetStateRoot() != stateRoot {
+		return errors.Wrapf(
+			ErrBlockMismatch, "block state root %s", cp.block.GetStateRoot(),
+		)
+	}
+	return nil
+}
+
+// decodeBlock decodes an SSZ encoded beacon block, using the fork active at
+// the given slot.
+func decodeBlock(
+	bz []byte,
+	cs common.ChainSpec,
+	slot math.Slot,
+) (*components.BeaconBlock, error) {
+	blk, err := new(components.BeaconBlock).NewFromSSZ(
+		bz, cs.ActiveForkVersionForSlot(slot),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode checkpoint block")
+	}
+	return blk, nil
+}
+
+// fetch requests the SSZ encoding of an object from the trusted node.
+func fetch(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", types.ContentTypeSSZ)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return nil, errors.Wrapf(
+			ErrFetchFailed, "%s: status %d: %s",
+			url, resp.StatusCode, strings.TrimSpace(string(msg)),
+		)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// configureStateSync enables CometBFT state sync in the config of the node,
+// trusting the CometBFT block that carries the checkpoint block. On start,
+// the node restores a state sync snapshot taken at or after the checkpoint,
+// which the light client verifies from that trusted block.
+func configureStateSync(cmd *cobra.Command, cp *checkpoint) error {
+	rpcServers, err := cmd.Flags().GetStringSlice(flagRPCServers)
+	if err != nil {
+		return err
+	}
+	if len(rpcServers) == 0 {
+		return ErrNoRPCServers
+	}
+
+	//#nosec:G701 // slots are heights, which fit in an int64.
+	height := int64(cp.block.GetSlot().Unwrap())
+	trustHash, err := trustedBlockHash(
+		cmd.Context(), rpcServers[0], height, cp,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The light client needs a witness besides its primary, a single
+	// trusted server is used as both.
+	if len(rpcServers) == 1 {
+		rpcServers = append(rpcServers, rpcServers[0])
+	}
+
+	cfg := server.GetServerContextFromCmd(cmd).Config
+	cfg.StateSync.Enable = true
+	cfg.StateSync.RPCServers = rpcServers
+	cfg.StateSync.TrustHeight = height
+	cfg.StateSync.TrustHash = trustHash
+	if err = cfg.StateSync.ValidateBasic(); err != nil {
+		return err
+	}
+	cmtcfg.WriteConfigFile(
+		filepath.Join(cfg.RootDir, "config", "config.toml"), cfg,
+	)
+
+	cmd.Printf(
+		"configured state sync from checkpoint at slot %d, trust hash %s\n",
+		height, trustHash,
+	)
+	return nil
+}
+
+// trustedBlockHash returns the hash of the CometBFT block at the given
+// height, served by the given CometBFT RPC server, after checking that the
+// block carries the checkpoint block.
+func trustedBlockHash(
+	ctx context.Context,
+	rpcServer string,
+	height int64,
+	cp *checkpoint,
+) (string, error) {
+	blockBz, err := cp.block.MarshalSSZ()
+	if err != nil {
+		return "", err
+	}
+
+	client, err := rpchttp.New(rpcServer)
+	if err != nil {
+		return "", err
+	}
+	res, err := client.Block(ctx, &height)
+	if err != nil {
+		return "", errors.Wrapf(
+			ErrFetchFailed, "cometbft block %d: %s", height, err,
+		)
+	}
+
+	txs := res.Block.Txs
+	if uint(len(txs)) <= middleware.BeaconBlockTxIndex ||
+		!bytes.Equal(txs[middleware.BeaconBlockTxIndex], blockBz) {
+		return "", errors.Wrapf(
+			ErrBlockMismatch,
+			"cometbft block %d does not carry the checkpoint block", height,
+		)
+	}
+	return res.BlockID.Hash.String(), nil
+}
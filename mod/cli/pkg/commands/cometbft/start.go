@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servercmtlog "github.com/cosmos/cosmos-sdk/server/log"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"golang.org/x/sync/errgroup"
+)
+
+// flagTraceStore is the start command flag of the file the store operations
+// are traced to.
+const flagTraceStore = "trace-store"
+
+// StartInProcess is the handler of the start command. It starts the node
+// with CometBFT in-process like the default handler, but registers the p2p
+// reactors of the node with the CometBFT switch. The gRPC and API servers of
+// the Cosmos SDK are not started, as the node does not serve them.
+func StartInProcess[T types.Node](
+	svrCtx *server.Context,
+	clientCtx client.Context,
+	appCreator servertypes.AppCreator[T],
+	withCmt bool,
+	opts server.StartCmdOptions[T],
+) error {
+	if !withCmt {
+		return ErrStandaloneUnsupported
+	}
+
+	svrCfg, err := serverconfig.GetConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if err = svrCfg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	traceWriter, traceCleanupFn, err := server.SetupTraceWriter(
+		svrCtx.Logger, svrCtx.Viper.GetString(flagTraceStore),
+	)
+	if err != nil {
+		return err
+	}
+	defer traceCleanupFn()
+
+	cfg := svrCtx.Config
+	db, err := opts.DBOpener(
+		cfg.RootDir, server.GetAppDBBackend(svrCtx.Viper),
+	)
+	if err != nil {
+		return err
+	}
+	app := appCreator(svrCtx.Logger, db, traceWriter, svrCtx.Viper)
+	defer func() {
+		if err = app.Close(); err != nil {
+			svrCtx.Logger.Error(err.Error())
+		}
+	}()
+
+	if _, err = telemetry.New(svrCfg.Telemetry); err != nil {
+		return err
+	}
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g, ctx := errgroup.WithContext(ctx)
+	server.ListenForQuitSignals(g, true, cancel, svrCtx.Logger)
+
+	svrCtx.Logger.Info("starting node with ABCI CometBFT in-process")
+	cmtNode, err := node.NewNode(
+		ctx,
+		cfg,
+		privval.LoadOrGenFilePV(
+			cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile(),
+		),
+		nodeKey,
+		proxy.NewLocalClientCreator(server.NewCometABCIWrapper(app)),
+		genesisDocProvider(cfg),
+		cmtcfg.DefaultDBProvider,
+		node.DefaultMetricsProvider(cfg.Instrumentation),
+		servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger},
+		node.CustomReactors(app.Reactors()),
+	)
+	if err != nil {
+		return err
+	}
+	if err = cmtNode.Start(); err != nil {
+		return err
+	}
+	defer func() {
+		if cmtNode.IsRunning() {
+			_ = cmtNode.Stop()
+		}
+	}()
+
+	if opts.PostSetup != nil {
+		if err = opts.PostSetup(app, svrCtx, clientCtx, ctx, g); err != nil {
+			return err
+		}
+	}
+
+	// Wait for a quit signal.
+	return g.Wait()
+}
+
+// genesisDocProvider returns the genesis doc of the genesis file, along with
+// its checksum.
+func genesisDocProvider(
+	cfg *cmtcfg.Config,
+) func() (node.ChecksummedGenesisDoc, error) {
+	return func() (node.ChecksummedGenesisDoc, error) {
+		appGenesis, err := genutiltypes.AppGenesisFromFile(cfg.GenesisFile())
+		if err != nil {
+			return node.ChecksummedGenesisDoc{}, err
+		}
+		gen, err := appGenesis.ToGenesisDoc()
+		if err != nil {
+			return node.ChecksummedGenesisDoc{}, err
+		}
+		genBz, err := gen.AppState.MarshalJSON()
+		if err != nil {
+			return node.ChecksummedGenesisDoc{}, err
+		}
+		bz, err := json.Marshal(genBz)
+		if err != nil {
+			return node.ChecksummedGenesisDoc{}, err
+		}
+		sum := sha256.Sum256(bz)
+		return node.ChecksummedGenesisDoc{
+			GenesisDoc:     gen,
+			Sha256Checksum: sum[:],
+		}, nil
+	}
+}
@@ -127,7 +127,7 @@ func executableDataToExecutionPayloadHeader(
 ) *types.ExecutionPayloadHeader {
 	var executionPayloadHeader *types.ExecutionPayloadHeader
 	switch forkVersion {
-	case version.Deneb, version.DenebPlus:
+	case version.Deneb, version.DenebPlus, version.Electra:
 		withdrawals := make(
 			[]*engineprimitives.Withdrawal,
 			len(data.Withdrawals),
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package prune
+
+import (
+	clicomponents "github.com/berachain/beacon-kit/mod/cli/pkg/components"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/cosmos/cosmos-sdk/client/pruning"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+)
+
+// prunableStore is a store pruned by its retention policy.
+type prunableStore struct {
+	name     string
+	prunable pruner.Prunable
+	windowFn func(*config.Config, common.ChainSpec) (uint64, error)
+}
+
+// Commands creates a new command for pruning the stores of a stopped node.
+// The pruning of the application state is available as a subcommand.
+func Commands[T types.Node](
+	chainSpec common.ChainSpec,
+	appCreator servertypes.AppCreator[T],
+) *cobra.Command {
+	stateCmd := pruning.Cmd(appCreator)
+	stateCmd.Use = "state [pruning-method]"
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prunes the block, blob and deposit stores of a stopped node",
+		Long: `Prunes the block, blob and deposit stores of a stopped node.
+Each store is pruned by its retention policy in app.toml, up to the last block
+finalized by the node, resuming from where the node left off. This applies a
+stricter policy right away, rather than as the node finalizes new blocks.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return pruneStores(cmd, chainSpec)
+		},
+	}
+	cmd.AddCommand(stateCmd)
+
+	return cmd
+}
+
+// pruneStores prunes each store by its retention policy.
+func pruneStores(cmd *cobra.Command, chainSpec common.ChainSpec) error {
+	appOpts := server.GetServerContextFromCmd(cmd).Viper
+	cfg, err := config.ReadConfigFromAppOpts(appOpts)
+	if err != nil {
+		return err
+	}
+	logger := clicomponents.ProvideLogger(
+		clicomponents.LoggerInput{Out: cmd.OutOrStdout()},
+	)
+
+	checkpoints, err := components.ProvidePruneCheckpoints(
+		components.PruneCheckpointsInput[components.LoggerT]{
+			AppOpts: appOpts,
+			Logger:  logger,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	stores, err := openStores(appOpts, chainSpec, cfg, logger)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		window, windowErr := store.windowFn(cfg, chainSpec)
+		if windowErr != nil {
+			return windowErr
+		}
+		if err = pruner.Compact(
+			store.prunable, checkpoints, store.name, window,
+		); err != nil {
+			return err
+		}
+
+		cursor, ok, cursorErr := checkpoints.Cursor(store.name)
+		switch {
+		case cursorErr != nil:
+			return cursorErr
+		case ok:
+			cmd.Printf("%s: pruned up to index %d\n", store.name, cursor)
+		default:
+			cmd.Printf("%s: nothing to prune\n", store.name)
+		}
+	}
+	return nil
+}
+
+// openStores opens the block, blob and deposit stores of the node.
+func openStores(
+	appOpts servertypes.AppOptions,
+	chainSpec common.ChainSpec,
+	cfg *config.Config,
+	logger components.LoggerT,
+) ([]prunableStore, error) {
+	blockStore, err := components.ProvideBlockStore(
+		components.BlockStoreInput[components.LoggerT]{
+			AppOpts:   appOpts,
+			ChainSpec: chainSpec,
+			Logger:    logger,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	availabilityStore, err := components.ProvideAvailibilityStore(
+		components.AvailabilityStoreInput[components.LoggerT]{
+			AppOpts:   appOpts,
+			ChainSpec: chainSpec,
+			Cfg:       cfg,
+			Logger:    logger,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	rangeDB, ok := availabilityStore.IndexDB.(*components.IndexDB)
+	if !ok {
+		return nil, ErrNoRangeDB
+	}
+	depositStore, err := components.ProvideDepositStore(
+		components.DepositStoreInput{AppOpts: appOpts},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []prunableStore{
+		{
+			name:     manager.BlockPrunerName,
+			prunable: blockStore,
+			windowFn: components.BlockRetentionWindow,
+		},
+		{
+			name:     manager.AvailabilityPrunerName,
+			prunable: rangeDB,
+			windowFn: components.AvailabilityRetentionWindow,
+		},
+		{
+			name:     manager.DepositPrunerName,
+			prunable: depositStore,
+			windowFn: components.DepositRetentionWindow,
+		},
+	}, nil
+}
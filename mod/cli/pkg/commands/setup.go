@@ -22,17 +22,18 @@ package commands
 
 import (
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/checkpoint"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/prune"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -51,11 +52,14 @@ func DefaultRootCommandSetup[
 ) {
 	// Setup the custom start command options.
 	startCmdOptions := server.StartCmdOptions[T]{
-		AddFlags: flags.AddBeaconKitFlags,
+		AddFlags:            flags.AddBeaconKitFlags,
+		StartCommandHandler: cometbft.StartInProcess[T],
 	}
 
 	// Add all the commands to the root command.
 	root.cmd.AddCommand(
+		// `checkpoint`
+		checkpoint.Commands(chainSpec),
 		// `comet`
 		cometbft.Commands(appCreator),
 		// `config`
@@ -71,7 +75,7 @@ func DefaultRootCommandSetup[
 		// `keys`
 		keys.Commands(),
 		// `prune`
-		pruning.Cmd(appCreator),
+		prune.Commands(chainSpec, appCreator),
 		// `rollback`
 		server.NewRollbackCmd(appCreator),
 		// `start`
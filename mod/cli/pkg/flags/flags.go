@@ -49,6 +49,7 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	RPCBroadcast            = engineRoot + "rpc-broadcast"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -66,8 +67,6 @@ const (
 	BlockStoreServiceEnabled       = blockStoreServiceRoot + "enabled"
 	BlockStoreServicePrunerEnabled = blockStoreServiceRoot +
 		"pruner-enabled"
-	BlockStoreServiceAvailabilityWindow = blockStoreServiceRoot +
-		"availability-window"
 
 	// Node API Config.
 	nodeAPIRoot    = beaconKitRoot + "node-api."
@@ -103,6 +102,11 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Bool(
+		RPCBroadcast,
+		defaultCfg.Engine.RPCBroadcast,
+		"broadcast newPayload and forkchoiceUpdated to all endpoints",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
@@ -143,11 +147,6 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.BlockStoreService.PrunerEnabled,
 		"block service pruner enabled",
 	)
-	startCmd.Flags().Uint64(
-		BlockStoreServiceAvailabilityWindow,
-		defaultCfg.BlockStoreService.AvailabilityWindow,
-		"block service availability window",
-	)
 	startCmd.Flags().Bool(
 		NodeAPIEnabled,
 		defaultCfg.NodeAPI.Enabled,
@@ -31,6 +31,9 @@ import (
 	log "github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/builder"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -50,6 +53,9 @@ func DefaultConfig() *Config {
 		Validator:         validator.DefaultConfig(),
 		BlockStoreService: blockstore.DefaultConfig(),
 		NodeAPI:           server.DefaultConfig(),
+		Archive:           archive.DefaultConfig(),
+		BlobStore:         filedb.DefaultConfig(),
+		Retention:         pruner.DefaultRetentionConfig(),
 	}
 }
 
@@ -69,6 +75,12 @@ type Config struct {
 	BlockStoreService blockstore.Config `mapstructure:"block-store-service"`
 	// NodeAPI is the configuration for the node API.
 	NodeAPI server.Config `mapstructure:"node-api"`
+	// Archive is the configuration for the historical beacon state archive.
+	Archive archive.Config `mapstructure:"archive"`
+	// BlobStore is the configuration for the blob sidecar file database.
+	BlobStore filedb.Config `mapstructure:"blob-store"`
+	// Retention is the retention policy of the blocks, blobs and deposits.
+	Retention pruner.RetentionConfig `mapstructure:"retention"`
 }
 
 // GetEngine returns the execution client configuration.
@@ -60,14 +60,21 @@ func BaseSpec() chain.SpecData[
 		any,
 	]{
 		// // Gwei value constants.
-		MinDepositAmount:          uint64(1e9),
-		MaxEffectiveBalance:       uint64(32e9),
-		EjectionBalance:           uint64(16e9),
-		EffectiveBalanceIncrement: uint64(1e9),
+		MinDepositAmount:             uint64(1e9),
+		MaxEffectiveBalance:          uint64(32e9),
+		EjectionBalance:              uint64(16e9),
+		EffectiveBalanceIncrement:    uint64(1e9),
+		HysteresisQuotient:           4,
+		HysteresisDownwardMultiplier: 1,
+		HysteresisUpwardMultiplier:   5,
 		// Time parameters constants.
-		SlotsPerEpoch:                32,
-		MinEpochsToInactivityPenalty: 4,
-		SlotsPerHistoricalRoot:       8,
+		SlotsPerEpoch:                    32,
+		MinEpochsToInactivityPenalty:     4,
+		EpochsPerEth1VotingPeriod:        4,
+		SlotsPerHistoricalRoot:           8,
+		MaxSeedLookahead:                 4,
+		MinValidatorWithdrawabilityDelay: 256,
+		ShardCommitteePeriod:             256,
 		// Signature domains.
 		DomainTypeProposer: common.DomainType{
 			0x00, 0x00, 0x00, 0x00,
@@ -93,6 +100,10 @@ func BaseSpec() chain.SpecData[
 		DomainTypeApplicationMask: common.DomainType{
 			0x00, 0x00, 0x00, 0x01,
 		},
+		// Validator registry values.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
+		ValidatorSetCap:       256,
 		// Eth1-related values.
 		DepositContractAddress: common.NewExecutionAddressFromHex(
 			"0x4242424242424242424242424242424242424242",
@@ -110,8 +121,14 @@ func BaseSpec() chain.SpecData[
 		ValidatorRegistryLimit:    1099511627776,
 		// Max operations per block constants.
 		MaxDepositsPerBlock: 16,
+		// Rewards and penalties.
+		BaseRewardFactor:          64,
+		InactivityPenaltyQuotient: 1 << 24,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		MinSlashingPenaltyQuotient:     32,
+		WhistleblowerRewardQuotient:    512,
+		ProposerRewardQuotient:         8,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
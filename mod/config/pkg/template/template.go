@@ -45,6 +45,24 @@ rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
+# If enabled, newPayload and forkchoiceUpdated calls are sent to every
+# endpoint, while payloads are only built on the active endpoint.
+rpc-broadcast = {{ .BeaconKit.Engine.RPCBroadcast }}
+
+# Backup execution client endpoints, in order of preference, that requests are
+# failed over to when the primary endpoint is unhealthy. An endpoint without a
+# JWT-secret path uses the JWT-secret of the primary endpoint. For example:
+#
+# [[beacon-kit.engine.backup-endpoints]]
+# rpc-dial-url = "http://localhost:8552"
+# jwt-secret-path = "./jwt-backup.hex"
+{{- range .BeaconKit.Engine.BackupEndpoints }}
+
+[[beacon-kit.engine.backup-endpoints]]
+rpc-dial-url = "{{ .RPCDialURL }}"
+jwt-secret-path = "{{ .JWTSecretPath }}"
+{{- end }}
+
 [beacon-kit.logger]
 # TimeFormat is a string that defines the format of the time in the logger.
 time-format = "{{.BeaconKit.Logger.TimeFormat}}"
@@ -61,9 +79,14 @@ style = "{{.BeaconKit.Logger.Style}}"
 trusted-setup-path = "{{.BeaconKit.KZG.TrustedSetupPath}}"
 
 # KZG implementation to use.
-# Options are "crate-crypto/go-kzg-4844" or "ethereum/c-kzg-4844".
+# Options are "crate-crypto/go-kzg-4844", "ethereum/c-kzg-4844" or "auto", which
+# benchmarks the implementations at startup and uses the fastest.
 implementation = "{{.BeaconKit.KZG.Implementation}}"
 
+# Number of verified KZG proofs to cache, so that the proofs of sidecars are not
+# verified again. Zero disables the cache.
+verification-cache-size = {{.BeaconKit.KZG.VerificationCacheSize}}
+
 [beacon-kit.payload-builder]
 # Enabled determines if the local payload builder is enabled.
 enabled = {{ .BeaconKit.PayloadBuilder.Enabled }}
@@ -85,6 +108,51 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+[beacon-kit.validator.remote-signer]
+# Enabled determines if a remote signer implementing the Web3Signer ETH2 API is
+# used instead of the local validator key.
+enabled = "{{.BeaconKit.Validator.RemoteSigner.Enabled}}"
+
+# Base url of the remote signer.
+url = "{{.BeaconKit.Validator.RemoteSigner.URL}}"
+
+# Public key of the validator key held by the remote signer. If empty, the
+# remote signer must hold exactly one key.
+public-key = "{{.BeaconKit.Validator.RemoteSigner.PublicKey}}"
+
+# Timeout for requests to the remote signer. The default timeout is used if it
+# is not positive.
+timeout = "{{.BeaconKit.Validator.RemoteSigner.Timeout}}"
+
+# Paths to the TLS client certificate and its key.
+tls-cert-path = "{{.BeaconKit.Validator.RemoteSigner.TLSCertPath}}"
+tls-key-path = "{{.BeaconKit.Validator.RemoteSigner.TLSKeyPath}}"
+
+# Path to the CA certificate the remote signer's certificate is verified
+# against. The system roots are used if empty.
+tls-ca-path = "{{.BeaconKit.Validator.RemoteSigner.TLSCAPath}}"
+
+[beacon-kit.validator.external-builder]
+# Enabled determines if payloads are requested from an external block builder
+# implementing the builder API, such as an MEV-boost relay. The local signing
+# key must be used to sign the builder API messages.
+enabled = "{{.BeaconKit.Validator.ExternalBuilder.Enabled}}"
+
+# Base url of the external builder.
+url = "{{.BeaconKit.Validator.ExternalBuilder.URL}}"
+
+# Timeout for requests to the external builder. The local payload is proposed
+# if the builder does not respond in time. The default timeout is used if it is
+# not positive.
+timeout = "{{.BeaconKit.Validator.ExternalBuilder.Timeout}}"
+
+# Minimum value in gwei a bid of the external builder must have to be proposed
+# instead of the local payload.
+min-bid-gwei = {{.BeaconKit.Validator.ExternalBuilder.MinBidGwei}}
+
+# Gas limit the validator registers with the external builder.
+gas-limit = {{.BeaconKit.Validator.ExternalBuilder.GasLimit}}
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -92,9 +160,6 @@ enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
 # PrunerEnabled determines if the block pruner is enabled.
 pruner-enabled = "{{ .BeaconKit.BlockStoreService.PrunerEnabled }}"
 
-# AvailabilityWindow is the number of slots to keep in the store.
-availability-window = "{{ .BeaconKit.BlockStoreService.AvailabilityWindow }}"
-
 [beacon-kit.node-api]
 # Enabled determines if the node API is enabled.
 enabled = "{{ .BeaconKit.NodeAPI.Enabled }}"
@@ -104,4 +169,61 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+[beacon-kit.archive]
+# Enabled determines if historical beacon states are archived. Requires the
+# block store service to be enabled, and disables block pruning.
+enabled = "{{ .BeaconKit.Archive.Enabled }}"
+
+# SnapshotInterval is the number of slots between two archived state
+# snapshots. The states in between are regenerated by replaying blocks.
+snapshot-interval = "{{ .BeaconKit.Archive.SnapshotInterval }}"
+
+# CacheSize is the number of recently regenerated states kept in memory.
+cache-size = "{{ .BeaconKit.Archive.CacheSize }}"
+
+[beacon-kit.retention]
+# The retention policy of each store is one of "keep-last-slots", which keeps
+# the data of the last number of slots, "keep-epochs", which keeps the data of
+# the last number of epochs, or "archive", which keeps all data. Pruning
+# resumes from where it left off after a restart, and "beacond prune" applies
+# the policies while the node is stopped.
+
+[beacon-kit.retention.blocks]
+# Policy is the retention policy of the block store. Blocks are always
+# archived when the state archive is enabled.
+policy = "{{ .BeaconKit.Retention.Blocks.Policy }}"
+
+# Slots is the number of slots kept by the keep-last-slots policy.
+slots = {{ .BeaconKit.Retention.Blocks.Slots }}
+
+# Epochs is the number of epochs kept by the keep-epochs policy.
+epochs = {{ .BeaconKit.Retention.Blocks.Epochs }}
+
+[beacon-kit.retention.blobs]
+# Policy is the retention policy of the blob sidecars. Blob sidecars within
+# the data availability window of the chain are always kept.
+policy = "{{ .BeaconKit.Retention.Blobs.Policy }}"
+
+# Slots is the number of slots kept by the keep-last-slots policy.
+slots = {{ .BeaconKit.Retention.Blobs.Slots }}
+
+# Epochs is the number of epochs kept by the keep-epochs policy.
+epochs = {{ .BeaconKit.Retention.Blobs.Epochs }}
+
+[beacon-kit.retention.deposits]
+# Policy is the retention policy of the deposits, which are kept from the slot
+# of the block that processed them.
+policy = "{{ .BeaconKit.Retention.Deposits.Policy }}"
+
+# Slots is the number of slots kept by the keep-last-slots policy.
+slots = {{ .BeaconKit.Retention.Deposits.Slots }}
+
+# Epochs is the number of epochs kept by the keep-epochs policy.
+epochs = {{ .BeaconKit.Retention.Deposits.Epochs }}
+
+[beacon-kit.blob-store]
+# Fsync determines if blob sidecars are synced to disk before they are
+# reported as written. This survives power loss at the cost of write latency.
+fsync = "{{ .BeaconKit.BlobStore.Fsync }}"
 `
@@ -48,7 +48,7 @@ type AttestationData struct {
 	Slot math.U64 `json:"slot"`
 	// Index is the index of the validator.
 	Index math.U64 `json:"index"`
-	// BeaconBlockRoot is the root of the beacon block.
+	// BeaconBlockRoot is the root of the beacon block the vote was cast for.
 	BeaconBlockRoot common.Root `json:"beaconBlockRoot"`
 }
 
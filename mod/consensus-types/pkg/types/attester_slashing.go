@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// AttesterSlashingSize is the size of the AttesterSlashing object in bytes.
+//
+// Total size: Attestation1 (144) + Attestation2 (144).
+const AttesterSlashingSize = 2 * SignedAttestationDataSize
+
+var (
+	_ ssz.StaticObject                    = (*AttesterSlashing)(nil)
+	_ constraints.SSZMarshallableRootable = (*AttesterSlashing)(nil)
+)
+
+// AttesterSlashing is the evidence of a validator having signed two
+// conflicting attestations for the same slot.
+//
+// NOTE: Unlike the Ethereum 2.0 specification, attestations are made by single
+// validators, so the slashing carries signed attestation data rather than
+// aggregated indexed attestations.
+type AttesterSlashing struct {
+	// Attestation1 is the first of the two conflicting attestations.
+	Attestation1 *SignedAttestationData
+	// Attestation2 is the second of the two conflicting attestations.
+	Attestation2 *SignedAttestationData
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewAttesterSlashing creates a new AttesterSlashing.
+func NewAttesterSlashing(
+	attestation1, attestation2 *SignedAttestationData,
+) *AttesterSlashing {
+	return &AttesterSlashing{
+		Attestation1: attestation1,
+		Attestation2: attestation2,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the AttesterSlashing object in SSZ encoding.
+func (*AttesterSlashing) SizeSSZ() uint32 {
+	return AttesterSlashingSize
+}
+
+// DefineSSZ defines the SSZ encoding for the AttesterSlashing object.
+func (a *AttesterSlashing) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &a.Attestation1)
+	ssz.DefineStaticObject(codec, &a.Attestation2)
+}
+
+// MarshalSSZ marshals the AttesterSlashing object to SSZ format.
+func (a *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, a.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, a)
+}
+
+// UnmarshalSSZ unmarshals the AttesterSlashing object from SSZ format.
+func (a *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, a)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the AttesterSlashing object.
+func (a *AttesterSlashing) HashTreeRoot() common.Root {
+	return ssz.HashSequential(a)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the AttesterSlashing object into a pre-allocated byte
+// slice.
+func (a *AttesterSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := a.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the AttesterSlashing object with a hasher.
+func (a *AttesterSlashing) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Attestation1'
+	if a.Attestation1 == nil {
+		a.Attestation1 = new(SignedAttestationData)
+	}
+	if err := a.Attestation1.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'Attestation2'
+	if a.Attestation2 == nil {
+		a.Attestation2 = new(SignedAttestationData)
+	}
+	if err := a.Attestation2.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the AttesterSlashing object.
+func (a *AttesterSlashing) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(a)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Getters                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetValidatorIndex returns the index of the attester being slashed.
+func (a *AttesterSlashing) GetValidatorIndex() math.ValidatorIndex {
+	return a.Attestation1.Data.GetIndex()
+}
+
+// GetSlot returns the slot of the conflicting attestations.
+func (a *AttesterSlashing) GetSlot() math.Slot {
+	return a.Attestation1.Data.GetSlot()
+}
+
+// IsSlashable returns true if the two attestations are distinct votes cast by
+// the same validator for the same slot.
+func (a *AttesterSlashing) IsSlashable() bool {
+	if a.Attestation1 == nil || a.Attestation2 == nil ||
+		a.Attestation1.Data == nil || a.Attestation2.Data == nil {
+		return false
+	}
+	data1, data2 := a.Attestation1.Data, a.Attestation2.Data
+	return data1.GetSlot() == data2.GetSlot() &&
+		data1.GetIndex() == data2.GetIndex() &&
+		data1.HashTreeRoot() != data2.HashTreeRoot()
+}
+
+// VerifySignatures verifies the signatures of both attestations against the
+// given public key of the attester.
+func (a *AttesterSlashing) VerifySignatures(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	for _, attestation := range []*SignedAttestationData{
+		a.Attestation1, a.Attestation2,
+	} {
+		if err := attestation.VerifySignature(
+			forkData, domainType, pubkey, signatureVerificationFn,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AttesterSlashings is a typealias for a list of AttesterSlashings.
+type AttesterSlashings []*AttesterSlashing
+
+// SizeSSZ returns the SSZ encoded size in bytes for the AttesterSlashings.
+func (as AttesterSlashings) SizeSSZ(bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(([]*AttesterSlashing)(as))
+}
+
+// DefineSSZ defines the SSZ encoding for the AttesterSlashings object.
+func (as AttesterSlashings) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*AttesterSlashing)(&as),
+			constants.MaxAttesterSlashingsPerBlock,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*AttesterSlashing)(&as),
+			constants.MaxAttesterSlashingsPerBlock,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*AttesterSlashing)(&as),
+			constants.MaxAttesterSlashingsPerBlock,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the AttesterSlashings.
+func (as AttesterSlashings) HashTreeRoot() common.Root {
+	return ssz.HashSequential(as)
+}
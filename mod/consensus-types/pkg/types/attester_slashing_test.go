@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// signedAttestation builds an attestation signed with fakeSign for the
+// attester domain.
+func signedAttestation(
+	slot math.Slot,
+	index math.ValidatorIndex,
+	blockRoot common.Root,
+) *types.SignedAttestationData {
+	data := &types.AttestationData{
+		Slot:            slot,
+		Index:           index,
+		BeaconBlockRoot: blockRoot,
+	}
+	signingRoot := types.ComputeSigningRoot(
+		data, testForkData.ComputeDomain(common.DomainType{}),
+	)
+	return types.NewSignedAttestationData(
+		data, fakeSign(testPubkey, signingRoot[:]),
+	)
+}
+
+func generateAttesterSlashing() *types.AttesterSlashing {
+	return types.NewAttesterSlashing(
+		signedAttestation(20, 7, common.Root{1}),
+		signedAttestation(20, 7, common.Root{2}),
+	)
+}
+
+func TestAttesterSlashing_MarshalSSZ_UnmarshalSSZ(t *testing.T) {
+	slashing := generateAttesterSlashing()
+
+	data, err := slashing.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.AttesterSlashingSize)
+
+	var unmarshalled types.AttesterSlashing
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, slashing, &unmarshalled)
+}
+
+func TestAttesterSlashing_GetTree(t *testing.T) {
+	slashing := generateAttesterSlashing()
+
+	tree, err := slashing.GetTree()
+	require.NoError(t, err)
+
+	expectedRoot := slashing.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
+
+func TestAttesterSlashing_IsSlashable(t *testing.T) {
+	require.True(t, generateAttesterSlashing().IsSlashable())
+	require.False(t, types.NewAttesterSlashing(
+		signedAttestation(20, 7, common.Root{1}),
+		signedAttestation(20, 7, common.Root{1}),
+	).IsSlashable())
+	require.False(t, types.NewAttesterSlashing(
+		signedAttestation(20, 7, common.Root{1}),
+		signedAttestation(21, 7, common.Root{2}),
+	).IsSlashable())
+	require.False(t, types.NewAttesterSlashing(
+		signedAttestation(20, 7, common.Root{1}),
+		signedAttestation(20, 8, common.Root{2}),
+	).IsSlashable())
+}
+
+func TestAttesterSlashing_VerifySignatures(t *testing.T) {
+	slashing := generateAttesterSlashing()
+	require.Equal(t, math.ValidatorIndex(7), slashing.GetValidatorIndex())
+	require.Equal(t, math.Slot(20), slashing.GetSlot())
+
+	require.NoError(t, slashing.VerifySignatures(
+		testForkData, common.DomainType{}, testPubkey, fakeVerify,
+	))
+
+	slashing.Attestation2.Signature[0] ^= 0xff
+	err := slashing.VerifySignatures(
+		testForkData, common.DomainType{}, testPubkey, fakeVerify,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidSlashingSignature)
+}
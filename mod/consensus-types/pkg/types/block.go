@@ -69,6 +69,27 @@ func (b *BeaconBlock) NewWithVersion(
 			StateRoot:     common.Root{},
 			Body:          &BeaconBlockBody{},
 		}
+	case version.DenebPlus:
+		block = &BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentBlockRoot,
+			StateRoot:     common.Root{},
+			Body: &BeaconBlockBody{
+				Slashings: &SlashingOperations{},
+			},
+		}
+	case version.Electra:
+		block = &BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentBlockRoot,
+			StateRoot:     common.Root{},
+			Body: &BeaconBlockBody{
+				Slashings:         &SlashingOperations{},
+				ExecutionRequests: &ExecutionRequests{},
+			},
+		}
 	default:
 		return &BeaconBlock{}, ErrForkVersionNotSupported
 	}
@@ -86,7 +107,18 @@ func (b *BeaconBlock) NewFromSSZ(
 	case version.Deneb:
 		block = &BeaconBlock{}
 	case version.DenebPlus:
-		panic("unsupported fork version")
+		// The body is created upfront so that it is decoded with the
+		// fields of its fork.
+		block = &BeaconBlock{
+			Body: &BeaconBlockBody{Slashings: &SlashingOperations{}},
+		}
+	case version.Electra:
+		block = &BeaconBlock{
+			Body: &BeaconBlockBody{
+				Slashings:         &SlashingOperations{},
+				ExecutionRequests: &ExecutionRequests{},
+			},
+		}
 	default:
 		return block, ErrForkVersionNotSupported
 	}
@@ -210,7 +242,10 @@ func (b *BeaconBlock) GetStateRoot() common.Root {
 
 // Version identifies the version of the BeaconBlock.
 func (b *BeaconBlock) Version() uint32 {
-	return version.Deneb
+	if b.Body == nil {
+		return version.Deneb
+	}
+	return b.Body.Version()
 }
 
 // SetStateRoot sets the state root of the BeaconBlock.
@@ -239,3 +274,9 @@ func (b *BeaconBlock) GetHeader() *BeaconBlockHeader {
 func (b *BeaconBlock) GetExecutionNumber() math.U64 {
 	return b.Body.ExecutionPayload.Number
 }
+
+// GetExecutionHash retrieves the execution block hash of the BeaconBlock from
+// the ExecutionPayload.
+func (b *BeaconBlock) GetExecutionHash() common.ExecutionHash {
+	return b.Body.ExecutionPayload.BlockHash
+}
@@ -27,6 +27,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -40,8 +41,16 @@ const (
 	// struct.
 	BodyLengthDeneb uint64 = 6
 
+	// BodyLengthDenebPlus is the number of fields in the BeaconBlockBody
+	// struct from the Deneb+ fork onwards, which appends the slashing
+	// operations and the voluntary exits to the Deneb fields. The Electra
+	// body has the same number of fields, as the slashing operations are
+	// nested with the voluntary exits to make room for the execution
+	// requests.
+	BodyLengthDenebPlus uint64 = 8
+
 	// KZGPositionDeneb is the position of BlobKzgCommitments in the block body.
-	KZGPositionDeneb = BodyLengthDeneb - 1
+	KZGPositionDeneb uint64 = 5
 
 	// KZGMerkleIndexDeneb is the merkle index of BlobKzgCommitments' root
 	// in the merkle tree built from the block body.
@@ -62,6 +71,23 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 				ExtraData: make([]byte, ExtraDataSize),
 			},
 		}
+	case version.DenebPlus:
+		return &BeaconBlockBody{
+			Eth1Data: new(Eth1Data),
+			ExecutionPayload: &ExecutionPayload{
+				ExtraData: make([]byte, ExtraDataSize),
+			},
+			Slashings: &SlashingOperations{},
+		}
+	case version.Electra:
+		return &BeaconBlockBody{
+			Eth1Data: new(Eth1Data),
+			ExecutionPayload: &ExecutionPayload{
+				ExtraData: make([]byte, ExtraDataSize),
+			},
+			Slashings:         &SlashingOperations{},
+			ExecutionRequests: &ExecutionRequests{},
+		}
 	default:
 		panic("unsupported fork version")
 	}
@@ -75,7 +101,10 @@ func BlockBodyKZGOffset(
 	cs common.ChainSpec,
 ) uint64 {
 	switch cs.ActiveForkVersionForSlot(slot) {
-	case version.Deneb:
+	// The slashing operations and voluntary exits of Deneb+, as well as the
+	// block operations and execution requests of Electra, fit in the Deneb
+	// body tree, so the commitments are at the same index.
+	case version.Deneb, version.DenebPlus, version.Electra:
 		return KZGMerkleIndexDeneb * cs.MaxBlobCommitmentsPerBlock()
 	default:
 		panic("unsupported fork version")
@@ -83,7 +112,9 @@ func BlockBodyKZGOffset(
 }
 
 // BeaconBlockBody represents the body of a beacon block in the Deneb
-// chain.
+// chain. From the Deneb+ fork onwards the body also carries the slashing
+// operations and the voluntary exits of the block, and from the Electra fork
+// onwards the execution requests of its payload.
 type BeaconBlockBody struct {
 	// RandaoReveal is the reveal of the RANDAO.
 	RandaoReveal crypto.BLSSignature
@@ -97,6 +128,18 @@ type BeaconBlockBody struct {
 	ExecutionPayload *ExecutionPayload
 	// BlobKzgCommitments is the list of KZG commitments for the EIP-4844 blobs.
 	BlobKzgCommitments []eip4844.KZGCommitment
+	// Slashings is the slashing operations included in the body, from the
+	// Deneb+ fork onwards. It is nil for Deneb bodies.
+	Slashings *SlashingOperations
+	// VoluntaryExits is the list of voluntary exits included in the body,
+	// from the Deneb+ fork onwards.
+	VoluntaryExits []*SignedVoluntaryExit
+	// ExecutionRequests is the requests triggered by the execution payload,
+	// from the Electra fork onwards. It is nil for earlier bodies. In SSZ it
+	// is the last field of the body, while the slashing operations and the
+	// voluntary exits are nested in a single field, so that the depth of the
+	// body tree is the same as in Deneb.
+	ExecutionRequests *ExecutionRequests
 }
 
 /* -------------------------------------------------------------------------- */
@@ -106,6 +149,9 @@ type BeaconBlockBody struct {
 // SizeSSZ returns the size of the BeaconBlockBody in SSZ.
 func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	var size uint32 = 96 + 72 + 32 + 4 + 4 + 4
+	if b.hasSlashings() {
+		size += 4 + 4
+	}
 	if fixed {
 		return size
 	}
@@ -113,6 +159,14 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 	size += ssz.SizeSliceOfStaticObjects(b.Deposits)
 	size += ssz.SizeDynamicObject(b.ExecutionPayload)
 	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	switch {
+	case b.hasExecutionRequests():
+		size += ssz.SizeDynamicObject((*blockOperations)(b))
+		size += ssz.SizeDynamicObject(b.ExecutionRequests)
+	case b.hasSlashings():
+		size += ssz.SizeDynamicObject(b.Slashings)
+		size += ssz.SizeSliceOfStaticObjects(b.VoluntaryExits)
+	}
 	return size
 }
 
@@ -120,6 +174,10 @@ func (b *BeaconBlockBody) SizeSSZ(fixed bool) uint32 {
 //
 //nolint:mnd // TODO: chainspec.
 func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
+	// The slashing operations and the voluntary exits of Electra are defined
+	// as a single nested object.
+	ops := (*blockOperations)(b)
+
 	// Define the static data (fields and dynamic offsets)
 	ssz.DefineStaticBytes(codec, &b.RandaoReveal)
 	ssz.DefineStaticObject(codec, &b.Eth1Data)
@@ -127,11 +185,31 @@ func (b *BeaconBlockBody) DefineSSZ(codec *ssz.Codec) {
 	ssz.DefineSliceOfStaticObjectsOffset(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesOffset(codec, &b.BlobKzgCommitments, 16)
+	switch {
+	case b.hasExecutionRequests():
+		ssz.DefineDynamicObjectOffset(codec, &ops)
+		ssz.DefineDynamicObjectOffset(codec, &b.ExecutionRequests)
+	case b.hasSlashings():
+		ssz.DefineDynamicObjectOffset(codec, &b.Slashings)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &b.VoluntaryExits, constants.MaxVoluntaryExitsPerBlock,
+		)
+	}
 
 	// Define the dynamic data (fields)
 	ssz.DefineSliceOfStaticObjectsContent(codec, &b.Deposits, 16)
 	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayload)
 	ssz.DefineSliceOfStaticBytesContent(codec, &b.BlobKzgCommitments, 16)
+	switch {
+	case b.hasExecutionRequests():
+		ssz.DefineDynamicObjectContent(codec, &ops)
+		ssz.DefineDynamicObjectContent(codec, &b.ExecutionRequests)
+	case b.hasSlashings():
+		ssz.DefineDynamicObjectContent(codec, &b.Slashings)
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &b.VoluntaryExits, constants.MaxVoluntaryExitsPerBlock,
+		)
+	}
 }
 
 // MarshalSSZ serializes the BeaconBlockBody to SSZ-encoded bytes.
@@ -221,6 +299,29 @@ func (b *BeaconBlockBody) HashTreeRootWith(hh fastssz.HashWalker) error {
 		hh.MerkleizeWithMixin(subIndx, numItems, 16)
 	}
 
+	switch {
+	case b.hasExecutionRequests():
+		// Field (6) 'Operations'
+		if err := (*blockOperations)(b).HashTreeRootWith(hh); err != nil {
+			return err
+		}
+
+		// Field (7) 'ExecutionRequests'
+		if err := b.ExecutionRequests.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	case b.hasSlashings():
+		// Field (6) 'Slashings'
+		if err := b.Slashings.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+
+		// Field (7) 'VoluntaryExits'
+		if err := b.hashVoluntaryExitsWith(hh); err != nil {
+			return err
+		}
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -230,6 +331,25 @@ func (b *BeaconBlockBody) GetTree() (*fastssz.Node, error) {
 	return fastssz.ProofTree(b)
 }
 
+// hashVoluntaryExitsWith ssz hashes the VoluntaryExits of the
+// BeaconBlockBody with a hasher.
+func (b *BeaconBlockBody) hashVoluntaryExitsWith(
+	hh fastssz.HashWalker,
+) error {
+	subIndx := hh.Index()
+	num := uint64(len(b.VoluntaryExits))
+	if num > constants.MaxVoluntaryExitsPerBlock {
+		return fastssz.ErrIncorrectListSize
+	}
+	for _, elem := range b.VoluntaryExits {
+		if err := elem.HashTreeRootWith(hh); err != nil {
+			return err
+		}
+	}
+	hh.MerkleizeWithMixin(subIndx, num, constants.MaxVoluntaryExitsPerBlock)
+	return nil
+}
+
 // IsNil checks if the BeaconBlockBody is nil.
 func (b *BeaconBlockBody) IsNil() bool {
 	return b == nil
@@ -269,29 +389,39 @@ func (b *BeaconBlockBody) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
+// GetAttestations returns the Attestations of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetAttestations() []*AttestationData {
-	panic("not implemented")
+	if !b.hasSlashings() {
+		return nil
+	}
+	return b.Slashings.Attestations
 }
 
-// SetDeposits is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetAttestations(_ []*AttestationData) {
-	panic("not implemented")
+// SetAttestations sets the Attestations of the BeaconBlockBody. It panics
+// before the Deneb+ fork.
+func (b *BeaconBlockBody) SetAttestations(attestations []*AttestationData) {
+	b.mustHaveSlashings()
+	b.Slashings.Attestations = attestations
 }
 
-// GetSlashingInfo is not implemented for BeaconBlockDeneb.
+// GetSlashingInfo returns the SlashingInfo of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetSlashingInfo() []*SlashingInfo {
-	panic("not implemented")
+	if !b.hasSlashings() {
+		return nil
+	}
+	return b.Slashings.SlashingInfo
 }
 
-// SetSlashingInfo is not implemented for BeaconBlockDeneb.
-func (b *BeaconBlockBody) SetSlashingInfo(_ []*SlashingInfo) {
-	panic("not implemented")
+// SetSlashingInfo sets the SlashingInfo of the BeaconBlockBody. It panics
+// before the Deneb+ fork.
+func (b *BeaconBlockBody) SetSlashingInfo(slashingInfo []*SlashingInfo) {
+	b.mustHaveSlashings()
+	b.Slashings.SlashingInfo = slashingInfo
 }
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBody.
 func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
-	return []common.Root{
+	roots := []common.Root{
 		common.Root(b.GetRandaoReveal().HashTreeRoot()),
 		b.Eth1Data.HashTreeRoot(),
 		common.Root(b.GetGraffiti().HashTreeRoot()),
@@ -300,13 +430,63 @@ func (b *BeaconBlockBody) GetTopLevelRoots() []common.Root {
 		// I think this is a bug.
 		common.Root{},
 	}
+	switch {
+	case b.hasExecutionRequests():
+		return append(
+			roots,
+			(*blockOperations)(b).HashTreeRoot(),
+			b.ExecutionRequests.HashTreeRoot(),
+		)
+	case b.hasSlashings():
+		return append(
+			roots,
+			b.Slashings.HashTreeRoot(),
+			VoluntaryExits(b.GetVoluntaryExits()).HashTreeRoot(),
+		)
+	default:
+		return roots
+	}
 }
 
 // Length returns the number of fields in the BeaconBlockBody struct.
 func (b *BeaconBlockBody) Length() uint64 {
+	if b.hasSlashings() {
+		return BodyLengthDenebPlus
+	}
 	return BodyLengthDeneb
 }
 
+// Version returns the version of the fork the BeaconBlockBody belongs to.
+func (b *BeaconBlockBody) Version() uint32 {
+	if b.hasExecutionRequests() {
+		return version.Electra
+	}
+	if b.hasSlashings() {
+		return version.DenebPlus
+	}
+	return version.Deneb
+}
+
+// hasSlashings returns whether the BeaconBlockBody carries the slashing
+// operations, which were added in the Deneb+ fork.
+func (b *BeaconBlockBody) hasSlashings() bool {
+	return b.Slashings != nil
+}
+
+// hasExecutionRequests returns whether the BeaconBlockBody carries the
+// execution requests, which were added in the Electra fork.
+func (b *BeaconBlockBody) hasExecutionRequests() bool {
+	return b.ExecutionRequests != nil
+}
+
+// mustHaveSlashings panics if the BeaconBlockBody predates the slashing
+// operations.
+func (b *BeaconBlockBody) mustHaveSlashings() {
+	if !b.hasSlashings() {
+		panic("slashing operations are not supported before Deneb+")
+	}
+}
+
 // GetRandaoReveal returns the RandaoReveal of the Body.
 func (b *BeaconBlockBody) GetRandaoReveal() crypto.BLSSignature {
 	return b.RandaoReveal
@@ -341,3 +521,132 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
 }
+
+// GetProposerSlashings returns the ProposerSlashings of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetProposerSlashings() []*ProposerSlashing {
+	if !b.hasSlashings() {
+		return nil
+	}
+	return b.Slashings.ProposerSlashings
+}
+
+// SetProposerSlashings sets the ProposerSlashings of the BeaconBlockBody. It
+// panics before the Deneb+ fork.
+func (b *BeaconBlockBody) SetProposerSlashings(
+	proposerSlashings []*ProposerSlashing,
+) {
+	b.mustHaveSlashings()
+	b.Slashings.ProposerSlashings = proposerSlashings
+}
+
+// GetAttesterSlashings returns the AttesterSlashings of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetAttesterSlashings() []*AttesterSlashing {
+	if !b.hasSlashings() {
+		return nil
+	}
+	return b.Slashings.AttesterSlashings
+}
+
+// SetAttesterSlashings sets the AttesterSlashings of the BeaconBlockBody. It
+// panics before the Deneb+ fork.
+func (b *BeaconBlockBody) SetAttesterSlashings(
+	attesterSlashings []*AttesterSlashing,
+) {
+	b.mustHaveSlashings()
+	b.Slashings.AttesterSlashings = attesterSlashings
+}
+
+// GetVoluntaryExits returns the VoluntaryExits of the BeaconBlockBody.
+func (b *BeaconBlockBody) GetVoluntaryExits() []*SignedVoluntaryExit {
+	return b.VoluntaryExits
+}
+
+// SetVoluntaryExits sets the VoluntaryExits of the BeaconBlockBody. It panics
+// before the Deneb+ fork.
+func (b *BeaconBlockBody) SetVoluntaryExits(
+	voluntaryExits []*SignedVoluntaryExit,
+) {
+	if !b.hasSlashings() {
+		panic("voluntary exits are not supported before Deneb+")
+	}
+	b.VoluntaryExits = voluntaryExits
+}
+
+// GetExecutionRequests returns the ExecutionRequests of the BeaconBlockBody,
+// which are nil before the Electra fork.
+func (b *BeaconBlockBody) GetExecutionRequests() *ExecutionRequests {
+	return b.ExecutionRequests
+}
+
+// SetExecutionRequests sets the ExecutionRequests of the BeaconBlockBody,
+// which makes it an Electra body. Nil requests are set as empty requests.
+func (b *BeaconBlockBody) SetExecutionRequests(
+	executionRequests *ExecutionRequests,
+) {
+	if executionRequests == nil {
+		executionRequests = &ExecutionRequests{}
+	}
+	if !b.hasSlashings() {
+		b.Slashings = &SlashingOperations{}
+	}
+	b.ExecutionRequests = executionRequests
+}
+
+// blockOperations is the view of the field of a BeaconBlockBody from the
+// Electra fork onwards which nests the slashing operations and the
+// voluntary exits.
+type blockOperations BeaconBlockBody
+
+// SizeSSZ returns the size of the slashing operations and voluntary exits in
+// SSZ.
+func (o *blockOperations) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeDynamicObject(o.Slashings)
+	size += ssz.SizeSliceOfStaticObjects(o.VoluntaryExits)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the slashing operations and
+// voluntary exits.
+func (o *blockOperations) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineDynamicObjectOffset(codec, &o.Slashings)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &o.VoluntaryExits, constants.MaxVoluntaryExitsPerBlock,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineDynamicObjectContent(codec, &o.Slashings)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &o.VoluntaryExits, constants.MaxVoluntaryExitsPerBlock,
+	)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the slashing operations and
+// voluntary exits.
+func (o *blockOperations) HashTreeRoot() common.Root {
+	return ssz.HashSequential(o)
+}
+
+// HashTreeRootWith ssz hashes the slashing operations and voluntary exits
+// with a hasher.
+func (o *blockOperations) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Slashings'
+	if err := o.Slashings.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'VoluntaryExits'
+	if err := (*BeaconBlockBody)(o).hashVoluntaryExitsWith(hh); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
@@ -21,6 +21,7 @@
 package types_test
 
 import (
+	"math/bits"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -160,3 +161,189 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	body := blockBody.Empty(version.Deneb)
 	require.NotNil(t, body)
 }
+
+func generateDenebPlusBeaconBlockBody() *types.BeaconBlockBody {
+	body := (&types.BeaconBlockBody{}).Empty(version.DenebPlus)
+	body.SetRandaoReveal([96]byte{1, 2, 3})
+	body.SetGraffiti([32]byte{4, 5, 6})
+	body.GetExecutionPayload().BaseFeePerGas = math.NewU256(0)
+	body.SetProposerSlashings(
+		[]*types.ProposerSlashing{generateProposerSlashing()},
+	)
+	body.SetAttesterSlashings(
+		[]*types.AttesterSlashing{generateAttesterSlashing()},
+	)
+	body.SetSlashingInfo([]*types.SlashingInfo{{Slot: 7, Index: 3}})
+	body.SetAttestations([]*types.AttestationData{
+		{Slot: 8, Index: 1, BeaconBlockRoot: common.Root{1}},
+		{Slot: 8, Index: 3, BeaconBlockRoot: common.Root{1}},
+	})
+	body.SetVoluntaryExits(
+		[]*types.SignedVoluntaryExit{generateSignedVoluntaryExit()},
+	)
+	return body
+}
+
+func TestBeaconBlockBody_Slashings(t *testing.T) {
+	body := generateDenebPlusBeaconBlockBody()
+	require.Equal(t, version.DenebPlus, body.Version())
+	require.Equal(t, types.BodyLengthDenebPlus, body.Length())
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthDenebPlus))
+
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := (&types.BeaconBlockBody{}).Empty(version.DenebPlus)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, body.GetProposerSlashings(),
+		unmarshalled.GetProposerSlashings())
+	require.Equal(t, body.GetAttesterSlashings(),
+		unmarshalled.GetAttesterSlashings())
+	require.Equal(t, body.GetSlashingInfo(), unmarshalled.GetSlashingInfo())
+	require.Equal(t, body.GetAttestations(), unmarshalled.GetAttestations())
+	require.Equal(t, body.GetVoluntaryExits(),
+		unmarshalled.GetVoluntaryExits())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	expectedRoot := body.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
+
+func TestBeaconBlockBody_DenebWithoutSlashings(t *testing.T) {
+	// Deneb bodies keep their six fields and carry no slashing operations.
+	body := generateBeaconBlockBody()
+	require.Equal(t, version.Deneb, body.Version())
+	require.Equal(t, types.BodyLengthDeneb, body.Length())
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthDeneb))
+	require.Nil(t, body.GetProposerSlashings())
+	require.Nil(t, body.GetSlashingInfo())
+	require.Nil(t, body.GetAttestations())
+	require.Nil(t, body.GetVoluntaryExits())
+	require.Panics(t, func() {
+		body.SetProposerSlashings(
+			[]*types.ProposerSlashing{generateProposerSlashing()},
+		)
+	})
+	require.Panics(t, func() {
+		body.SetVoluntaryExits(
+			[]*types.SignedVoluntaryExit{generateSignedVoluntaryExit()},
+		)
+	})
+}
+
+func TestBeaconBlockBody_DenebPlusTreeDepth(t *testing.T) {
+	// The slashing operations are nested in a single field, so together
+	// with the voluntary exits the Deneb+ body tree has the depth of the
+	// Deneb one and the blob sidecars keep their inclusion proof depth.
+	deneb := generateBeaconBlockBody()
+	denebPlus := generateDenebPlusBeaconBlockBody()
+	require.Equal(t,
+		bits.Len64(deneb.Length()-1), bits.Len64(denebPlus.Length()-1),
+	)
+}
+
+func TestBeaconBlock_DenebPlusFromSSZ(t *testing.T) {
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 3, common.Root{1}, version.DenebPlus,
+	)
+	require.NoError(t, err)
+	blk.Body = generateDenebPlusBeaconBlockBody()
+	require.Equal(t, version.DenebPlus, blk.Version())
+
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.DenebPlus)
+	require.NoError(t, err)
+	require.Equal(t, version.DenebPlus, decoded.Version())
+	require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+	require.Equal(t,
+		blk.GetBody().GetSlashingInfo(), decoded.GetBody().GetSlashingInfo())
+
+	// A Deneb decoder does not accept the Deneb+ encoding.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(bz, version.Deneb)
+	require.Error(t, err)
+}
+
+func generateElectraBeaconBlockBody() *types.BeaconBlockBody {
+	body := generateDenebPlusBeaconBlockBody()
+	body.ExecutionRequests = generateExecutionRequests()
+	return body
+}
+
+func TestBeaconBlockBody_ExecutionRequests(t *testing.T) {
+	body := generateElectraBeaconBlockBody()
+	require.Equal(t, version.Electra, body.Version())
+	require.Equal(t, types.BodyLengthDenebPlus, body.Length())
+	require.Len(t, body.GetTopLevelRoots(), int(types.BodyLengthDenebPlus))
+
+	data, err := body.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := (&types.BeaconBlockBody{}).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, body.GetVoluntaryExits(),
+		unmarshalled.GetVoluntaryExits())
+	require.Equal(t, body.GetExecutionRequests(),
+		unmarshalled.GetExecutionRequests())
+	require.Equal(t, body.HashTreeRoot(), unmarshalled.HashTreeRoot())
+
+	tree, err := body.GetTree()
+	require.NoError(t, err)
+	expectedRoot := body.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+
+	// The execution requests are the last leaf of the body tree.
+	roots := body.GetTopLevelRoots()
+	leaf, err := tree.Get(2*len(roots) - 1)
+	require.NoError(t, err)
+	requestsRoot := body.GetExecutionRequests().HashTreeRoot()
+	require.Equal(t, requestsRoot, roots[len(roots)-1])
+	require.Equal(t, string(requestsRoot[:]), string(leaf.Hash()))
+}
+
+func TestBeaconBlockBody_SetExecutionRequests(t *testing.T) {
+	body := generateDenebPlusBeaconBlockBody()
+	require.Nil(t, body.GetExecutionRequests())
+
+	// The Electra body nests the slashing operations with the voluntary
+	// exits, so its root differs even without any request.
+	electra := generateDenebPlusBeaconBlockBody()
+	electra.SetExecutionRequests(nil)
+	require.Equal(t, version.Electra, electra.Version())
+	require.Equal(t, &types.ExecutionRequests{}, electra.GetExecutionRequests())
+	require.NotEqual(t, body.HashTreeRoot(), electra.HashTreeRoot())
+
+	// Setting the requests of a body without slashing operations allocates
+	// them.
+	deneb := generateBeaconBlockBody()
+	deneb.SetExecutionRequests(generateExecutionRequests())
+	require.Equal(t, version.Electra, deneb.Version())
+	require.Len(t, deneb.GetTopLevelRoots(), int(types.BodyLengthDenebPlus))
+	data, err := deneb.MarshalSSZ()
+	require.NoError(t, err)
+	unmarshalled := (&types.BeaconBlockBody{}).Empty(version.Electra)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, deneb.HashTreeRoot(), unmarshalled.HashTreeRoot())
+}
+
+func TestBeaconBlock_ElectraFromSSZ(t *testing.T) {
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		10, 3, common.Root{1}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, blk.GetBody().GetExecutionRequests())
+	blk.Body = generateElectraBeaconBlockBody()
+	require.Equal(t, version.Electra, blk.Version())
+
+	bz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	decoded, err := (&types.BeaconBlock{}).NewFromSSZ(bz, version.Electra)
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, decoded.Version())
+	require.Equal(t, blk.HashTreeRoot(), decoded.HashTreeRoot())
+
+	// A Deneb+ decoder does not accept the Electra encoding.
+	_, err = (&types.BeaconBlock{}).NewFromSSZ(bz, version.DenebPlus)
+	require.Error(t, err)
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// ConsolidationRequestSize is the size of the ConsolidationRequest object in
+// bytes.
+//
+// Total size: SourceAddress (20) + SourcePubkey (48) + TargetPubkey (48).
+const ConsolidationRequestSize = 116
+
+var (
+	_ ssz.StaticObject                    = (*ConsolidationRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*ConsolidationRequest)(nil)
+)
+
+// ConsolidationRequest is a request of the withdrawal address of a validator
+// to consolidate its balance into another validator, triggered from the
+// execution layer as per EIP-7251.
+type ConsolidationRequest struct {
+	// SourceAddress is the address that sent the request, which must be
+	// the withdrawal address of the source validator.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// SourcePubkey is the public key of the validator that is consolidated.
+	SourcePubkey crypto.BLSPubkey `json:"source_pubkey"`
+	// TargetPubkey is the public key of the validator the balance of the
+	// source validator is consolidated into.
+	TargetPubkey crypto.BLSPubkey `json:"target_pubkey"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewConsolidationRequest creates a new ConsolidationRequest.
+func NewConsolidationRequest(
+	sourceAddress common.ExecutionAddress,
+	sourcePubkey crypto.BLSPubkey,
+	targetPubkey crypto.BLSPubkey,
+) *ConsolidationRequest {
+	return &ConsolidationRequest{
+		SourceAddress: sourceAddress,
+		SourcePubkey:  sourcePubkey,
+		TargetPubkey:  targetPubkey,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ConsolidationRequest object in SSZ
+// encoding.
+func (*ConsolidationRequest) SizeSSZ() uint32 {
+	return ConsolidationRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ConsolidationRequest object.
+func (c *ConsolidationRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &c.SourceAddress)
+	ssz.DefineStaticBytes(codec, &c.SourcePubkey)
+	ssz.DefineStaticBytes(codec, &c.TargetPubkey)
+}
+
+// MarshalSSZ marshals the ConsolidationRequest object to SSZ format.
+func (c *ConsolidationRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, c.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, c)
+}
+
+// UnmarshalSSZ unmarshals the ConsolidationRequest object from SSZ format.
+func (c *ConsolidationRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, c)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ConsolidationRequest
+// object.
+func (c *ConsolidationRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(c)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the ConsolidationRequest object into a pre-allocated
+// byte slice.
+func (c *ConsolidationRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := c.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the ConsolidationRequest object with a hasher.
+func (c *ConsolidationRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(c.SourceAddress[:])
+
+	// Field (1) 'SourcePubkey'
+	hh.PutBytes(c.SourcePubkey[:])
+
+	// Field (2) 'TargetPubkey'
+	hh.PutBytes(c.TargetPubkey[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the ConsolidationRequest object.
+func (c *ConsolidationRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(c)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetSourceAddress returns the address that sent the request.
+func (c *ConsolidationRequest) GetSourceAddress() common.ExecutionAddress {
+	return c.SourceAddress
+}
+
+// GetSourcePubkey returns the public key of the validator that is
+// consolidated.
+func (c *ConsolidationRequest) GetSourcePubkey() crypto.BLSPubkey {
+	return c.SourcePubkey
+}
+
+// GetTargetPubkey returns the public key of the validator the balance is
+// consolidated into.
+func (c *ConsolidationRequest) GetTargetPubkey() crypto.BLSPubkey {
+	return c.TargetPubkey
+}
@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -29,8 +30,23 @@ import (
 	"github.com/karalabe/ssz"
 )
 
-// DepositSize is the size of the SSZ encoding of a Deposit.
-const DepositSize = 192 // 48 + 32 + 8 + 96 + 8
+const (
+	// DepositDataSize is the size of the SSZ encoding of the deposit data,
+	// i.e. the leaf of a deposit in the deposit tree.
+	DepositDataSize = 184 // 48 + 32 + 8 + 96
+
+	// DepositSizeWithoutProof is the size of the SSZ encoding of a Deposit
+	// without its proof, i.e. of a DepositRequest, which is how deposits are
+	// persisted.
+	DepositSizeWithoutProof = DepositDataSize + 8
+
+	// DepositProofLength is the number of roots in the merkle proof of a
+	// deposit, including the mixed in length of the deposit tree.
+	DepositProofLength = uint32(constants.DepositContractDepth) + 1
+
+	// DepositSize is the size of the SSZ encoding of a Deposit.
+	DepositSize = DepositSizeWithoutProof + DepositProofLength*32
+)
 
 // Compile-time assertions to ensure Deposit implements necessary interfaces.
 var (
@@ -52,6 +68,8 @@ type Deposit struct {
 	Signature crypto.BLSSignature `json:"signature"`
 	// Index of the deposit in the deposit contract.
 	Index uint64 `json:"index"`
+	// Proof is the merkle proof of the deposit data against the deposit root.
+	Proof [DepositProofLength]common.Root `json:"proof"`
 }
 
 // NewDeposit creates a new Deposit instance.
@@ -118,6 +136,9 @@ func (d *Deposit) DefineSSZ(c *ssz.Codec) {
 	ssz.DefineUint64(c, &d.Amount)
 	ssz.DefineStaticBytes(c, &d.Signature)
 	ssz.DefineUint64(c, &d.Index)
+	ssz.DefineArrayOfStaticBytes[[DepositProofLength]common.Root, common.Root](
+		c, &d.Proof,
+	)
 }
 
 // MarshalSSZ marshals the Deposit object to SSZ format.
@@ -174,6 +195,15 @@ func (d *Deposit) HashTreeRootWith(hh fastssz.HashWalker) error {
 	// Field (4) 'Index'
 	hh.PutUint64(d.Index)
 
+	// Field (5) 'Proof'
+	{
+		subIndx := hh.Index()
+		for _, root := range d.Proof {
+			hh.PutBytes(root[:])
+		}
+		hh.Merkleize(subIndx)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
@@ -211,3 +241,49 @@ func (d *Deposit) GetSignature() crypto.BLSSignature {
 func (d *Deposit) GetWithdrawalCredentials() WithdrawalCredentials {
 	return d.Credentials
 }
+
+// GetProof returns the merkle proof of the deposit.
+func (d *Deposit) GetProof() []common.Root {
+	return d.Proof[:]
+}
+
+// SetProof sets the merkle proof of the deposit.
+func (d *Deposit) SetProof(proof []common.Root) {
+	copy(d.Proof[:], proof)
+}
+
+// GetDataRoot returns the hash tree root of the deposit data, which is the
+// leaf of the deposit in the deposit tree.
+func (d *Deposit) GetDataRoot() common.Root {
+	return ssz.HashSequential((*depositData)(d))
+}
+
+// MarshalSSZWithoutProof marshals the Deposit object to SSZ format, leaving
+// out its proof.
+func (d *Deposit) MarshalSSZWithoutProof() ([]byte, error) {
+	buf := make([]byte, DepositSizeWithoutProof)
+	return buf, ssz.EncodeToBytes(buf, (*DepositRequest)(d))
+}
+
+// UnmarshalSSZWithoutProof unmarshals the Deposit object from the SSZ format
+// produced by MarshalSSZWithoutProof.
+func (d *Deposit) UnmarshalSSZWithoutProof(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, (*DepositRequest)(d))
+}
+
+// depositData is the view of a Deposit as the DepositData of the deposit
+// contract.
+type depositData Deposit
+
+// SizeSSZ returns the SSZ encoded size of the deposit data.
+func (*depositData) SizeSSZ() uint32 {
+	return DepositDataSize
+}
+
+// DefineSSZ defines the SSZ encoding for the deposit data.
+func (d *depositData) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time assertions to ensure DepositRequest implements necessary
+// interfaces.
+var (
+	_ ssz.StaticObject                    = (*DepositRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*DepositRequest)(nil)
+)
+
+// DepositRequest is a deposit of the deposit contract processed in protocol
+// as an execution layer request from the Electra fork onwards, as per
+// EIP-6110. It is the view of a Deposit without its proof, as the deposit
+// requests are part of the execution payload they are emitted by.
+type DepositRequest Deposit
+
+// Deposit returns the DepositRequest as a Deposit, without a proof.
+func (d *DepositRequest) Deposit() *Deposit {
+	return (*Deposit)(d)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the SSZ encoded size of the DepositRequest object.
+func (*DepositRequest) SizeSSZ() uint32 {
+	return DepositSizeWithoutProof
+}
+
+// DefineSSZ defines the SSZ encoding for the DepositRequest object.
+func (d *DepositRequest) DefineSSZ(c *ssz.Codec) {
+	ssz.DefineStaticBytes(c, &d.Pubkey)
+	ssz.DefineStaticBytes(c, &d.Credentials)
+	ssz.DefineUint64(c, &d.Amount)
+	ssz.DefineStaticBytes(c, &d.Signature)
+	ssz.DefineUint64(c, &d.Index)
+}
+
+// MarshalSSZ marshals the DepositRequest object to SSZ format.
+func (d *DepositRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, d.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, d)
+}
+
+// UnmarshalSSZ unmarshals the DepositRequest object from SSZ format.
+func (d *DepositRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, d)
+}
+
+// HashTreeRoot computes the Merkleization of the DepositRequest object.
+func (d *DepositRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(d)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the DepositRequest object into a pre-allocated byte
+// slice.
+func (d *DepositRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := d.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the DepositRequest object with a hasher.
+func (d *DepositRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Pubkey'
+	hh.PutBytes(d.Pubkey[:])
+
+	// Field (1) 'Credentials'
+	hh.PutBytes(d.Credentials[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(d.Amount))
+
+	// Field (3) 'Signature'
+	hh.PutBytes(d.Signature[:])
+
+	// Field (4) 'Index'
+	hh.PutUint64(d.Index)
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the DepositRequest object.
+func (d *DepositRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(d)
+}
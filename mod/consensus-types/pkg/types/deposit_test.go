@@ -26,8 +26,10 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
 )
@@ -94,7 +96,7 @@ func TestDeposit_HashTreeRoot(t *testing.T) {
 func TestDeposit_SizeSSZ(t *testing.T) {
 	deposit := generateValidDeposit()
 
-	require.Equal(t, uint32(192), deposit.SizeSSZ())
+	require.Equal(t, uint32(1248), deposit.SizeSSZ())
 }
 
 func TestDeposit_HashTreeRootWith(t *testing.T) {
@@ -108,8 +110,77 @@ func TestDeposit_HashTreeRootWith(t *testing.T) {
 
 func TestDeposit_GetTree(t *testing.T) {
 	deposit := generateValidDeposit()
-	_, err := deposit.GetTree()
+	deposit.SetProof([]common.Root{{1}, {2}, {3}})
+	tree, err := deposit.GetTree()
 	require.NoError(t, err)
+
+	expectedRoot := deposit.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
+
+func TestDeposit_GetDataRoot(t *testing.T) {
+	deposit := generateValidDeposit()
+	dataRoot := deposit.GetDataRoot()
+
+	// The data root commits to the deposit data but not to the proof.
+	deposit.SetProof([]common.Root{{1}, {2}, {3}})
+	require.Equal(t, dataRoot, deposit.GetDataRoot())
+	require.NotEqual(t, dataRoot, deposit.HashTreeRoot())
+	require.Equal(t, common.Root{3}, deposit.GetProof()[2])
+
+	// Nor to the index, which is given by the position of the leaf.
+	deposit.Index++
+	require.Equal(t, dataRoot, deposit.GetDataRoot())
+
+	deposit.Amount++
+	require.NotEqual(t, dataRoot, deposit.GetDataRoot())
+}
+
+func TestDeposit_MarshalUnmarshalSSZWithoutProof(t *testing.T) {
+	deposit := generateValidDeposit()
+	deposit.SetProof([]common.Root{{1}, {2}, {3}})
+
+	bz, err := deposit.MarshalSSZWithoutProof()
+	require.NoError(t, err)
+	require.Len(t, bz, types.DepositSizeWithoutProof)
+
+	var unmarshalled types.Deposit
+	require.NoError(t, unmarshalled.UnmarshalSSZWithoutProof(bz))
+	require.Equal(t, deposit.Index, unmarshalled.Index)
+	require.Equal(t, deposit.GetDataRoot(), unmarshalled.GetDataRoot())
+	require.Equal(t, [types.DepositProofLength]common.Root{}, unmarshalled.Proof)
+}
+
+func TestDeposit_VerifyProof(t *testing.T) {
+	deposits := []*types.Deposit{
+		generateValidDeposit(), generateValidDeposit(), generateValidDeposit(),
+	}
+	leaves := make([]common.Root, len(deposits))
+	for i, deposit := range deposits {
+		deposit.Index = uint64(i)
+		deposit.Amount += math.Gwei(i)
+		leaves[i] = deposit.GetDataRoot()
+	}
+
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		leaves, constants.DepositContractDepth,
+	)
+	require.NoError(t, err)
+	root := tree.HashTreeRoot()
+
+	for i, deposit := range deposits {
+		proof, err := tree.MerkleProofWithMixin(uint64(i))
+		require.NoError(t, err)
+		deposit.SetProof(proof)
+
+		require.True(t, merkle.IsValidMerkleBranch(
+			deposit.GetDataRoot(),
+			deposit.GetProof(),
+			constants.DepositContractDepth+1,
+			deposit.Index,
+			root,
+		))
+	}
 }
 
 func TestDeposit_UnmarshalSSZ_ErrSize(t *testing.T) {
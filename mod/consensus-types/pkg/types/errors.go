@@ -38,4 +38,18 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrInvalidSlashingSignature is an error for when a signature included
+	// in slashing evidence doesn't match.
+	ErrInvalidSlashingSignature = errors.New("invalid slashing signature")
+
+	// ErrInvalidVoluntaryExitSignature is an error for when the signature of
+	// a voluntary exit doesn't match.
+	ErrInvalidVoluntaryExitSignature = errors.New(
+		"invalid voluntary exit signature",
+	)
+
+	// ErrInvalidExecutionRequests is an error for when the execution
+	// requests returned by the execution client are malformed.
+	ErrInvalidExecutionRequests = errors.New("invalid execution requests")
 )
@@ -140,3 +140,8 @@ func (e *Eth1Data) GetTree() (*fastssz.Node, error) {
 func (e *Eth1Data) GetDepositCount() math.U64 {
 	return e.DepositCount
 }
+
+// GetDepositRoot returns the deposit root.
+func (e *Eth1Data) GetDepositRoot() common.Root {
+	return e.DepositRoot
+}
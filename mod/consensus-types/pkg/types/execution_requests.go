@@ -0,0 +1,320 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// The types of the execution layer requests, as per EIP-7685.
+const (
+	// DepositRequestType is the type of the deposit requests of EIP-6110.
+	DepositRequestType byte = 0x00
+	// WithdrawalRequestType is the type of the withdrawal requests of
+	// EIP-7002.
+	WithdrawalRequestType byte = 0x01
+	// ConsolidationRequestType is the type of the consolidation requests of
+	// EIP-7251.
+	ConsolidationRequestType byte = 0x02
+)
+
+// Compile-time assertion to ensure ExecutionRequests implements the correct
+// interfaces.
+var _ ssz.DynamicObject = (*ExecutionRequests)(nil)
+
+// ExecutionRequests are the requests triggered from the execution layer by
+// the execution payload of a block, from the Electra fork onwards.
+type ExecutionRequests struct {
+	// Deposits is the list of deposit requests.
+	Deposits []*DepositRequest
+	// Withdrawals is the list of withdrawal requests.
+	Withdrawals []*WithdrawalRequest
+	// Consolidations is the list of consolidation requests.
+	Consolidations []*ConsolidationRequest
+}
+
+// NewFromEncodedRequests decodes the ExecutionRequests from the encoding of
+// EIP-7685 used by the engine API, i.e. a list of the requests of each type
+// as the type followed by the concatenated SSZ encodings of the requests, in
+// ascending order of type and leaving out the types without requests.
+func (*ExecutionRequests) NewFromEncodedRequests(
+	encoded [][]byte,
+) (*ExecutionRequests, error) {
+	var (
+		requests = &ExecutionRequests{}
+		prevType = -1
+		err      error
+	)
+	for _, bz := range encoded {
+		if len(bz) < 2 || int(bz[0]) <= prevType {
+			return nil, ErrInvalidExecutionRequests
+		}
+		prevType = int(bz[0])
+
+		switch bz[0] {
+		case DepositRequestType:
+			requests.Deposits, err = decodeRequests[DepositRequest](
+				bz[1:], DepositSizeWithoutProof,
+				constants.MaxDepositRequestsPerPayload,
+			)
+		case WithdrawalRequestType:
+			requests.Withdrawals, err = decodeRequests[WithdrawalRequest](
+				bz[1:], WithdrawalRequestSize,
+				constants.MaxWithdrawalRequestsPerPayload,
+			)
+		case ConsolidationRequestType:
+			requests.Consolidations, err = decodeRequests[ConsolidationRequest](
+				bz[1:], ConsolidationRequestSize,
+				constants.MaxConsolidationRequestsPerPayload,
+			)
+		default:
+			err = errors.Wrapf(
+				ErrInvalidExecutionRequests, "unknown type %d", bz[0],
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// EncodeRequests encodes the ExecutionRequests as per EIP-7685 for the
+// engine API. It is the inverse of NewFromEncodedRequests.
+func (e *ExecutionRequests) EncodeRequests() ([][]byte, error) {
+	encoded := make([][]byte, 0)
+	for _, r := range []struct {
+		requestType byte
+		requests    []ssz.StaticObject
+	}{
+		{DepositRequestType, toStaticObjects(e.Deposits)},
+		{WithdrawalRequestType, toStaticObjects(e.Withdrawals)},
+		{ConsolidationRequestType, toStaticObjects(e.Consolidations)},
+	} {
+		if len(r.requests) == 0 {
+			continue
+		}
+		bz := []byte{r.requestType}
+		for _, request := range r.requests {
+			buf := make([]byte, request.SizeSSZ())
+			if err := ssz.EncodeToBytes(buf, request); err != nil {
+				return nil, err
+			}
+			bz = append(bz, buf...)
+		}
+		encoded = append(encoded, bz)
+	}
+	return encoded, nil
+}
+
+// decodeRequests decodes the concatenated SSZ encodings of requests of the
+// given size.
+func decodeRequests[T any, PT interface {
+	*T
+	ssz.StaticObject
+}](bz []byte, size uint32, limit uint64) ([]PT, error) {
+	if len(bz)%int(size) != 0 || uint64(len(bz))/uint64(size) > limit {
+		return nil, ErrInvalidExecutionRequests
+	}
+	requests := make([]PT, 0, len(bz)/int(size))
+	for i := 0; i < len(bz); i += int(size) {
+		request := PT(new(T))
+		if err := ssz.DecodeFromBytes(bz[i:i+int(size)], request); err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// toStaticObjects returns the requests as SSZ static objects.
+func toStaticObjects[T ssz.StaticObject](requests []T) []ssz.StaticObject {
+	objects := make([]ssz.StaticObject, len(requests))
+	for i, request := range requests {
+		objects[i] = request
+	}
+	return objects
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ExecutionRequests in SSZ.
+func (e *ExecutionRequests) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(e.Deposits)
+	size += ssz.SizeSliceOfStaticObjects(e.Withdrawals)
+	size += ssz.SizeSliceOfStaticObjects(e.Consolidations)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the ExecutionRequests.
+func (e *ExecutionRequests) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &e.Deposits, constants.MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &e.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &e.Consolidations,
+		constants.MaxConsolidationRequestsPerPayload,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &e.Deposits, constants.MaxDepositRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &e.Withdrawals, constants.MaxWithdrawalRequestsPerPayload,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &e.Consolidations,
+		constants.MaxConsolidationRequestsPerPayload,
+	)
+}
+
+// MarshalSSZ serializes the ExecutionRequests to SSZ-encoded bytes.
+func (e *ExecutionRequests) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, e.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, e)
+}
+
+// UnmarshalSSZ deserializes the ExecutionRequests from SSZ-encoded bytes.
+func (e *ExecutionRequests) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, e)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the ExecutionRequests.
+func (e *ExecutionRequests) HashTreeRoot() common.Root {
+	return ssz.HashSequential(e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo serializes the ExecutionRequests into a writer.
+func (e *ExecutionRequests) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := e.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the ExecutionRequests object with a hasher.
+func (e *ExecutionRequests) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Deposits'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Deposits))
+		if num > constants.MaxDepositRequestsPerPayload {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range e.Deposits {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxDepositRequestsPerPayload,
+		)
+	}
+
+	// Field (1) 'Withdrawals'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Withdrawals))
+		if num > constants.MaxWithdrawalRequestsPerPayload {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range e.Withdrawals {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxWithdrawalRequestsPerPayload,
+		)
+	}
+
+	// Field (2) 'Consolidations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(e.Consolidations))
+		if num > constants.MaxConsolidationRequestsPerPayload {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range e.Consolidations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxConsolidationRequestsPerPayload,
+		)
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the ExecutionRequests object.
+func (e *ExecutionRequests) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(e)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetDeposits returns the deposit requests as deposits without a proof.
+func (e *ExecutionRequests) GetDeposits() []*Deposit {
+	deposits := make([]*Deposit, len(e.Deposits))
+	for i, request := range e.Deposits {
+		deposits[i] = request.Deposit()
+	}
+	return deposits
+}
+
+// GetWithdrawals returns the withdrawal requests.
+func (e *ExecutionRequests) GetWithdrawals() []*WithdrawalRequest {
+	return e.Withdrawals
+}
+
+// GetConsolidations returns the consolidation requests.
+func (e *ExecutionRequests) GetConsolidations() []*ConsolidationRequest {
+	return e.Consolidations
+}
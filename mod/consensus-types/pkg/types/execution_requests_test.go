@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func generateExecutionRequests() *types.ExecutionRequests {
+	return &types.ExecutionRequests{
+		Deposits: []*types.DepositRequest{
+			{
+				Pubkey:      crypto.BLSPubkey{1},
+				Credentials: types.WithdrawalCredentials{0x01},
+				Amount:      32e9,
+				Signature:   crypto.BLSSignature{2},
+				Index:       7,
+			},
+		},
+		Withdrawals: []*types.WithdrawalRequest{
+			types.NewWithdrawalRequest(
+				common.ExecutionAddress{3}, crypto.BLSPubkey{4}, 0,
+			),
+		},
+		Consolidations: []*types.ConsolidationRequest{
+			types.NewConsolidationRequest(
+				common.ExecutionAddress{5},
+				crypto.BLSPubkey{6},
+				crypto.BLSPubkey{7},
+			),
+		},
+	}
+}
+
+func TestExecutionRequests_MarshalUnmarshalSSZ(t *testing.T) {
+	requests := generateExecutionRequests()
+	bz, err := requests.MarshalSSZ()
+	require.NoError(t, err)
+
+	unmarshalled := new(types.ExecutionRequests)
+	require.NoError(t, unmarshalled.UnmarshalSSZ(bz))
+	require.Equal(t, requests, unmarshalled)
+
+	tree, err := requests.GetTree()
+	require.NoError(t, err)
+	expectedRoot := requests.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
+
+func TestExecutionRequests_EncodeRequests(t *testing.T) {
+	requests := generateExecutionRequests()
+	encoded, err := requests.EncodeRequests()
+	require.NoError(t, err)
+	require.Len(t, encoded, 3)
+	require.Equal(t, types.DepositRequestType, encoded[0][0])
+	require.Len(t, encoded[0], 1+types.DepositSizeWithoutProof)
+	require.Equal(t, types.WithdrawalRequestType, encoded[1][0])
+	require.Len(t, encoded[1], 1+types.WithdrawalRequestSize)
+	require.Equal(t, types.ConsolidationRequestType, encoded[2][0])
+	require.Len(t, encoded[2], 1+types.ConsolidationRequestSize)
+
+	decoded, err := (*types.ExecutionRequests)(nil).NewFromEncodedRequests(
+		encoded,
+	)
+	require.NoError(t, err)
+	require.Equal(t, requests, decoded)
+
+	// The types without requests are left out.
+	requests.Deposits = nil
+	encoded, err = requests.EncodeRequests()
+	require.NoError(t, err)
+	require.Len(t, encoded, 2)
+	require.Equal(t, types.WithdrawalRequestType, encoded[0][0])
+
+	// No requests are encoded as an empty list.
+	encoded, err = (&types.ExecutionRequests{}).EncodeRequests()
+	require.NoError(t, err)
+	require.NotNil(t, encoded)
+	require.Empty(t, encoded)
+}
+
+func TestExecutionRequests_NewFromEncodedRequestsInvalid(t *testing.T) {
+	encoded, err := generateExecutionRequests().EncodeRequests()
+	require.NoError(t, err)
+
+	for name, invalid := range map[string][][]byte{
+		"unordered types":   {encoded[1], encoded[0]},
+		"duplicate types":   {encoded[1], encoded[1]},
+		"empty requests":    {{types.WithdrawalRequestType}},
+		"truncated request": {encoded[1][:len(encoded[1])-1]},
+		"unknown type":      {{0x03, 0x00}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err = (*types.ExecutionRequests)(nil).NewFromEncodedRequests(
+				invalid,
+			)
+			require.ErrorIs(t, err, types.ErrInvalidExecutionRequests)
+		})
+	}
+}
+
+func TestExecutionRequests_GetDeposits(t *testing.T) {
+	requests := generateExecutionRequests()
+	deposits := requests.GetDeposits()
+	require.Len(t, deposits, 1)
+	require.Equal(t, requests.Deposits[0].Pubkey, deposits[0].GetPubkey())
+	require.Equal(t, uint64(7), deposits[0].GetIndex().Unwrap())
+}
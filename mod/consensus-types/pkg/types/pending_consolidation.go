@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// PendingConsolidationSize is the size of the PendingConsolidation object in
+// bytes.
+//
+// Total size: SourceIndex (8) + TargetIndex (8).
+const PendingConsolidationSize = 16
+
+var (
+	_ ssz.StaticObject                    = (*PendingConsolidation)(nil)
+	_ constraints.SSZMarshallableRootable = (*PendingConsolidation)(nil)
+)
+
+// PendingConsolidation is a consolidation of the balance of a validator into
+// another one, which is applied once the source validator is withdrawable.
+type PendingConsolidation struct {
+	// SourceIndex is the index of the validator that is consolidated.
+	SourceIndex math.ValidatorIndex `json:"source_index"`
+	// TargetIndex is the index of the validator the balance of the source
+	// validator is consolidated into.
+	TargetIndex math.ValidatorIndex `json:"target_index"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewPendingConsolidation creates a new PendingConsolidation.
+func NewPendingConsolidation(
+	sourceIndex, targetIndex math.ValidatorIndex,
+) *PendingConsolidation {
+	return &PendingConsolidation{
+		SourceIndex: sourceIndex,
+		TargetIndex: targetIndex,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the PendingConsolidation object in SSZ
+// encoding.
+func (*PendingConsolidation) SizeSSZ() uint32 {
+	return PendingConsolidationSize
+}
+
+// DefineSSZ defines the SSZ encoding for the PendingConsolidation object.
+func (p *PendingConsolidation) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &p.SourceIndex)
+	ssz.DefineUint64(codec, &p.TargetIndex)
+}
+
+// MarshalSSZ marshals the PendingConsolidation object to SSZ format.
+func (p *PendingConsolidation) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// UnmarshalSSZ unmarshals the PendingConsolidation object from SSZ format.
+func (p *PendingConsolidation) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, p)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the PendingConsolidation
+// object.
+func (p *PendingConsolidation) HashTreeRoot() common.Root {
+	return ssz.HashSequential(p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the PendingConsolidation object into a pre-allocated
+// byte slice.
+func (p *PendingConsolidation) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := p.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the PendingConsolidation object with a hasher.
+func (p *PendingConsolidation) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceIndex'
+	hh.PutUint64(uint64(p.SourceIndex))
+
+	// Field (1) 'TargetIndex'
+	hh.PutUint64(uint64(p.TargetIndex))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the PendingConsolidation object.
+func (p *PendingConsolidation) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetSourceIndex returns the index of the validator that is consolidated.
+func (p *PendingConsolidation) GetSourceIndex() math.ValidatorIndex {
+	return p.SourceIndex
+}
+
+// GetTargetIndex returns the index of the validator the balance is
+// consolidated into.
+func (p *PendingConsolidation) GetTargetIndex() math.ValidatorIndex {
+	return p.TargetIndex
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// ProposerSlashingSize is the size of the ProposerSlashing object in bytes.
+//
+// Total size: SignedHeader1 (208) + SignedHeader2 (208).
+const ProposerSlashingSize = 2 * SignedBeaconBlockHeaderSize
+
+var (
+	_ ssz.StaticObject                    = (*ProposerSlashing)(nil)
+	_ constraints.SSZMarshallableRootable = (*ProposerSlashing)(nil)
+)
+
+// ProposerSlashing as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposerslashing
+//
+//nolint:lll // link.
+type ProposerSlashing struct {
+	// SignedHeader1 is the first of the two conflicting signed headers.
+	SignedHeader1 *SignedBeaconBlockHeader
+	// SignedHeader2 is the second of the two conflicting signed headers.
+	SignedHeader2 *SignedBeaconBlockHeader
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewProposerSlashing creates a new ProposerSlashing.
+func NewProposerSlashing(
+	signedHeader1, signedHeader2 *SignedBeaconBlockHeader,
+) *ProposerSlashing {
+	return &ProposerSlashing{
+		SignedHeader1: signedHeader1,
+		SignedHeader2: signedHeader2,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the ProposerSlashing object in SSZ encoding.
+func (*ProposerSlashing) SizeSSZ() uint32 {
+	return ProposerSlashingSize
+}
+
+// DefineSSZ defines the SSZ encoding for the ProposerSlashing object.
+func (p *ProposerSlashing) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &p.SignedHeader1)
+	ssz.DefineStaticObject(codec, &p.SignedHeader2)
+}
+
+// MarshalSSZ marshals the ProposerSlashing object to SSZ format.
+func (p *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, p.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, p)
+}
+
+// UnmarshalSSZ unmarshals the ProposerSlashing object from SSZ format.
+func (p *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, p)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ProposerSlashing object.
+func (p *ProposerSlashing) HashTreeRoot() common.Root {
+	return ssz.HashSequential(p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the ProposerSlashing object into a pre-allocated byte
+// slice.
+func (p *ProposerSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := p.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the ProposerSlashing object with a hasher.
+func (p *ProposerSlashing) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SignedHeader1'
+	if p.SignedHeader1 == nil {
+		p.SignedHeader1 = new(SignedBeaconBlockHeader)
+	}
+	if err := p.SignedHeader1.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'SignedHeader2'
+	if p.SignedHeader2 == nil {
+		p.SignedHeader2 = new(SignedBeaconBlockHeader)
+	}
+	if err := p.SignedHeader2.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the ProposerSlashing object.
+func (p *ProposerSlashing) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(p)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Getters                                  */
+/* -------------------------------------------------------------------------- */
+
+// GetProposerIndex returns the index of the proposer being slashed.
+func (p *ProposerSlashing) GetProposerIndex() math.ValidatorIndex {
+	return p.SignedHeader1.Header.GetProposerIndex()
+}
+
+// GetSlot returns the slot of the conflicting headers.
+func (p *ProposerSlashing) GetSlot() math.Slot {
+	return p.SignedHeader1.Header.GetSlot()
+}
+
+// IsSlashable returns true if the two headers are distinct blocks proposed by
+// the same proposer for the same slot.
+func (p *ProposerSlashing) IsSlashable() bool {
+	if p.SignedHeader1 == nil || p.SignedHeader2 == nil ||
+		p.SignedHeader1.Header == nil || p.SignedHeader2.Header == nil {
+		return false
+	}
+	header1, header2 := p.SignedHeader1.Header, p.SignedHeader2.Header
+	return header1.GetSlot() == header2.GetSlot() &&
+		header1.GetProposerIndex() == header2.GetProposerIndex() &&
+		header1.HashTreeRoot() != header2.HashTreeRoot()
+}
+
+// VerifySignatures verifies the signatures of both headers against the given
+// public key of the proposer.
+func (p *ProposerSlashing) VerifySignatures(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	for _, signedHeader := range []*SignedBeaconBlockHeader{
+		p.SignedHeader1, p.SignedHeader2,
+	} {
+		if err := signedHeader.VerifySignature(
+			forkData, domainType, pubkey, signatureVerificationFn,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProposerSlashings is a typealias for a list of ProposerSlashings.
+type ProposerSlashings []*ProposerSlashing
+
+// SizeSSZ returns the SSZ encoded size in bytes for the ProposerSlashings.
+func (ps ProposerSlashings) SizeSSZ(bool) uint32 {
+	return ssz.SizeSliceOfStaticObjects(([]*ProposerSlashing)(ps))
+}
+
+// DefineSSZ defines the SSZ encoding for the ProposerSlashings object.
+func (ps ProposerSlashings) DefineSSZ(c *ssz.Codec) {
+	c.DefineDecoder(func(*ssz.Decoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*ProposerSlashing)(&ps),
+			constants.MaxProposerSlashingsPerBlock,
+		)
+	})
+	c.DefineEncoder(func(*ssz.Encoder) {
+		ssz.DefineSliceOfStaticObjectsContent(
+			c, (*[]*ProposerSlashing)(&ps),
+			constants.MaxProposerSlashingsPerBlock,
+		)
+	})
+	c.DefineHasher(func(*ssz.Hasher) {
+		ssz.DefineSliceOfStaticObjectsOffset(
+			c, (*[]*ProposerSlashing)(&ps),
+			constants.MaxProposerSlashingsPerBlock,
+		)
+	})
+}
+
+// HashTreeRoot returns the hash tree root of the ProposerSlashings.
+func (ps ProposerSlashings) HashTreeRoot() common.Root {
+	return ssz.HashSequential(ps)
+}
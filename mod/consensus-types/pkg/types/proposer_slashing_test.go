@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPubkey     = crypto.BLSPubkey{0xaa, 0xbb}
+	testForkData   = types.NewForkData(common.Version{1}, common.Root{2})
+	errInvalidTest = errors.New("invalid signature")
+)
+
+// fakeSign produces a deterministic "signature" over the message for the
+// given pubkey, used together with fakeVerify in tests.
+func fakeSign(pubkey crypto.BLSPubkey, message []byte) crypto.BLSSignature {
+	var sig crypto.BLSSignature
+	copy(sig[:], message)
+	copy(sig[len(message):], pubkey[:])
+	return sig
+}
+
+// fakeVerify verifies a signature produced by fakeSign.
+func fakeVerify(
+	pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+) error {
+	expected := fakeSign(pubkey, message)
+	if !bytes.Equal(expected[:], signature[:]) {
+		return errInvalidTest
+	}
+	return nil
+}
+
+// signedHeader builds a header signed with fakeSign for the proposer domain.
+func signedHeader(
+	slot math.Slot,
+	proposer math.ValidatorIndex,
+	bodyRoot common.Root,
+) *types.SignedBeaconBlockHeader {
+	header := types.NewBeaconBlockHeader(
+		slot, proposer, common.Root{1}, common.Root{2}, bodyRoot,
+	)
+	signingRoot := types.ComputeSigningRoot(
+		header, testForkData.ComputeDomain(common.DomainType{}),
+	)
+	return types.NewSignedBeaconBlockHeader(
+		header, fakeSign(testPubkey, signingRoot[:]),
+	)
+}
+
+func generateProposerSlashing() *types.ProposerSlashing {
+	return types.NewProposerSlashing(
+		signedHeader(10, 3, common.Root{4}),
+		signedHeader(10, 3, common.Root{5}),
+	)
+}
+
+func TestProposerSlashing_MarshalSSZ_UnmarshalSSZ(t *testing.T) {
+	slashing := generateProposerSlashing()
+
+	data, err := slashing.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.ProposerSlashingSize)
+
+	var unmarshalled types.ProposerSlashing
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, slashing, &unmarshalled)
+
+	var buf []byte
+	buf, err = slashing.MarshalSSZTo(buf)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+}
+
+func TestProposerSlashing_GetTree(t *testing.T) {
+	slashing := generateProposerSlashing()
+
+	tree, err := slashing.GetTree()
+	require.NoError(t, err)
+
+	expectedRoot := slashing.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+}
+
+func TestProposerSlashing_IsSlashable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		slashing *types.ProposerSlashing
+		expected bool
+	}{
+		{
+			name:     "double proposal",
+			slashing: generateProposerSlashing(),
+			expected: true,
+		},
+		{
+			name: "identical headers",
+			slashing: types.NewProposerSlashing(
+				signedHeader(10, 3, common.Root{4}),
+				signedHeader(10, 3, common.Root{4}),
+			),
+			expected: false,
+		},
+		{
+			name: "different slots",
+			slashing: types.NewProposerSlashing(
+				signedHeader(10, 3, common.Root{4}),
+				signedHeader(11, 3, common.Root{5}),
+			),
+			expected: false,
+		},
+		{
+			name: "different proposers",
+			slashing: types.NewProposerSlashing(
+				signedHeader(10, 3, common.Root{4}),
+				signedHeader(10, 4, common.Root{5}),
+			),
+			expected: false,
+		},
+		{
+			name:     "missing header",
+			slashing: types.NewProposerSlashing(nil, nil),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.slashing.IsSlashable())
+		})
+	}
+}
+
+func TestProposerSlashing_VerifySignatures(t *testing.T) {
+	slashing := generateProposerSlashing()
+	require.Equal(t, math.ValidatorIndex(3), slashing.GetProposerIndex())
+	require.Equal(t, math.Slot(10), slashing.GetSlot())
+
+	require.NoError(t, slashing.VerifySignatures(
+		testForkData, common.DomainType{}, testPubkey, fakeVerify,
+	))
+
+	// A signature from a different key must be rejected.
+	err := slashing.VerifySignatures(
+		testForkData, common.DomainType{}, crypto.BLSPubkey{0xcc}, fakeVerify,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidSlashingSignature)
+
+	// A signature over a different domain must be rejected.
+	err = slashing.VerifySignatures(
+		testForkData, common.DomainType{1}, testPubkey, fakeVerify,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidSlashingSignature)
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// SignedAttestationDataSize is the size of the SignedAttestationData object in
+// bytes.
+//
+// Total size: Data (48) + Signature (96).
+const SignedAttestationDataSize = 144
+
+var (
+	_ ssz.StaticObject                    = (*SignedAttestationData)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedAttestationData)(nil)
+)
+
+// SignedAttestationData is an attestation data signed by the attesting
+// validator.
+type SignedAttestationData struct {
+	// Data is the attestation data that was signed.
+	Data *AttestationData
+	// Signature is the signature of the attester over the data.
+	Signature crypto.BLSSignature
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewSignedAttestationData creates a new SignedAttestationData.
+func NewSignedAttestationData(
+	data *AttestationData,
+	signature crypto.BLSSignature,
+) *SignedAttestationData {
+	return &SignedAttestationData{
+		Data:      data,
+		Signature: signature,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SignedAttestationData object in SSZ
+// encoding.
+func (*SignedAttestationData) SizeSSZ() uint32 {
+	return SignedAttestationDataSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedAttestationData object.
+func (s *SignedAttestationData) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Data)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// MarshalSSZ marshals the SignedAttestationData object to SSZ format.
+func (s *SignedAttestationData) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// UnmarshalSSZ unmarshals the SignedAttestationData object from SSZ format.
+func (s *SignedAttestationData) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedAttestationData
+// object.
+func (s *SignedAttestationData) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the SignedAttestationData object into a pre-allocated
+// byte slice.
+func (s *SignedAttestationData) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the SignedAttestationData object with a hasher.
+func (s *SignedAttestationData) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Data'
+	if s.Data == nil {
+		s.Data = new(AttestationData)
+	}
+	if err := s.Data.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the SignedAttestationData object.
+func (s *SignedAttestationData) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            Getters and Setters                             */
+/* -------------------------------------------------------------------------- */
+
+// GetData returns the data of the SignedAttestationData.
+func (s *SignedAttestationData) GetData() *AttestationData {
+	return s.Data
+}
+
+// GetSignature returns the signature of the SignedAttestationData.
+func (s *SignedAttestationData) GetSignature() crypto.BLSSignature {
+	return s.Signature
+}
+
+// VerifySignature verifies the signature of the attestation data against the
+// given public key of the attester.
+func (s *SignedAttestationData) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		s.Data, forkData.ComputeDomain(domainType),
+	)
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], s.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidSlashingSignature)
+	}
+	return nil
+}
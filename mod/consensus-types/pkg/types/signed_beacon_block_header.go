@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// SignedBeaconBlockHeaderSize is the size of the SignedBeaconBlockHeader
+// object in bytes.
+//
+// Total size: Header (112) + Signature (96).
+const SignedBeaconBlockHeaderSize = 208
+
+var (
+	_ ssz.StaticObject                    = (*SignedBeaconBlockHeader)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedBeaconBlockHeader)(nil)
+)
+
+// SignedBeaconBlockHeader is a beacon block header signed by its proposer.
+type SignedBeaconBlockHeader struct {
+	// Header is the beacon block header that was signed.
+	Header *BeaconBlockHeader
+	// Signature is the signature of the proposer over the header.
+	Signature crypto.BLSSignature
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewSignedBeaconBlockHeader creates a new SignedBeaconBlockHeader.
+func NewSignedBeaconBlockHeader(
+	header *BeaconBlockHeader,
+	signature crypto.BLSSignature,
+) *SignedBeaconBlockHeader {
+	return &SignedBeaconBlockHeader{
+		Header:    header,
+		Signature: signature,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SignedBeaconBlockHeader object in SSZ
+// encoding.
+func (*SignedBeaconBlockHeader) SizeSSZ() uint32 {
+	return SignedBeaconBlockHeaderSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedBeaconBlockHeader object.
+func (s *SignedBeaconBlockHeader) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Header)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// MarshalSSZ marshals the SignedBeaconBlockHeader object to SSZ format.
+func (s *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// UnmarshalSSZ unmarshals the SignedBeaconBlockHeader object from SSZ format.
+func (s *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedBeaconBlockHeader
+// object.
+func (s *SignedBeaconBlockHeader) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the SignedBeaconBlockHeader object into a
+// pre-allocated byte slice.
+func (s *SignedBeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the SignedBeaconBlockHeader object with a
+// hasher.
+func (s *SignedBeaconBlockHeader) HashTreeRootWith(
+	hh fastssz.HashWalker,
+) error {
+	indx := hh.Index()
+
+	// Field (0) 'Header'
+	if s.Header == nil {
+		s.Header = new(BeaconBlockHeader)
+	}
+	if err := s.Header.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the SignedBeaconBlockHeader object.
+func (s *SignedBeaconBlockHeader) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            Getters and Setters                             */
+/* -------------------------------------------------------------------------- */
+
+// GetHeader returns the header of the SignedBeaconBlockHeader.
+func (s *SignedBeaconBlockHeader) GetHeader() *BeaconBlockHeader {
+	return s.Header
+}
+
+// GetSignature returns the signature of the SignedBeaconBlockHeader.
+func (s *SignedBeaconBlockHeader) GetSignature() crypto.BLSSignature {
+	return s.Signature
+}
+
+// VerifySignature verifies the signature of the header against the given
+// public key of its proposer.
+func (s *SignedBeaconBlockHeader) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		s.Header, forkData.ComputeDomain(domainType),
+	)
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], s.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidSlashingSignature)
+	}
+	return nil
+}
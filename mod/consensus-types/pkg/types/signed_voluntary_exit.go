@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// SignedVoluntaryExitSize is the size of the SignedVoluntaryExit object in
+// bytes.
+//
+// Total size: Message (16) + Signature (96).
+const SignedVoluntaryExitSize = 112
+
+var (
+	_ ssz.StaticObject                    = (*SignedVoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*SignedVoluntaryExit)(nil)
+)
+
+// SignedVoluntaryExit is a voluntary exit signed by the exiting validator.
+type SignedVoluntaryExit struct {
+	// Message is the voluntary exit that was signed.
+	Message *VoluntaryExit `json:"message"`
+	// Signature is the signature of the validator over the message.
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewSignedVoluntaryExit creates a new SignedVoluntaryExit.
+func NewSignedVoluntaryExit(
+	message *VoluntaryExit,
+	signature crypto.BLSSignature,
+) *SignedVoluntaryExit {
+	return &SignedVoluntaryExit{
+		Message:   message,
+		Signature: signature,
+	}
+}
+
+// New creates a new SignedVoluntaryExit for the given epoch, validator index
+// and signature.
+func (*SignedVoluntaryExit) New(
+	epoch math.Epoch,
+	index math.ValidatorIndex,
+	signature crypto.BLSSignature,
+) *SignedVoluntaryExit {
+	return NewSignedVoluntaryExit(NewVoluntaryExit(epoch, index), signature)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SignedVoluntaryExit object in SSZ encoding.
+func (*SignedVoluntaryExit) SizeSSZ() uint32 {
+	return SignedVoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the SignedVoluntaryExit object.
+func (s *SignedVoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticObject(codec, &s.Message)
+	ssz.DefineStaticBytes(codec, &s.Signature)
+}
+
+// MarshalSSZ marshals the SignedVoluntaryExit object to SSZ format.
+func (s *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// UnmarshalSSZ unmarshals the SignedVoluntaryExit object from SSZ format.
+func (s *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the SignedVoluntaryExit
+// object.
+func (s *SignedVoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the SignedVoluntaryExit object into a pre-allocated
+// byte slice.
+func (s *SignedVoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the SignedVoluntaryExit object with a hasher.
+func (s *SignedVoluntaryExit) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Message'
+	if s.Message == nil {
+		s.Message = new(VoluntaryExit)
+	}
+	if err := s.Message.HashTreeRootWith(hh); err != nil {
+		return err
+	}
+
+	// Field (1) 'Signature'
+	hh.PutBytes(s.Signature[:])
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the SignedVoluntaryExit object.
+func (s *SignedVoluntaryExit) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            Getters and Setters                             */
+/* -------------------------------------------------------------------------- */
+
+// GetMessage returns the message of the SignedVoluntaryExit.
+func (s *SignedVoluntaryExit) GetMessage() *VoluntaryExit {
+	return s.Message
+}
+
+// GetSignature returns the signature of the SignedVoluntaryExit.
+func (s *SignedVoluntaryExit) GetSignature() crypto.BLSSignature {
+	return s.Signature
+}
+
+// GetEpoch returns the earliest epoch at which the exit can be processed.
+func (s *SignedVoluntaryExit) GetEpoch() math.Epoch {
+	return s.Message.GetEpoch()
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (s *SignedVoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return s.Message.GetValidatorIndex()
+}
+
+// VerifySignature verifies the signature of the exit against the given public
+// key of the exiting validator.
+func (s *SignedVoluntaryExit) VerifySignature(
+	forkData *ForkData,
+	domainType common.DomainType,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(
+		s.Message, forkData.ComputeDomain(domainType),
+	)
+	if err := signatureVerificationFn(
+		pubkey, signingRoot[:], s.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidVoluntaryExitSignature)
+	}
+	return nil
+}
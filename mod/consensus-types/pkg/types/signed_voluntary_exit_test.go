@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// generateSignedVoluntaryExit builds an exit signed with fakeSign for the
+// default domain.
+func generateSignedVoluntaryExit() *types.SignedVoluntaryExit {
+	exit := types.NewVoluntaryExit(5, 3)
+	signingRoot := types.ComputeSigningRoot(
+		exit, testForkData.ComputeDomain(common.DomainType{}),
+	)
+	return types.NewSignedVoluntaryExit(
+		exit, fakeSign(testPubkey, signingRoot[:]),
+	)
+}
+
+func TestSignedVoluntaryExit_MarshalSSZ_UnmarshalSSZ(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+
+	data, err := exit.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, types.SignedVoluntaryExitSize)
+
+	var unmarshalled types.SignedVoluntaryExit
+	require.NoError(t, unmarshalled.UnmarshalSSZ(data))
+	require.Equal(t, exit, &unmarshalled)
+
+	var buf []byte
+	buf, err = exit.MarshalSSZTo(buf)
+	require.NoError(t, err)
+	require.Equal(t, data, buf)
+}
+
+func TestSignedVoluntaryExit_GetTree(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+
+	tree, err := exit.GetTree()
+	require.NoError(t, err)
+
+	expectedRoot := exit.HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(tree.Hash()))
+
+	messageTree, err := exit.GetMessage().GetTree()
+	require.NoError(t, err)
+
+	expectedRoot = exit.GetMessage().HashTreeRoot()
+	require.Equal(t, string(expectedRoot[:]), string(messageTree.Hash()))
+}
+
+func TestVoluntaryExits_HashTreeRoot(t *testing.T) {
+	exits := types.VoluntaryExits{
+		generateSignedVoluntaryExit(),
+		generateSignedVoluntaryExit(),
+	}
+	require.NotEqual(t, common.Root{}, exits.HashTreeRoot())
+	require.NotEqual(t, exits.HashTreeRoot(), exits[:1].HashTreeRoot())
+}
+
+func TestSignedVoluntaryExit_VerifySignature(t *testing.T) {
+	exit := generateSignedVoluntaryExit()
+	require.Equal(t, math.Epoch(5), exit.GetEpoch())
+	require.Equal(t, math.ValidatorIndex(3), exit.GetValidatorIndex())
+
+	require.NoError(t, exit.VerifySignature(
+		testForkData, common.DomainType{}, testPubkey, fakeVerify,
+	))
+
+	// A signature from a different key must be rejected.
+	err := exit.VerifySignature(
+		testForkData, common.DomainType{}, crypto.BLSPubkey{0xcc}, fakeVerify,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidVoluntaryExitSignature)
+
+	// A signature over a different domain must be rejected.
+	err = exit.VerifySignature(
+		testForkData, common.DomainType{1}, testPubkey, fakeVerify,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidVoluntaryExitSignature)
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// Compile-time assertion to ensure SlashingOperations implements the correct
+// interfaces.
+var _ ssz.DynamicObject = (*SlashingOperations)(nil)
+
+// SlashingOperations groups the slashing operations included in a block body
+// from the Deneb+ fork onwards, along with the votes reported by the consensus
+// engine. They are nested in a single body field so that the depth of the
+// body tree, and with it the KZG commitment inclusion proofs of the blob
+// sidecars, is the same as in Deneb.
+type SlashingOperations struct {
+	// ProposerSlashings is the list of proposer slashings.
+	ProposerSlashings []*ProposerSlashing
+	// AttesterSlashings is the list of attester slashings.
+	AttesterSlashings []*AttesterSlashing
+	// SlashingInfo is the list of validators reported as misbehaving by the
+	// consensus engine.
+	SlashingInfo []*SlashingInfo
+	// Attestations is the list of validators whose vote for the parent block
+	// was committed by the consensus engine, sorted by validator index.
+	Attestations []*AttestationData
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the SlashingOperations in SSZ.
+func (s *SlashingOperations) SizeSSZ(fixed bool) uint32 {
+	var size uint32 = 4 + 4 + 4 + 4
+	if fixed {
+		return size
+	}
+
+	size += ssz.SizeSliceOfStaticObjects(s.ProposerSlashings)
+	size += ssz.SizeSliceOfStaticObjects(s.AttesterSlashings)
+	size += ssz.SizeSliceOfStaticObjects(s.SlashingInfo)
+	size += ssz.SizeSliceOfStaticObjects(s.Attestations)
+	return size
+}
+
+// DefineSSZ defines the SSZ serialization of the SlashingOperations.
+func (s *SlashingOperations) DefineSSZ(codec *ssz.Codec) {
+	// Define the static data (fields and dynamic offsets)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.ProposerSlashings, constants.MaxProposerSlashingsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.AttesterSlashings, constants.MaxAttesterSlashingsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsOffset(
+		codec, &s.Attestations, constants.MaxAttestationsPerBlock,
+	)
+
+	// Define the dynamic data (fields)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.ProposerSlashings, constants.MaxProposerSlashingsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.AttesterSlashings, constants.MaxAttesterSlashingsPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.SlashingInfo, constants.MaxSlashingInfoPerBlock,
+	)
+	ssz.DefineSliceOfStaticObjectsContent(
+		codec, &s.Attestations, constants.MaxAttestationsPerBlock,
+	)
+}
+
+// MarshalSSZ serializes the SlashingOperations to SSZ-encoded bytes.
+func (s *SlashingOperations) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, s.SizeSSZ(false))
+	return buf, ssz.EncodeToBytes(buf, s)
+}
+
+// UnmarshalSSZ deserializes the SlashingOperations from SSZ-encoded bytes.
+func (s *SlashingOperations) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, s)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the SlashingOperations.
+func (s *SlashingOperations) HashTreeRoot() common.Root {
+	return ssz.HashSequential(s)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo serializes the SlashingOperations into a writer.
+func (s *SlashingOperations) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := s.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the SlashingOperations object with a hasher.
+func (s *SlashingOperations) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'ProposerSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.ProposerSlashings))
+		if num > constants.MaxProposerSlashingsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range s.ProposerSlashings {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxProposerSlashingsPerBlock,
+		)
+	}
+
+	// Field (1) 'AttesterSlashings'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.AttesterSlashings))
+		if num > constants.MaxAttesterSlashingsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range s.AttesterSlashings {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.MaxAttesterSlashingsPerBlock,
+		)
+	}
+
+	// Field (2) 'SlashingInfo'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.SlashingInfo))
+		if num > constants.MaxSlashingInfoPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range s.SlashingInfo {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxSlashingInfoPerBlock)
+	}
+
+	// Field (3) 'Attestations'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(s.Attestations))
+		if num > constants.MaxAttestationsPerBlock {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range s.Attestations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxAttestationsPerBlock)
+	}
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the SlashingOperations object.
+func (s *SlashingOperations) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(s)
+}
@@ -21,14 +21,40 @@
 package types
 
 import (
+	"cmp"
+	"encoding/binary"
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	fastssz "github.com/ferranbt/fastssz"
 	"github.com/karalabe/ssz"
 )
 
-// BeaconState represents the entire state of the beacon chain.
+const (
+	// stateFixedSizeDeneb is the size of the static part of the BeaconState
+	// in the Deneb fork.
+	stateFixedSizeDeneb = 300
+	// stateFixedSizeDenebPlus is the size of the static part of the
+	// BeaconState from the Deneb+ fork onwards.
+	stateFixedSizeDenebPlus = stateFixedSizeDeneb + 4 + 4 + 4 + 4
+	// stateFixedSizeElectra is the size of the static part of the
+	// BeaconState from the Electra fork onwards.
+	stateFixedSizeElectra = stateFixedSizeDenebPlus + 8 + 4
+	// blockRootsOffsetPosition is the position of the offset of the block
+	// roots in the SSZ encoding of the BeaconState. As the block roots are
+	// the first dynamic field, their offset is the size of the static part.
+	blockRootsOffsetPosition = 168
+)
+
+// BeaconState represents the entire state of the beacon chain. From the
+// Deneb+ fork onwards the state also carries the participation, the
+// inactivity scores and the voting powers of the validators and the eth1 data
+// votes, and from the Electra fork onwards the state of the execution layer
+// requests.
 type BeaconState[
 	BeaconBlockHeaderT constraints.
 		StaticSSZField[BeaconBlockHeaderT, B],
@@ -71,6 +97,23 @@ type BeaconState[
 	// Slashing
 	Slashings     []uint64
 	TotalSlashing math.Gwei
+
+	// Participation, from the Deneb+ fork onwards
+	EpochParticipation []uint64
+	InactivityScores   []uint64
+
+	// Consensus, from the Deneb+ fork onwards
+	ValidatorPowers []uint64
+
+	// Eth1 data voting, from the Deneb+ fork onwards
+	Eth1DataVotes []Eth1DataT
+
+	// Execution requests, from the Electra fork onwards
+	DepositRequestsStartIndex uint64
+	PendingConsolidations     []*PendingConsolidation
+
+	// forkVersion is the fork version the layout of the state is taken from.
+	forkVersion uint32
 }
 
 // New creates a new BeaconState.
@@ -82,7 +125,7 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ]) New(
-	_ uint32,
+	forkVersion uint32,
 	genesisValidatorsRoot common.Root,
 	slot math.Slot,
 	fork ForkT,
@@ -99,6 +142,12 @@ func (st *BeaconState[
 	nextWithdrawalValidatorIndex math.ValidatorIndex,
 	slashings []uint64,
 	totalSlashing math.Gwei,
+	epochParticipation []uint64,
+	inactivityScores []uint64,
+	validatorPowers []uint64,
+	eth1DataVotes []Eth1DataT,
+	depositRequestsStartIndex uint64,
+	pendingConsolidations map[math.ValidatorIndex]math.ValidatorIndex,
 ) (*BeaconState[
 	BeaconBlockHeaderT,
 	Eth1DataT,
@@ -107,6 +156,28 @@ func (st *BeaconState[
 	ValidatorT,
 	B, E, P, F, V,
 ], error) {
+	// The state of the execution requests is only part of the state from
+	// Electra onwards, with the pending consolidations in order of their
+	// source validator.
+	var (
+		startIndex     uint64
+		consolidations []*PendingConsolidation
+	)
+	if forkVersion >= version.Electra {
+		startIndex = depositRequestsStartIndex
+		consolidations = make(
+			[]*PendingConsolidation, 0, len(pendingConsolidations),
+		)
+		for source, target := range pendingConsolidations {
+			consolidations = append(
+				consolidations, NewPendingConsolidation(source, target),
+			)
+		}
+		slices.SortFunc(consolidations, func(a, b *PendingConsolidation) int {
+			return cmp.Compare(a.SourceIndex, b.SourceIndex)
+		})
+	}
+
 	return &BeaconState[
 		BeaconBlockHeaderT,
 		Eth1DataT,
@@ -131,9 +202,41 @@ func (st *BeaconState[
 		NextWithdrawalValidatorIndex: nextWithdrawalValidatorIndex,
 		Slashings:                    slashings,
 		TotalSlashing:                totalSlashing,
+		EpochParticipation:           epochParticipation,
+		InactivityScores:             inactivityScores,
+		ValidatorPowers:              validatorPowers,
+		Eth1DataVotes:                eth1DataVotes,
+		DepositRequestsStartIndex:    startIndex,
+		PendingConsolidations:        consolidations,
+		forkVersion:                  forkVersion,
 	}, nil
 }
 
+// Version returns the fork version of the layout of the BeaconState. States
+// of earlier forks share the layout of Deneb.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) Version() uint32 {
+	return max(st.forkVersion, version.Deneb)
+}
+
+// hasParticipation returns whether the BeaconState carries the participation
+// and the voting powers of the validators and the eth1 data votes, which were
+// added in the Deneb+ fork.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) hasParticipation() bool {
+	return st.forkVersion >= version.DenebPlus
+}
+
+// hasExecutionRequests returns whether the BeaconState carries the state of
+// the execution layer requests, which was added in the Electra fork.
+func (st *BeaconState[
+	_, _, _, _, _, _, _, _, _, _,
+]) hasExecutionRequests() bool {
+	return st.forkVersion >= version.Electra
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     SSZ                                    */
 /* -------------------------------------------------------------------------- */
@@ -142,7 +245,15 @@ func (st *BeaconState[
 func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) SizeSSZ(fixed bool) uint32 {
-	var size uint32 = 300
+	var size uint32
+	switch {
+	case st.hasExecutionRequests():
+		size = stateFixedSizeElectra
+	case st.hasParticipation():
+		size = stateFixedSizeDenebPlus
+	default:
+		size = stateFixedSizeDeneb
+	}
 
 	if fixed {
 		return size
@@ -156,6 +267,15 @@ func (st *BeaconState[
 	size += ssz.SizeSliceOfUint64s(st.Balances)
 	size += ssz.SizeSliceOfStaticBytes(st.RandaoMixes)
 	size += ssz.SizeSliceOfUint64s(st.Slashings)
+	if st.hasParticipation() {
+		size += ssz.SizeSliceOfUint64s(st.EpochParticipation)
+		size += ssz.SizeSliceOfUint64s(st.InactivityScores)
+		size += ssz.SizeSliceOfUint64s(st.ValidatorPowers)
+		size += ssz.SizeSliceOfStaticObjects(st.Eth1DataVotes)
+	}
+	if st.hasExecutionRequests() {
+		size += ssz.SizeSliceOfStaticObjects(st.PendingConsolidations)
+	}
 
 	return size
 }
@@ -196,6 +316,35 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sOffset(codec, &st.Slashings, 1099511627776)
 	ssz.DefineUint64(codec, (*uint64)(&st.TotalSlashing))
 
+	// Participation
+	if st.hasParticipation() {
+		ssz.DefineSliceOfUint64sOffset(
+			codec, &st.EpochParticipation, 1099511627776,
+		)
+		ssz.DefineSliceOfUint64sOffset(
+			codec, &st.InactivityScores, 1099511627776,
+		)
+
+		// Consensus
+		ssz.DefineSliceOfUint64sOffset(
+			codec, &st.ValidatorPowers, 1099511627776,
+		)
+
+		// Eth1 data voting
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.Eth1DataVotes, constants.MaxEth1DataVotes,
+		)
+	}
+
+	// Execution requests
+	if st.hasExecutionRequests() {
+		ssz.DefineUint64(codec, &st.DepositRequestsStartIndex)
+		ssz.DefineSliceOfStaticObjectsOffset(
+			codec, &st.PendingConsolidations,
+			constants.PendingConsolidationsLimit,
+		)
+	}
+
 	// Dynamic content
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.BlockRoots, 8192)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.StateRoots, 8192)
@@ -204,6 +353,26 @@ func (st *BeaconState[
 	ssz.DefineSliceOfUint64sContent(codec, &st.Balances, 1099511627776)
 	ssz.DefineSliceOfStaticBytesContent(codec, &st.RandaoMixes, 65536)
 	ssz.DefineSliceOfUint64sContent(codec, &st.Slashings, 1099511627776)
+	if st.hasParticipation() {
+		ssz.DefineSliceOfUint64sContent(
+			codec, &st.EpochParticipation, 1099511627776,
+		)
+		ssz.DefineSliceOfUint64sContent(
+			codec, &st.InactivityScores, 1099511627776,
+		)
+		ssz.DefineSliceOfUint64sContent(
+			codec, &st.ValidatorPowers, 1099511627776,
+		)
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.Eth1DataVotes, constants.MaxEth1DataVotes,
+		)
+	}
+	if st.hasExecutionRequests() {
+		ssz.DefineSliceOfStaticObjectsContent(
+			codec, &st.PendingConsolidations,
+			constants.PendingConsolidationsLimit,
+		)
+	}
 }
 
 // MarshalSSZ marshals the BeaconState into SSZ format.
@@ -214,10 +383,23 @@ func (st *BeaconState[
 	return buf, ssz.EncodeToBytes(buf, st)
 }
 
-// UnmarshalSSZ unmarshals the BeaconState from SSZ format.
+// UnmarshalSSZ unmarshals the BeaconState from SSZ format. The layout of the
+// state is picked from the size of its static part.
 func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
 ]) UnmarshalSSZ(buf []byte) error {
+	var fixedSize uint32
+	if len(buf) >= blockRootsOffsetPosition+4 {
+		fixedSize = binary.LittleEndian.Uint32(buf[blockRootsOffsetPosition:])
+	}
+	switch fixedSize {
+	case stateFixedSizeElectra:
+		st.forkVersion = version.Electra
+	case stateFixedSizeDenebPlus:
+		st.forkVersion = version.DenebPlus
+	default:
+		st.forkVersion = min(st.forkVersion, version.Deneb)
+	}
 	return ssz.DecodeFromBytes(buf, st)
 }
 
@@ -391,10 +573,90 @@ func (st *BeaconState[
 	// Field (15) 'TotalSlashing'
 	hh.PutUint64(uint64(st.TotalSlashing))
 
+	if st.hasParticipation() {
+		// Field (16) 'EpochParticipation'
+		if err := hashUint64sWith(
+			hh, "BeaconState.EpochParticipation", st.EpochParticipation,
+		); err != nil {
+			return err
+		}
+
+		// Field (17) 'InactivityScores'
+		if err := hashUint64sWith(
+			hh, "BeaconState.InactivityScores", st.InactivityScores,
+		); err != nil {
+			return err
+		}
+
+		// Field (18) 'ValidatorPowers'
+		if err := hashUint64sWith(
+			hh, "BeaconState.ValidatorPowers", st.ValidatorPowers,
+		); err != nil {
+			return err
+		}
+
+		// Field (19) 'Eth1DataVotes'
+		subIndx = hh.Index()
+		num = uint64(len(st.Eth1DataVotes))
+		if num > constants.MaxEth1DataVotes {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.Eth1DataVotes {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, constants.MaxEth1DataVotes)
+	}
+
+	if st.hasExecutionRequests() {
+		// Field (20) 'DepositRequestsStartIndex'
+		hh.PutUint64(st.DepositRequestsStartIndex)
+
+		// Field (21) 'PendingConsolidations'
+		subIndx = hh.Index()
+		num = uint64(len(st.PendingConsolidations))
+		if num > constants.PendingConsolidationsLimit {
+			return fastssz.ErrIncorrectListSize
+		}
+		for _, elem := range st.PendingConsolidations {
+			if err := elem.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(
+			subIndx, num, constants.PendingConsolidationsLimit,
+		)
+	}
+
 	hh.Merkleize(indx)
 	return nil
 }
 
+// hashUint64sWith ssz hashes a list of uint64s of the size of the registry
+// with a hasher.
+//
+//nolint:mnd // registry limit.
+func hashUint64sWith(
+	hh fastssz.HashWalker, name string, values []uint64,
+) error {
+	if size := len(values); size > 1099511627776 {
+		return fastssz.ErrListTooBigFn(name, size, 1099511627776)
+	}
+	subIndx := hh.Index()
+	for _, i := range values {
+		hh.AppendUint64(i)
+	}
+	hh.FillUpTo32()
+	numItems := uint64(len(values))
+	hh.MerkleizeWithMixin(
+		subIndx,
+		numItems,
+		fastssz.CalculateLimit(1099511627776, numItems, 8),
+	)
+	return nil
+}
+
 // GetTree ssz hashes the BeaconState object.
 func (st *BeaconState[
 	_, _, _, _, _, _, _, _, _, _,
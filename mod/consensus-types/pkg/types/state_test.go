@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	karalabessz "github.com/karalabe/ssz"
 	"github.com/stretchr/testify/require"
 )
@@ -137,6 +138,92 @@ func generateValidBeaconState() *types.BeaconState[
 	}
 }
 
+// generateDenebPlusBeaconState generates a valid beacon state in the layout
+// of the Deneb+ fork.
+func generateDenebPlusBeaconState(t *testing.T) *types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+] {
+	t.Helper()
+	return generateVersionedBeaconState(t, version.DenebPlus)
+}
+
+// generateElectraBeaconState generates a valid beacon state in the layout of
+// the Electra fork.
+func generateElectraBeaconState(t *testing.T) *types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+] {
+	t.Helper()
+	return generateVersionedBeaconState(t, version.Electra)
+}
+
+// generateVersionedBeaconState generates a valid beacon state in the layout
+// of the given fork version.
+func generateVersionedBeaconState(
+	t *testing.T, forkVersion uint32,
+) *types.BeaconState[
+	*types.BeaconBlockHeader,
+	*types.Eth1Data,
+	*types.ExecutionPayloadHeader,
+	*types.Fork,
+	*types.Validator,
+	types.BeaconBlockHeader,
+	types.Eth1Data,
+	types.ExecutionPayloadHeader,
+	types.Fork,
+	types.Validator,
+] {
+	t.Helper()
+	st := generateValidBeaconState()
+	st, err := st.New(
+		forkVersion,
+		st.GenesisValidatorsRoot,
+		st.Slot,
+		st.Fork,
+		st.LatestBlockHeader,
+		st.BlockRoots,
+		st.StateRoots,
+		st.Eth1Data,
+		st.Eth1DepositIndex,
+		st.LatestExecutionPayloadHeader,
+		st.Validators,
+		st.Balances,
+		st.RandaoMixes,
+		st.NextWithdrawalIndex,
+		st.NextWithdrawalValidatorIndex,
+		st.Slashings,
+		st.TotalSlashing,
+		[]uint64{3, 0},
+		[]uint64{0, 2},
+		[]uint64{32000000000, 0},
+		[]*types.Eth1Data{
+			{DepositRoot: common.Root{0x44}, DepositCount: 1001},
+			{DepositRoot: common.Root{0x45}, DepositCount: 1002},
+		},
+		7,
+		map[math.ValidatorIndex]math.ValidatorIndex{1: 0, 0: 1},
+	)
+	require.NoError(t, err)
+	return st
+}
+
 func generateRandomBytes32(count int) []common.Bytes32 {
 	result := make([]common.Bytes32, count)
 	for i := range result {
@@ -177,6 +264,92 @@ func TestBeaconStateMarshalUnmarshalSSZ(t *testing.T) {
 	require.Positive(t, genState.SizeSSZ(false))
 }
 
+func TestBeaconStateMarshalUnmarshalSSZDenebPlus(t *testing.T) {
+	genState := generateDenebPlusBeaconState(t)
+	require.Equal(t, version.DenebPlus, genState.Version())
+
+	data, err := genState.MarshalSSZ()
+	require.NoError(t, err)
+
+	newState := &types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]{}
+	require.NoError(t, newState.UnmarshalSSZ(data))
+	require.Equal(t, genState, newState)
+	require.Equal(t, version.DenebPlus, newState.Version())
+
+	// The participation, the voting powers and the eth1 data votes are part
+	// of the root of the state.
+	require.NotEqual(
+		t, generateValidBeaconState().HashTreeRoot(), genState.HashTreeRoot(),
+	)
+	tree, err := genState.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, genState.HashTreeRoot(), common.Root(tree.Hash()))
+
+	// Decoding a Deneb state into it resets the layout.
+	data, err = generateValidBeaconState().MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, newState.UnmarshalSSZ(data))
+	require.Equal(t, version.Deneb, newState.Version())
+	require.Equal(t, generateValidBeaconState().HashTreeRoot(),
+		newState.HashTreeRoot())
+}
+
+func TestBeaconStateMarshalUnmarshalSSZElectra(t *testing.T) {
+	genState := generateElectraBeaconState(t)
+	require.Equal(t, version.Electra, genState.Version())
+	require.Equal(t, []*types.PendingConsolidation{
+		types.NewPendingConsolidation(0, 1),
+		types.NewPendingConsolidation(1, 0),
+	}, genState.PendingConsolidations)
+
+	data, err := genState.MarshalSSZ()
+	require.NoError(t, err)
+
+	newState := &types.BeaconState[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.BeaconBlockHeader,
+		types.Eth1Data,
+		types.ExecutionPayloadHeader,
+		types.Fork,
+		types.Validator,
+	]{}
+	require.NoError(t, newState.UnmarshalSSZ(data))
+	require.Equal(t, genState, newState)
+	require.Equal(t, version.Electra, newState.Version())
+
+	// The state of the execution requests is part of the root of the state.
+	require.NotEqual(
+		t,
+		generateDenebPlusBeaconState(t).HashTreeRoot(),
+		genState.HashTreeRoot(),
+	)
+	tree, err := genState.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, genState.HashTreeRoot(), common.Root(tree.Hash()))
+	require.Equal(t,
+		genState.HashTreeRoot(),
+		common.Root(karalabessz.HashSequential(genState)),
+	)
+
+	// A Deneb+ state does not carry them.
+	require.Nil(t, generateDenebPlusBeaconState(t).PendingConsolidations)
+}
+
 func TestHashTreeRoot(t *testing.T) {
 	state := generateValidBeaconState()
 	require.NotPanics(t, func() {
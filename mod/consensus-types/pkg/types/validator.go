@@ -313,6 +313,48 @@ func (v *Validator) SetEffectiveBalance(balance math.Gwei) {
 	v.EffectiveBalance = balance
 }
 
+// SetSlashed sets the slashed status of the validator.
+func (v *Validator) SetSlashed(slashed bool) {
+	v.Slashed = slashed
+}
+
+// GetActivationEligibilityEpoch returns the epoch when the validator became
+// eligible for activation.
+func (v Validator) GetActivationEligibilityEpoch() math.Epoch {
+	return v.ActivationEligibilityEpoch
+}
+
+// SetActivationEligibilityEpoch sets the epoch when the validator became
+// eligible for activation.
+func (v *Validator) SetActivationEligibilityEpoch(epoch math.Epoch) {
+	v.ActivationEligibilityEpoch = epoch
+}
+
+// GetActivationEpoch returns the epoch when the validator activates.
+func (v Validator) GetActivationEpoch() math.Epoch {
+	return v.ActivationEpoch
+}
+
+// SetActivationEpoch sets the epoch when the validator activates.
+func (v *Validator) SetActivationEpoch(epoch math.Epoch) {
+	v.ActivationEpoch = epoch
+}
+
+// GetExitEpoch returns the epoch when the validator exits.
+func (v Validator) GetExitEpoch() math.Epoch {
+	return v.ExitEpoch
+}
+
+// SetExitEpoch sets the epoch when the validator exits.
+func (v *Validator) SetExitEpoch(epoch math.Epoch) {
+	v.ExitEpoch = epoch
+}
+
+// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+func (v *Validator) SetWithdrawableEpoch(epoch math.Epoch) {
+	v.WithdrawableEpoch = epoch
+}
+
 // GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 func (v Validator) GetWithdrawableEpoch() math.Epoch {
 	return v.WithdrawableEpoch
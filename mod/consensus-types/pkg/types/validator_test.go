@@ -823,6 +823,23 @@ func TestValidator_GetWithdrawableEpoch(t *testing.T) {
 	}
 }
 
+func TestValidator_LifecycleEpochs(t *testing.T) {
+	v := &types.Validator{
+		ActivationEligibilityEpoch: math.Epoch(constants.FarFutureEpoch),
+		ActivationEpoch:            math.Epoch(constants.FarFutureEpoch),
+		ExitEpoch:                  math.Epoch(constants.FarFutureEpoch),
+	}
+
+	v.SetActivationEligibilityEpoch(1)
+	v.SetActivationEpoch(2)
+	v.SetExitEpoch(3)
+	require.Equal(t, math.Epoch(1), v.GetActivationEligibilityEpoch())
+	require.Equal(t, math.Epoch(2), v.GetActivationEpoch())
+	require.Equal(t, math.Epoch(3), v.GetExitEpoch())
+	require.True(t, v.IsActive(2))
+	require.False(t, v.IsActive(3))
+}
+
 func TestValidator_GetWithdrawalCredentials(t *testing.T) {
 	tests := []struct {
 		name      string
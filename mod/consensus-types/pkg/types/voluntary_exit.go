@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// VoluntaryExitSize is the size of the VoluntaryExit object in bytes.
+//
+// Total size: Epoch (8) + ValidatorIndex (8).
+const VoluntaryExitSize = 16
+
+var (
+	_ ssz.StaticObject                    = (*VoluntaryExit)(nil)
+	_ constraints.SSZMarshallableRootable = (*VoluntaryExit)(nil)
+)
+
+// VoluntaryExit is the message a validator signs to leave the validator set.
+type VoluntaryExit struct {
+	// Epoch is the earliest epoch at which the exit can be processed.
+	Epoch math.Epoch `json:"epoch"`
+	// ValidatorIndex is the index of the exiting validator.
+	ValidatorIndex math.ValidatorIndex `json:"validator_index"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewVoluntaryExit creates a new VoluntaryExit.
+func NewVoluntaryExit(
+	epoch math.Epoch,
+	validatorIndex math.ValidatorIndex,
+) *VoluntaryExit {
+	return &VoluntaryExit{
+		Epoch:          epoch,
+		ValidatorIndex: validatorIndex,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the VoluntaryExit object in SSZ encoding.
+func (*VoluntaryExit) SizeSSZ() uint32 {
+	return VoluntaryExitSize
+}
+
+// DefineSSZ defines the SSZ encoding for the VoluntaryExit object.
+func (v *VoluntaryExit) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &v.Epoch)
+	ssz.DefineUint64(codec, &v.ValidatorIndex)
+}
+
+// MarshalSSZ marshals the VoluntaryExit object to SSZ format.
+func (v *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, v.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, v)
+}
+
+// UnmarshalSSZ unmarshals the VoluntaryExit object from SSZ format.
+func (v *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, v)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the VoluntaryExit object.
+func (v *VoluntaryExit) HashTreeRoot() common.Root {
+	return ssz.HashSequential(v)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the VoluntaryExit object into a pre-allocated byte
+// slice.
+func (v *VoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := v.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the VoluntaryExit object with a hasher.
+func (v *VoluntaryExit) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(uint64(v.Epoch))
+
+	// Field (1) 'ValidatorIndex'
+	hh.PutUint64(uint64(v.ValidatorIndex))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the VoluntaryExit object.
+func (v *VoluntaryExit) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(v)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                            Getters and Setters                             */
+/* -------------------------------------------------------------------------- */
+
+// GetEpoch returns the epoch of the VoluntaryExit.
+func (v *VoluntaryExit) GetEpoch() math.Epoch {
+	return v.Epoch
+}
+
+// GetValidatorIndex returns the index of the exiting validator.
+func (v *VoluntaryExit) GetValidatorIndex() math.ValidatorIndex {
+	return v.ValidatorIndex
+}
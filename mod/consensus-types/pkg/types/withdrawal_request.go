@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/karalabe/ssz"
+)
+
+// WithdrawalRequestSize is the size of the WithdrawalRequest object in bytes.
+//
+// Total size: SourceAddress (20) + ValidatorPubkey (48) + Amount (8).
+const WithdrawalRequestSize = 76
+
+var (
+	_ ssz.StaticObject                    = (*WithdrawalRequest)(nil)
+	_ constraints.SSZMarshallableRootable = (*WithdrawalRequest)(nil)
+)
+
+// WithdrawalRequest is a request of the withdrawal address of a validator,
+// triggered from the execution layer as per EIP-7002.
+type WithdrawalRequest struct {
+	// SourceAddress is the address that sent the request, which must be
+	// the withdrawal address of the validator.
+	SourceAddress common.ExecutionAddress `json:"source_address"`
+	// ValidatorPubkey is the public key of the validator.
+	ValidatorPubkey crypto.BLSPubkey `json:"validator_pubkey"`
+	// Amount is the amount to withdraw, or FullExitRequestAmount to exit
+	// the validator.
+	Amount math.Gwei `json:"amount"`
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Constructor                                */
+/* -------------------------------------------------------------------------- */
+
+// NewWithdrawalRequest creates a new WithdrawalRequest.
+func NewWithdrawalRequest(
+	sourceAddress common.ExecutionAddress,
+	validatorPubkey crypto.BLSPubkey,
+	amount math.Gwei,
+) *WithdrawalRequest {
+	return &WithdrawalRequest{
+		SourceAddress:   sourceAddress,
+		ValidatorPubkey: validatorPubkey,
+		Amount:          amount,
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                     SSZ                                    */
+/* -------------------------------------------------------------------------- */
+
+// SizeSSZ returns the size of the WithdrawalRequest object in SSZ encoding.
+func (*WithdrawalRequest) SizeSSZ() uint32 {
+	return WithdrawalRequestSize
+}
+
+// DefineSSZ defines the SSZ encoding for the WithdrawalRequest object.
+func (w *WithdrawalRequest) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &w.SourceAddress)
+	ssz.DefineStaticBytes(codec, &w.ValidatorPubkey)
+	ssz.DefineUint64(codec, &w.Amount)
+}
+
+// MarshalSSZ marshals the WithdrawalRequest object to SSZ format.
+func (w *WithdrawalRequest) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, w.SizeSSZ())
+	return buf, ssz.EncodeToBytes(buf, w)
+}
+
+// UnmarshalSSZ unmarshals the WithdrawalRequest object from SSZ format.
+func (w *WithdrawalRequest) UnmarshalSSZ(buf []byte) error {
+	return ssz.DecodeFromBytes(buf, w)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the WithdrawalRequest
+// object.
+func (w *WithdrawalRequest) HashTreeRoot() common.Root {
+	return ssz.HashSequential(w)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   FastSSZ                                  */
+/* -------------------------------------------------------------------------- */
+
+// MarshalSSZTo marshals the WithdrawalRequest object into a pre-allocated
+// byte slice.
+func (w *WithdrawalRequest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	bz, err := w.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	dst = append(dst, bz...)
+	return dst, nil
+}
+
+// HashTreeRootWith ssz hashes the WithdrawalRequest object with a hasher.
+func (w *WithdrawalRequest) HashTreeRootWith(hh fastssz.HashWalker) error {
+	indx := hh.Index()
+
+	// Field (0) 'SourceAddress'
+	hh.PutBytes(w.SourceAddress[:])
+
+	// Field (1) 'ValidatorPubkey'
+	hh.PutBytes(w.ValidatorPubkey[:])
+
+	// Field (2) 'Amount'
+	hh.PutUint64(uint64(w.Amount))
+
+	hh.Merkleize(indx)
+	return nil
+}
+
+// GetTree ssz hashes the WithdrawalRequest object.
+func (w *WithdrawalRequest) GetTree() (*fastssz.Node, error) {
+	return fastssz.ProofTree(w)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             Getters and Setters                            */
+/* -------------------------------------------------------------------------- */
+
+// GetSourceAddress returns the address that sent the request.
+func (w *WithdrawalRequest) GetSourceAddress() common.ExecutionAddress {
+	return w.SourceAddress
+}
+
+// GetValidatorPubkey returns the public key of the validator.
+func (w *WithdrawalRequest) GetValidatorPubkey() crypto.BLSPubkey {
+	return w.ValidatorPubkey
+}
+
+// GetAmount returns the amount to withdraw.
+func (w *WithdrawalRequest) GetAmount() math.Gwei {
+	return w.Amount
+}
@@ -31,7 +31,8 @@ import (
 // eventually fully decouple this.
 type ConsensusEngine[
 	AttestationDataT AttestationData[AttestationDataT],
-	BeaconStateT BeaconState,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
 	SlashingInfoT SlashingInfo[SlashingInfoT],
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT, SlotDataT],
 	StorageBackendT StorageBackend[BeaconStateT],
@@ -44,7 +45,8 @@ type ConsensusEngine[
 // NewConsensusEngine returns a new consensus middleware.
 func NewConsensusEngine[
 	AttestationDataT AttestationData[AttestationDataT],
-	BeaconStateT BeaconState,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BeaconStateT BeaconState[BeaconBlockHeaderT],
 	SlashingInfoT SlashingInfo[SlashingInfoT],
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT, SlotDataT],
 	StorageBackendT StorageBackend[BeaconStateT],
@@ -54,6 +56,7 @@ func NewConsensusEngine[
 	sb StorageBackendT,
 ) *ConsensusEngine[
 	AttestationDataT,
+	BeaconBlockHeaderT,
 	BeaconStateT,
 	SlashingInfoT,
 	SlotDataT,
@@ -62,6 +65,7 @@ func NewConsensusEngine[
 ] {
 	return &ConsensusEngine[
 		AttestationDataT,
+		BeaconBlockHeaderT,
 		BeaconStateT,
 		SlashingInfoT,
 		SlotDataT,
@@ -74,7 +78,7 @@ func NewConsensusEngine[
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, _]) PrepareProposal(
+func (c *ConsensusEngine[_, _, _, _, _, _, _]) PrepareProposal(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
@@ -98,7 +102,7 @@ func (c *ConsensusEngine[_, _, _, _, _, _]) PrepareProposal(
 }
 
 // TODO: Decouple Comet Types
-func (c *ConsensusEngine[_, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
+func (c *ConsensusEngine[_, _, _, _, _, _, ValidatorUpdateT]) ProcessProposal(
 	ctx sdk.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
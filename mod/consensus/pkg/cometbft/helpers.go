@@ -23,16 +23,18 @@ package cometbft
 import (
 	"sort"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // convertPrepareProposalToSlotData converts a prepare proposal request to
 // a slot data.
 func (c *ConsensusEngine[
-	_, _, _, SlotDataT, _, _,
+	_, _, _, _, SlotDataT, _, _,
 ]) convertPrepareProposalToSlotData(
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
@@ -68,9 +70,10 @@ func (c *ConsensusEngine[
 	return t, nil
 }
 
-// attestationsFromVotes returns a list of attestation data from the votes.
+// attestationsFromVotes returns a list of attestation data from the votes
+// that were committed for the parent block, which attest to its block root.
 func (c *ConsensusEngine[
-	AttestationDataT, _, _, _, _, _,
+	AttestationDataT, _, _, _, _, _, _,
 ]) attestationsFromVotes(
 	ctx sdk.Context,
 	votes []v1.ExtendedVoteInfo,
@@ -78,10 +81,25 @@ func (c *ConsensusEngine[
 ) ([]AttestationDataT, error) {
 	var err error
 	var index math.U64
-	attestations := make([]AttestationDataT, len(votes))
+	attestations := make([]AttestationDataT, 0, len(votes))
 	st := c.sb.StateFromContext(ctx)
-	root := st.HashTreeRoot()
-	for i, vote := range votes {
+
+	// The state is the one left by the parent block, whose header only gets
+	// its state root when the next slot is processed.
+	parentHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	if (parentHeader.GetStateRoot() == common.Root{}) {
+		parentHeader.SetStateRoot(st.HashTreeRoot())
+	}
+	root := parentHeader.HashTreeRoot()
+	for _, vote := range votes {
+		// Absent and nil votes do not attest to the parent block.
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
 		index, err = st.ValidatorIndexByCometBFTAddress(vote.Validator.Address)
 		if err != nil {
 			return nil, err
@@ -93,7 +111,7 @@ func (c *ConsensusEngine[
 			index,
 			root,
 		)
-		attestations[i] = t
+		attestations = append(attestations, t)
 	}
 
 	// Attestations are sorted by index.
@@ -106,7 +124,7 @@ func (c *ConsensusEngine[
 // slashingInfoFromMisbehaviors returns a list of slashing info from the
 // comet misbehaviors.
 func (c *ConsensusEngine[
-	_, _, SlashingInfoT, _, _, _,
+	_, _, _, SlashingInfoT, _, _, _,
 ]) slashingInfoFromMisbehaviors(
 	ctx sdk.Context,
 	misbehaviors []v1.Misbehavior,
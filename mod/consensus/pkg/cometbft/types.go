@@ -37,8 +37,21 @@ type AttestationData[AttestationDataT any] interface {
 	New(math.U64, math.U64, common.Root) AttestationDataT
 }
 
+// BeaconBlockHeader is an interface for accessing the beacon block header.
+type BeaconBlockHeader interface {
+	// GetStateRoot returns the state root of the beacon block header.
+	GetStateRoot() common.Root
+	// SetStateRoot sets the state root of the beacon block header.
+	SetStateRoot(common.Root)
+	// HashTreeRoot returns the hash tree root of the beacon block header.
+	HashTreeRoot() common.Root
+}
+
 // BeaconState is an interface for accessing the beacon state.
-type BeaconState interface {
+type BeaconState[BeaconBlockHeaderT any] interface {
+	// GetLatestBlockHeader returns the header of the latest block applied to
+	// the beacon state.
+	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 	// GetValidatorIndexByCometBFTAddress returns the validator index by the
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
@@ -80,7 +93,7 @@ type SlotData[AttestationDataT, SlashingInfoT, SlotDataT any] interface {
 
 // StorageBackend defines an interface for accessing various storage components
 // required by the beacon node.
-type StorageBackend[BeaconStateT BeaconState] interface {
+type StorageBackend[BeaconStateT any] interface {
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
 }
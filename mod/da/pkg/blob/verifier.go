@@ -25,7 +25,12 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -38,23 +43,40 @@ type Verifier[
 ] struct {
 	// proofVerifier is used to verify the KZG proofs of the blobs.
 	proofVerifier kzg.BlobProofVerifier
+	// verifiedProofs holds the keys of the KZG proofs verified recently, or
+	// nil if they are not cached.
+	verifiedProofs *lru.Cache[common.Root, struct{}]
 	// metrics collects and reports metrics related to the verification process.
 	metrics *verifierMetrics
 }
 
-// NewVerifier creates a new Verifier with the given proof verifier.
+// NewVerifier creates a new Verifier with the given proof verifier, which
+// caches up to verificationCacheSize verified KZG proofs.
 func NewVerifier[
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BlobSidecarT Sidecar[BeaconBlockHeaderT],
 	BlobSidecarsT Sidecars[BlobSidecarT],
 ](
 	proofVerifier kzg.BlobProofVerifier,
+	verificationCacheSize int,
 	telemetrySink TelemetrySink,
-) *Verifier[BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT] {
-	return &Verifier[BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT]{
+) (*Verifier[BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT], error) {
+	bv := &Verifier[BeaconBlockHeaderT, BlobSidecarT, BlobSidecarsT]{
 		proofVerifier: proofVerifier,
 		metrics:       newVerifierMetrics(telemetrySink),
 	}
+	if verificationCacheSize <= 0 {
+		return bv, nil
+	}
+
+	var err error
+	bv.verifiedProofs, err = lru.New[common.Root, struct{}](
+		verificationCacheSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return bv, nil
 }
 
 // VerifySidecars verifies the blobs for both inclusion as well
@@ -105,7 +127,8 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifyInclusionProofs(
 	return scs.VerifyInclusionProofs(kzgOffset)
 }
 
-// VerifyKZGProofs verifies the sidecars.
+// VerifyKZGProofs verifies the KZG proofs of the sidecars, skipping the
+// proofs that were verified recently.
 func (bv *Verifier[_, _, BlobSidecarsT]) VerifyKZGProofs(
 	scs BlobSidecarsT,
 ) error {
@@ -115,20 +138,72 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifyKZGProofs(
 		bv.proofVerifier.GetImplementation(),
 	)
 
-	switch scs.Len() {
+	args := &kzgtypes.BlobProofArgs{
+		Blobs:       make([]*eip4844.Blob, 0, scs.Len()),
+		Proofs:      make([]eip4844.KZGProof, 0, scs.Len()),
+		Commitments: make([]eip4844.KZGCommitment, 0, scs.Len()),
+	}
+	keys := make([]common.Root, 0, scs.Len())
+	for _, sidecar := range scs.GetSidecars() {
+		var (
+			blob       = sidecar.GetBlob()
+			proof      = sidecar.GetKzgProof()
+			commitment = sidecar.GetKzgCommitment()
+		)
+		if bv.verifiedProofs != nil {
+			key := verifiedProofKey(&blob, proof, commitment)
+			if bv.verifiedProofs.Contains(key) {
+				bv.metrics.markKZGProofCacheHit()
+				continue
+			}
+			bv.metrics.markKZGProofCacheMiss()
+			keys = append(keys, key)
+		}
+		args.Blobs = append(args.Blobs, &blob)
+		args.Proofs = append(args.Proofs, proof)
+		args.Commitments = append(args.Commitments, commitment)
+	}
+
+	if err := bv.verifyKZGProofs(args); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		bv.verifiedProofs.Add(key, struct{}{})
+	}
+	return nil
+}
+
+// verifyKZGProofs verifies the KZG proofs of the blobs.
+func (bv *Verifier[_, _, _]) verifyKZGProofs(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	switch len(args.Blobs) {
 	case 0:
 		return nil
 	case 1:
-		blob := scs.Get(0).GetBlob()
 		// This method is fastest for a single blob.
 		return bv.proofVerifier.VerifyBlobProof(
-			&blob,
-			scs.Get(0).GetKzgProof(),
-			scs.Get(0).GetKzgCommitment(),
+			args.Blobs[0], args.Proofs[0], args.Commitments[0],
 		)
 	default:
 		// For multiple blobs batch verification is more performant
 		// than verifying each blob individually (even when done in parallel).
-		return bv.proofVerifier.VerifyBlobProofBatch(kzg.ArgsFromSidecars(scs))
+		return bv.proofVerifier.VerifyBlobProofBatch(args)
 	}
 }
+
+// verifiedProofKey returns the key a verified KZG proof is cached by. It
+// commits to the commitment, the proof and the blob, so that a cached proof
+// is only skipped for the exact tuple that was verified.
+func verifiedProofKey(
+	blob *eip4844.Blob,
+	proof eip4844.KZGProof,
+	commitment eip4844.KZGCommitment,
+) common.Root {
+	blobHash := sha256.Hash(blob[:])
+	bz := make([]byte, 0, len(commitment)+len(proof)+len(blobHash))
+	bz = append(bz, commitment[:]...)
+	bz = append(bz, proof[:]...)
+	bz = append(bz, blobHash[:]...)
+	return sha256.Hash(bz)
+}
@@ -87,3 +87,19 @@ func (vm *verifierMetrics) measureVerifyKZGProofsDuration(
 		kzgImplementation,
 	)
 }
+
+// markKZGProofCacheHit increments the counter for the number of KZG proofs
+// that were verified before.
+func (vm *verifierMetrics) markKZGProofCacheHit() {
+	vm.sink.IncrementCounter(
+		"beacon_kit.da.blob.verifier.kzg_proof_cache_hit",
+	)
+}
+
+// markKZGProofCacheMiss increments the counter for the number of KZG proofs
+// that were not verified before.
+func (vm *verifierMetrics) markKZGProofCacheMiss() {
+	vm.sink.IncrementCounter(
+		"beacon_kit.da.blob.verifier.kzg_proof_cache_miss",
+	)
+}
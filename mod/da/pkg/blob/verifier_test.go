@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifier_KZGProofCache(t *testing.T) {
+	proofVerifier := &countingProofVerifier{}
+	sink := newCountingSink()
+	verifier := newVerifier(t, proofVerifier, 16, sink)
+
+	scs := newSidecars(1, 2)
+	require.NoError(t, verifier.VerifyKZGProofs(scs))
+	require.Equal(t, []int{2}, proofVerifier.verified)
+
+	// Verifying the same sidecars again skips the proofs.
+	require.NoError(t, verifier.VerifyKZGProofs(scs))
+	require.Equal(t, []int{2}, proofVerifier.verified)
+	require.Equal(t, 2, sink.counters["kzg_proof_cache_hit"])
+	require.Equal(t, 2, sink.counters["kzg_proof_cache_miss"])
+
+	// Only the proofs that were not verified before are verified.
+	require.NoError(t, verifier.VerifyKZGProofs(newSidecars(1, 2, 3)))
+	require.Equal(t, []int{2, 1}, proofVerifier.verified)
+	require.Equal(t, 4, sink.counters["kzg_proof_cache_hit"])
+	require.Equal(t, 3, sink.counters["kzg_proof_cache_miss"])
+
+	// A sidecar with a different blob for the same commitment and proof is
+	// verified.
+	tampered := newSidecars(1)
+	tampered.Sidecars[0].Blob[0]++
+	require.NoError(t, verifier.VerifyKZGProofs(tampered))
+	require.Equal(t, []int{2, 1, 1}, proofVerifier.verified)
+}
+
+func TestVerifier_KZGProofCacheInvalid(t *testing.T) {
+	errInvalid := errors.New("invalid proof")
+	proofVerifier := &countingProofVerifier{err: errInvalid}
+	verifier := newVerifier(t, proofVerifier, 16, newCountingSink())
+
+	// Proofs that failed to verify are verified again.
+	scs := newSidecars(1, 2)
+	require.ErrorIs(t, verifier.VerifyKZGProofs(scs), errInvalid)
+	require.ErrorIs(t, verifier.VerifyKZGProofs(scs), errInvalid)
+	require.Equal(t, []int{2, 2}, proofVerifier.verified)
+}
+
+func TestVerifier_KZGProofCacheDisabled(t *testing.T) {
+	proofVerifier := &countingProofVerifier{}
+	sink := newCountingSink()
+	verifier := newVerifier(t, proofVerifier, 0, sink)
+
+	scs := newSidecars(1, 2)
+	require.NoError(t, verifier.VerifyKZGProofs(scs))
+	require.NoError(t, verifier.VerifyKZGProofs(scs))
+	require.Equal(t, []int{2, 2}, proofVerifier.verified)
+	require.Empty(t, sink.counters)
+}
+
+func newVerifier(
+	t *testing.T,
+	proofVerifier *countingProofVerifier,
+	verificationCacheSize int,
+	sink *countingSink,
+) *blob.Verifier[
+	*ctypes.BeaconBlockHeader, *datypes.BlobSidecar, *datypes.BlobSidecars,
+] {
+	t.Helper()
+	verifier, err := blob.NewVerifier[
+		*ctypes.BeaconBlockHeader,
+		*datypes.BlobSidecar,
+		*datypes.BlobSidecars,
+	](proofVerifier, verificationCacheSize, sink)
+	require.NoError(t, err)
+	return verifier
+}
+
+// newSidecars returns sidecars whose blobs, commitments and proofs are
+// derived from the seeds.
+func newSidecars(seeds ...byte) *datypes.BlobSidecars {
+	scs := &datypes.BlobSidecars{}
+	for i, seed := range seeds {
+		scs.Sidecars = append(scs.Sidecars, &datypes.BlobSidecar{
+			Index:         uint64(i),
+			Blob:          eip4844.Blob{seed},
+			KzgCommitment: eip4844.KZGCommitment{seed},
+			KzgProof:      eip4844.KZGProof{seed},
+		})
+	}
+	return scs
+}
+
+// countingProofVerifier records the number of blobs of every verification.
+type countingProofVerifier struct {
+	err      error
+	verified []int
+}
+
+func (*countingProofVerifier) GetImplementation() string {
+	return "counting"
+}
+
+func (v *countingProofVerifier) VerifyBlobProof(
+	*eip4844.Blob, eip4844.KZGProof, eip4844.KZGCommitment,
+) error {
+	v.verified = append(v.verified, 1)
+	return v.err
+}
+
+func (v *countingProofVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	v.verified = append(v.verified, len(args.Blobs))
+	return v.err
+}
+
+// countingSink counts the increments of the verifier counters.
+type countingSink struct {
+	counters map[string]int
+}
+
+func newCountingSink() *countingSink {
+	return &countingSink{counters: make(map[string]int)}
+}
+
+func (s *countingSink) IncrementCounter(key string, _ ...string) {
+	s.counters[strings.TrimPrefix(key, "beacon_kit.da.blob.verifier.")]++
+}
+
+func (*countingSink) MeasureSince(string, time.Time, ...string) {}
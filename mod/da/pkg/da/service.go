@@ -64,7 +64,9 @@ func (s *Service[_, _]) Name() string {
 
 // Start starts the service.
 func (s *Service[_, _]) Start(ctx context.Context) error {
-	subSidecarsCh, err := s.sidecarsBroker.Subscribe()
+	subSidecarsCh, err := s.sidecarsBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+)
+
+const (
+	// AutoImplementation benchmarks the available implementations at startup
+	// and picks the fastest for single and batch verification.
+	AutoImplementation = "auto"
+	// autoBenchmarkBlobs is the number of blobs batch verification is
+	// benchmarked with, the maximum number of blobs of a Deneb block.
+	autoBenchmarkBlobs = 6
+	// autoBenchmarkRounds is the number of times each implementation is
+	// benchmarked, of which the fastest round counts.
+	autoBenchmarkRounds = 3
+)
+
+// autoVerifier is a BlobProofVerifier that verifies single and batch proofs
+// with the implementations that were fastest on this machine.
+type autoVerifier struct {
+	// single verifies single proofs.
+	single BlobProofVerifier
+	// batch verifies batches of proofs.
+	batch BlobProofVerifier
+}
+
+// newAutoVerifier benchmarks the available implementations and returns an
+// autoVerifier using the fastest ones. Implementations that fail to verify
+// the benchmark proofs, such as c-kzg-4844 in an executable built without
+// it, are not considered.
+func newAutoVerifier(
+	ts *gokzg4844.JSONTrustedSetup,
+) (*autoVerifier, error) {
+	goVerifier, err := gokzg.NewVerifier(ts)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []BlobProofVerifier{goVerifier}
+	if cVerifier, cErr := ckzg.NewVerifier(ts); cErr == nil {
+		candidates = append(candidates, cVerifier)
+	}
+
+	args, err := benchmarkArgs(goVerifier)
+	if err != nil {
+		return nil, err
+	}
+	single, err := fastest(candidates, func(v BlobProofVerifier) error {
+		return v.VerifyBlobProof(
+			args.Blobs[0], args.Proofs[0], args.Commitments[0],
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	batch, err := fastest(candidates, func(v BlobProofVerifier) error {
+		return v.VerifyBlobProofBatch(args)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &autoVerifier{single: single, batch: batch}, nil
+}
+
+// GetImplementation returns the implementations used for single and batch
+// verification.
+func (v *autoVerifier) GetImplementation() string {
+	return fmt.Sprintf(
+		"%s(single=%s,batch=%s)", AutoImplementation,
+		v.single.GetImplementation(), v.batch.GetImplementation(),
+	)
+}
+
+// VerifyBlobProof verifies the proof with the fastest single verifier.
+func (v *autoVerifier) VerifyBlobProof(
+	blob *eip4844.Blob,
+	proof eip4844.KZGProof,
+	commitment eip4844.KZGCommitment,
+) error {
+	return v.single.VerifyBlobProof(blob, proof, commitment)
+}
+
+// VerifyBlobProofBatch verifies the proofs with the fastest batch verifier.
+func (v *autoVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	return v.batch.VerifyBlobProofBatch(args)
+}
+
+// fastest returns the candidate that runs verify the fastest.
+func fastest(
+	candidates []BlobProofVerifier,
+	verify func(BlobProofVerifier) error,
+) (BlobProofVerifier, error) {
+	var (
+		best     BlobProofVerifier
+		bestTime time.Duration
+		errs     []error
+	)
+	for _, candidate := range candidates {
+		elapsed, err := benchmark(candidate, verify)
+		if err != nil {
+			errs = append(errs, errors.Wrap(
+				err, candidate.GetImplementation(),
+			))
+			continue
+		}
+		if best == nil || elapsed < bestTime {
+			best, bestTime = candidate, elapsed
+		}
+	}
+	if best == nil {
+		return nil, errors.Join(errs...)
+	}
+	return best, nil
+}
+
+// benchmark returns the fastest of autoBenchmarkRounds runs of verify with
+// the verifier.
+func benchmark(
+	verifier BlobProofVerifier,
+	verify func(BlobProofVerifier) error,
+) (time.Duration, error) {
+	var best time.Duration
+	for i := range autoBenchmarkRounds {
+		start := time.Now()
+		if err := verify(verifier); err != nil {
+			return 0, err
+		}
+		if elapsed := time.Since(start); i == 0 || elapsed < best {
+			best = elapsed
+		}
+	}
+	return best, nil
+}
+
+// benchmarkArgs returns autoBenchmarkBlobs valid blobs along with their
+// commitments and proofs, computed with the go-kzg-4844 verifier.
+func benchmarkArgs(
+	verifier *gokzg.Verifier,
+) (*kzgtypes.BlobProofArgs, error) {
+	args := &kzgtypes.BlobProofArgs{
+		Blobs:       make([]*eip4844.Blob, autoBenchmarkBlobs),
+		Proofs:      make([]eip4844.KZGProof, autoBenchmarkBlobs),
+		Commitments: make([]eip4844.KZGCommitment, autoBenchmarkBlobs),
+	}
+	for i := range autoBenchmarkBlobs {
+		blob := benchmarkBlob(uint64(i))
+		commitment, err := verifier.BlobToKZGCommitment(
+			(*gokzg4844.Blob)(blob), runtime.NumCPU(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		proof, err := verifier.ComputeBlobKZGProof(
+			(*gokzg4844.Blob)(blob), commitment, runtime.NumCPU(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		args.Blobs[i] = blob
+		args.Commitments[i] = eip4844.KZGCommitment(commitment)
+		args.Proofs[i] = eip4844.KZGProof(proof)
+	}
+	return args, nil
+}
+
+// benchmarkBlob returns a pseudo-random blob derived from the seed. The first
+// byte of every field element is zero, so that each is a canonical scalar.
+func benchmarkBlob(seed uint64) *eip4844.Blob {
+	var (
+		blob  eip4844.Blob
+		input = binary.LittleEndian.AppendUint64(make([]byte, 8), seed)
+	)
+	for i := 0; i < len(blob); i += gokzg4844.SerializedScalarSize {
+		binary.LittleEndian.PutUint64(input, uint64(i))
+		element := sha256.Hash(input)
+		copy(blob[i+1:i+gokzg4844.SerializedScalarSize], element[1:])
+	}
+	return &blob
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package kzg_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlobProofVerifier_AutoImpl(t *testing.T) {
+	ts, err := loadTrustedSetupFromFile()
+	require.NoError(t, err)
+
+	verifier, err := kzg.NewBlobProofVerifier(kzg.AutoImplementation, ts)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(
+		verifier.GetImplementation(), kzg.AutoImplementation+"(",
+	))
+
+	args := loadBatchArgs(t)
+	require.NoError(t, verifier.VerifyBlobProofBatch(args))
+	require.NoError(t, verifier.VerifyBlobProof(
+		args.Blobs[0], args.Proofs[0], args.Commitments[0],
+	))
+
+	// Proofs that do not match their blobs are rejected.
+	last := len(args.Proofs) - 1
+	args.Proofs[0], args.Proofs[last] = args.Proofs[last], args.Proofs[0]
+	require.Error(t, verifier.VerifyBlobProofBatch(args))
+	require.Error(t, verifier.VerifyBlobProof(
+		args.Blobs[0], args.Proofs[0], args.Commitments[0],
+	))
+}
+
+// loadBatchArgs loads the blobs, proofs and commitments of the batch test
+// data.
+func loadBatchArgs(t *testing.T) *kzgtypes.BlobProofArgs {
+	t.Helper()
+	file, err := afero.ReadFile(
+		afero.NewOsFs(), filepath.Join(baseDir, "test_data_batch.json"),
+	)
+	require.NoError(t, err)
+
+	var test struct {
+		Input struct {
+			Blobs       []string `json:"blobs"`
+			Proofs      []string `json:"proofs"`
+			Commitments []string `json:"commitments"`
+		} `json:"input"`
+	}
+	require.NoError(t, json.Unmarshal(file, &test))
+	data := test.Input
+	require.GreaterOrEqual(t, len(data.Blobs), 2)
+
+	args := &kzgtypes.BlobProofArgs{
+		Blobs:       make([]*eip4844.Blob, len(data.Blobs)),
+		Proofs:      make([]eip4844.KZGProof, len(data.Proofs)),
+		Commitments: make([]eip4844.KZGCommitment, len(data.Commitments)),
+	}
+	for i := range data.Blobs {
+		var blob eip4844.Blob
+		require.NoError(t, blob.UnmarshalJSON(
+			[]byte(`"`+data.Blobs[i]+`"`),
+		))
+		args.Blobs[i] = &blob
+		require.NoError(t, args.Proofs[i].UnmarshalJSON(
+			[]byte(`"`+data.Proofs[i]+`"`),
+		))
+		require.NoError(t, args.Commitments[i].UnmarshalJSON(
+			[]byte(`"`+data.Commitments[i]+`"`),
+		))
+	}
+	return args
+}
@@ -21,6 +21,8 @@
 package ckzg
 
 import (
+	"sync"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	ckzg4844 "github.com/ethereum/c-kzg-4844/bindings/go"
@@ -29,6 +31,15 @@ import (
 // Implementation is the ethereum/c-kzg-4844 implementation.
 const Implementation = "ethereum/c-kzg-4844"
 
+//nolint:gochecknoglobals // c-kzg-4844 keeps the trusted setup globally.
+var (
+	// loadTrustedSetupOnce guards loading the trusted setup, which c-kzg-4844
+	// panics on if it is loaded more than once per process.
+	loadTrustedSetupOnce sync.Once
+	// errLoadTrustedSetup is the error returned by loading the trusted setup.
+	errLoadTrustedSetup error
+)
+
 // Verifier is a verifier that utilizies the CKZG library.
 type Verifier struct{}
 
@@ -37,13 +48,25 @@ func (v Verifier) GetImplementation() string {
 	return Implementation
 }
 
-// NewVerifier creates a new CKZG verifier.
-//
-//nolint:mnd // lots of random numbers because cryptography.
+// NewVerifier creates a new CKZG verifier. The trusted setup is loaded by the
+// first verifier created in the process, and shared by all verifiers.
 func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
 	if err := gokzg4844.CheckTrustedSetupIsWellFormed(ts); err != nil {
 		return nil, err
 	}
+	loadTrustedSetupOnce.Do(func() {
+		errLoadTrustedSetup = loadTrustedSetup(ts)
+	})
+	if errLoadTrustedSetup != nil {
+		return nil, errLoadTrustedSetup
+	}
+	return &Verifier{}, nil
+}
+
+// loadTrustedSetup loads the trusted setup into c-kzg-4844.
+//
+//nolint:mnd // lots of random numbers because cryptography.
+func loadTrustedSetup(ts *gokzg4844.JSONTrustedSetup) error {
 	g1s := make(
 		[]byte,
 		len(ts.SetupG1Lagrange)*(len(ts.SetupG1Lagrange[0])-2)/2,
@@ -55,8 +78,5 @@ func NewVerifier(ts *gokzg4844.JSONTrustedSetup) (*Verifier, error) {
 	for i, g2 := range ts.SetupG2 {
 		copy(g2s[i*(len(g2)-2)/2:], hex.MustToBytes(g2))
 	}
-	if err := ckzg4844.LoadTrustedSetup(g1s, g2s); err != nil {
-		return nil, err
-	}
-	return &Verifier{}, nil
+	return ckzg4844.LoadTrustedSetup(g1s, g2s)
 }
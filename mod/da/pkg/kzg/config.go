@@ -24,8 +24,12 @@ const (
 	// defaultTrustedSetupPath is the default path to the trusted setup.
 	defaultTrustedSetupPath = "./testing/files/kzg-trusted-setup.json"
 	// defaultImplementation is the default KZG implementation to use.
-	// Options are `crate-crypto/go-kzg-4844` or `ethereum/c-kzg-4844`.
+	// Options are `crate-crypto/go-kzg-4844`, `ethereum/c-kzg-4844` or
+	// `auto`.
 	defaultImplementation = "crate-crypto/go-kzg-4844"
+	// defaultVerificationCacheSize is the default number of verified KZG
+	// proofs to cache.
+	defaultVerificationCacheSize = 1024
 )
 
 type Config struct {
@@ -33,12 +37,17 @@ type Config struct {
 	TrustedSetupPath string `mapstructure:"trusted-setup-path"`
 	// Implementation is the KZG implementation to use.
 	Implementation string `mapstructure:"implementation"`
+	// VerificationCacheSize is the number of verified KZG proofs to cache,
+	// so that the proofs of sidecars are not verified again. Zero disables
+	// the cache.
+	VerificationCacheSize int `mapstructure:"verification-cache-size"`
 }
 
 // DefaultConfig returns the default configuration.
 func DefaultConfig() Config {
 	return Config{
-		TrustedSetupPath: defaultTrustedSetupPath,
-		Implementation:   defaultImplementation,
+		TrustedSetupPath:      defaultTrustedSetupPath,
+		Implementation:        defaultImplementation,
+		VerificationCacheSize: defaultVerificationCacheSize,
 	}
 }
@@ -39,4 +39,5 @@ func TestDefaultConfig(t *testing.T) {
 		"crate-crypto/go-kzg-4844",
 		cfg.Implementation,
 	)
+	require.Equal(t, 1024, cfg.VerificationCacheSize)
 }
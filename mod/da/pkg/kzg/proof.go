@@ -59,11 +59,14 @@ func NewBlobProofVerifier(
 		return gokzg.NewVerifier(ts)
 	case ckzg.Implementation:
 		return ckzg.NewVerifier(ts)
+	case AutoImplementation:
+		return newAutoVerifier(ts)
 	default:
 		return nil, errors.Wrapf(
 			ErrUnsupportedKzgImplementation,
-			"supplied: %s, supported: %s, %s",
+			"supplied: %s, supported: %s, %s, %s",
 			impl, gokzg.Implementation, ckzg.Implementation,
+			AutoImplementation,
 		)
 	}
 }
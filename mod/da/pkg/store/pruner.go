@@ -25,15 +25,21 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
-func BuildPruneRangeFn[BeaconBlockT BeaconBlock](
-	cs common.ChainSpec,
-) func(*asynctypes.Event[BeaconBlockT]) (uint64, uint64) {
+// BuildPruneRangeFn builds a function that returns the range of blob sidecars
+// that can be pruned once the given block leaves the retention window, which
+// are the sidecars of the blocks before it.
+func BuildPruneRangeFn[
+	BeaconBlockT BeaconBlock,
+]() func(*asynctypes.Event[BeaconBlockT]) (uint64, uint64) {
 	return func(event *asynctypes.Event[BeaconBlockT]) (uint64, uint64) {
-		window := cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
-		if event.Data().GetSlot().Unwrap() < window {
-			return 0, 0
-		}
-
-		return 0, event.Data().GetSlot().Unwrap() - window
+		return 0, event.Data().GetSlot().Unwrap()
 	}
 }
+
+// RetentionWindow returns the number of most recent slots whose blob sidecars
+// are kept, which is at least the window within which peers may request them.
+func RetentionWindow(cs common.ChainSpec, window uint64) uint64 {
+	return max(
+		window, cs.MinEpochsForBlobsSidecarsRequest()*cs.SlotsPerEpoch(),
+	)
+}
@@ -44,65 +44,59 @@ func (b MockBeaconBlock) GetSlot() math.U64 {
 }
 
 // TestBuildPruneRangeFn tests the BuildPruneRangeFn function.
-//
-
 func TestBuildPruneRangeFn(t *testing.T) {
+	pruneFn := store.BuildPruneRangeFn[MockBeaconBlock]()
+	for _, slot := range []math.U64{0, 1, 200} {
+		event := asynctypes.NewEvent[MockBeaconBlock](
+			context.Background(),
+			asynctypes.EventID("mock"),
+			MockBeaconBlock{
+				slot: slot,
+			},
+		)
+		start, end := pruneFn(event)
+		require.Equal(t, uint64(0), start)
+		require.Equal(t, slot.Unwrap(), end)
+	}
+}
+
+// TestRetentionWindow tests the RetentionWindow function.
+func TestRetentionWindow(t *testing.T) {
 	// Define test cases
 	tests := []struct {
-		name          string
-		slotsPerEpoch uint64
-		minEpochs     uint64
-		eventSlot     math.U64
-		expectedStart uint64
-		expectedEnd   uint64
+		name           string
+		slotsPerEpoch  uint64
+		minEpochs      uint64
+		window         uint64
+		expectedWindow uint64
 	}{
 		{
-			name:          "Slot greater than window",
-			slotsPerEpoch: 32,
-			minEpochs:     5,
-			eventSlot:     math.U64(200),
-			expectedStart: 0,
-			expectedEnd:   40,
-		},
-		{
-			name:          "Slot less than window",
-			slotsPerEpoch: 32,
-			minEpochs:     5,
-			eventSlot:     math.U64(100),
-			expectedStart: 0,
-			expectedEnd:   0,
-		},
-		{
-			name:          "Exact boundary case",
-			slotsPerEpoch: 32,
-			minEpochs:     5,
-			eventSlot:     math.U64(160),
-			expectedStart: 0,
-			expectedEnd:   0,
+			name:           "Window less than data availability window",
+			slotsPerEpoch:  32,
+			minEpochs:      5,
+			window:         100,
+			expectedWindow: 160,
 		},
 		{
-			name:          "Greater than boundary just 1 uint case",
-			slotsPerEpoch: 32,
-			minEpochs:     5,
-			eventSlot:     math.U64(161),
-			expectedStart: 0,
-			expectedEnd:   1,
+			name:           "Window greater than data availability window",
+			slotsPerEpoch:  32,
+			minEpochs:      5,
+			window:         200,
+			expectedWindow: 200,
 		},
 		{
-			name:          "Zero slot case",
-			slotsPerEpoch: 32,
-			minEpochs:     5,
-			eventSlot:     math.U64(0),
-			expectedStart: 0,
-			expectedEnd:   0,
+			name:           "Zero window",
+			slotsPerEpoch:  32,
+			minEpochs:      5,
+			window:         0,
+			expectedWindow: 160,
 		},
 		{
-			name:          "SlotsPerEpoch as one",
-			slotsPerEpoch: 1,
-			minEpochs:     5,
-			eventSlot:     math.U64(50),
-			expectedStart: 0,
-			expectedEnd:   45,
+			name:           "SlotsPerEpoch as one",
+			slotsPerEpoch:  1,
+			minEpochs:      5,
+			window:         0,
+			expectedWindow: 5,
 		},
 	}
 	for _, tt := range tests {
@@ -115,29 +109,11 @@ func TestBuildPruneRangeFn(t *testing.T) {
 					MinEpochsForBlobsSidecarsRequest: tt.minEpochs,
 				},
 			)
-			pruneFn := store.BuildPruneRangeFn[MockBeaconBlock](
-				cs,
-			)
-			event := asynctypes.NewEvent[MockBeaconBlock](
-				context.Background(),
-				asynctypes.EventID("mock"),
-				MockBeaconBlock{
-					slot: tt.eventSlot,
-				},
-			)
-			start, end := pruneFn(event)
-			require.Equal(
-				t,
-				tt.expectedStart,
-				start,
-				"Test case : %s (expectedStart)",
-				tt.name,
-			)
 			require.Equal(
 				t,
-				tt.expectedEnd,
-				end,
-				"Test case : %s (expectedEnd)",
+				tt.expectedWindow,
+				store.RetentionWindow(cs, tt.window),
+				"Test case : %s",
 				tt.name,
 			)
 		})
@@ -21,7 +21,9 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -71,6 +73,28 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// GetBlobSidecars returns the blob sidecars stored for the given slot, ordered
+// by their index in the block.
+func (s *Store[_]) GetBlobSidecars(
+	slot math.Slot,
+) (*types.BlobSidecars, error) {
+	values, err := s.IndexDB.GetAll(slot.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	sidecars := make([]*types.BlobSidecar, len(values))
+	for i, bz := range values {
+		sidecars[i] = new(types.BlobSidecar)
+		if err = sidecars[i].UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return &types.BlobSidecars{Sidecars: sidecars}, nil
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
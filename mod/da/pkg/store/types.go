@@ -38,6 +38,7 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 
 // IndexDB is a database that allows prefixing by index.
 type IndexDB interface {
+	GetAll(index uint64) ([][]byte, error)
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
 }
@@ -123,6 +123,23 @@ func (
 	return p.SuggestedFeeRecipient
 }
 
+// GetTimestamp returns the timestamp at which the block will be built at.
+func (p *PayloadAttributes[WithdrawalT]) GetTimestamp() math.U64 {
+	return p.Timestamp
+}
+
+// GetWithdrawals returns the withdrawals to be included in the block.
+func (p *PayloadAttributes[WithdrawalT]) GetWithdrawals() []WithdrawalT {
+	return p.Withdrawals
+}
+
+// GetParentBeaconBlockRoot returns the root of the parent beacon block.
+func (
+	p *PayloadAttributes[WithdrawalT],
+) GetParentBeaconBlockRoot() common.Root {
+	return p.ParentBeaconBlockRoot
+}
+
 // Version returns the version of the PayloadAttributes.
 func (p *PayloadAttributes[WithdrawalT]) Version() uint32 {
 	return p.version
@@ -25,6 +25,7 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
@@ -58,6 +59,15 @@ func TestPayloadAttributes(t *testing.T) {
 		payloadAttributes.GetSuggestedFeeRecipient(),
 	)
 	require.Equal(t, forkVersion, payloadAttributes.Version())
+	require.Equal(
+		t, math.U64(timestamp), payloadAttributes.GetTimestamp(),
+	)
+	require.Equal(t, withdrawals, payloadAttributes.GetWithdrawals())
+	require.Equal(
+		t,
+		parentBeaconBlockRoot,
+		payloadAttributes.GetParentBeaconBlockRoot(),
+	)
 
 	require.NoError(t, payloadAttributes.Validate())
 }
@@ -21,6 +21,7 @@
 package engineprimitives
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -34,6 +35,9 @@ type BuiltExecutionPayloadEnv[ExecutionPayloadT any] interface {
 	GetValue() *math.U256
 	// GetBlobsBundle fetches the associated BlobsBundleV1 if available.
 	GetBlobsBundle() BlobsBundle
+	// GetExecutionRequests returns the execution requests of the payload,
+	// encoded as per EIP-7685, from the Electra fork onwards.
+	GetExecutionRequests() [][]byte
 	// ShouldOverrideBuilder indicates if the builder should be overridden.
 	ShouldOverrideBuilder() bool
 }
@@ -60,6 +64,8 @@ type ExecutionPayloadEnvelope[
 	BlockValue       *math.U256        `json:"blockValue"`
 	BlobsBundle      BlobsBundleT      `json:"blobsBundle"`
 	Override         bool              `json:"shouldOverrideBuilder"`
+	// ExecutionRequests is only returned from the Electra fork onwards.
+	ExecutionRequests []bytes.Bytes `json:"executionRequests,omitempty"`
 }
 
 // GetExecutionPayload returns the execution payload of the
@@ -84,6 +90,21 @@ func (e *ExecutionPayloadEnvelope[
 	return e.BlobsBundle
 }
 
+// GetExecutionRequests returns the execution requests of the
+// ExecutionPayloadEnvelope.
+func (e *ExecutionPayloadEnvelope[
+	ExecutionPayloadT, BlobsBundleT,
+]) GetExecutionRequests() [][]byte {
+	if e.ExecutionRequests == nil {
+		return nil
+	}
+	requests := make([][]byte, len(e.ExecutionRequests))
+	for i, request := range e.ExecutionRequests {
+		requests[i] = request
+	}
+	return requests
+}
+
 // ShouldOverrideBuilder returns whether the builder should be overridden.
 func (e *ExecutionPayloadEnvelope[
 	ExecutionPayloadT, BlobsBundleT,
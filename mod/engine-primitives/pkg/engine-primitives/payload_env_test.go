@@ -21,7 +21,12 @@
 package engineprimitives_test
 
 import (
+	"testing"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/stretchr/testify/require"
 )
 
 type MockExecutionPayloadT struct {
@@ -35,3 +40,24 @@ func (m MockExecutionPayloadT) MarshalJSON() ([]byte, error) {
 func (m MockExecutionPayloadT) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &m.Value)
 }
+
+func TestExecutionPayloadEnvelope_GetExecutionRequests(t *testing.T) {
+	type envelope = engineprimitives.ExecutionPayloadEnvelope[
+		*MockExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]
+
+	// The envelopes of getPayloadV3 carry no execution requests.
+	env := &envelope{ExecutionPayload: &MockExecutionPayloadT{}}
+	require.NoError(t, json.Unmarshal([]byte(`{"blockValue":"0x1"}`), env))
+	require.Nil(t, env.GetExecutionRequests())
+
+	env = &envelope{ExecutionPayload: &MockExecutionPayloadT{}}
+	require.NoError(t, json.Unmarshal(
+		[]byte(`{"blockValue":"0x1","executionRequests":["0x0102","0x02"]}`),
+		env,
+	))
+	require.Equal(t, [][]byte{{0x01, 0x02}, {0x02}}, env.GetExecutionRequests())
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // NewPayloadRequest as per the Ethereum 2.0 specification:
@@ -69,6 +70,11 @@ type NewPayloadRequest[
 	VersionedHashes []common.ExecutionHash
 	// ParentBeaconBlockRoot is the root of the parent beacon block.
 	ParentBeaconBlockRoot *common.Root
+	// ExecutionRequests is the execution requests of the payload, encoded
+	// as per EIP-7685, from the Electra fork onwards.
+	ExecutionRequests [][]byte
+	// ForkVersion is the fork version of the block of the payload.
+	ForkVersion uint32
 	// Optimistic is a flag that indicates if the payload should be
 	// optimistically deemed valid. This is useful during syncing.
 	Optimistic bool
@@ -111,12 +117,16 @@ func BuildNewPayloadRequest[
 	executionPayload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests [][]byte,
+	forkVersion uint32,
 	optimistic bool,
 ) *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT] {
 	return &NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]{
 		ExecutionPayload:      executionPayload,
 		VersionedHashes:       versionedHashes,
 		ParentBeaconBlockRoot: parentBeaconBlockRoot,
+		ExecutionRequests:     executionRequests,
+		ForkVersion:           forkVersion,
 		Optimistic:            optimistic,
 	}
 }
@@ -173,6 +183,12 @@ func (n *NewPayloadRequest[ExecutionPayloadT, WithdrawalsT]) HasValidVersionedAn
 		}
 	}
 
+	// From the Electra fork onwards the block hash commits to the execution
+	// requests, which the execution client verifies on newPayloadV4.
+	if n.ForkVersion >= version.Electra {
+		return nil
+	}
+
 	wds := payload.GetWithdrawals()
 	withdrawalsHash := gethprimitives.DeriveSha(
 		wds,
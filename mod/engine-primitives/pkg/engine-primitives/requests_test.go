@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
 )
 
@@ -107,6 +108,8 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -114,6 +117,8 @@ func TestBuildNewPayloadRequest(t *testing.T) {
 	require.Equal(t, executionPayload, request.ExecutionPayload)
 	require.Equal(t, versionedHashes, request.VersionedHashes)
 	require.Equal(t, &parentBeaconBlockRoot, request.ParentBeaconBlockRoot)
+	require.Nil(t, request.ExecutionRequests)
+	require.Equal(t, version.Deneb, request.ForkVersion)
 	require.Equal(t, optimistic, request.Optimistic)
 }
 
@@ -155,6 +160,8 @@ func TestHasValidVersionedAndBlockHashesPayloadError(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
+		version.Deneb,
 		optimistic,
 	)
 
@@ -174,9 +181,35 @@ func TestHasValidVersionedAndBlockHashesMismatchedHashes(t *testing.T) {
 		executionPayload,
 		versionedHashes,
 		&parentBeaconBlockRoot,
+		nil,
+		version.Deneb,
 		optimistic,
 	)
 
 	err := request.HasValidVersionedAndBlockHashes()
 	require.ErrorIs(t, err, engineprimitives.ErrMismatchedNumVersionedHashes)
 }
+
+func TestHasValidVersionedAndBlockHashesElectra(t *testing.T) {
+	executionPayload := MockExecutionPayload{}
+	parentBeaconBlockRoot := common.Root{}
+
+	// The block hash of Electra payloads commits to the execution requests,
+	// so it is left to the execution client.
+	request := engineprimitives.BuildNewPayloadRequest(
+		executionPayload,
+		[]common.ExecutionHash{},
+		&parentBeaconBlockRoot,
+		[][]byte{{0x01}},
+		version.Electra,
+		false,
+	)
+	require.NoError(t, request.HasValidVersionedAndBlockHashes())
+
+	// The versioned hashes are still verified.
+	request.VersionedHashes = []common.ExecutionHash{{}}
+	require.ErrorIs(t,
+		request.HasValidVersionedAndBlockHashes(),
+		engineprimitives.ErrMismatchedNumVersionedHashes,
+	)
+}
@@ -10,6 +10,7 @@ require (
 	github.com/berachain/beacon-kit/mod/log v0.0.0-20240610210054-bfdc14c4013c
 	github.com/berachain/beacon-kit/mod/primitives v0.0.0-20240808194557-e72e74f58197
 	github.com/ethereum/go-ethereum v1.14.7
+	github.com/stretchr/testify v1.9.0
 )
 
 require (
@@ -24,23 +24,29 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
-	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
-	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
-// EngineClient is a struct that holds a pointer to an Eth1Client.
+// connectionCheckInterval is the interval at which the connection to the
+// execution client is checked once it has been established.
+const connectionCheckInterval = 10 * time.Second
+
+// EngineClient is a struct that holds the execution client endpoints and
+// fails requests over between them.
 type EngineClient[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
 ] struct {
-	*ethclient.Client[ExecutionPayloadT]
 	// cfg is the supplied configuration for the engine client.
 	cfg *Config
 	// logger is the logger for the engine client.
@@ -49,13 +55,19 @@ type EngineClient[
 	eth1ChainID *big.Int
 	// clientMetrics is the metrics for the engine client.
 	metrics *clientMetrics
-	// capabilities is a map of capabilities that the execution client has.
-	capabilities map[string]struct{}
+	// endpoints are the execution client endpoints in order of preference,
+	// starting with the primary endpoint.
+	endpoints []*endpoint[ExecutionPayloadT]
+	// active is the endpoint that currently serves requests.
+	active atomic.Pointer[endpoint[ExecutionPayloadT]]
+	// builder is the endpoint that accepted the last payload attributes, and
+	// thus the one the built payload is retrieved from.
+	builder atomic.Pointer[endpoint[ExecutionPayloadT]]
 }
 
-// New creates a new engine client EngineClient.
-// It takes an Eth1Client as an argument and returns a pointer  to an
-// EngineClient.
+// New creates a new engine client EngineClient. The primary endpoint uses
+// jwtSecret, while each backup endpoint uses the secret at the same index of
+// backupJWTSecrets, falling back to jwtSecret if it is nil.
 func New[
 	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
 	PayloadAttributesT PayloadAttributes,
@@ -63,25 +75,31 @@ func New[
 	cfg *Config,
 	logger log.Logger[any],
 	jwtSecret *jwt.Secret,
+	backupJWTSecrets []*jwt.Secret,
 	telemetrySink TelemetrySink,
 	eth1ChainID *big.Int,
 ) *EngineClient[
 	ExecutionPayloadT, PayloadAttributesT,
 ] {
+	endpoints := []*endpoint[ExecutionPayloadT]{
+		newEndpoint[ExecutionPayloadT](cfg.RPCDialURL, jwtSecret, cfg),
+	}
+	for i, backup := range cfg.BackupEndpoints {
+		secret := jwtSecret
+		if i < len(backupJWTSecrets) && backupJWTSecrets[i] != nil {
+			secret = backupJWTSecrets[i]
+		}
+		endpoints = append(endpoints, newEndpoint[ExecutionPayloadT](
+			backup.RPCDialURL, secret, cfg,
+		))
+	}
+
 	return &EngineClient[ExecutionPayloadT, PayloadAttributesT]{
-		cfg:    cfg,
-		logger: logger,
-		Client: ethclient.New[ExecutionPayloadT](
-			ethclientrpc.NewClient(
-				cfg.RPCDialURL.String(),
-				ethclientrpc.WithJWTSecret(jwtSecret),
-				ethclientrpc.WithJWTRefreshInterval(
-					cfg.RPCJWTRefreshInterval,
-				),
-			)),
-		capabilities: make(map[string]struct{}),
-		eth1ChainID:  eth1ChainID,
-		metrics:      newClientMetrics(telemetrySink, logger),
+		cfg:         cfg,
+		logger:      logger,
+		endpoints:   endpoints,
+		eth1ChainID: eth1ChainID,
+		metrics:     newClientMetrics(telemetrySink, logger),
 	}
 }
 
@@ -98,17 +116,97 @@ func (s *EngineClient[
 ]) Start(
 	ctx context.Context,
 ) error {
-	// Start the Clien.
-	go s.Client.Start(ctx)
+	// Start the clients.
+	for _, ep := range s.endpoints {
+		go ep.Start(ctx)
+	}
 
 	s.logger.Info(
 		"Initializing connection to the execution client...",
-		"dial_url", s.cfg.RPCDialURL.String(),
+		"dial_urls", s.dialURLs(),
 	)
 
-	// If the connection connection succeeds, we can skip the
-	// connection initialization loop.
-	if err := s.verifyChainIDAndConnection(ctx); err == nil {
+	if err := s.initializeConnection(ctx); err != nil {
+		return err
+	}
+	s.updateActiveEndpoint()
+	go s.monitorConnection(ctx)
+	return nil
+}
+
+// IsConnected returns true if any execution client endpoint was reachable at
+// the last connection check.
+func (s *EngineClient[
+	_, _,
+]) IsConnected() bool {
+	for _, ep := range s.endpoints {
+		if ep.isHealthy() {
+			return true
+		}
+	}
+	return false
+}
+
+// ChainID returns the chain ID of the active execution client endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) ChainID(ctx context.Context) (math.U64, error) {
+	chainID, _, err := withFailover(
+		ctx, s, nil,
+		func(
+			ctx context.Context, ep *endpoint[ExecutionPayloadT],
+		) (math.U64, error) {
+			return ep.ChainID(ctx)
+		},
+	)
+	return chainID, err
+}
+
+// FilterLogs executes a filter query on the active execution client
+// endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) FilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+) ([]types.Log, error) {
+	logs, _, err := withFailover(
+		ctx, s, nil,
+		func(
+			ctx context.Context, ep *endpoint[ExecutionPayloadT],
+		) ([]types.Log, error) {
+			return ep.FilterLogs(ctx, q)
+		},
+	)
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes to the results of a filter query on the
+// active execution client endpoint.
+func (s *EngineClient[
+	_, _,
+]) SubscribeFilterLogs(
+	ctx context.Context,
+	q ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	return s.activeEndpoint().SubscribeFilterLogs(ctx, q, ch)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// initializeConnection blocks until the connection to at least one execution
+// client endpoint is established and its chain ID is verified.
+func (s *EngineClient[
+	_, _,
+]) initializeConnection(
+	ctx context.Context,
+) error {
+	// If the connection succeeds, we can skip the connection initialization
+	// loop.
+	if s.checkEndpoints(ctx) {
 		return nil
 	}
 
@@ -122,58 +220,134 @@ func (s *EngineClient[
 		case <-ticker.C:
 			s.logger.Info(
 				"Waiting for execution client to start... 🍺🕔",
-				"dial_url", s.cfg.RPCDialURL,
+				"dial_urls", s.dialURLs(),
 			)
-			if err := s.verifyChainIDAndConnection(ctx); err != nil {
-				if errors.Is(err, ErrMismatchedEth1ChainID) {
-					s.logger.Error(err.Error())
-				}
-				continue
+			if s.checkEndpoints(ctx) {
+				return nil
 			}
-			return nil
 		}
 	}
 }
 
-/* -------------------------------------------------------------------------- */
-/*                                   Helpers                                  */
-/* -------------------------------------------------------------------------- */
+// monitorConnection periodically checks that the execution client endpoints
+// are still reachable and fails over to the next healthy endpoint if the
+// active one is not.
+func (s *EngineClient[
+	_, _,
+]) monitorConnection(
+	ctx context.Context,
+) {
+	ticker := time.NewTicker(connectionCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkEndpoints(ctx)
+			s.updateActiveEndpoint()
+		}
+	}
+}
 
-// verifyChainID dials the execution client and
-// ensures the chain ID is correct.
+// checkEndpoints runs a health check on every endpoint concurrently and
+// returns true if any of them is healthy.
 func (s *EngineClient[
 	_, _,
-]) verifyChainIDAndConnection(
+]) checkEndpoints(ctx context.Context) bool {
+	var wg sync.WaitGroup
+	for _, ep := range s.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.checkEndpoint(ctx, ep)
+		}()
+	}
+	wg.Wait()
+	return s.IsConnected()
+}
+
+// checkEndpoint runs a health check on the endpoint. Healthy endpoints only
+// have their chain ID checked, while unhealthy ones go through the full
+// connection verification.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) checkEndpoint(
 	ctx context.Context,
-) error {
-	var (
-		err     error
-		chainID math.U64
-	)
+	ep *endpoint[ExecutionPayloadT],
+) {
+	cctx, cancel := context.WithTimeout(ctx, s.cfg.RPCTimeout)
+	defer cancel()
 
-	defer func() {
-		if err != nil {
-			err = s.Client.Close()
-		}
-	}()
+	var err error
+	wasHealthy := ep.isHealthy()
+	if wasHealthy {
+		err = s.verifyChainID(cctx, ep)
+	} else {
+		err = s.verifyChainIDAndConnection(cctx, ep)
+	}
+	ep.markHealthCheck(err == nil)
+	s.metrics.setEndpointHealthScore(ep.url.String(), ep.score.Load())
 
-	// After the initial dial, check to make sure the chain ID is correct.
-	chainID, err = s.Client.ChainID(ctx)
+	switch {
+	case wasHealthy && err != nil:
+		s.logger.Error(
+			"Lost connection to the execution client",
+			"dial_url", ep.url.String(), "err", err,
+		)
+	case errors.Is(err, ErrMismatchedEth1ChainID):
+		s.logger.Error(err.Error(), "dial_url", ep.url.String())
+	}
+}
+
+// verifyChainID ensures the chain ID of the endpoint is correct.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) verifyChainID(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+) error {
+	chainID, err := ep.ChainID(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			// We always log this error as it is a critical error.
-			s.logger.Error(UnauthenticatedConnectionErrorStr)
+			s.logger.Error(
+				UnauthenticatedConnectionErrorStr,
+				"dial_url", ep.url.String(),
+			)
 		}
 		return err
 	}
 
 	if chainID.Unwrap() != s.eth1ChainID.Uint64() {
-		err = errors.Wrapf(
+		return errors.Wrapf(
 			ErrMismatchedEth1ChainID,
 			"wanted chain ID %d, got %d",
 			s.eth1ChainID,
 			chainID,
 		)
+	}
+	return nil
+}
+
+// verifyChainIDAndConnection dials the endpoint, ensures the chain ID is
+// correct and exchanges capabilities with it.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) verifyChainIDAndConnection(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+) error {
+	var err error
+	defer func() {
+		if err != nil {
+			//nolint:errcheck // closing only drops idle connections.
+			ep.Close()
+		}
+	}()
+
+	// After the initial dial, check to make sure the chain ID is correct.
+	if err = s.verifyChainID(ctx, ep); err != nil {
 		return err
 	}
 
@@ -181,17 +355,159 @@ func (s *EngineClient[
 	s.logger.Info(
 		"Connected to execution client 🔌",
 		"dial_url",
-		s.cfg.RPCDialURL.String(),
-		"chain_id",
-		chainID.Unwrap(),
+		ep.url.String(),
 		"required_chain_id",
 		s.eth1ChainID,
 	)
 
 	// Exchange capabilities with the execution client.
-	if _, err = s.ExchangeCapabilities(ctx); err != nil {
-		s.logger.Error("failed to exchange capabilities", "err", err)
+	if _, err = s.exchangeCapabilities(ctx, ep); err != nil {
+		s.logger.Error(
+			"failed to exchange capabilities",
+			"dial_url", ep.url.String(), "err", err,
+		)
 		return err
 	}
 	return nil
 }
+
+// activeEndpoint returns the first healthy endpoint in order of preference,
+// or the primary endpoint if none of them is healthy.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) activeEndpoint() *endpoint[ExecutionPayloadT] {
+	for _, ep := range s.endpoints {
+		if ep.isHealthy() {
+			return ep
+		}
+	}
+	return s.endpoints[0]
+}
+
+// updateActiveEndpoint switches the active endpoint to the first healthy
+// endpoint, and reports the switch.
+func (s *EngineClient[
+	_, _,
+]) updateActiveEndpoint() {
+	active := s.activeEndpoint()
+	previous := s.active.Swap(active)
+	if previous == active {
+		return
+	}
+
+	if previous != nil {
+		s.logger.Warn(
+			"Switched active execution client endpoint 🔀",
+			"from", previous.url.String(), "to", active.url.String(),
+		)
+		s.metrics.incrementFailover()
+	}
+	for _, ep := range s.endpoints {
+		s.metrics.setActiveEndpoint(ep.url.String(), ep == active)
+	}
+}
+
+// candidates returns the endpoints to send a request to, in order. The
+// preferred endpoint comes first if it is healthy, followed by the other
+// healthy endpoints in order of preference. If no endpoint is healthy, all
+// endpoints are returned.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) candidates(
+	preferred *endpoint[ExecutionPayloadT],
+) []*endpoint[ExecutionPayloadT] {
+	candidates := make([]*endpoint[ExecutionPayloadT], 0, len(s.endpoints))
+	if preferred != nil && preferred.isHealthy() {
+		candidates = append(candidates, preferred)
+	}
+	for _, ep := range s.endpoints {
+		if ep != preferred && ep.isHealthy() {
+			candidates = append(candidates, ep)
+		}
+	}
+	if len(candidates) == 0 {
+		return s.endpoints
+	}
+	return candidates
+}
+
+// dialURLs returns the dial urls of the endpoints in order of preference.
+func (s *EngineClient[
+	_, _,
+]) dialURLs() []string {
+	urls := make([]string, len(s.endpoints))
+	for i, ep := range s.endpoints {
+		urls[i] = ep.url.String()
+	}
+	return urls
+}
+
+// withFailover sends a request to the candidate endpoints in order until one
+// of them serves it, and returns its result along with the endpoint that
+// served it. Errors returned by the execution client itself are not failed
+// over, since every endpoint is expected to return the same. Any timeout
+// must be applied per request by fn, so that a timed out endpoint does not
+// exhaust the deadline of the next one.
+func withFailover[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+	PayloadAttributesT PayloadAttributes,
+	ResultT any,
+](
+	ctx context.Context,
+	s *EngineClient[ExecutionPayloadT, PayloadAttributesT],
+	preferred *endpoint[ExecutionPayloadT],
+	fn func(context.Context, *endpoint[ExecutionPayloadT]) (ResultT, error),
+) (ResultT, *endpoint[ExecutionPayloadT], error) {
+	var (
+		result ResultT
+		err    error
+	)
+	defer s.updateActiveEndpoint()
+
+	for _, ep := range s.candidates(preferred) {
+		result, err = fn(ctx, ep)
+
+		failed := isEndpointFailure(err)
+		ep.markRequest(failed)
+		s.metrics.setEndpointHealthScore(ep.url.String(), ep.score.Load())
+		if !failed {
+			return result, ep, err
+		}
+		s.logger.Warn(
+			"Execution client endpoint failed to serve request",
+			"dial_url", ep.url.String(), "err", err,
+		)
+	}
+	return result, nil, err
+}
+
+// broadcast sends a request to every healthy endpoint other than the active
+// one, if broadcasting is enabled. The requests run in the background, so that
+// a slow endpoint never delays the caller, and are detached from the
+// cancellation of ctx since they outlive the call that started them. An
+// endpoint still serving a previous broadcast is skipped. The results are
+// discarded, only the health of the endpoints is updated.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) broadcast(
+	ctx context.Context,
+	fn func(context.Context, *endpoint[ExecutionPayloadT]) error,
+) {
+	if !s.cfg.RPCBroadcast {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	active := s.activeEndpoint()
+	for _, ep := range s.endpoints {
+		if ep == active || !ep.isHealthy() ||
+			!ep.broadcasting.CompareAndSwap(false, true) {
+			continue
+		}
+		go func() {
+			defer ep.broadcasting.Store(false)
+			ep.markRequest(isEndpointFailure(fn(ctx, ep)))
+			s.metrics.setEndpointHealthScore(ep.url.String(), ep.score.Load())
+		}()
+	}
+}
@@ -0,0 +1,421 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = 80087
+
+// testPayload is a minimal execution payload.
+type testPayload struct {
+	Number string `json:"blockNumber"`
+}
+
+func (*testPayload) Empty(uint32) *testPayload { return &testPayload{} }
+
+func (*testPayload) Version() uint32 { return version.Deneb }
+
+func (p *testPayload) IsNil() bool { return p == nil }
+
+func (p *testPayload) MarshalJSON() ([]byte, error) {
+	type payload testPayload
+	return json.Marshal((*payload)(p))
+}
+
+func (p *testPayload) UnmarshalJSON(bz []byte) error {
+	type payload testPayload
+	return json.Unmarshal(bz, (*payload)(p))
+}
+
+type testAttributes struct {
+	FeeRecipient common.ExecutionAddress `json:"suggestedFeeRecipient"`
+}
+
+func (a *testAttributes) IsNil() bool { return a == nil }
+
+func (a *testAttributes) GetSuggestedFeeRecipient() common.ExecutionAddress {
+	return a.FeeRecipient
+}
+
+// fakeEL is an execution client endpoint serving canned engine API
+// responses. It fails every request while it is down, and holds requests
+// while it is paused.
+type fakeEL struct {
+	*httptest.Server
+	down   atomic.Bool
+	paused chan struct{}
+	mu     sync.Mutex
+	calls  []string
+	params []json.RawMessage
+}
+
+func newFakeEL(t *testing.T) *fakeEL {
+	t.Helper()
+	el := &fakeEL{}
+	el.Server = httptest.NewServer(http.HandlerFunc(el.serve))
+	t.Cleanup(el.Close)
+	return el
+}
+
+func (el *fakeEL) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     int             `json:"id"`
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	el.mu.Lock()
+	el.calls = append(el.calls, req.Method)
+	el.params = append(el.params, req.Params)
+	paused := el.paused
+	el.mu.Unlock()
+	if paused != nil {
+		<-paused
+	}
+	if el.down.Load() {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	var result any
+	switch req.Method {
+	case "eth_chainId":
+		result = "0x138d7"
+	case "engine_exchangeCapabilities":
+		result = []string{}
+	case "engine_newPayloadV3", "engine_newPayloadV4":
+		result = map[string]any{"status": "VALID"}
+	case "engine_forkchoiceUpdatedV3":
+		result = map[string]any{
+			"payloadStatus": map[string]any{"status": "VALID"},
+			"payloadId":     "0x0102030405060708",
+		}
+	case "engine_getPayloadV3":
+		result = map[string]any{
+			"executionPayload": map[string]any{"blockNumber": r.Host},
+			"blockValue":       "0x0",
+			"blobsBundle": map[string]any{
+				"commitments": []string{},
+				"proofs":      []string{},
+				"blobs":       []string{},
+			},
+		}
+	case "engine_getPayloadV4":
+		result = map[string]any{
+			"executionPayload": map[string]any{"blockNumber": r.Host},
+			"blockValue":       "0x0",
+			"blobsBundle": map[string]any{
+				"commitments": []string{},
+				"proofs":      []string{},
+				"blobs":       []string{},
+			},
+			"executionRequests": []string{"0x01aa"},
+		}
+	}
+	//nolint:errcheck // the test fails on the client side instead.
+	json.NewEncoder(w).Encode(map[string]any{
+		"jsonrpc": "2.0", "id": req.ID, "result": result,
+	})
+}
+
+// pause holds the requests to the endpoint until the returned function is
+// called.
+func (el *fakeEL) pause() func() {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	el.paused = make(chan struct{})
+	return func() {
+		el.mu.Lock()
+		defer el.mu.Unlock()
+		close(el.paused)
+		el.paused = nil
+	}
+}
+
+// countCalls returns the number of requests to the endpoint with the given
+// method.
+func (el *fakeEL) countCalls(method string) int {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	var count int
+	for _, call := range el.calls {
+		if call == method {
+			count++
+		}
+	}
+	return count
+}
+
+type testSink struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+func (s *testSink) IncrementCounter(key string, _ ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+}
+
+func (*testSink) SetGauge(string, int64, ...string) {}
+
+func (*testSink) MeasureSince(string, time.Time, ...string) {}
+
+type testEngineClient = EngineClient[*testPayload, *testAttributes]
+
+// newTestClient returns an engine client over the given endpoints, the first
+// of which is the primary, after a first round of health checks.
+func newTestClient(
+	t *testing.T, broadcast bool, els ...*fakeEL,
+) (*testEngineClient, *testSink) {
+	t.Helper()
+	cfg := DefaultConfig()
+	cfg.RPCTimeout = time.Second
+	cfg.RPCBroadcast = broadcast
+	for i, el := range els {
+		dialURL, err := url.NewFromRaw(el.URL)
+		require.NoError(t, err)
+		if i == 0 {
+			cfg.RPCDialURL = dialURL
+			continue
+		}
+		cfg.BackupEndpoints = append(
+			cfg.BackupEndpoints, EndpointConfig{RPCDialURL: dialURL},
+		)
+	}
+
+	sink := &testSink{counters: make(map[string]int)}
+	s := New[*testPayload, *testAttributes](
+		&cfg, noop.NewLogger[any](), nil, nil, sink,
+		big.NewInt(testChainID),
+	)
+	s.checkEndpoints(context.Background())
+	s.updateActiveEndpoint()
+	return s, sink
+}
+
+func newPayload(t *testing.T, s *testEngineClient) {
+	t.Helper()
+	_, err := s.NewPayload(
+		context.Background(), &testPayload{}, nil, &common.Root{}, nil,
+		version.Deneb,
+	)
+	require.NoError(t, err)
+}
+
+func TestFailoverOrder(t *testing.T) {
+	primary, backup1, backup2 := newFakeEL(t), newFakeEL(t), newFakeEL(t)
+	primary.down.Store(true)
+	s, _ := newTestClient(t, false, primary, backup1, backup2)
+
+	// Unhealthy endpoints are skipped, the first healthy backup serves.
+	require.Equal(t, s.endpoints[1], s.active.Load())
+	newPayload(t, s)
+	require.Equal(t, 0, primary.countCalls("engine_newPayloadV3"))
+	require.Equal(t, 1, backup1.countCalls("engine_newPayloadV3"))
+	require.Equal(t, 0, backup2.countCalls("engine_newPayloadV3"))
+
+	// A backup that fails a request is failed over to the next one.
+	backup1.down.Store(true)
+	newPayload(t, s)
+	require.Equal(t, 2, backup1.countCalls("engine_newPayloadV3"))
+	require.Equal(t, 1, backup2.countCalls("engine_newPayloadV3"))
+}
+
+func TestFailoverWhenAllUnhealthy(t *testing.T) {
+	primary, backup := newFakeEL(t), newFakeEL(t)
+	primary.down.Store(true)
+	backup.down.Store(true)
+	s, _ := newTestClient(t, false, primary, backup)
+	require.False(t, s.IsConnected())
+
+	// Every endpoint is tried in order of preference.
+	_, err := s.NewPayload(
+		context.Background(), &testPayload{}, nil, &common.Root{}, nil,
+		version.Deneb,
+	)
+	require.Error(t, err)
+	require.Equal(t, 1, primary.countCalls("engine_newPayloadV3"))
+	require.Equal(t, 1, backup.countCalls("engine_newPayloadV3"))
+}
+
+func TestScorePenaltyAndRecovery(t *testing.T) {
+	primary, backup := newFakeEL(t), newFakeEL(t)
+	s, sink := newTestClient(t, false, primary, backup)
+	require.Equal(t, maxHealthScore, s.endpoints[0].score.Load())
+
+	// Each failed request costs the primary requestFailurePenalty points,
+	// until it is no longer healthy and the backup takes over.
+	primary.down.Store(true)
+	for score := maxHealthScore; score > 0; score -= requestFailurePenalty {
+		require.Equal(t, s.endpoints[0], s.active.Load())
+		newPayload(t, s)
+		require.Equal(
+			t, max(score-requestFailurePenalty, 0),
+			s.endpoints[0].score.Load(),
+		)
+	}
+	require.Equal(t, s.endpoints[1], s.active.Load())
+	require.Equal(t, 1, sink.counters["beacon_kit.execution.client.failover"])
+
+	// Successful requests recover a point at a time, up to the maximum.
+	s.endpoints[1].score.Store(maxHealthScore - 2)
+	newPayload(t, s)
+	require.Equal(t, maxHealthScore-1, s.endpoints[1].score.Load())
+	newPayload(t, s)
+	newPayload(t, s)
+	require.Equal(t, maxHealthScore, s.endpoints[1].score.Load())
+
+	// A passing health check restores the primary.
+	primary.down.Store(false)
+	s.checkEndpoints(context.Background())
+	s.updateActiveEndpoint()
+	require.Equal(t, maxHealthScore, s.endpoints[0].score.Load())
+	require.Equal(t, s.endpoints[0], s.active.Load())
+	require.Equal(t, 2, sink.counters["beacon_kit.execution.client.failover"])
+}
+
+func TestGetPayloadRoutedToBuilder(t *testing.T) {
+	primary, backup := newFakeEL(t), newFakeEL(t)
+	s, _ := newTestClient(t, false, primary, backup)
+
+	// The primary fails to accept the payload attributes, so the payload is
+	// built by the backup.
+	primary.down.Store(true)
+	payloadID, _, err := s.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{},
+		&testAttributes{FeeRecipient: common.ExecutionAddress{1}},
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, payloadID)
+	require.Equal(t, 1, backup.countCalls("engine_forkchoiceUpdatedV3"))
+
+	// The payload is retrieved from the backup even once the primary is
+	// active again.
+	primary.down.Store(false)
+	s.checkEndpoints(context.Background())
+	s.updateActiveEndpoint()
+	require.Equal(t, s.endpoints[0], s.active.Load())
+
+	envelope, err := s.GetPayload(
+		context.Background(), *payloadID, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, backup.Listener.Addr().String(),
+		envelope.GetExecutionPayload().Number,
+	)
+	require.Equal(t, 0, primary.countCalls("engine_getPayloadV3"))
+	require.Equal(t, 1, backup.countCalls("engine_getPayloadV3"))
+}
+
+func TestBroadcast(t *testing.T) {
+	primary, slow, down := newFakeEL(t), newFakeEL(t), newFakeEL(t)
+	down.down.Store(true)
+	s, _ := newTestClient(t, true, primary, slow, down)
+
+	// The slow endpoint holds the broadcast, which must not delay the call.
+	resume := slow.pause()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		newPayload(t, s)
+		newPayload(t, s)
+	}()
+	select {
+	case <-done:
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("newPayload waited for the broadcast")
+	}
+	require.Equal(t, 2, primary.countCalls("engine_newPayloadV3"))
+
+	// The unhealthy endpoint is skipped, and the slow one only receives the
+	// first broadcast while it is still in flight.
+	resume()
+	require.Eventually(t, func() bool {
+		return !s.endpoints[1].broadcasting.Load()
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, slow.countCalls("engine_newPayloadV3"))
+	require.Equal(t, 0, down.countCalls("engine_newPayloadV3"))
+
+	// Payload attributes are only sent to the active endpoint.
+	_, _, err := s.ForkchoiceUpdated(
+		context.Background(),
+		&engineprimitives.ForkchoiceStateV1{},
+		&testAttributes{FeeRecipient: common.ExecutionAddress{1}},
+		version.Deneb,
+	)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		return slow.countCalls("engine_forkchoiceUpdatedV3") == 1
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, s.endpoints[0], s.builder.Load())
+}
+
+func TestElectraEngineMethods(t *testing.T) {
+	el := newFakeEL(t)
+	s, _ := newTestClient(t, false, el)
+
+	// Electra payloads are sent along with their execution requests.
+	_, err := s.NewPayload(
+		context.Background(), &testPayload{}, nil, &common.Root{},
+		[][]byte{{0x01, 0xaa}}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, el.countCalls("engine_newPayloadV3"))
+	require.Equal(t, 1, el.countCalls("engine_newPayloadV4"))
+	var params []json.RawMessage
+	el.mu.Lock()
+	require.NoError(t, json.Unmarshal(el.params[len(el.params)-1], &params))
+	el.mu.Unlock()
+	require.Len(t, params, 4)
+	require.JSONEq(t, `["0x01aa"]`, string(params[3]))
+
+	// The envelopes of Electra payloads carry their execution requests.
+	envelope, err := s.GetPayload(
+		context.Background(), engineprimitives.PayloadID{1}, version.Electra,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, el.countCalls("engine_getPayloadV4"))
+	require.Equal(t, [][]byte{{0x01, 0xaa}}, envelope.GetExecutionRequests())
+}
@@ -67,4 +67,19 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// RPCBroadcast enables sending newPayload and forkchoiceUpdated calls to
+	// every endpoint, while payloads are only built on the active endpoint.
+	RPCBroadcast bool `mapstructure:"rpc-broadcast"`
+	// BackupEndpoints are the endpoints, in order of preference, that
+	// requests are failed over to when the primary endpoint is unhealthy.
+	BackupEndpoints []EndpointConfig `mapstructure:"backup-endpoints"`
+}
+
+// EndpointConfig is the configuration of a backup execution client endpoint.
+type EndpointConfig struct {
+	// RPCDialURL is the HTTP url of the execution client JSON-RPC endpoint.
+	RPCDialURL *url.ConnectionURL `mapstructure:"rpc-dial-url"`
+	// JWTSecretPath is the path to the JWT secret of the endpoint. The JWT
+	// secret of the primary endpoint is used if it is empty.
+	JWTSecretPath string `mapstructure:"jwt-secret-path"`
 }
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
+	ethclientrpc "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient/rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/http"
+	jsonrpc "github.com/berachain/beacon-kit/mod/primitives/pkg/net/json-rpc"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
+)
+
+const (
+	// maxHealthScore is the health score of an endpoint that passed its last
+	// health check and has not failed a request since.
+	maxHealthScore int32 = 10
+	// requestFailurePenalty is the amount the health score of an endpoint is
+	// reduced by when a request to it fails.
+	requestFailurePenalty int32 = 4
+)
+
+// endpoint is a single execution client endpoint of the engine client.
+type endpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+] struct {
+	*ethclient.Client[ExecutionPayloadT]
+	// url is the dial url of the endpoint.
+	url *url.ConnectionURL
+	// score is the health score of the endpoint. The endpoint is considered
+	// healthy while its score is positive.
+	score atomic.Int32
+	// broadcasting is set while a broadcast request to the endpoint is in
+	// flight.
+	broadcasting atomic.Bool
+	// capabilities is a map of capabilities that the endpoint has.
+	capabilities map[string]struct{}
+	// mu protects capabilities.
+	mu sync.RWMutex
+}
+
+// newEndpoint creates a new endpoint dialing the given url with the given
+// JWT secret.
+func newEndpoint[
+	ExecutionPayloadT constraints.EngineType[ExecutionPayloadT],
+](
+	dialURL *url.ConnectionURL,
+	jwtSecret *jwt.Secret,
+	cfg *Config,
+) *endpoint[ExecutionPayloadT] {
+	return &endpoint[ExecutionPayloadT]{
+		Client: ethclient.New[ExecutionPayloadT](
+			ethclientrpc.NewClient(
+				dialURL.String(),
+				ethclientrpc.WithJWTSecret(jwtSecret),
+				ethclientrpc.WithJWTRefreshInterval(
+					cfg.RPCJWTRefreshInterval,
+				),
+			)),
+		url:          dialURL,
+		capabilities: make(map[string]struct{}),
+	}
+}
+
+// isHealthy returns true if the health score of the endpoint is positive.
+func (e *endpoint[_]) isHealthy() bool {
+	return e.score.Load() > 0
+}
+
+// markHealthCheck resets the health score of the endpoint according to the
+// result of a health check.
+func (e *endpoint[_]) markHealthCheck(healthy bool) {
+	if healthy {
+		e.score.Store(maxHealthScore)
+	} else {
+		e.score.Store(0)
+	}
+}
+
+// markRequest updates the health score of the endpoint according to the
+// result of a request. A successful request recovers a point of health while
+// a failed request costs requestFailurePenalty points.
+func (e *endpoint[_]) markRequest(failed bool) {
+	for {
+		score := e.score.Load()
+		next := min(score+1, maxHealthScore)
+		if failed {
+			next = max(score-requestFailurePenalty, 0)
+		}
+		if e.score.CompareAndSwap(score, next) {
+			return
+		}
+	}
+}
+
+// setCapabilities replaces the capabilities of the endpoint.
+func (e *endpoint[_]) setCapabilities(capabilities []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.capabilities = make(map[string]struct{}, len(capabilities))
+	for _, capability := range capabilities {
+		e.capabilities[capability] = struct{}{}
+	}
+}
+
+// hasCapability returns true if the endpoint has the given capability.
+func (e *endpoint[_]) hasCapability(capability string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.capabilities[capability]
+	return ok
+}
+
+// isEndpointFailure returns true if the error indicates that the endpoint
+// could not serve the request, as opposed to the execution client rejecting
+// it. Only such errors are failed over to the next endpoint.
+func isEndpointFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if http.IsTimeoutError(err) {
+		return true
+	}
+	var rpcErr jsonrpc.Error
+	return !errors.As(err, &rpcErr)
+}
@@ -36,7 +36,9 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadVX method via JSON-RPC.
+// NewPayload calls the engine_newPayloadVX method via JSON-RPC. The payload
+// is sent to every healthy endpoint if broadcasting is enabled, but only the
+// result of the active endpoint is returned.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) NewPayload(
@@ -44,18 +46,31 @@ func (s *EngineClient[
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBeaconBlockRoot *common.Root,
+	executionRequests [][]byte,
+	forkVersion uint32,
 ) (*common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureNewPayloadDuration(startTime)
-	defer cancel()
 
-	// Call the appropriate RPC method based on the payload version.
-	result, err := s.Client.NewPayload(
-		cctx, payload, versionedHashes, parentBeaconBlockRoot,
+	newPayload := func(
+		ctx context.Context, ep *endpoint[ExecutionPayloadT],
+	) (*engineprimitives.PayloadStatusV1, error) {
+		cctx, cancel := s.createContextWithTimeout(ctx)
+		defer cancel()
+		return ep.NewPayload(
+			cctx, payload, versionedHashes, parentBeaconBlockRoot,
+			executionRequests, forkVersion,
+		)
+	}
+	s.broadcast(
+		ctx, func(ctx context.Context, ep *endpoint[ExecutionPayloadT]) error {
+			_, err := newPayload(ctx, ep)
+			return err
+		},
 	)
+
+	// Call the appropriate RPC method based on the fork version.
+	result, _, err := withFailover(ctx, s, nil, newPayload)
 	if err != nil {
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
 			s.metrics.incrementNewPayloadTimeout()
@@ -83,20 +98,18 @@ func (s *EngineClient[
 /* -------------------------------------------------------------------------- */
 
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
+// The forkchoice is sent to every healthy endpoint if broadcasting is enabled,
+// but only the active endpoint is asked to build a payload.
 func (s *EngineClient[
-	_, PayloadAttributesT,
+	ExecutionPayloadT, PayloadAttributesT,
 ]) ForkchoiceUpdated(
 	ctx context.Context,
 	state *engineprimitives.ForkchoiceStateV1,
 	attrs PayloadAttributesT,
 	forkVersion uint32,
 ) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureForkchoiceUpdateDuration(startTime)
-	defer cancel()
 
 	// If the suggested fee recipient is not set, log a warning.
 	if !attrs.IsNil() &&
@@ -107,8 +120,24 @@ func (s *EngineClient[
 		)
 	}
 
-	result, err := s.Client.ForkchoiceUpdated(
-		cctx, state, attrs, forkVersion,
+	s.broadcast(
+		ctx, func(ctx context.Context, ep *endpoint[ExecutionPayloadT]) error {
+			cctx, cancel := s.createContextWithTimeout(ctx)
+			defer cancel()
+			_, err := ep.ForkchoiceUpdated(cctx, state, nil, forkVersion)
+			return err
+		},
+	)
+
+	result, ep, err := withFailover(
+		ctx, s, nil,
+		func(
+			ctx context.Context, ep *endpoint[ExecutionPayloadT],
+		) (*engineprimitives.ForkchoiceResponseV1, error) {
+			cctx, cancel := s.createContextWithTimeout(ctx)
+			defer cancel()
+			return ep.ForkchoiceUpdated(cctx, state, attrs, forkVersion)
+		},
 	)
 
 	if err != nil {
@@ -124,6 +153,11 @@ func (s *EngineClient[
 	if err != nil {
 		return nil, latestValidHash, err
 	}
+
+	// The payload can only be retrieved from the endpoint building it.
+	if result.PayloadID != nil {
+		s.builder.Store(ep)
+	}
 	return result.PayloadID, latestValidHash, nil
 }
 
@@ -132,7 +166,8 @@ func (s *EngineClient[
 /* -------------------------------------------------------------------------- */
 
 // GetPayload calls the engine_getPayloadVX method via JSON-RPC. It returns
-// the execution data as well as the blobs bundle.
+// the execution data as well as the blobs bundle. The payload is requested
+// from the endpoint that is building it, if it is still healthy.
 func (s *EngineClient[
 	ExecutionPayloadT, _,
 ]) GetPayload(
@@ -140,15 +175,22 @@ func (s *EngineClient[
 	payloadID engineprimitives.PayloadID,
 	forkVersion uint32,
 ) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
-	var (
-		startTime    = time.Now()
-		cctx, cancel = s.createContextWithTimeout(ctx)
-	)
+	startTime := time.Now()
 	defer s.metrics.measureGetPayloadDuration(startTime)
-	defer cancel()
 
 	// Call and check for errors.
-	result, err := s.Client.GetPayload(cctx, payloadID, forkVersion)
+	result, _, err := withFailover(
+		ctx, s, s.builder.Load(),
+		func(
+			ctx context.Context, ep *endpoint[ExecutionPayloadT],
+		) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT],
+			error,
+		) {
+			cctx, cancel := s.createContextWithTimeout(ctx)
+			defer cancel()
+			return ep.GetPayload(cctx, payloadID, forkVersion)
+		},
+	)
 	switch {
 	case err != nil:
 		if errors.Is(err, engineerrors.ErrEngineAPITimeout) {
@@ -166,13 +208,24 @@ func (s *EngineClient[
 }
 
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
-// JSON-RPC.
+// JSON-RPC on the active endpoint.
 func (s *EngineClient[
 	_, _,
 ]) ExchangeCapabilities(
 	ctx context.Context,
 ) ([]string, error) {
-	result, err := s.Client.ExchangeCapabilities(
+	return s.exchangeCapabilities(ctx, s.activeEndpoint())
+}
+
+// exchangeCapabilities calls the engine_exchangeCapabilities method via
+// JSON-RPC on the given endpoint.
+func (s *EngineClient[
+	ExecutionPayloadT, _,
+]) exchangeCapabilities(
+	ctx context.Context,
+	ep *endpoint[ExecutionPayloadT],
+) ([]string, error) {
+	result, err := ep.ExchangeCapabilities(
 		ctx, ethclient.BeaconKitSupportedCapabilities(),
 	)
 	if err != nil {
@@ -180,16 +233,20 @@ func (s *EngineClient[
 	}
 
 	// Capture and log the capabilities that the execution client has.
+	ep.setCapabilities(result)
 	for _, capability := range result {
-		s.logger.Info("Exchanged capability", "capability", capability)
-		s.capabilities[capability] = struct{}{}
+		s.logger.Info(
+			"Exchanged capability",
+			"dial_url", ep.url.String(), "capability", capability,
+		)
 	}
 
 	// Log the capabilities that the execution client does not have.
 	for _, capability := range ethclient.BeaconKitSupportedCapabilities() {
-		if _, exists := s.capabilities[capability]; !exists {
+		if !ep.hasCapability(capability) {
 			s.logger.Warn(
 				"Your execution client may require an update 🚸",
+				"dial_url", ep.url.String(),
 				"unsupported_capability", capability,
 			)
 		}
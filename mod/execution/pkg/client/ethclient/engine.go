@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -33,18 +34,25 @@ import (
 /*                                 NewPayload                                 */
 /* -------------------------------------------------------------------------- */
 
-// NewPayload calls the engine_newPayloadV3 method via JSON-RPC.
+// NewPayload is a helper function to call the appropriate version of the
+// engine_newPayload method.
 func (s *Client[ExecutionPayloadT]) NewPayload(
 	ctx context.Context,
 	payload ExecutionPayloadT,
 	versionedHashes []common.ExecutionHash,
 	parentBlockRoot *common.Root,
+	executionRequests [][]byte,
+	forkVersion uint32,
 ) (*engineprimitives.PayloadStatusV1, error) {
-	switch payload.Version() {
+	switch forkVersion {
 	case version.Deneb, version.DenebPlus:
 		return s.NewPayloadV3(
 			ctx, payload, versionedHashes, parentBlockRoot,
 		)
+	case version.Electra:
+		return s.NewPayloadV4(
+			ctx, payload, versionedHashes, parentBlockRoot, executionRequests,
+		)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -66,6 +74,30 @@ func (s *Client[ExecutionPayloadT]) NewPayloadV3(
 	return result, nil
 }
 
+// NewPayloadV4 calls the engine_newPayloadV4 method via JSON-RPC, which also
+// takes the execution requests of the payload encoded as per EIP-7685.
+func (s *Client[ExecutionPayloadT]) NewPayloadV4(
+	ctx context.Context,
+	payload ExecutionPayloadT,
+	versionedHashes []common.ExecutionHash,
+	parentBlockRoot *common.Root,
+	executionRequests [][]byte,
+) (*engineprimitives.PayloadStatusV1, error) {
+	requests := make([]bytes.Bytes, len(executionRequests))
+	for i, request := range executionRequests {
+		requests[i] = request
+	}
+
+	result := &engineprimitives.PayloadStatusV1{}
+	if err := s.Call(
+		ctx, result, NewPayloadMethodV4,
+		payload, versionedHashes, parentBlockRoot, requests,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                              ForkchoiceUpdated                             */
 /* -------------------------------------------------------------------------- */
@@ -78,8 +110,9 @@ func (s *Client[ExecutionPayloadT]) ForkchoiceUpdated(
 	attrs any,
 	forkVersion uint32,
 ) (*engineprimitives.ForkchoiceResponseV1, error) {
+	// The payload attributes are unchanged in Electra.
 	switch forkVersion {
-	case version.Deneb, version.DenebPlus:
+	case version.Deneb, version.DenebPlus, version.Electra:
 		return s.ForkchoiceUpdatedV3(ctx, state, attrs)
 	default:
 		return nil, ErrInvalidVersion
@@ -132,6 +165,8 @@ func (s *Client[ExecutionPayloadT]) GetPayload(
 	switch forkVersion {
 	case version.Deneb, version.DenebPlus:
 		return s.GetPayloadV3(ctx, payloadID)
+	case version.Electra:
+		return s.GetPayloadV4(ctx, payloadID)
 	default:
 		return nil, ErrInvalidVersion
 	}
@@ -159,6 +194,34 @@ func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+// GetPayloadV4 calls the engine_getPayloadV4 method via JSON-RPC, whose
+// envelope also carries the execution requests of the payload.
+func (s *Client[ExecutionPayloadT]) GetPayloadV4(
+	ctx context.Context, payloadID engineprimitives.PayloadID,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	var t ExecutionPayloadT
+	result := &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: t.Empty(version.Electra),
+	}
+
+	if err := s.Call(
+		ctx, result, GetPayloadMethodV4, payloadID,
+	); err != nil {
+		return nil, err
+	}
+
+	// The execution requests are always returned, even if there are none.
+	if result.ExecutionRequests == nil {
+		return nil, ErrNilResponse
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
@@ -62,3 +62,8 @@ type Error struct {
 func (err Error) Error() string {
 	return fmt.Sprintf("Error %d (%s)", err.Code, err.Message)
 }
+
+// ErrorCode returns the JSON-RPC error code.
+func (err Error) ErrorCode() int {
+	return err.Code
+}
@@ -73,6 +73,35 @@ func (cm *clientMetrics) measureGetPayloadDuration(startTime time.Time) {
 	)
 }
 
+// setEndpointHealthScore sets the health score gauge of the given endpoint.
+func (cm *clientMetrics) setEndpointHealthScore(dialURL string, score int32) {
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.endpoint_health_score",
+		int64(score),
+		"endpoint", dialURL,
+	)
+}
+
+// setActiveEndpoint sets the active endpoint gauge of the given endpoint to 1
+// if it is the active endpoint, and to 0 otherwise.
+func (cm *clientMetrics) setActiveEndpoint(dialURL string, active bool) {
+	var value int64
+	if active {
+		value = 1
+	}
+	cm.sink.SetGauge(
+		"beacon_kit.execution.client.active_endpoint",
+		value,
+		"endpoint", dialURL,
+	)
+}
+
+// incrementFailover increments the counter for switches of the active
+// endpoint.
+func (cm *clientMetrics) incrementFailover() {
+	cm.sink.IncrementCounter("beacon_kit.execution.client.failover")
+}
+
 // incrementForkchoiceUpdateTimeout increments the timeout counter
 // for forkchoice update.
 func (cm *clientMetrics) incrementForkchoiceUpdateTimeout() {
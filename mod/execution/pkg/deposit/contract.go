@@ -70,24 +70,28 @@ func NewWrappedBeaconDepositContract[
 	}, nil
 }
 
-// ReadDeposits reads deposits from the deposit contract.
+// ReadDeposits reads the deposits emitted by the deposit contract in the
+// inclusive block range [fromBlock, toBlock].
 func (dc *WrappedBeaconDepositContract[
 	DepositT,
 	WithdrawalCredentialsT,
 ]) ReadDeposits(
 	ctx context.Context,
-	blkNum math.U64,
+	fromBlock math.U64,
+	toBlock math.U64,
 ) ([]DepositT, error) {
 	logs, err := dc.FilterDeposit(
 		&bind.FilterOpts{
 			Context: ctx,
-			Start:   blkNum.Unwrap(),
-			End:     (*uint64)(&blkNum),
+			Start:   fromBlock.Unwrap(),
+			End:     (*uint64)(&toBlock),
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
+	//nolint:errcheck // closing the iterator only releases the subscription.
+	defer logs.Close()
 
 	deposits := make([]DepositT, 0)
 	for logs.Next() {
@@ -102,5 +106,5 @@ func (dc *WrappedBeaconDepositContract[
 		))
 	}
 
-	return deposits, nil
+	return deposits, logs.Error()
 }
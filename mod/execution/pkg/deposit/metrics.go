@@ -40,10 +40,20 @@ func newMetrics(sink TelemetrySink) *metrics {
 }
 
 // markFailedToGetBlockLogs increments the counter for failed to get block logs.
-func (m *metrics) markFailedToGetBlockLogs(blockNum math.U64) {
+func (m *metrics) markFailedToGetBlockLogs(fromBlock, toBlock math.U64) {
 	m.sink.IncrementCounter(
 		"beacon_kit.execution.deposit.failed_to_get_block_logs",
-		"block_num",
-		strconv.FormatUint(blockNum.Unwrap(), 10),
+		"from_block",
+		strconv.FormatUint(fromBlock.Unwrap(), 10),
+		"to_block",
+		strconv.FormatUint(toBlock.Unwrap(), 10),
+	)
+}
+
+// markDepositIndexGap increments the counter for gaps detected in the
+// deposit indices read from the deposit contract.
+func (m *metrics) markDepositIndexGap() {
+	m.sink.IncrementCounter(
+		"beacon_kit.execution.deposit.index_gap",
 	)
 }
@@ -23,9 +23,11 @@ package deposit
 import (
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BuildPruneRangeFn builds a function that returns the range of deposits that
+// can be pruned once the given block leaves the retention window, which are
+// the deposits before the last one included in it, up to MaxDepositsPerBlock.
 func BuildPruneRangeFn[
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
 	BeaconBlockBodyT interface {
@@ -39,13 +41,7 @@ func BuildPruneRangeFn[
 		if len(deposits) == 0 || cs.MaxDepositsPerBlock() == 0 {
 			return 0, 0
 		}
-		index := deposits[len(deposits)-1].GetIndex()
-
-		end := min(index.Unwrap(), cs.MaxDepositsPerBlock())
-		if index < math.U64(cs.MaxDepositsPerBlock()) {
-			return 0, end
-		}
-
-		return index.Unwrap() - cs.MaxDepositsPerBlock(), end
+		index := deposits[len(deposits)-1].GetIndex().Unwrap()
+		return index - min(index, cs.MaxDepositsPerBlock()), index
 	}
 }
@@ -48,9 +48,12 @@ type Service[
 	feed chan *asynctypes.Event[BeaconBlockT]
 	// metrics is the metrics for the deposit service.
 	metrics *metrics
-	// failedBlocks is a map of blocks that failed to be processed to be
-	// retried.
-	failedBlocks map[math.U64]struct{}
+	// targetBlock is the latest execution block whose deposit logs should be
+	// synced.
+	targetBlock math.U64
+	// requestsActive is set once a finalized Electra block is seen, from
+	// which the deposits are processed as execution layer requests.
+	requestsActive bool
 }
 
 // NewService creates a new instance of the Service struct.
@@ -81,7 +84,6 @@ func NewService[
 		metrics:            newMetrics(telemetrySink),
 		dc:                 dc,
 		ds:                 ds,
-		failedBlocks:       make(map[math.Slot]struct{}),
 	}
 }
 
@@ -90,7 +92,6 @@ func (s *Service[
 	_, _, _, _, _,
 ]) Start(ctx context.Context) error {
 	go s.depositFetcher(ctx)
-	go s.depositCatchupFetcher(ctx)
 	return nil
 }
 
@@ -24,82 +24,220 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-// defaultRetryInterval processes a deposit event.
-const defaultRetryInterval = 20 * time.Second
+const (
+	// defaultRetryInterval is the interval at which the deposit logs are
+	// re-synced if the service has fallen behind the target block.
+	defaultRetryInterval = 20 * time.Second
+	// maxBlockRange is the maximum number of execution blocks whose deposit
+	// logs are requested at once.
+	maxBlockRange = 1000
+)
 
-// depositFetcher processes a deposit event.
+// depositFetcher syncs the deposit logs up to eth1FollowDistance blocks
+// behind each finalized block. Since finalized blocks cannot be reorged, the
+// synced range never has to be rolled back. The target is persisted, so that
+// blocks missed while the node was offline are backfilled on startup, and the
+// ticker retries failed syncs.
 func (s *Service[
 	_, _, _, _, _,
 ]) depositFetcher(ctx context.Context) {
+	targetBlock, err := s.ds.GetSyncTargetBlock()
+	if err != nil {
+		s.logger.Error("Failed to get deposit sync target", "error", err)
+	}
+	s.targetBlock = math.U64(targetBlock)
+	s.syncDeposits(ctx)
+
+	ticker := time.NewTicker(defaultRetryInterval)
+	defer ticker.Stop()
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case msg := <-s.feed:
-			if msg.Is(events.BeaconBlockFinalized) {
-				blockNum := msg.Data().
-					GetBody().GetExecutionPayload().GetNumber()
-				s.fetchAndStoreDeposits(ctx, blockNum-s.eth1FollowDistance)
+			if !msg.Is(events.BeaconBlockFinalized) {
+				continue
 			}
+			s.handleFinalizedBlock(msg.Data())
+			s.syncDeposits(ctx)
+		case <-ticker.C:
+			s.syncDeposits(ctx)
 		}
 	}
 }
 
-// depositCatchupFetcher fetches deposits for blocks that failed to be
-// processed.
+// handleFinalizedBlock updates the sync target for a finalized beacon block.
+// From Electra, deposits are processed as execution layer requests. The
+// deposits still to be included by proposers were all emitted before the
+// payload of the first finalized Electra block, so the logs are synced up
+// to the block before it, after which the service stops polling.
+func (s *Service[
+	BeaconBlockT, _, _, _, _,
+]) handleFinalizedBlock(blk BeaconBlockT) {
+	if s.requestsActive {
+		return
+	}
+
+	blockNum := blk.GetBody().GetExecutionPayload().GetNumber()
+	if blk.Version() < version.Electra {
+		s.updateTargetBlock(blockNum)
+		return
+	}
+
+	s.requestsActive = true
+	if blockNum > 0 {
+		s.setTargetBlock(blockNum - 1)
+	}
+	s.logger.Info(
+		"Deposits are processed as execution layer requests, "+
+			"syncing remaining deposit logs",
+		"target_block", s.targetBlock,
+	)
+}
+
+// updateTargetBlock advances the sync target to eth1FollowDistance blocks
+// behind the execution block of a finalized beacon block.
 func (s *Service[
 	_, _, _, _, _,
-]) depositCatchupFetcher(ctx context.Context) {
-	ticker := time.NewTicker(defaultRetryInterval)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			if len(s.failedBlocks) == 0 {
-				continue
-			}
+]) updateTargetBlock(blockNum math.U64) {
+	if blockNum <= s.eth1FollowDistance {
+		return
+	}
+	s.setTargetBlock(blockNum - s.eth1FollowDistance)
+}
+
+// setTargetBlock advances and persists the sync target. The target never
+// moves backwards.
+func (s *Service[
+	_, _, _, _, _,
+]) setTargetBlock(target math.U64) {
+	if target <= s.targetBlock {
+		return
+	}
+
+	s.targetBlock = target
+	if err := s.ds.SetSyncTargetBlock(s.targetBlock.Unwrap()); err != nil {
+		s.logger.Error("Failed to persist deposit sync target", "error", err)
+	}
+}
+
+// syncDeposits fetches the deposit logs from the block after the last synced
+// block up to the target block, in ranges of at most maxBlockRange blocks.
+func (s *Service[
+	_, _, _, _, _,
+]) syncDeposits(ctx context.Context) {
+	lastSynced, err := s.ds.GetLastSyncedBlock()
+	if err != nil {
+		s.logger.Error("Failed to get last synced block", "error", err)
+		return
+	}
+
+	for from := math.U64(lastSynced) + 1; from <= s.targetBlock; {
+		to := min(from+maxBlockRange-1, s.targetBlock)
+		if err = s.syncRange(ctx, from, to); err != nil {
 			s.logger.Warn(
-				"Failed to get deposits from block(s), retrying...",
-				"num_blocks",
-				s.failedBlocks,
+				"Failed to sync deposits, retrying...",
+				"from_block", from, "to_block", to, "error", err,
 			)
-
-			// Fetch deposits for blocks that failed to be processed.
-			for blockNum := range s.failedBlocks {
-				s.fetchAndStoreDeposits(ctx, blockNum)
-			}
+			return
 		}
+		from = to + 1
 	}
 }
 
+// syncRange fetches the deposit logs in the inclusive range [from, to],
+// stores the new deposits and advances the last synced block.
 func (s *Service[
 	_, _, _, _, _,
-]) fetchAndStoreDeposits(ctx context.Context, blockNum math.U64) {
-	deposits, err := s.dc.ReadDeposits(ctx, blockNum)
+]) syncRange(ctx context.Context, from, to math.U64) error {
+	deposits, err := s.dc.ReadDeposits(ctx, from, to)
 	if err != nil {
-		s.metrics.markFailedToGetBlockLogs(blockNum)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		s.metrics.markFailedToGetBlockLogs(from, to)
+		return err
+	}
+
+	if deposits, err = s.filterNewDeposits(deposits); errors.Is(
+		err, ErrDepositIndexGap,
+	) {
+		s.metrics.markDepositIndexGap()
+		return s.rewindToLastDeposit(err)
+	} else if err != nil {
+		return err
 	}
 
 	if len(deposits) > 0 {
 		s.logger.Info(
 			"Found deposits on execution layer",
-			"block", blockNum, "deposits", len(deposits),
+			"from_block", from, "to_block", to, "deposits", len(deposits),
 		)
 	}
 
 	if err = s.ds.EnqueueDeposits(deposits); err != nil {
-		s.logger.Error("Failed to store deposits", "error", err)
-		s.failedBlocks[blockNum] = struct{}{}
-		return
+		return err
+	}
+	if len(deposits) > 0 {
+		if err = s.ds.SetLastDepositBlock(from.Unwrap()); err != nil {
+			return err
+		}
+	}
+	return s.ds.SetLastSyncedBlock(to.Unwrap())
+}
+
+// rewindToLastDeposit moves the last synced block back to before the range
+// that contained the last contiguous deposit. A deposit index gap means a
+// deposit was missed in an already synced range, and since the deposits are
+// emitted in index order, the missing deposit is in a block at or after the
+// one of the last contiguous deposit. The next sync re-reads from there.
+func (s *Service[
+	_, _, _, _, _,
+]) rewindToLastDeposit(gapErr error) error {
+	lastDepositBlock, err := s.ds.GetLastDepositBlock()
+	if err != nil {
+		return err
+	}
+
+	var lastSynced uint64
+	if lastDepositBlock > 0 {
+		lastSynced = lastDepositBlock - 1
 	}
+	if err = s.ds.SetLastSyncedBlock(lastSynced); err != nil {
+		return err
+	}
+	return errors.Wrapf(
+		gapErr, "re-syncing deposits from block %d", lastSynced+1,
+	)
+}
 
-	delete(s.failedBlocks, blockNum)
+// filterNewDeposits drops the deposits that are already in the store, which
+// happens when a range is re-read after a restart, and ensures the remaining
+// deposits directly follow the stored ones.
+func (s *Service[
+	_, _, DepositT, _, _,
+]) filterNewDeposits(deposits []DepositT) ([]DepositT, error) {
+	next, err := s.ds.GetDepositCount()
+	if err != nil {
+		return nil, err
+	}
+
+	newDeposits := make([]DepositT, 0, len(deposits))
+	for _, deposit := range deposits {
+		index := deposit.GetIndex().Unwrap()
+		switch {
+		case index < next:
+			continue
+		case index > next:
+			return nil, errors.Wrapf(
+				ErrDepositIndexGap, "expected index %d, got %d", next, index,
+			)
+		}
+		newDeposits = append(newDeposits, deposit)
+		next++
+	}
+	return newDeposits, nil
 }
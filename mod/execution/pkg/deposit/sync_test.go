@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	failedToGetBlockLogsKey = "beacon_kit.execution.deposit.failed_to_get_block_logs"
+	depositIndexGapKey      = "beacon_kit.execution.deposit.index_gap"
+)
+
+type testDeposit struct {
+	index uint64
+}
+
+func (*testDeposit) New(
+	_ crypto.BLSPubkey, _ common.Bytes32, _ math.U64,
+	_ crypto.BLSSignature, index uint64,
+) *testDeposit {
+	return &testDeposit{index: index}
+}
+
+func (d *testDeposit) GetIndex() math.U64 { return math.U64(d.index) }
+
+// testContract serves the deposits of each block, optionally leaving out
+// the deposits of some blocks to simulate incomplete logs.
+type testContract struct {
+	deposits map[uint64][]*testDeposit
+	missing  map[uint64]bool
+	err      error
+	reads    [][2]math.U64
+}
+
+func (c *testContract) ReadDeposits(
+	_ context.Context, from, to math.U64,
+) ([]*testDeposit, error) {
+	c.reads = append(c.reads, [2]math.U64{from, to})
+	if c.err != nil {
+		return nil, c.err
+	}
+	deposits := []*testDeposit{}
+	for blockNum := from.Unwrap(); blockNum <= to.Unwrap(); blockNum++ {
+		if !c.missing[blockNum] {
+			deposits = append(deposits, c.deposits[blockNum]...)
+		}
+	}
+	return deposits, nil
+}
+
+type testStore struct {
+	deposits         map[uint64]*testDeposit
+	lastSyncedBlock  uint64
+	lastDepositBlock uint64
+	syncTargetBlock  uint64
+}
+
+func newTestStore() *testStore {
+	return &testStore{deposits: make(map[uint64]*testDeposit)}
+}
+
+func (*testStore) Prune(uint64, uint64) error { return nil }
+
+func (s *testStore) EnqueueDeposits(deposits []*testDeposit) error {
+	for _, deposit := range deposits {
+		s.deposits[deposit.index] = deposit
+	}
+	return nil
+}
+
+func (s *testStore) GetDepositCount() (uint64, error) {
+	var count uint64
+	for s.deposits[count] != nil {
+		count++
+	}
+	return count, nil
+}
+
+func (s *testStore) GetLastSyncedBlock() (uint64, error) {
+	return s.lastSyncedBlock, nil
+}
+
+func (s *testStore) SetLastSyncedBlock(blockNum uint64) error {
+	s.lastSyncedBlock = blockNum
+	return nil
+}
+
+func (s *testStore) GetLastDepositBlock() (uint64, error) {
+	return s.lastDepositBlock, nil
+}
+
+func (s *testStore) SetLastDepositBlock(blockNum uint64) error {
+	s.lastDepositBlock = blockNum
+	return nil
+}
+
+func (s *testStore) GetSyncTargetBlock() (uint64, error) {
+	return s.syncTargetBlock, nil
+}
+
+func (s *testStore) SetSyncTargetBlock(blockNum uint64) error {
+	s.syncTargetBlock = blockNum
+	return nil
+}
+
+// testBlock is a finalized beacon block of the given fork version, whose
+// payload has the given number.
+type testBlock struct {
+	version uint32
+	number  uint64
+}
+
+func (*testBlock) GetSlot() math.U64 { return 0 }
+
+func (b *testBlock) GetBody() BeaconBlockBody[*testDeposit, ExecutionPayload] {
+	return b
+}
+
+func (*testBlock) GetDeposits() []*testDeposit { return nil }
+
+func (b *testBlock) GetExecutionPayload() ExecutionPayload { return b }
+
+func (b *testBlock) GetNumber() math.U64 { return math.U64(b.number) }
+
+func (b *testBlock) Version() uint32 { return b.version }
+
+type testSink struct {
+	counters map[string]int
+}
+
+func (s *testSink) IncrementCounter(key string, _ ...string) {
+	s.counters[key]++
+}
+
+type testService = Service[
+	BeaconBlock[BeaconBlockBody[*testDeposit, ExecutionPayload]],
+	BeaconBlockBody[*testDeposit, ExecutionPayload],
+	*testDeposit,
+	ExecutionPayload,
+	common.Bytes32,
+]
+
+func newTestService(
+	ds *testStore, dc *testContract,
+) (*testService, *testSink) {
+	sink := &testSink{counters: make(map[string]int)}
+	return NewService[
+		BeaconBlock[BeaconBlockBody[*testDeposit, ExecutionPayload]],
+		BeaconBlockBody[*testDeposit, ExecutionPayload],
+		*testDeposit,
+		ExecutionPayload,
+		common.Bytes32,
+	](noop.NewLogger[any](), 10, sink, ds, dc, nil), sink
+}
+
+// newTestContract returns a contract with one deposit in each of the given
+// blocks, in order.
+func newTestContract(blocks ...uint64) *testContract {
+	dc := &testContract{
+		deposits: make(map[uint64][]*testDeposit),
+		missing:  make(map[uint64]bool),
+	}
+	for i, blockNum := range blocks {
+		dc.deposits[blockNum] = append(
+			dc.deposits[blockNum], &testDeposit{index: uint64(i)},
+		)
+	}
+	return dc
+}
+
+func TestSyncDepositsInRanges(t *testing.T) {
+	ds := newTestStore()
+	dc := newTestContract(5, 5, 1500, 2400)
+	s, _ := newTestService(ds, dc)
+
+	s.updateTargetBlock(2510)
+	require.Equal(t, uint64(2500), ds.syncTargetBlock)
+	s.syncDeposits(context.Background())
+
+	require.Equal(t, [][2]math.U64{
+		{1, 1000}, {1001, 2000}, {2001, 2500},
+	}, dc.reads)
+	count, err := ds.GetDepositCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+	require.Equal(t, uint64(2500), ds.lastSyncedBlock)
+	require.Equal(t, uint64(2001), ds.lastDepositBlock)
+}
+
+func TestUpdateTargetBlock(t *testing.T) {
+	ds := newTestStore()
+	s, _ := newTestService(ds, newTestContract())
+
+	// Blocks within the follow distance do not move the target.
+	s.updateTargetBlock(10)
+	require.Equal(t, uint64(0), ds.syncTargetBlock)
+
+	s.updateTargetBlock(110)
+	require.Equal(t, math.U64(100), s.targetBlock)
+
+	// The target never moves backwards.
+	s.updateTargetBlock(50)
+	require.Equal(t, math.U64(100), s.targetBlock)
+	require.Equal(t, uint64(100), ds.syncTargetBlock)
+}
+
+func TestHandleFinalizedBlockElectra(t *testing.T) {
+	ds := newTestStore()
+	dc := newTestContract(5, 95, 150)
+	s, _ := newTestService(ds, dc)
+
+	s.handleFinalizedBlock(&testBlock{version: version.Deneb, number: 110})
+	require.Equal(t, math.U64(100), s.targetBlock)
+
+	// The logs are synced up to the block before the payload of the first
+	// finalized Electra block, regardless of the follow distance.
+	s.handleFinalizedBlock(&testBlock{version: version.Electra, number: 120})
+	require.Equal(t, math.U64(119), s.targetBlock)
+	require.Equal(t, uint64(119), ds.syncTargetBlock)
+
+	// Later blocks no longer move the target, so polling stops once the
+	// remaining logs are synced.
+	s.handleFinalizedBlock(&testBlock{version: version.Electra, number: 200})
+	require.Equal(t, math.U64(119), s.targetBlock)
+	s.syncDeposits(context.Background())
+	require.Equal(t, uint64(119), ds.lastSyncedBlock)
+	require.Len(t, ds.deposits, 2)
+}
+
+func TestSyncDepositsBackfillsOnStartup(t *testing.T) {
+	ds := newTestStore()
+	ds.syncTargetBlock = 300
+	ds.lastSyncedBlock = 100
+	dc := newTestContract(50, 150, 250)
+	require.NoError(t, ds.EnqueueDeposits([]*testDeposit{{index: 0}}))
+	s, _ := newTestService(ds, dc)
+
+	// The persisted target is synced to before any block is finalized.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.depositFetcher(ctx)
+
+	require.Equal(t, [][2]math.U64{{101, 300}}, dc.reads)
+	require.Equal(t, uint64(300), ds.lastSyncedBlock)
+	count, err := ds.GetDepositCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestSyncDepositsReadError(t *testing.T) {
+	ds := newTestStore()
+	dc := newTestContract(5)
+	dc.err = errors.New("connection refused")
+	s, sink := newTestService(ds, dc)
+
+	s.updateTargetBlock(110)
+	s.syncDeposits(context.Background())
+	require.Equal(t, uint64(0), ds.lastSyncedBlock)
+	require.Equal(t, 1, sink.counters[failedToGetBlockLogsKey])
+
+	dc.err = nil
+	s.syncDeposits(context.Background())
+	require.Equal(t, uint64(100), ds.lastSyncedBlock)
+	require.Len(t, ds.deposits, 1)
+}
+
+func TestSyncDepositsIndexGap(t *testing.T) {
+	ds := newTestStore()
+	dc := newTestContract(1200, 1500, 2100, 2200)
+	s, sink := newTestService(ds, dc)
+
+	// The logs of block 1500 are missing the first time they are read, so
+	// the deposit of block 2100 does not follow the stored ones.
+	dc.missing[1500] = true
+	s.updateTargetBlock(2510)
+	s.syncDeposits(context.Background())
+	require.Equal(t, 1, sink.counters[depositIndexGapKey])
+
+	// The sync rewinds to the range of the last contiguous deposit.
+	require.Equal(t, uint64(1000), ds.lastSyncedBlock)
+	require.Equal(t, uint64(1001), ds.lastDepositBlock)
+	require.Len(t, ds.deposits, 1)
+
+	dc.missing[1500] = false
+	dc.reads = nil
+	s.syncDeposits(context.Background())
+	require.Equal(t, [][2]math.U64{{1001, 2000}, {2001, 2500}}, dc.reads)
+	count, err := ds.GetDepositCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+	require.Equal(t, uint64(2500), ds.lastSyncedBlock)
+}
+
+func TestSyncDepositsIndexGapBeforeFirstDeposit(t *testing.T) {
+	ds := newTestStore()
+	dc := newTestContract(20, 40)
+	s, _ := newTestService(ds, dc)
+
+	dc.missing[20] = true
+	s.updateTargetBlock(110)
+	s.syncDeposits(context.Background())
+
+	// Without a synced deposit the sync restarts from the first block.
+	require.Equal(t, uint64(0), ds.lastSyncedBlock)
+
+	dc.missing[20] = false
+	s.syncDeposits(context.Background())
+	require.Len(t, ds.deposits, 2)
+}
@@ -39,6 +39,7 @@ type BeaconBlockBody[
 type BeaconBlock[BeaconBlockBodyT any] interface {
 	GetSlot() math.U64
 	GetBody() BeaconBlockBodyT
+	Version() uint32
 }
 
 // ExecutionPayload is an interface for execution payloads.
@@ -48,10 +49,12 @@ type ExecutionPayload interface {
 
 // Contract is the ABI for the deposit contract.
 type Contract[DepositT any] interface {
-	// ReadDeposits reads deposits from the deposit contract.
+	// ReadDeposits reads the deposits emitted by the deposit contract in the
+	// inclusive block range [fromBlock, toBlock].
 	ReadDeposits(
 		ctx context.Context,
-		blockNumber math.U64,
+		fromBlock math.U64,
+		toBlock math.U64,
 	) ([]DepositT, error)
 }
 
@@ -75,6 +78,27 @@ type Store[DepositT any] interface {
 	Prune(index uint64, numPrune uint64) error
 	// EnqueueDeposits adds a list of deposits to the deposit store.
 	EnqueueDeposits(deposits []DepositT) error
+	// GetDepositCount returns the number of contiguous deposits in the
+	// deposit store, starting at index 0.
+	GetDepositCount() (uint64, error)
+	// GetLastSyncedBlock returns the last execution block whose deposit logs
+	// have been synced.
+	GetLastSyncedBlock() (uint64, error)
+	// SetLastSyncedBlock sets the last execution block whose deposit logs
+	// have been synced.
+	SetLastSyncedBlock(blockNum uint64) error
+	// GetLastDepositBlock returns the first block of the synced range that
+	// contained the last contiguous deposit.
+	GetLastDepositBlock() (uint64, error)
+	// SetLastDepositBlock sets the first block of the synced range that
+	// contained the last contiguous deposit.
+	SetLastDepositBlock(blockNum uint64) error
+	// GetSyncTargetBlock returns the last execution block whose deposit logs
+	// should be synced.
+	GetSyncTargetBlock() (uint64, error)
+	// SetSyncTargetBlock sets the last execution block whose deposit logs
+	// should be synced.
+	SetSyncTargetBlock(blockNum uint64) error
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
@@ -23,6 +23,7 @@ package engine
 import (
 	"bytes"
 	"context"
+	"sync/atomic"
 
 	broker "github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
@@ -56,6 +57,9 @@ type Engine[
 	metrics *engineMetrics
 	// statusPublisher is the status publishder for the engine.
 	statusPublisher *broker.Broker[*asynctypes.Event[*service.StatusEvent]]
+	// optimistic is set while the head of the execution client has only
+	// been accepted optimistically, i.e. it has not been validated yet.
+	optimistic atomic.Bool
 }
 
 // New creates a new Engine.
@@ -113,6 +117,13 @@ func (ee *Engine[
 	)
 }
 
+// IsOptimistic returns true if the head of the execution client has only been
+// accepted optimistically, i.e. the execution client is still syncing and has
+// not validated it yet.
+func (ee *Engine[_, _, _, _]) IsOptimistic() bool {
+	return ee.optimistic.Load()
+}
+
 // NotifyForkchoiceUpdate notifies the execution client of a forkchoice update.
 func (ee *Engine[
 	_, PayloadAttributesT, _, _,
@@ -141,6 +152,7 @@ func (ee *Engine[
 		engineerrors.ErrSyncingPayloadStatus,
 	):
 		ee.metrics.markForkchoiceUpdateAcceptedSyncing(req.State, err)
+		ee.optimistic.Store(true)
 		return payloadID, nil, nil
 
 	// If we get invalid payload status, we will need to find a valid
@@ -169,6 +181,7 @@ func (ee *Engine[
 		ee.metrics.markForkchoiceUpdateValid(
 			req.State, hasPayloadAttributes, payloadID,
 		)
+		ee.optimistic.Store(false)
 	}
 
 	// If we reached here, and we have a nil payload ID, we should log a
@@ -217,6 +230,8 @@ func (ee *Engine[
 		req.ExecutionPayload,
 		req.VersionedHashes,
 		req.ParentBeaconBlockRoot,
+		req.ExecutionRequests,
+		req.ForkVersion,
 	)
 
 	// We abstract away some of the complexity and categorize status codes
@@ -236,6 +251,7 @@ func (ee *Engine[
 			req.ExecutionPayload.GetParentHash(),
 			req.Optimistic,
 		)
+		ee.optimistic.Store(true)
 
 	// These two cases are semantically the same:
 	// https://github.com/ethereum/execution-apis/issues/270
@@ -23,6 +23,7 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -57,6 +58,7 @@ type Backend[
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
+	VoluntaryExitT VoluntaryExit[VoluntaryExitT],
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
@@ -64,7 +66,9 @@ type Backend[
 	cs   common.ChainSpec
 	node NodeT
 
-	sp StateProcessor[BeaconStateT]
+	sp      StateProcessor[BeaconStateT]
+	archive StateArchive[BeaconStateT]
+	pool    VoluntaryExitPool[BeaconStateT, VoluntaryExitT]
 }
 
 // New creates and returns a new Backend instance.
@@ -95,56 +99,61 @@ func New[
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
 	ValidatorsT ~[]ValidatorT,
+	VoluntaryExitT VoluntaryExit[VoluntaryExitT],
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
+	archive StateArchive[BeaconStateT],
+	pool VoluntaryExitPool[BeaconStateT, VoluntaryExitT],
 ) *Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-	NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
-	WithdrawalCredentialsT,
+	NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT,
+	VoluntaryExitT, WithdrawalT, WithdrawalCredentialsT,
 ] {
 	return &Backend[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 		ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
-		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
-		WithdrawalCredentialsT,
+		NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT,
+		VoluntaryExitT, WithdrawalT, WithdrawalCredentialsT,
 	]{
-		sb: storageBackend,
-		cs: cs,
-		sp: sp,
+		sb:      storageBackend,
+		cs:      cs,
+		sp:      sp,
+		archive: archive,
+		pool:    pool,
 	}
 }
 
 // AttachNode sets the node on the backend for querying historical heights.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _, _,
 ]) AttachNode(node NodeT) {
 	b.node = node
 }
 
 // ChainSpec returns the chain spec from the backend.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _, _,
 ]) ChainSpec() common.ChainSpec {
 	return b.cs
 }
 
 // GetSlotByBlockRoot retrieves the slot by a block root from the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByBlockRoot(root)
 }
 
 // GetSlotByStateRoot retrieves the slot by a state root from the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByStateRoot(root common.Root) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByStateRoot(root)
 }
@@ -152,15 +161,53 @@ func (b *Backend[
 // GetSlotByExecutionNumber retrieves the slot by a given execution number from
 // the block store.
 func (b *Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error) {
 	return b.sb.BlockStore().GetSlotByExecutionNumber(executionNumber)
 }
 
+// GetSlotByExecutionHash retrieves the slot by a given execution block hash
+// from the block store.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GetSlotByExecutionHash(
+	executionHash common.ExecutionHash,
+) (math.Slot, error) {
+	return b.sb.BlockStore().GetSlotByExecutionHash(executionHash)
+}
+
+// GetSlotsByParentRoot retrieves the slots of the blocks with the given
+// parent block root from the block store.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GetSlotsByParentRoot(parentRoot common.Root) ([]math.Slot, error) {
+	return b.sb.BlockStore().GetSlotsByParentRoot(parentRoot)
+}
+
+// GetBlockSlotsInRange retrieves the slots of up to limit blocks in the
+// [start, end) slot range from the block store, in ascending order of slots
+// or in descending order if reverse is set. Missed slots are skipped.
+func (b *Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GetBlockSlotsInRange(
+	start, end math.Slot, limit uint64, reverse bool,
+) ([]math.Slot, error) {
+	var slots []math.Slot
+	walk := b.sb.BlockStore().Walk
+	if reverse {
+		walk = b.sb.BlockStore().WalkReverse
+	}
+	err := walk(start, end, func(slot math.Slot, _ BeaconBlockT) (bool, error) {
+		slots = append(slots, slot)
+		return uint64(len(slots)) >= limit, nil
+	})
+	return slots, err
+}
+
 // stateFromSlot returns the state at the given slot, after also processing the
 // next slot to ensure the returned beacon state is up to date.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) stateFromSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var (
 		st  BeaconStateT
@@ -182,16 +229,24 @@ func (b *Backend[
 }
 
 // stateFromSlotRaw returns the state at the given slot using query context,
-// resolving an input slot of 0 to the latest slot. It does not process the
+// resolving an input slot of 0 to the latest slot. States no longer held by
+// the live store are regenerated by the archive. It does not process the
 // next slot on the beacon state.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var st BeaconStateT
 	//#nosec:G701 // not an issue in practice.
 	queryCtx, err := b.node.CreateQueryContext(int64(slot), false)
 	if err != nil {
-		return st, slot, err
+		if slot == 0 || !b.archive.Enabled() {
+			return st, slot, err
+		}
+		archived, archiveErr := b.archive.StateAtSlot(context.TODO(), slot)
+		if archiveErr != nil {
+			return st, slot, errors.Join(err, archiveErr)
+		}
+		return archived, slot, nil
 	}
 	st = b.sb.StateFromContext(queryCtx)
 
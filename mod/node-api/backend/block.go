@@ -26,10 +26,27 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// BlockAtSlot returns the beacon block at the given slot from the block
+// store, resolving a slot of 0 to the head slot.
+func (b Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BlockAtSlot(slot math.Slot) (BeaconBlockT, error) {
+	if slot == 0 {
+		var (
+			blk BeaconBlockT
+			err error
+		)
+		if slot, err = b.GetHeadSlot(); err != nil {
+			return blk, err
+		}
+	}
+	return b.sb.BlockStore().Get(slot)
+}
+
 // BlockHeader returns the block header at the given slot.
 func (b Backend[
 	_, _, _, BeaconBlockHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
-	_,
+	_, _,
 ]) BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error) {
 	var blockHeader BeaconBlockHeaderT
 
@@ -44,7 +61,7 @@ func (b Backend[
 
 // GetBlockRoot returns the root of the block at the given stateID.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
 	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
@@ -58,7 +75,7 @@ func (b Backend[
 
 // TODO: Implement this.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) BlockRewardsAtSlot(math.Slot) (*types.BlockRewardsData, error) {
 	return &types.BlockRewardsData{
 		ProposerIndex:     1,
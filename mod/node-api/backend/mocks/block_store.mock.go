@@ -22,6 +22,62 @@ func (_m *BlockStore[BeaconBlockT]) EXPECT() *BlockStore_Expecter[BeaconBlockT]
 	return &BlockStore_Expecter[BeaconBlockT]{mock: &_m.Mock}
 }
 
+// Get provides a mock function with given fields: slot
+func (_m *BlockStore[BeaconBlockT]) Get(slot math.U64) (BeaconBlockT, error) {
+	ret := _m.Called(slot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 BeaconBlockT
+	var r1 error
+	if rf, ok := ret.Get(0).(func(math.U64) (BeaconBlockT, error)); ok {
+		return rf(slot)
+	}
+	if rf, ok := ret.Get(0).(func(math.U64) BeaconBlockT); ok {
+		r0 = rf(slot)
+	} else {
+		r0 = ret.Get(0).(BeaconBlockT)
+	}
+
+	if rf, ok := ret.Get(1).(func(math.U64) error); ok {
+		r1 = rf(slot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type BlockStore_Get_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - slot math.U64
+func (_e *BlockStore_Expecter[BeaconBlockT]) Get(slot interface{}) *BlockStore_Get_Call[BeaconBlockT] {
+	return &BlockStore_Get_Call[BeaconBlockT]{Call: _e.mock.On("Get", slot)}
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) Run(run func(slot math.U64)) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64))
+	})
+	return _c
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) Return(_a0 BeaconBlockT, _a1 error) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_Get_Call[BeaconBlockT]) RunAndReturn(run func(math.U64) (BeaconBlockT, error)) *BlockStore_Get_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByBlockRoot provides a mock function with given fields: root
 func (_m *BlockStore[BeaconBlockT]) GetSlotByBlockRoot(root common.Root) (math.U64, error) {
 	ret := _m.Called(root)
@@ -78,6 +134,62 @@ func (_c *BlockStore_GetSlotByBlockRoot_Call[BeaconBlockT]) RunAndReturn(run fun
 	return _c
 }
 
+// GetSlotByExecutionHash provides a mock function with given fields: executionHash
+func (_m *BlockStore[BeaconBlockT]) GetSlotByExecutionHash(executionHash common.ExecutionHash) (math.U64, error) {
+	ret := _m.Called(executionHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlotByExecutionHash")
+	}
+
+	var r0 math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.ExecutionHash) (math.U64, error)); ok {
+		return rf(executionHash)
+	}
+	if rf, ok := ret.Get(0).(func(common.ExecutionHash) math.U64); ok {
+		r0 = rf(executionHash)
+	} else {
+		r0 = ret.Get(0).(math.U64)
+	}
+
+	if rf, ok := ret.Get(1).(func(common.ExecutionHash) error); ok {
+		r1 = rf(executionHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetSlotByExecutionHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlotByExecutionHash'
+type BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// GetSlotByExecutionHash is a helper method to define mock.On call
+//   - executionHash common.ExecutionHash
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetSlotByExecutionHash(executionHash interface{}) *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT] {
+	return &BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT]{Call: _e.mock.On("GetSlotByExecutionHash", executionHash)}
+}
+
+func (_c *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT]) Run(run func(executionHash common.ExecutionHash)) *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.ExecutionHash))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT]) Return(_a0 math.U64, _a1 error) *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT]) RunAndReturn(run func(common.ExecutionHash) (math.U64, error)) *BlockStore_GetSlotByExecutionHash_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSlotByExecutionNumber provides a mock function with given fields: executionNumber
 func (_m *BlockStore[BeaconBlockT]) GetSlotByExecutionNumber(executionNumber math.U64) (math.U64, error) {
 	ret := _m.Called(executionNumber)
@@ -190,6 +302,160 @@ func (_c *BlockStore_GetSlotByStateRoot_Call[BeaconBlockT]) RunAndReturn(run fun
 	return _c
 }
 
+// GetSlotsByParentRoot provides a mock function with given fields: parentRoot
+func (_m *BlockStore[BeaconBlockT]) GetSlotsByParentRoot(parentRoot common.Root) ([]math.U64, error) {
+	ret := _m.Called(parentRoot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlotsByParentRoot")
+	}
+
+	var r0 []math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(common.Root) ([]math.U64, error)); ok {
+		return rf(parentRoot)
+	}
+	if rf, ok := ret.Get(0).(func(common.Root) []math.U64); ok {
+		r0 = rf(parentRoot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]math.U64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(common.Root) error); ok {
+		r1 = rf(parentRoot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetSlotsByParentRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlotsByParentRoot'
+type BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// GetSlotsByParentRoot is a helper method to define mock.On call
+//   - parentRoot common.Root
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetSlotsByParentRoot(parentRoot interface{}) *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT] {
+	return &BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT]{Call: _e.mock.On("GetSlotsByParentRoot", parentRoot)}
+}
+
+func (_c *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT]) Run(run func(parentRoot common.Root)) *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(common.Root))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT]) Return(_a0 []math.U64, _a1 error) *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT]) RunAndReturn(run func(common.Root) ([]math.U64, error)) *BlockStore_GetSlotsByParentRoot_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Walk provides a mock function with given fields: start, end, walkFn
+func (_m *BlockStore[BeaconBlockT]) Walk(start math.U64, end math.U64, walkFn func(math.U64, BeaconBlockT) (bool, error)) error {
+	ret := _m.Called(start, end, walkFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Walk")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(math.U64, math.U64, func(math.U64, BeaconBlockT) (bool, error)) error); ok {
+		r0 = rf(start, end, walkFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BlockStore_Walk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Walk'
+type BlockStore_Walk_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// Walk is a helper method to define mock.On call
+//   - start math.U64
+//   - end math.U64
+//   - walkFn func(math.U64 , BeaconBlockT)(bool , error)
+func (_e *BlockStore_Expecter[BeaconBlockT]) Walk(start interface{}, end interface{}, walkFn interface{}) *BlockStore_Walk_Call[BeaconBlockT] {
+	return &BlockStore_Walk_Call[BeaconBlockT]{Call: _e.mock.On("Walk", start, end, walkFn)}
+}
+
+func (_c *BlockStore_Walk_Call[BeaconBlockT]) Run(run func(start math.U64, end math.U64, walkFn func(math.U64, BeaconBlockT) (bool, error))) *BlockStore_Walk_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(math.U64), args[2].(func(math.U64, BeaconBlockT) (bool, error)))
+	})
+	return _c
+}
+
+func (_c *BlockStore_Walk_Call[BeaconBlockT]) Return(_a0 error) *BlockStore_Walk_Call[BeaconBlockT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BlockStore_Walk_Call[BeaconBlockT]) RunAndReturn(run func(math.U64, math.U64, func(math.U64, BeaconBlockT) (bool, error)) error) *BlockStore_Walk_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WalkReverse provides a mock function with given fields: start, end, walkFn
+func (_m *BlockStore[BeaconBlockT]) WalkReverse(start math.U64, end math.U64, walkFn func(math.U64, BeaconBlockT) (bool, error)) error {
+	ret := _m.Called(start, end, walkFn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WalkReverse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(math.U64, math.U64, func(math.U64, BeaconBlockT) (bool, error)) error); ok {
+		r0 = rf(start, end, walkFn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BlockStore_WalkReverse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WalkReverse'
+type BlockStore_WalkReverse_Call[BeaconBlockT interface{}] struct {
+	*mock.Call
+}
+
+// WalkReverse is a helper method to define mock.On call
+//   - start math.U64
+//   - end math.U64
+//   - walkFn func(math.U64 , BeaconBlockT)(bool , error)
+func (_e *BlockStore_Expecter[BeaconBlockT]) WalkReverse(start interface{}, end interface{}, walkFn interface{}) *BlockStore_WalkReverse_Call[BeaconBlockT] {
+	return &BlockStore_WalkReverse_Call[BeaconBlockT]{Call: _e.mock.On("WalkReverse", start, end, walkFn)}
+}
+
+func (_c *BlockStore_WalkReverse_Call[BeaconBlockT]) Run(run func(start math.U64, end math.U64, walkFn func(math.U64, BeaconBlockT) (bool, error))) *BlockStore_WalkReverse_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(math.U64), args[1].(math.U64), args[2].(func(math.U64, BeaconBlockT) (bool, error)))
+	})
+	return _c
+}
+
+func (_c *BlockStore_WalkReverse_Call[BeaconBlockT]) Return(_a0 error) *BlockStore_WalkReverse_Call[BeaconBlockT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BlockStore_WalkReverse_Call[BeaconBlockT]) RunAndReturn(run func(math.U64, math.U64, func(math.U64, BeaconBlockT) (bool, error)) error) *BlockStore_WalkReverse_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBlockStore creates a new instance of BlockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBlockStore[BeaconBlockT interface{}](t interface {
@@ -22,6 +22,64 @@ func (_m *StateProcessor[BeaconStateT]) EXPECT() *StateProcessor_Expecter[Beacon
 	return &StateProcessor_Expecter[BeaconStateT]{mock: &_m.Mock}
 }
 
+// AttestationDeltas provides a mock function with given fields: _a0
+func (_m *StateProcessor[BeaconStateT]) AttestationDeltas(_a0 BeaconStateT) ([]transition.AttestationDelta, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttestationDeltas")
+	}
+
+	var r0 []transition.AttestationDelta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(BeaconStateT) ([]transition.AttestationDelta, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(BeaconStateT) []transition.AttestationDelta); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]transition.AttestationDelta)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(BeaconStateT) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateProcessor_AttestationDeltas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttestationDeltas'
+type StateProcessor_AttestationDeltas_Call[BeaconStateT interface{}] struct {
+	*mock.Call
+}
+
+// AttestationDeltas is a helper method to define mock.On call
+//   - _a0 BeaconStateT
+func (_e *StateProcessor_Expecter[BeaconStateT]) AttestationDeltas(_a0 interface{}) *StateProcessor_AttestationDeltas_Call[BeaconStateT] {
+	return &StateProcessor_AttestationDeltas_Call[BeaconStateT]{Call: _e.mock.On("AttestationDeltas", _a0)}
+}
+
+func (_c *StateProcessor_AttestationDeltas_Call[BeaconStateT]) Run(run func(_a0 BeaconStateT)) *StateProcessor_AttestationDeltas_Call[BeaconStateT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(BeaconStateT))
+	})
+	return _c
+}
+
+func (_c *StateProcessor_AttestationDeltas_Call[BeaconStateT]) Return(_a0 []transition.AttestationDelta, _a1 error) *StateProcessor_AttestationDeltas_Call[BeaconStateT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateProcessor_AttestationDeltas_Call[BeaconStateT]) RunAndReturn(run func(BeaconStateT) ([]transition.AttestationDelta, error)) *StateProcessor_AttestationDeltas_Call[BeaconStateT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessSlots provides a mock function with given fields: _a0, _a1
 func (_m *StateProcessor[BeaconStateT]) ProcessSlots(_a0 BeaconStateT, _a1 math.U64) (transition.ValidatorUpdates, error) {
 	ret := _m.Called(_a0, _a1)
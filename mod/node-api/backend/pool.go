@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// VoluntaryExits returns the voluntary exits in the operation pool.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) VoluntaryExits() []*beacontypes.SignedVoluntaryExitData {
+	exits := b.pool.Get()
+	data := make([]*beacontypes.SignedVoluntaryExitData, len(exits))
+	for i, exit := range exits {
+		data[i] = &beacontypes.SignedVoluntaryExitData{
+			Message: beacontypes.VoluntaryExitData{
+				Epoch:          exit.GetEpoch().Unwrap(),
+				ValidatorIndex: exit.GetValidatorIndex().Unwrap(),
+			},
+			Signature: exit.GetSignature(),
+		}
+	}
+	return data
+}
+
+// SubmitVoluntaryExit verifies the voluntary exit against the head state and
+// adds it to the operation pool. Exits failing verification are rejected as
+// invalid requests.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, VoluntaryExitT, _,
+	_,
+]) SubmitVoluntaryExit(exit *beacontypes.SignedVoluntaryExitData) error {
+	st, _, err := b.stateFromSlotRaw(0)
+	if err != nil {
+		return err
+	}
+	var voluntaryExit VoluntaryExitT
+	voluntaryExit = voluntaryExit.New(
+		math.Epoch(exit.Message.Epoch),
+		math.ValidatorIndex(exit.Message.ValidatorIndex),
+		exit.Signature,
+	)
+	if err = b.pool.Add(st, voluntaryExit); err != nil {
+		return errors.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// AttestationRewardsAtEpoch returns the attestation rewards of the given
+// validators, or of every validator if none is given, for the given epoch.
+// The rewards are computed from the state at the last slot of the epoch, so
+// the epoch must be complete.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+]) AttestationRewardsAtEpoch(
+	epoch math.Epoch, ids []string,
+) (*beacontypes.AttestationRewardsData, error) {
+	headSlot, err := b.GetHeadSlot()
+	if err != nil {
+		return nil, err
+	}
+	lastSlot := (epoch+1)*math.Slot(b.cs.SlotsPerEpoch()) - 1
+	if lastSlot > headSlot {
+		return nil, errors.Wrapf(
+			ErrEpochNotComplete, "epoch %d, head slot %d", epoch, headSlot,
+		)
+	}
+
+	// The deltas are computed before the epoch is processed, so the next
+	// slot must not be processed on the state.
+	st, _, err := b.stateFromSlotRaw(lastSlot)
+	if err != nil {
+		return nil, err
+	}
+	deltas, err := b.sp.AttestationDeltas(st)
+	if err != nil {
+		return nil, err
+	}
+
+	indices, err := attestationRewardsIndices(st, ids, len(deltas))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &beacontypes.AttestationRewardsData{
+		IdealRewards: make([]*beacontypes.IdealAttestationRewardsData, 0),
+		TotalRewards: make(
+			[]*beacontypes.TotalAttestationRewardsData, 0, len(indices),
+		),
+	}
+	var validator ValidatorT
+	idealRewards := make(map[math.Gwei]math.Gwei)
+	for _, index := range indices {
+		if validator, err = st.ValidatorByIndex(index); err != nil {
+			return nil, err
+		}
+		delta := deltas[index]
+		idealRewards[validator.GetEffectiveBalance()] = delta.IdealReward
+
+		//#nosec:G701 // rewards are far below the int64 range.
+		data.TotalRewards = append(
+			data.TotalRewards, &beacontypes.TotalAttestationRewardsData{
+				ValidatorIndex: index.Unwrap(),
+				Source: int64(delta.Reward.Unwrap()) -
+					int64(delta.Penalty.Unwrap()),
+				Inactivity: -int64(delta.InactivityPenalty.Unwrap()),
+			},
+		)
+	}
+
+	// Ideal rewards are reported once for every effective balance of the
+	// validators, in ascending order.
+	for _, balance := range slices.Sorted(maps.Keys(idealRewards)) {
+		//#nosec:G701 // rewards are far below the int64 range.
+		data.IdealRewards = append(
+			data.IdealRewards, &beacontypes.IdealAttestationRewardsData{
+				EffectiveBalance: balance.Unwrap(),
+				Source:           int64(idealRewards[balance].Unwrap()),
+			},
+		)
+	}
+	return data, nil
+}
+
+// attestationRewardsIndices returns the indices of the validators whose
+// attestation rewards are requested, or of every validator if no ID is
+// given.
+func attestationRewardsIndices[
+	BeaconStateT interface {
+		ValidatorIndexByPubkey(key crypto.BLSPubkey) (math.U64, error)
+	},
+](
+	st BeaconStateT, ids []string, numValidators int,
+) ([]math.ValidatorIndex, error) {
+	if len(ids) == 0 {
+		indices := make([]math.ValidatorIndex, numValidators)
+		for i := range indices {
+			indices[i] = math.ValidatorIndex(i)
+		}
+		return indices, nil
+	}
+
+	indices := make([]math.ValidatorIndex, len(ids))
+	for i, id := range ids {
+		index, err := utils.ValidatorIndexByID(st, id)
+		if err != nil {
+			return nil, err
+		}
+		if index.Unwrap() >= uint64(numValidators) {
+			return nil, errors.Wrapf(
+				types.ErrInvalidRequest, "unknown validator %s", id,
+			)
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
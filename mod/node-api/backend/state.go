@@ -29,14 +29,38 @@ import (
 // to calculate the parent beacon block root, which has the empty state root in
 // the latest block header. Hence we do not process the next slot.
 func (b *Backend[
-	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	return b.stateFromSlotRaw(slot)
 }
 
+// BeaconStateAtSlot returns the beacon state committed at the given slot. Its
+// hash tree root is the state root of the block at that slot, which makes it
+// suitable for bootstrapping other nodes.
+func (b *Backend[
+	_, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) BeaconStateAtSlot(slot math.Slot) (BeaconStateT, math.Slot, error) {
+	return b.stateFromSlotRaw(slot)
+}
+
+// ForkVersionAtSlot returns the version of the fork active at the given slot.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ForkVersionAtSlot(slot math.Slot) uint32 {
+	return b.cs.ActiveForkVersionForSlot(slot)
+}
+
+// GetHeadSlot returns the slot of the latest committed beacon state.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GetHeadSlot() (math.Slot, error) {
+	_, slot, err := b.stateFromSlotRaw(0)
+	return slot, err
+}
+
 // GetStateRoot returns the root of the state at the given slot.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) StateRootAtSlot(slot math.Slot) (common.Root, error) {
 	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
@@ -50,7 +74,7 @@ func (b Backend[
 
 // GetStateFork returns the fork of the state at the given stateID.
 func (b Backend[
-	_, _, _, _, _, _, _, _, _, _, _, _, _, ForkT, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, ForkT, _, _, _, _, _, _, _, _,
 ]) StateForkAtSlot(slot math.Slot) (ForkT, error) {
 	var fork ForkT
 	st, _, err := b.stateFromSlot(slot)
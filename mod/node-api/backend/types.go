@@ -25,6 +25,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
@@ -34,6 +35,8 @@ import (
 // sidecars for specific blocks, as well as verifying sidecars that have already
 // been stored.
 type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
+	// GetBlobSidecars returns the blob sidecars stored for the given slot.
+	GetBlobSidecars(math.Slot) (BlobSidecarsT, error)
 	// IsDataAvailable ensures that all blobs referenced in the block are
 	// securely stored before it returns without an error.
 	IsDataAvailable(
@@ -78,12 +81,34 @@ type BeaconState[
 
 // BlockStore is the interface for block storage.
 type BlockStore[BeaconBlockT any] interface {
+	// Get retrieves the block at the given slot.
+	Get(slot math.Slot) (BeaconBlockT, error)
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given state root.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	// GetSlotByExecutionNumber retrieves the slot by a given execution number.
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+	// GetSlotByExecutionHash retrieves the slot by a given execution block
+	// hash.
+	GetSlotByExecutionHash(
+		executionHash common.ExecutionHash,
+	) (math.Slot, error)
+	// GetSlotsByParentRoot retrieves the slots of the blocks with the given
+	// parent block root.
+	GetSlotsByParentRoot(parentRoot common.Root) ([]math.Slot, error)
+	// Walk calls walkFn for each block in the [start, end) slot range in
+	// ascending order of slots, until walkFn returns true or an error.
+	Walk(
+		start, end math.Slot,
+		walkFn func(slot math.Slot, blk BeaconBlockT) (bool, error),
+	) error
+	// WalkReverse calls walkFn for each block in the [start, end) slot range
+	// in descending order of slots, until walkFn returns true or an error.
+	WalkReverse(
+		start, end math.Slot,
+		walkFn func(slot math.Slot, blk BeaconBlockT) (bool, error),
+	) error
 }
 
 // DepositStore defines the interface for deposit storage.
@@ -103,8 +128,20 @@ type Node[ContextT any] interface {
 	CreateQueryContext(height int64, prove bool) (ContextT, error)
 }
 
+// StateArchive is the interface for the archive of historical beacon states.
+type StateArchive[BeaconStateT any] interface {
+	// Enabled returns whether the node is running in archive mode.
+	Enabled() bool
+	// StateAtSlot returns the beacon state at the end of the given slot.
+	StateAtSlot(ctx context.Context, slot math.Slot) (BeaconStateT, error)
+}
+
 type StateProcessor[BeaconStateT any] interface {
 	ProcessSlots(BeaconStateT, math.Slot) (transition.ValidatorUpdates, error)
+	// AttestationDeltas returns the balance changes of every validator for
+	// its participation in the epoch of the given state, which must be at
+	// the last slot of the epoch.
+	AttestationDeltas(BeaconStateT) ([]transition.AttestationDelta, error)
 }
 
 // StorageBackend is the interface for the storage backend.
@@ -121,6 +158,8 @@ type StorageBackend[
 // credentials. WithdrawalCredentialsT is a type parameter that must implement
 // the WithdrawalCredentials interface.
 type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
@@ -132,6 +171,32 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	IsPartiallyWithdrawable(amount1 math.Gwei, amount2 math.Gwei) bool
 }
 
+// VoluntaryExit represents an interface for a signed voluntary exit.
+type VoluntaryExit[VoluntaryExitT any] interface {
+	// New creates a new signed voluntary exit.
+	New(
+		epoch math.Epoch,
+		index math.ValidatorIndex,
+		signature crypto.BLSSignature,
+	) VoluntaryExitT
+	// GetEpoch returns the epoch from which the exit is valid.
+	GetEpoch() math.Epoch
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetSignature returns the signature of the exiting validator.
+	GetSignature() crypto.BLSSignature
+}
+
+// VoluntaryExitPool is the interface for the pool of voluntary exits awaiting
+// inclusion in a block.
+type VoluntaryExitPool[BeaconStateT, VoluntaryExitT any] interface {
+	// Add verifies the voluntary exit against the given state and adds it to
+	// the pool.
+	Add(st BeaconStateT, exit VoluntaryExitT) error
+	// Get returns the voluntary exits in the pool.
+	Get() []VoluntaryExitT
+}
+
 // Withdrawal represents an interface for a withdrawal.
 type Withdrawal[T any] interface {
 	New(
@@ -22,6 +22,7 @@ package echo
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
@@ -42,11 +43,35 @@ func responseMiddleware(
 ) echo.HandlerFunc {
 	return func(c Context) error {
 		data, err := handler.Handler(c)
+		if err == nil {
+			switch res := data.(type) {
+			case types.Stream:
+				return streamResponse(c, res)
+			case *types.VersionedResponse:
+				return versionedResponse(c, res)
+			}
+		}
 		code, response := responseFromError(data, err)
 		return c.JSON(code, response)
 	}
 }
 
+// versionedResponse writes a fork versioned response as SSZ if the client
+// accepts it and as JSON otherwise.
+func versionedResponse(c Context, res *types.VersionedResponse) error {
+	c.Response().Header().Set(types.HeaderConsensusVersion, res.Version)
+	accept := c.Request().Header.Get(echo.HeaderAccept)
+	if res.SSZ == nil || !strings.Contains(accept, types.ContentTypeSSZ) {
+		return c.JSON(http.StatusOK, res.JSON)
+	}
+	bz, err := res.SSZ.MarshalSSZ()
+	if err != nil {
+		code, response := responseFromError(nil, err)
+		return c.JSON(code, response)
+	}
+	return c.Blob(http.StatusOK, types.ContentTypeSSZ, bz)
+}
+
 // responseFromErr converts an error to an HTTP status code and response. If
 // the error is nil, the response is returned as is.
 func responseFromError(data any, err error) (int, any) {
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package echo
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/labstack/echo/v4"
+)
+
+// eventWriter writes server-sent events to an echo response.
+type eventWriter struct {
+	res *echo.Response
+}
+
+// WriteEvent writes an event with the given name and data to the response.
+func (w *eventWriter) WriteEvent(event string, data []byte) error {
+	if _, err := fmt.Fprintf(
+		w.res, "event: %s\ndata: %s\n\n", event, data,
+	); err != nil {
+		return err
+	}
+	w.res.Flush()
+	return nil
+}
+
+// WriteComment writes a comment line to the response.
+func (w *eventWriter) WriteComment(comment string) error {
+	if _, err := fmt.Fprintf(w.res, ": %s\n\n", comment); err != nil {
+		return err
+	}
+	w.res.Flush()
+	return nil
+}
+
+// streamResponse writes the SSE headers and hands the response over to the
+// stream until the client disconnects or the stream ends.
+func streamResponse(c Context, stream types.Stream) error {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.WriteHeader(http.StatusOK)
+	res.Flush()
+	return stream.Stream(c.Request().Context(), &eventWriter{res: res})
+}
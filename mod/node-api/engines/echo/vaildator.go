@@ -66,6 +66,7 @@ func ConstructValidator() *validator.Validate {
 		"validator_id": ValidateValidatorID,
 		"epoch":        ValidateUint64,
 		"slot":         ValidateUint64,
+		"limit":        ValidateUint64,
 	}
 	validate := validator.New()
 	for tag, fn := range validators {
@@ -27,17 +27,43 @@ import (
 )
 
 // Backend is the interface for backend of the beacon API.
-type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
+type Backend[
+	BeaconBlockT, BlockHeaderT, BlobSidecarsT, ForkT, ValidatorT any,
+] interface {
 	GenesisBackend
-	BlockBackend[BlockHeaderT]
+	BlobBackend[BlobSidecarsT]
+	BlockBackend[BeaconBlockT, BlockHeaderT]
 	RandaoBackend
 	StateBackend[ForkT]
 	ValidatorBackend[ValidatorT]
 	HistoricalBackend[ForkT]
+	PoolBackend
+	RewardsBackend
 	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
+	// GetSlotByExecutionHash retrieves the slot by a given execution block
+	// hash from the store.
+	GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
+	// GetSlotsByParentRoot retrieves the slots of the blocks with the given
+	// parent block root from the store.
+	GetSlotsByParentRoot(parentRoot common.Root) ([]math.Slot, error)
+	// GetBlockSlotsInRange retrieves the slots of up to limit blocks in the
+	// [start, end) slot range from the store, in ascending order of slots or
+	// in descending order if reverse is set.
+	GetBlockSlotsInRange(
+		start, end math.Slot, limit uint64, reverse bool,
+	) ([]math.Slot, error)
+}
+
+type BlobBackend[BlobSidecarsT any] interface {
+	BlobSidecarsAtSlot(slot math.Slot) (BlobSidecarsT, error)
+	// ForkVersionAtSlot returns the version of the fork active at the given
+	// slot.
+	ForkVersionAtSlot(slot math.Slot) uint32
+	// GetHeadSlot returns the slot of the latest committed beacon state.
+	GetHeadSlot() (math.Slot, error)
 }
 
 type GenesisBackend interface {
@@ -49,11 +75,29 @@ type HistoricalBackend[ForkT any] interface {
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
+type PoolBackend interface {
+	// VoluntaryExits returns the voluntary exits in the operation pool.
+	VoluntaryExits() []*types.SignedVoluntaryExitData
+	// SubmitVoluntaryExit verifies the voluntary exit against the head state
+	// and adds it to the operation pool.
+	SubmitVoluntaryExit(exit *types.SignedVoluntaryExitData) error
+}
+
+type RewardsBackend interface {
+	// AttestationRewardsAtEpoch returns the attestation rewards of the given
+	// validators, or of every validator if none is given, for the given
+	// epoch.
+	AttestationRewardsAtEpoch(
+		epoch math.Epoch, ids []string,
+	) (*types.AttestationRewardsData, error)
+}
+
 type RandaoBackend interface {
 	RandaoAtEpoch(slot math.Slot, epoch math.Epoch) (common.Bytes32, error)
 }
 
-type BlockBackend[BeaconBlockHeaderT any] interface {
+type BlockBackend[BeaconBlockT, BeaconBlockHeaderT any] interface {
+	BlockAtSlot(slot math.Slot) (BeaconBlockT, error)
 	BlockRootAtSlot(slot math.Slot) (common.Root, error)
 	BlockRewardsAtSlot(slot math.Slot) (*types.BlockRewardsData, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
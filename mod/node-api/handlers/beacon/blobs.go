@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon
+
+import (
+	"slices"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GetBlobSidecars returns the blob sidecars of the block for the given block
+// ID, optionally filtered by the requested indices. The sidecars are served as
+// SSZ if the client accepts it, otherwise as JSON.
+func (h *Handler[
+	_, BeaconBlockHeaderT, BlobSidecarT, _, ContextT, _, _,
+]) GetBlobSidecars(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlobSidecarsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]uint64, len(req.Indices))
+	for i, index := range req.Indices {
+		var u64 math.U64
+		if u64, err = utils.U64FromString(index); err != nil {
+			return nil, types.ErrInvalidRequest
+		}
+		indices[i] = u64.Unwrap()
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	if slot == utils.Head {
+		if slot, err = h.backend.GetHeadSlot(); err != nil {
+			return nil, err
+		}
+	}
+	blobSidecars, err := h.backend.BlobSidecarsAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make(beacontypes.BlobSidecarList[BlobSidecarT], 0)
+	data := make([]*beacontypes.BlobSidecarData[BeaconBlockHeaderT], 0)
+	for _, sidecar := range blobSidecars.GetSidecars() {
+		if len(indices) > 0 && !slices.Contains(indices, sidecar.GetIndex()) {
+			continue
+		}
+		sidecars = append(sidecars, sidecar)
+		data = append(data, &beacontypes.BlobSidecarData[BeaconBlockHeaderT]{
+			Index:                       sidecar.GetIndex(),
+			Blob:                        sidecar.GetBlob(),
+			KzgCommitment:               sidecar.GetKzgCommitment(),
+			KzgProof:                    sidecar.GetKzgProof(),
+			BlockHeader:                 sidecar.GetBeaconBlockHeader(),
+			KzgCommitmentInclusionProof: sidecar.GetInclusionProof(),
+		})
+	}
+	return &types.VersionedResponse{
+		Version: version.Name(h.backend.ForkVersionAtSlot(slot)),
+		JSON:    &beacontypes.ValidatorResponse{Data: data},
+		SSZ:     sidecars,
+	}, nil
+}
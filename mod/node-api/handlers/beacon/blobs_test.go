@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package beacon_test
+
+import (
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBlobSidecars(t *testing.T) {
+	backend := newTestBackend()
+	root := backend.addBlock(1, version.Deneb, common.Root{})
+	backend.addBlock(4, version.DenebPlus, root)
+	for _, slot := range []uint64{1, 4} {
+		header := backend.headers[math.Slot(slot)]
+		backend.sidecars[math.Slot(slot)] = testSidecars{
+			{index: 0, header: header},
+			{index: 1, header: header},
+			{index: 2, header: header},
+		}
+	}
+	h := newTestHandler(backend)
+
+	cases := []struct {
+		name    string
+		id      string
+		indices []string
+		slot    math.Slot
+		served  []uint64
+		version string
+	}{
+		{
+			name:    "head",
+			id:      "head",
+			slot:    4,
+			served:  []uint64{0, 1, 2},
+			version: "deneb_plus",
+		},
+		{
+			name:    "slot with indices",
+			id:      "1",
+			indices: []string{"2", "0"},
+			slot:    1,
+			served:  []uint64{0, 2},
+			version: "deneb",
+		},
+		{
+			name:    "block root",
+			id:      root.Hex(),
+			indices: []string{"1"},
+			slot:    1,
+			served:  []uint64{1},
+			version: "deneb",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := h.GetBlobSidecars(newTestContext(func(req any) {
+				r, ok := req.(*beacontypes.GetBlobSidecarsRequest)
+				require.True(t, ok)
+				r.BlockID = tc.id
+				r.Indices = tc.indices
+			}))
+			require.NoError(t, err)
+			versioned, ok := res.(*types.VersionedResponse)
+			require.True(t, ok)
+			require.Equal(t, tc.version, versioned.Version)
+
+			sidecars, ok := versioned.SSZ.(beacontypes.BlobSidecarList[*testSidecar])
+			require.True(t, ok)
+			require.Len(t, sidecars, len(tc.served))
+			jsonRes, ok := versioned.JSON.(*beacontypes.ValidatorResponse)
+			require.True(t, ok)
+			data, ok := jsonRes.Data.([]*beacontypes.BlobSidecarData[*testHeader])
+			require.True(t, ok)
+			require.Len(t, data, len(tc.served))
+			for i, index := range tc.served {
+				require.Equal(t, index, sidecars[i].GetIndex())
+				require.Equal(t, index, data[i].Index)
+				require.Equal(t, tc.slot, data[i].BlockHeader.slot)
+			}
+		})
+	}
+}
+
+func TestGetBlobSidecarsInvalidIndex(t *testing.T) {
+	backend := newTestBackend()
+	backend.addBlock(1, version.Deneb, common.Root{})
+	h := newTestHandler(backend)
+
+	_, err := h.GetBlobSidecars(newTestContext(func(req any) {
+		r, ok := req.(*beacontypes.GetBlobSidecarsRequest)
+		require.True(t, ok)
+		r.BlockID = "1"
+		r.Indices = []string{"x"}
+	}))
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
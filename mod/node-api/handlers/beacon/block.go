@@ -22,10 +22,72 @@ package beacon
 
 import (
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
+// GetBlock returns the full beacon block for the given block ID. The block is
+// served as SSZ if the client accepts it, otherwise as JSON. Beacon blocks are
+// not signed by their proposer, the block is agreed upon by CometBFT, so the
+// bare block is served rather than a signed beacon block.
+func (h *Handler[BeaconBlockT, _, _, _, ContextT, _, _]) GetBlock(
+	c ContextT,
+) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlocksRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := h.backend.BlockAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	consensusVersion := version.Name(blk.Version())
+	return &types.VersionedResponse{
+		Version: consensusVersion,
+		JSON: &beacontypes.BlockResponse{
+			Version: consensusVersion,
+			ValidatorResponse: beacontypes.ValidatorResponse{
+				ExecutionOptimistic: false, // stubbed
+				Finalized:           false, // stubbed
+				Data:                blk,
+			},
+		},
+		SSZ: blk,
+	}, nil
+}
+
+// GetBlockRoot returns the hash tree root of the beacon block for the given
+// block ID.
+func (h *Handler[_, _, _, _, ContextT, _, _]) GetBlockRoot(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[beacontypes.GetBlockRootRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(req.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	root, err := h.backend.BlockRootAtSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	return &beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                beacontypes.RootData{Root: root},
+	}, nil
+}
+
+func (h *Handler[_, _, _, _, ContextT, _, _]) GetBlockRewards(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockRewardsRequest](
 		c, h.Logger(),
 	)
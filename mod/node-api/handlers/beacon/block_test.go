@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package beacon_test
+
+import (
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func blockID(id string) func(req any) {
+	return func(req any) {
+		switch r := req.(type) {
+		case *beacontypes.GetBlocksRequest:
+			r.BlockID = id
+		case *beacontypes.GetBlockRootRequest:
+			r.BlockID = id
+		case *beacontypes.GetBlockHeaderRequest:
+			r.BlockID = id
+		case *beacontypes.GetBlobSidecarsRequest:
+			r.BlockID = id
+		}
+	}
+}
+
+func TestGetBlock(t *testing.T) {
+	backend := newTestBackend()
+	root := backend.addBlock(0, version.Deneb, common.Root{})
+	backend.addBlock(5, version.DenebPlus, root)
+	h := newTestHandler(backend)
+
+	cases := []struct {
+		name    string
+		id      string
+		slot    uint64
+		version string
+	}{
+		{name: "head", id: "head", slot: 0, version: "deneb"},
+		{name: "slot", id: "5", slot: 5, version: "deneb_plus"},
+		{
+			name:    "block root",
+			id:      common.Root{5, 0xbb}.Hex(),
+			slot:    5,
+			version: "deneb_plus",
+		},
+		{
+			name:    "execution hash",
+			id:      common.Root{5, 0xee}.Hex(),
+			slot:    5,
+			version: "deneb_plus",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := h.GetBlock(newTestContext(blockID(tc.id)))
+			require.NoError(t, err)
+			versioned, ok := res.(*types.VersionedResponse)
+			require.True(t, ok)
+			require.Equal(t, tc.version, versioned.Version)
+
+			// The bare block is served, both as SSZ and as JSON.
+			blk, ok := versioned.SSZ.(*testBlock)
+			require.True(t, ok)
+			require.Equal(t, tc.slot, blk.slot.Unwrap())
+			jsonRes, ok := versioned.JSON.(*beacontypes.BlockResponse)
+			require.True(t, ok)
+			require.Equal(t, tc.version, jsonRes.Version)
+			require.Same(t, blk, jsonRes.Data)
+		})
+	}
+}
+
+func TestGetBlockUnknown(t *testing.T) {
+	backend := newTestBackend()
+	backend.addBlock(0, version.Deneb, common.Root{})
+	h := newTestHandler(backend)
+
+	_, err := h.GetBlock(newTestContext(blockID("7")))
+	require.ErrorIs(t, err, errNotFound)
+	_, err = h.GetBlock(newTestContext(blockID(common.Root{9}.Hex())))
+	require.ErrorIs(t, err, errNotFound)
+}
+
+func TestGetBlockRoot(t *testing.T) {
+	backend := newTestBackend()
+	backend.addBlock(0, version.Deneb, common.Root{})
+	root := backend.addBlock(3, version.Deneb, common.Root{})
+	h := newTestHandler(backend)
+
+	for _, id := range []string{"3", common.Root{3, 0xee}.Hex()} {
+		res, err := h.GetBlockRoot(newTestContext(blockID(id)))
+		require.NoError(t, err)
+		wrapped, ok := res.(*beacontypes.ValidatorResponse)
+		require.True(t, ok)
+		require.Equal(t, beacontypes.RootData{Root: root}, wrapped.Data)
+	}
+}
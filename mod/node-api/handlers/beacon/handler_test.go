@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package beacon_test
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon"
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+var (
+	// errNotFound is returned by the test backend for unknown objects.
+	errNotFound = errors.New("not found")
+	// errAlreadyExiting is returned by the test backend for a second
+	// voluntary exit of a validator.
+	errAlreadyExiting = errors.New("validator already exiting")
+)
+
+type testContext struct {
+	bind func(req any)
+}
+
+// newTestContext returns a context whose Bind populates the request with
+// the given function.
+func newTestContext(bind func(req any)) *testContext {
+	return &testContext{bind: bind}
+}
+
+func (c *testContext) Bind(req any) error {
+	if c.bind != nil {
+		c.bind(req)
+	}
+	return nil
+}
+
+func (c *testContext) Validate(any) error { return nil }
+
+type testBlock struct {
+	slot    math.Slot
+	version uint32
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return []byte{byte(b.slot)}, nil
+}
+
+func (b *testBlock) Version() uint32 { return b.version }
+
+type testHeader struct {
+	slot       math.Slot
+	parentRoot common.Root
+	bodyRoot   common.Root
+}
+
+func (h *testHeader) GetBodyRoot() common.Root { return h.bodyRoot }
+
+type testSidecar struct {
+	index  uint64
+	header *testHeader
+}
+
+func (s *testSidecar) MarshalSSZ() ([]byte, error) {
+	return []byte{byte(s.index)}, nil
+}
+
+func (s *testSidecar) GetIndex() uint64 { return s.index }
+
+func (s *testSidecar) GetBlob() eip4844.Blob { return eip4844.Blob{} }
+
+func (s *testSidecar) GetKzgCommitment() eip4844.KZGCommitment {
+	return eip4844.KZGCommitment{}
+}
+
+func (s *testSidecar) GetKzgProof() eip4844.KZGProof {
+	return eip4844.KZGProof{}
+}
+
+func (s *testSidecar) GetBeaconBlockHeader() *testHeader { return s.header }
+
+func (s *testSidecar) GetInclusionProof() []common.Root { return nil }
+
+type testSidecars []*testSidecar
+
+func (s testSidecars) GetSidecars() []*testSidecar { return s }
+
+// testBackend serves the objects of a chain of test blocks. Methods not
+// needed by the tests are left to the embedded nil backend.
+type testBackend struct {
+	beacon.Backend[
+		*testBlock, *testHeader, testSidecars, any, any,
+	]
+	blocks   map[math.Slot]*testBlock
+	headers  map[math.Slot]*testHeader
+	roots    map[common.Root]math.Slot
+	hashes   map[common.ExecutionHash]math.Slot
+	sidecars map[math.Slot]testSidecars
+	exits    []*beacontypes.SignedVoluntaryExitData
+	rewards  map[math.Epoch]*beacontypes.AttestationRewardsData
+}
+
+func newTestBackend() *testBackend {
+	return &testBackend{
+		blocks:   make(map[math.Slot]*testBlock),
+		headers:  make(map[math.Slot]*testHeader),
+		roots:    make(map[common.Root]math.Slot),
+		hashes:   make(map[common.ExecutionHash]math.Slot),
+		sidecars: make(map[math.Slot]testSidecars),
+		rewards:  make(map[math.Epoch]*beacontypes.AttestationRewardsData),
+	}
+}
+
+// addBlock adds a block at the given slot, with the given parent root, and
+// returns its root.
+func (b *testBackend) addBlock(
+	slot math.Slot, version uint32, parentRoot common.Root,
+) common.Root {
+	root := common.Root{byte(slot), 0xbb}
+	b.blocks[slot] = &testBlock{slot: slot, version: version}
+	b.headers[slot] = &testHeader{
+		slot: slot, parentRoot: parentRoot, bodyRoot: common.Root{byte(slot)},
+	}
+	b.roots[root] = slot
+	b.hashes[common.ExecutionHash{byte(slot), 0xee}] = slot
+	return root
+}
+
+func (b *testBackend) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
+	slot, ok := b.roots[root]
+	if !ok {
+		return 0, errNotFound
+	}
+	return slot, nil
+}
+
+func (b *testBackend) GetSlotByExecutionHash(
+	hash common.ExecutionHash,
+) (math.Slot, error) {
+	slot, ok := b.hashes[hash]
+	if !ok {
+		return 0, errNotFound
+	}
+	return slot, nil
+}
+
+func (b *testBackend) GetSlotsByParentRoot(
+	parentRoot common.Root,
+) ([]math.Slot, error) {
+	var slots []math.Slot
+	for slot, header := range b.headers {
+		if header.parentRoot == parentRoot {
+			slots = append(slots, slot)
+		}
+	}
+	slices.Sort(slots)
+	return slots, nil
+}
+
+func (b *testBackend) GetBlockSlotsInRange(
+	start, end math.Slot, limit uint64, reverse bool,
+) ([]math.Slot, error) {
+	slots := slices.Sorted(maps.Keys(b.blocks))
+	slots = slices.DeleteFunc(slots, func(slot math.Slot) bool {
+		return slot < start || slot >= end
+	})
+	if reverse {
+		slices.Reverse(slots)
+	}
+	if uint64(len(slots)) > limit {
+		slots = slots[:limit]
+	}
+	return slots, nil
+}
+
+func (b *testBackend) BlockAtSlot(slot math.Slot) (*testBlock, error) {
+	blk, ok := b.blocks[slot]
+	if !ok {
+		return nil, errNotFound
+	}
+	return blk, nil
+}
+
+func (b *testBackend) BlockRootAtSlot(slot math.Slot) (common.Root, error) {
+	for root, s := range b.roots {
+		if s == slot {
+			return root, nil
+		}
+	}
+	return common.Root{}, errNotFound
+}
+
+func (b *testBackend) BlockHeaderAtSlot(slot math.Slot) (*testHeader, error) {
+	header, ok := b.headers[slot]
+	if !ok {
+		return nil, errNotFound
+	}
+	return header, nil
+}
+
+func (b *testBackend) BlobSidecarsAtSlot(
+	slot math.Slot,
+) (testSidecars, error) {
+	return b.sidecars[slot], nil
+}
+
+func (b *testBackend) ForkVersionAtSlot(slot math.Slot) uint32 {
+	if blk, ok := b.blocks[slot]; ok {
+		return blk.version
+	}
+	return version.Deneb
+}
+
+func (b *testBackend) GetHeadSlot() (math.Slot, error) {
+	return slices.Max(slices.Collect(maps.Keys(b.blocks))), nil
+}
+
+func (b *testBackend) VoluntaryExits() []*beacontypes.SignedVoluntaryExitData {
+	return b.exits
+}
+
+// SubmitVoluntaryExit adds the exit to the pool, rejecting a second exit of
+// the same validator.
+func (b *testBackend) SubmitVoluntaryExit(
+	exit *beacontypes.SignedVoluntaryExitData,
+) error {
+	for _, e := range b.exits {
+		if e.Message.ValidatorIndex == exit.Message.ValidatorIndex {
+			return errAlreadyExiting
+		}
+	}
+	b.exits = append(b.exits, exit)
+	return nil
+}
+
+// AttestationRewardsAtEpoch returns the rewards of the given epoch, filtered
+// by validator index.
+func (b *testBackend) AttestationRewardsAtEpoch(
+	epoch math.Epoch, ids []string,
+) (*beacontypes.AttestationRewardsData, error) {
+	rewards, ok := b.rewards[epoch]
+	if !ok {
+		return nil, errNotFound
+	}
+	if len(ids) == 0 {
+		return rewards, nil
+	}
+	filtered := &beacontypes.AttestationRewardsData{
+		IdealRewards: rewards.IdealRewards,
+	}
+	for _, reward := range rewards.TotalRewards {
+		if slices.Contains(
+			ids, strconv.FormatUint(reward.ValidatorIndex, 10),
+		) {
+			filtered.TotalRewards = append(filtered.TotalRewards, reward)
+		}
+	}
+	return filtered, nil
+}
+
+// newTestHandler returns a beacon API handler serving the given backend.
+func newTestHandler(b *testBackend) *beacon.Handler[
+	*testBlock, *testHeader, *testSidecar, testSidecars, *testContext, any,
+	any,
+] {
+	h := beacon.NewHandler[
+		*testBlock, *testHeader, *testSidecar, testSidecars, *testContext,
+	](beacon.Backend[*testBlock, *testHeader, testSidecars, any, any](b))
+	h.SetLogger(noop.NewLogger[any]())
+	return h
+}
@@ -21,13 +21,20 @@
 package beacon
 
 import (
+	"slices"
+
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// GetBlockHeaders returns the block headers matching the given slot and
+// parent root, or the head block header if neither is given. Blocks with a
+// given parent root are looked up through the parent root index of the
+// block store, and a limit pages through the blocks of the store.
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	_, BeaconBlockHeaderT, _, _, ContextT, _, _,
 ]) GetBlockHeaders(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeadersRequest](
 		c, h.Logger(),
@@ -35,30 +42,108 @@ func (h *Handler[
 	if err != nil {
 		return nil, err
 	}
-	slot, err := utils.U64FromString(req.Slot)
-	if err != nil {
-		return nil, err
-	}
-	header, err := h.backend.BlockHeaderAtSlot(slot)
+	slots, err := h.blockHeaderSlots(req)
 	if err != nil {
 		return nil, err
 	}
-	return beacontypes.ValidatorResponse{
-		ExecutionOptimistic: false, // stubbed
-		Finalized:           false, // stubbed
-		Data: &beacontypes.BlockHeaderResponse[BeaconBlockHeaderT]{
+
+	data := make(
+		[]*beacontypes.BlockHeaderResponse[BeaconBlockHeaderT], 0, len(slots),
+	)
+	for _, slot := range slots {
+		header, err := h.backend.BlockHeaderAtSlot(slot)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, &beacontypes.BlockHeaderResponse[BeaconBlockHeaderT]{
 			Root:      header.GetBodyRoot(),
 			Canonical: true,
 			Header: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
-				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Message: header,
 			},
-		},
+		})
+	}
+	return beacontypes.ValidatorResponse{
+		ExecutionOptimistic: false, // stubbed
+		Finalized:           false, // stubbed
+		Data:                data,
 	}, nil
 }
 
+// blockHeaderSlots returns the slots of the block headers matching the
+// given request. Without a limit, this is the given slot, or the head if no
+// slot is given. With a limit, the block store is paged through instead,
+// forwards from the given slot or backwards from the head if no slot is
+// given, skipping missed slots.
+func (h *Handler[
+	_, _, _, _, _, _, _,
+]) blockHeaderSlots(
+	req beacontypes.GetBlockHeadersRequest,
+) ([]math.Slot, error) {
+	var (
+		slot  math.Slot
+		limit math.U64
+		err   error
+	)
+	if req.Slot != "" {
+		if slot, err = utils.U64FromString(req.Slot); err != nil {
+			return nil, err
+		}
+	}
+	if req.Limit != "" {
+		if limit, err = utils.U64FromString(req.Limit); err != nil {
+			return nil, err
+		}
+	}
+	if req.ParentRoot != "" {
+		return h.blockHeaderSlotsByParentRoot(req, slot, limit)
+	}
+	if limit == 0 {
+		return []math.Slot{slot}, nil
+	}
+
+	head, err := h.backend.GetHeadSlot()
+	if err != nil {
+		return nil, err
+	}
+	if req.Slot != "" {
+		return h.backend.GetBlockSlotsInRange(slot, head+1, limit.Unwrap(), false)
+	}
+	return h.backend.GetBlockSlotsInRange(0, head+1, limit.Unwrap(), true)
+}
+
+// blockHeaderSlotsByParentRoot returns the slots of the blocks with the
+// parent root of the given request, looked up through the parent root index
+// of the block store. If a slot is given, only the block at that slot is
+// returned, and if a limit is given, at most that many blocks are returned.
+func (h *Handler[
+	_, _, _, _, _, _, _,
+]) blockHeaderSlotsByParentRoot(
+	req beacontypes.GetBlockHeadersRequest,
+	slot math.Slot,
+	limit math.U64,
+) ([]math.Slot, error) {
+	parentRoot, err := common.NewRootFromHex(req.ParentRoot)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := h.backend.GetSlotsByParentRoot(parentRoot)
+	if err != nil {
+		return nil, err
+	}
+	if req.Slot != "" {
+		slots = slices.DeleteFunc(slots, func(s math.Slot) bool {
+			return s != slot
+		})
+	}
+	if limit > 0 && uint64(len(slots)) > limit.Unwrap() {
+		slots = slots[:limit]
+	}
+	return slots, nil
+}
+
 func (h *Handler[
-	BeaconBlockHeaderT, ContextT, _, _,
+	_, BeaconBlockHeaderT, _, _, ContextT, _, _,
 ]) GetBlockHeaderByID(c ContextT) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetBlockHeaderRequest](
 		c, h.Logger(),
@@ -81,8 +166,7 @@ func (h *Handler[
 			Root:      header.GetBodyRoot(),
 			Canonical: true,
 			Header: &beacontypes.BlockHeader[BeaconBlockHeaderT]{
-				Message:   header,
-				Signature: bytes.B48{}, // TODO: implement
+				Message: header,
 			},
 		},
 	}, nil
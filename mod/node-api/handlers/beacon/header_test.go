@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package beacon_test
+
+import (
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+// headerSlots returns the slots of the headers in the given response.
+func headerSlots(t *testing.T, res any) []math.Slot {
+	t.Helper()
+	validatorRes, ok := res.(beacontypes.ValidatorResponse)
+	require.True(t, ok)
+	data, ok := validatorRes.Data.([]*beacontypes.BlockHeaderResponse[*testHeader])
+	require.True(t, ok)
+	slots := make([]math.Slot, 0, len(data))
+	for _, header := range data {
+		slots = append(slots, header.Header.Message.slot)
+	}
+	return slots
+}
+
+func TestGetBlockHeaders(t *testing.T) {
+	// Slots 0 <- 1 <- {2, 3}, slot 4 is missed, and 5 builds on 3.
+	backend := newTestBackend()
+	genesis := backend.addBlock(0, version.Deneb, common.Root{})
+	root1 := backend.addBlock(1, version.Deneb, genesis)
+	backend.addBlock(2, version.Deneb, root1)
+	root3 := backend.addBlock(3, version.Deneb, root1)
+	backend.addBlock(5, version.Deneb, root3)
+	h := newTestHandler(backend)
+
+	cases := []struct {
+		name       string
+		slot       string
+		parentRoot string
+		limit      string
+		expected   []math.Slot
+	}{
+		{name: "no params", expected: []math.Slot{0}},
+		{name: "slot", slot: "3", expected: []math.Slot{3}},
+		{
+			name:       "parent root",
+			parentRoot: root1.Hex(),
+			expected:   []math.Slot{2, 3},
+		},
+		{
+			name:       "parent root and slot",
+			parentRoot: root1.Hex(),
+			slot:       "3",
+			expected:   []math.Slot{3},
+		},
+		{
+			name:       "parent root and other slot",
+			parentRoot: root1.Hex(),
+			slot:       "5",
+			expected:   []math.Slot{},
+		},
+		{
+			name:       "parent root and limit",
+			parentRoot: root1.Hex(),
+			limit:      "1",
+			expected:   []math.Slot{2},
+		},
+		{
+			name:       "unknown parent root",
+			parentRoot: common.Root{0xff}.Hex(),
+			expected:   []math.Slot{},
+		},
+		{name: "limit", limit: "3", expected: []math.Slot{5, 3, 2}},
+		{
+			name:     "limit and slot",
+			slot:     "2",
+			limit:    "3",
+			expected: []math.Slot{2, 3, 5},
+		},
+		{
+			name:     "limit past head",
+			slot:     "3",
+			limit:    "10",
+			expected: []math.Slot{3, 5},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := h.GetBlockHeaders(newTestContext(func(req any) {
+				r, ok := req.(*beacontypes.GetBlockHeadersRequest)
+				require.True(t, ok)
+				r.Slot = tc.slot
+				r.ParentRoot = tc.parentRoot
+				r.Limit = tc.limit
+			}))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, headerSlots(t, res))
+		})
+	}
+}
+
+func TestGetBlockHeaderByID(t *testing.T) {
+	backend := newTestBackend()
+	root := backend.addBlock(0, version.Deneb, common.Root{})
+	backend.addBlock(5, version.Deneb, root)
+	h := newTestHandler(backend)
+
+	res, err := h.GetBlockHeaderByID(newTestContext(blockID("5")))
+	require.NoError(t, err)
+	validatorRes, ok := res.(beacontypes.ValidatorResponse)
+	require.True(t, ok)
+	data, ok := validatorRes.Data.(*beacontypes.BlockHeaderResponse[*testHeader])
+	require.True(t, ok)
+	require.Equal(t, common.Root{5}, data.Root)
+	require.Equal(t, math.Slot(5), data.Header.Message.slot)
+	require.Equal(t, root, data.Header.Message.parentRoot)
+
+	// Blocks are not signed, the signature is served as null.
+	require.Nil(t, data.Header.Signature)
+
+	_, err = h.GetBlockHeaderByID(newTestContext(blockID("4")))
+	require.ErrorIs(t, err, errNotFound)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacon_test
+
+import (
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolVoluntaryExits(t *testing.T) {
+	backend := newTestBackend()
+	h := newTestHandler(backend)
+
+	submit := func(epoch, index uint64) error {
+		_, err := h.PostPoolVoluntaryExits(newTestContext(func(req any) {
+			r, ok := req.(*beacontypes.PostPoolVoluntaryExitsRequest)
+			require.True(t, ok)
+			r.Message.Epoch = epoch
+			r.Message.ValidatorIndex = index
+			r.Signature = crypto.BLSSignature{byte(index)}
+		}))
+		return err
+	}
+	require.NoError(t, submit(2, 1))
+	require.NoError(t, submit(3, 0))
+	require.ErrorIs(t, submit(4, 1), errAlreadyExiting)
+
+	res, err := h.GetPoolVoluntaryExits(newTestContext(nil))
+	require.NoError(t, err)
+	wrapped, ok := res.(types.DataResponse)
+	require.True(t, ok)
+	exits, ok := wrapped.Data.([]*beacontypes.SignedVoluntaryExitData)
+	require.True(t, ok)
+	require.Len(t, exits, 2)
+	require.Equal(t, uint64(2), exits[0].Message.Epoch)
+	require.Equal(t, uint64(1), exits[0].Message.ValidatorIndex)
+	require.Equal(t, crypto.BLSSignature{1}, exits[0].Signature)
+	require.Equal(t, uint64(0), exits[1].Message.ValidatorIndex)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+package beacon_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAttestationRewards(t *testing.T) {
+	backend := newTestBackend()
+	backend.rewards[2] = &beacontypes.AttestationRewardsData{
+		IdealRewards: []*beacontypes.IdealAttestationRewardsData{
+			{EffectiveBalance: 32e9, Source: 100},
+		},
+		TotalRewards: []*beacontypes.TotalAttestationRewardsData{
+			{ValidatorIndex: 0, Source: 90},
+			{ValidatorIndex: 1, Source: -100, Inactivity: -5},
+		},
+	}
+	h := newTestHandler(backend)
+
+	// The body of the request is the list of validator IDs.
+	var req beacontypes.PostAttestationsRewardsRequest
+	require.NoError(t, json.Unmarshal([]byte(`["1"]`), &req))
+	require.Equal(t, []string{"1"}, req.IDs)
+
+	res, err := h.PostAttestationRewards(newTestContext(func(r any) {
+		rewardsReq, ok := r.(*beacontypes.PostAttestationsRewardsRequest)
+		require.True(t, ok)
+		rewardsReq.Epoch = "2"
+		rewardsReq.IDs = req.IDs
+	}))
+	require.NoError(t, err)
+	wrapped, ok := res.(beacontypes.ValidatorResponse)
+	require.True(t, ok)
+	rewards, ok := wrapped.Data.(*beacontypes.AttestationRewardsData)
+	require.True(t, ok)
+	require.Len(t, rewards.IdealRewards, 1)
+	require.Equal(t, []*beacontypes.TotalAttestationRewardsData{
+		{ValidatorIndex: 1, Source: -100, Inactivity: -5},
+	}, rewards.TotalRewards)
+
+	// Negative rewards are encoded as strings like the positive ones.
+	encoded, err := json.Marshal(rewards.TotalRewards[0])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"validator_index": "1",
+		"head": "0",
+		"target": "0",
+		"source": "-100",
+		"inactivity": "-5"
+	}`, string(encoded))
+
+	_, err = h.PostAttestationRewards(newTestContext(func(r any) {
+		rewardsReq, ok := r.(*beacontypes.PostAttestationsRewardsRequest)
+		require.True(t, ok)
+		rewardsReq.Epoch = "3"
+	}))
+	require.ErrorIs(t, err, errNotFound)
+}
+
+func TestPostSyncCommitteeRewards(t *testing.T) {
+	backend := newTestBackend()
+	backend.addBlock(1, version.DenebPlus, common.Root{})
+	h := newTestHandler(backend)
+
+	res, err := h.PostSyncCommitteeRewards(newTestContext(func(r any) {
+		rewardsReq, ok := r.(*beacontypes.PostRewardsSyncCommitteeRequest)
+		require.True(t, ok)
+		rewardsReq.BlockID = "1"
+	}))
+	require.NoError(t, err)
+	wrapped, ok := res.(beacontypes.ValidatorResponse)
+	require.True(t, ok)
+	require.Empty(t, wrapped.Data)
+}
@@ -28,7 +28,7 @@ import (
 )
 
 //nolint:funlen // routes are long
-func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
+func (h *Handler[_, _, _, _, ContextT, _, _]) RegisterRoutes(
 	logger log.Logger[any],
 ) {
 	h.SetLogger(logger)
@@ -125,13 +125,13 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		},
 		{
 			Method:  http.MethodGet,
-			Path:    "eth/v2/beacon/blocks/:block_id",
-			Handler: h.NotImplemented,
+			Path:    "/eth/v2/beacon/blocks/:block_id",
+			Handler: h.GetBlock,
 		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blocks/:block_id/root",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlockRoot,
 		},
 		{
 			Method:  http.MethodGet,
@@ -141,12 +141,12 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/blob_sidecars/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetBlobSidecars,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/sync_committee/:block_id",
-			Handler: h.NotImplemented,
+			Handler: h.PostSyncCommitteeRewards,
 		},
 		{
 			Method:  http.MethodGet,
@@ -156,7 +156,7 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/rewards/attestation/:epoch",
-			Handler: h.NotImplemented,
+			Handler: h.PostAttestationRewards,
 		},
 		{
 			Method:  http.MethodGet,
@@ -221,12 +221,12 @@ func (h *Handler[_, ContextT, _, _]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.GetPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodPost,
 			Path:    "/eth/v1/beacon/pool/voluntary_exits",
-			Handler: h.NotImplemented,
+			Handler: h.PostPoolVoluntaryExits,
 		},
 		{
 			Method:  http.MethodGet,
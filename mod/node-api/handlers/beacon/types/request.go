@@ -20,7 +20,11 @@
 
 package types
 
-import "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+import (
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
 
 type GetGenesisRequest struct{}
 
@@ -83,6 +87,7 @@ type GetRandaoRequest struct {
 type GetBlockHeadersRequest struct {
 	SlotRequest
 	ParentRoot string `query:"parent_root" validate:"hex"`
+	Limit      string `query:"limit"       validate:"limit"`
 }
 
 type GetBlockHeaderRequest struct {
@@ -136,6 +141,11 @@ type PostRewardsSyncCommitteeRequest struct {
 	IDs []string `validate:"dive,validator_id"`
 }
 
+// UnmarshalJSON decodes the request body, which is a list of validator IDs.
+func (r *PostRewardsSyncCommitteeRequest) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.IDs)
+}
+
 type GetDepositTreeSnapshotRequest struct{}
 
 type GetBlockRewardsRequest struct {
@@ -147,6 +157,15 @@ type PostAttestationsRewardsRequest struct {
 	IDs []string `validate:"dive,validator_id"`
 }
 
+// UnmarshalJSON decodes the request body, which is a list of validator IDs.
+func (r *PostAttestationsRewardsRequest) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &r.IDs)
+}
+
+type PostPoolVoluntaryExitsRequest struct {
+	SignedVoluntaryExitData
+}
+
 type GetBlindedBlockRequest struct {
 	types.BlockIDRequest
 }
@@ -21,8 +21,9 @@
 package types
 
 import (
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 )
 
 type ValidatorResponse struct {
@@ -42,9 +43,45 @@ type BlockHeaderResponse[BlockHeaderT any] struct {
 	Header    *BlockHeader[BlockHeaderT] `json:"header"`
 }
 
+// BlockHeader is the JSON representation of a signed block header. Beacon
+// blocks are not signed by their proposer, so there is no signature to
+// serve and the signature is always null.
 type BlockHeader[BlockHeaderT any] struct {
-	Message   BlockHeaderT `json:"message"`
-	Signature bytes.B48    `json:"signature"`
+	Message   BlockHeaderT         `json:"message"`
+	Signature *crypto.BLSSignature `json:"signature"`
+}
+
+// BlobSidecarData is the JSON representation of a blob sidecar. Beacon blocks
+// are not signed by their proposer, so the sidecar carries the bare block
+// header, as in its SSZ encoding.
+//
+//nolint:lll // tags get long
+type BlobSidecarData[BlockHeaderT any] struct {
+	Index                       uint64                `json:"index,string"`
+	Blob                        eip4844.Blob          `json:"blob"`
+	KzgCommitment               eip4844.KZGCommitment `json:"kzg_commitment"`
+	KzgProof                    eip4844.KZGProof      `json:"kzg_proof"`
+	BlockHeader                 BlockHeaderT          `json:"block_header"`
+	KzgCommitmentInclusionProof []common.Root         `json:"kzg_commitment_inclusion_proof"`
+}
+
+// BlobSidecarList is a list of blob sidecars. Since blob sidecars are of fixed
+// size, its SSZ encoding is the concatenation of the encoded sidecars.
+type BlobSidecarList[BlobSidecarT interface {
+	MarshalSSZ() ([]byte, error)
+}] []BlobSidecarT
+
+// MarshalSSZ marshals the blob sidecars into their SSZ encoding.
+func (s BlobSidecarList[_]) MarshalSSZ() ([]byte, error) {
+	buf := make([]byte, 0)
+	for _, sidecar := range s {
+		bz, err := sidecar.MarshalSSZ()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, bz...)
+	}
+	return buf, nil
 }
 
 type GenesisData struct {
@@ -83,3 +120,49 @@ type BlockRewardsData struct {
 	ProposerSlashings uint64 `json:"proposer_slashings,string"`
 	AttesterSlashings uint64 `json:"attester_slashings,string"`
 }
+
+// AttestationRewardsData is the response of the attestation rewards of an
+// epoch.
+type AttestationRewardsData struct {
+	IdealRewards []*IdealAttestationRewardsData `json:"ideal_rewards"`
+	TotalRewards []*TotalAttestationRewardsData `json:"total_rewards"`
+}
+
+// IdealAttestationRewardsData holds the rewards of a validator with the given
+// effective balance that participated in every vote of the epoch.
+type IdealAttestationRewardsData struct {
+	EffectiveBalance uint64 `json:"effective_balance,string"`
+	Head             int64  `json:"head,string"`
+	Target           int64  `json:"target,string"`
+	Source           int64  `json:"source,string"`
+	Inactivity       int64  `json:"inactivity,string"`
+}
+
+// TotalAttestationRewardsData holds the rewards of a validator for its
+// participation in the votes of the epoch. The participation rewards and
+// penalties are reported as source rewards, and head and target rewards are
+// always zero.
+type TotalAttestationRewardsData struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Head           int64  `json:"head,string"`
+	Target         int64  `json:"target,string"`
+	Source         int64  `json:"source,string"`
+	Inactivity     int64  `json:"inactivity,string"`
+}
+
+// SyncCommitteeRewardData holds the sync committee reward of a validator for
+// a block.
+type SyncCommitteeRewardData struct {
+	ValidatorIndex uint64 `json:"validator_index,string"`
+	Reward         int64  `json:"reward,string"`
+}
+
+type VoluntaryExitData struct {
+	Epoch          uint64 `json:"epoch,string"`
+	ValidatorIndex uint64 `json:"validator_index,string"`
+}
+
+type SignedVoluntaryExitData struct {
+	Message   VoluntaryExitData   `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
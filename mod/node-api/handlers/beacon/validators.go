@@ -26,7 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
 )
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidators(
+func (h *Handler[_, _, _, _, ContextT, _, _]) GetStateValidators(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateValidatorsRequest](
@@ -61,7 +61,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidators(
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) PostStateValidators(
+func (h *Handler[_, _, _, _, ContextT, _, _]) PostStateValidators(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.PostStateValidatorsRequest](
@@ -93,7 +93,7 @@ func (h *Handler[_, ContextT, _, _]) PostStateValidators(
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidator(
+func (h *Handler[_, _, _, _, ContextT, _, _]) GetStateValidator(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetStateValidatorRequest](
@@ -116,7 +116,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidator(
 	return validator, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
+func (h *Handler[_, _, _, _, ContextT, _, _]) GetStateValidatorBalances(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.GetValidatorBalancesRequest](
@@ -143,7 +143,7 @@ func (h *Handler[_, ContextT, _, _]) GetStateValidatorBalances(
 	}, nil
 }
 
-func (h *Handler[_, ContextT, _, _]) PostStateValidatorBalances(
+func (h *Handler[_, _, _, _, ContextT, _, _]) PostStateValidatorBalances(
 	c ContextT,
 ) (any, error) {
 	req, err := utils.BindAndValidate[beacontypes.PostValidatorBalancesRequest](
@@ -27,7 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 )
 
-func (h *Handler[ContextT]) RegisterRoutes(
+func (h *Handler[_, _, ContextT]) RegisterRoutes(
 	logger log.Logger[any],
 ) {
 	h.SetLogger(logger)
@@ -35,7 +35,7 @@ func (h *Handler[ContextT]) RegisterRoutes(
 		{
 			Method:  http.MethodGet,
 			Path:    "/eth/v2/debug/beacon/states/:state_id",
-			Handler: h.NotImplemented,
+			Handler: h.GetState,
 		},
 		{
 			Method:  http.MethodGet,
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import "time"
+
+const (
+	// TopicHead is emitted when the head of the chain is updated.
+	TopicHead = "head"
+	// TopicBlock is emitted when a block is imported.
+	TopicBlock = "block"
+	// TopicFinalizedCheckpoint is emitted when a block is finalized. Since
+	// CometBFT provides single slot finality, this is emitted for every
+	// finalized block rather than once per epoch.
+	TopicFinalizedCheckpoint = "finalized_checkpoint"
+	// TopicBlobSidecar is emitted for every blob sidecar that is processed.
+	TopicBlobSidecar = "blob_sidecar"
+	// TopicValidatorSetUpdated is emitted when the validator set updates
+	// are sent to CometBFT.
+	TopicValidatorSetUpdated = "validator_set_updated"
+)
+
+const (
+	// heartbeatInterval is the interval at which heartbeats are sent to
+	// keep idle connections alive.
+	heartbeatInterval = 10 * time.Second
+	// defaultQueueSize is the number of events buffered for a client before
+	// it is considered too slow and disconnected.
+	defaultQueueSize = 256
+)
+
+//nolint:gochecknoglobals // read-only set of topics.
+var supportedTopics = map[string]struct{}{
+	TopicHead:                {},
+	TopicBlock:               {},
+	TopicFinalizedCheckpoint: {},
+	TopicBlobSidecar:         {},
+	TopicValidatorSetUpdated: {},
+}
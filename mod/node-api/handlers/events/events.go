@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+)
+
+// GetEvents opens a server-sent events stream for the requested topics.
+// Topics may be given as repeated query parameters or as a comma separated
+// list.
+func (h *Handler[_, _, _, _, ContextT]) GetEvents(c ContextT) (any, error) {
+	req, err := utils.BindAndValidate[types.GetEventsRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	topics := make(map[string]struct{})
+	for _, param := range req.Topics {
+		for _, topic := range strings.Split(param, ",") {
+			topic = strings.TrimSpace(topic)
+			if _, ok := supportedTopics[topic]; !ok {
+				return nil, errors.Wrapf(
+					apitypes.ErrInvalidRequest, "%s: %s",
+					ErrUnsupportedTopic, topic,
+				)
+			}
+			topics[topic] = struct{}{}
+		}
+	}
+	return h.newStream(topics), nil
+}
@@ -21,19 +21,66 @@
 package events
 
 import (
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
-type Handler[ContextT context.Context] struct {
+// Handler is the handler for the events API.
+type Handler[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconBlockT types.BeaconBlock,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
+	ContextT context.Context,
+] struct {
 	*handlers.BaseHandler[ContextT]
+	// blkFeed is the feed of finalized beacon blocks.
+	blkFeed Feed[*asynctypes.Event[BeaconBlockT]]
+	// sidecarsFeed is the feed of processed blob sidecars.
+	sidecarsFeed Feed[*asynctypes.Event[BlobSidecarsT]]
+	// valUpdatesFeed is the feed of validator set updates.
+	valUpdatesFeed Feed[*asynctypes.Event[transition.ValidatorUpdates]]
+	// slotsPerEpoch is used to derive epochs from slots.
+	slotsPerEpoch uint64
+	// heartbeatInterval is the interval at which heartbeats are sent to
+	// idle clients.
+	heartbeatInterval time.Duration
+	// queueSize is the number of events buffered for a client.
+	queueSize int
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
-	h := &Handler[ContextT]{
+// NewHandler creates a new handler for the events API.
+func NewHandler[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconBlockT types.BeaconBlock,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
+	ContextT context.Context,
+](
+	blkFeed Feed[*asynctypes.Event[BeaconBlockT]],
+	sidecarsFeed Feed[*asynctypes.Event[BlobSidecarsT]],
+	valUpdatesFeed Feed[*asynctypes.Event[transition.ValidatorUpdates]],
+	slotsPerEpoch uint64,
+) *Handler[
+	BeaconBlockHeaderT, BeaconBlockT, BlobSidecarT, BlobSidecarsT, ContextT,
+] {
+	h := &Handler[
+		BeaconBlockHeaderT, BeaconBlockT, BlobSidecarT, BlobSidecarsT, ContextT,
+	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		blkFeed:           blkFeed,
+		sidecarsFeed:      sidecarsFeed,
+		valUpdatesFeed:    valUpdatesFeed,
+		slotsPerEpoch:     slotsPerEpoch,
+		heartbeatInterval: heartbeatInterval,
+		queueSize:         defaultQueueSize,
 	}
 	return h
 }
@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	apicontext "github.com/berachain/beacon-kit/mod/node-api/server/context"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// event is a single server-sent event queued for a client.
+type event struct {
+	topic string
+	data  any
+}
+
+// stream streams the events of the subscribed topics to a single client.
+// Events are read off the feeds by dedicated goroutines and pushed into a
+// bounded queue, so that a slow client never blocks the feeds. If the queue
+// fills up, the client is disconnected.
+type stream[
+	BeaconBlockHeaderT types.BeaconBlockHeader,
+	BeaconBlockT types.BeaconBlock,
+	BlobSidecarT types.BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT types.BlobSidecars[BlobSidecarT],
+	ContextT apicontext.Context,
+] struct {
+	h *Handler[
+		BeaconBlockHeaderT, BeaconBlockT, BlobSidecarT, BlobSidecarsT, ContextT,
+	]
+	// topics is the set of topics the client subscribed to.
+	topics map[string]struct{}
+	// queue buffers the events that are yet to be written to the client.
+	queue chan *event
+}
+
+// newStream creates a new stream for the given topics.
+func (h *Handler[
+	BeaconBlockHeaderT, BeaconBlockT, BlobSidecarT, BlobSidecarsT, ContextT,
+]) newStream(topics map[string]struct{}) apitypes.Stream {
+	return &stream[
+		BeaconBlockHeaderT, BeaconBlockT, BlobSidecarT, BlobSidecarsT, ContextT,
+	]{
+		h:      h,
+		topics: topics,
+		queue:  make(chan *event, h.queueSize),
+	}
+}
+
+// Stream subscribes to the feeds backing the requested topics and writes
+// their events to the client until the client disconnects, the client falls
+// behind or a feed is closed.
+func (s *stream[_, _, _, _, _]) Stream(ctx context.Context, w apitypes.EventWriter) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	if s.subscribed(
+		TopicHead, TopicBlock, TopicFinalizedCheckpoint,
+	) {
+		ch, err := s.h.blkFeed.Subscribe()
+		if err != nil {
+			return err
+		}
+		defer s.h.blkFeed.Unsubscribe(ch)
+		go forward(ctx, cancel, ch, s.onBlock)
+	}
+	if s.subscribed(TopicBlobSidecar) {
+		ch, err := s.h.sidecarsFeed.Subscribe()
+		if err != nil {
+			return err
+		}
+		defer s.h.sidecarsFeed.Unsubscribe(ch)
+		go forward(ctx, cancel, ch, s.onSidecars)
+	}
+	if s.subscribed(TopicValidatorSetUpdated) {
+		ch, err := s.h.valUpdatesFeed.Subscribe()
+		if err != nil {
+			return err
+		}
+		defer s.h.valUpdatesFeed.Unsubscribe(ch)
+		go forward(ctx, cancel, ch, s.onValidatorUpdates)
+	}
+
+	ticker := time.NewTicker(s.h.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := context.Cause(ctx); errors.Is(err, ErrSlowClient) {
+				s.h.Logger().Warn("dropping events client", "reason", err)
+				return err
+			}
+			return nil
+		case <-ticker.C:
+			if err := w.WriteComment("heartbeat"); err != nil {
+				return err
+			}
+		case ev := <-s.queue:
+			data, err := json.Marshal(ev.data)
+			if err != nil {
+				return err
+			}
+			if err = w.WriteEvent(ev.topic, data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// forward reads events off a feed subscription and hands them to the given
+// function until the context is cancelled or the subscription is closed.
+func forward[EventT any](
+	ctx context.Context,
+	cancel context.CancelCauseFunc,
+	ch <-chan EventT,
+	fn func(EventT) error,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				cancel(nil)
+				return
+			}
+			if err := fn(msg); err != nil {
+				cancel(err)
+				return
+			}
+		}
+	}
+}
+
+// onBlock queues the head, block and finalized checkpoint events for a
+// finalized beacon block.
+func (s *stream[
+	_, BeaconBlockT, _, _, _,
+]) onBlock(msg *asynctypes.Event[BeaconBlockT]) error {
+	if !msg.Is(events.BeaconBlockFinalized) || msg.Error() != nil {
+		return nil
+	}
+	var (
+		blk       = msg.Data()
+		slot      = blk.GetSlot().Unwrap()
+		blockRoot = blk.HashTreeRoot()
+		stateRoot = blk.GetStateRoot()
+	)
+	if err := s.push(TopicBlock, &types.BlockEventData{
+		Slot:  slot,
+		Block: blockRoot,
+	}); err != nil {
+		return err
+	}
+	if err := s.push(TopicHead, &types.HeadEventData{
+		Slot:            slot,
+		Block:           blockRoot,
+		State:           stateRoot,
+		EpochTransition: slot%s.h.slotsPerEpoch == 0,
+	}); err != nil {
+		return err
+	}
+	return s.push(TopicFinalizedCheckpoint, &types.FinalizedCheckpointEventData{
+		Block: blockRoot,
+		State: stateRoot,
+		Epoch: slot / s.h.slotsPerEpoch,
+	})
+}
+
+// onSidecars queues an event for every processed blob sidecar.
+func (s *stream[
+	_, _, _, BlobSidecarsT, _,
+]) onSidecars(msg *asynctypes.Event[BlobSidecarsT]) error {
+	if !msg.Is(events.BlobSidecarsProcessed) || msg.Error() != nil {
+		return nil
+	}
+	for _, sidecar := range msg.Data().GetSidecars() {
+		header := sidecar.GetBeaconBlockHeader()
+		commitment := sidecar.GetKzgCommitment()
+		if err := s.push(TopicBlobSidecar, &types.BlobSidecarEventData{
+			BlockRoot:     header.HashTreeRoot(),
+			Index:         sidecar.GetIndex(),
+			Slot:          header.GetSlot().Unwrap(),
+			KzgCommitment: commitment,
+			VersionedHash: commitment.ToVersionedHash(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onValidatorUpdates queues an event for a validator set update.
+func (s *stream[
+	_, _, _, _, _,
+]) onValidatorUpdates(
+	msg *asynctypes.Event[transition.ValidatorUpdates],
+) error {
+	if !msg.Is(events.ValidatorSetUpdated) || msg.Error() != nil {
+		return nil
+	}
+	updates := msg.Data()
+	data := &types.ValidatorSetUpdatedEventData{
+		Validators: make([]*types.ValidatorUpdateData, 0, len(updates)),
+	}
+	for _, update := range updates {
+		data.Validators = append(data.Validators, &types.ValidatorUpdateData{
+			Pubkey:           update.Pubkey,
+			EffectiveBalance: update.EffectiveBalance.Unwrap(),
+		})
+	}
+	return s.push(TopicValidatorSetUpdated, data)
+}
+
+// push queues an event for the client if it subscribed to the topic. It
+// returns ErrSlowClient if the queue is full.
+func (s *stream[_, _, _, _, _]) push(topic string, data any) error {
+	if !s.subscribed(topic) {
+		return nil
+	}
+	select {
+	case s.queue <- &event{topic: topic, data: data}:
+		return nil
+	default:
+		return ErrSlowClient
+	}
+}
+
+// subscribed returns true if the client subscribed to any of the topics.
+func (s *stream[_, _, _, _, _]) subscribed(topics ...string) bool {
+	for _, topic := range topics {
+		if _, ok := s.topics[topic]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/events/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+type testBlock struct{ slot math.Slot }
+
+func (b *testBlock) GetSlot() math.Slot        { return b.slot }
+func (b *testBlock) GetStateRoot() common.Root { return common.Root{0x02} }
+func (b *testBlock) HashTreeRoot() common.Root { return common.Root{0x01} }
+
+type testHeader struct{ slot math.Slot }
+
+func (h *testHeader) GetSlot() math.Slot        { return h.slot }
+func (h *testHeader) HashTreeRoot() common.Root { return common.Root{0x03} }
+
+type testSidecar struct{ index uint64 }
+
+func (s *testSidecar) GetIndex() uint64 { return s.index }
+
+func (s *testSidecar) GetKzgCommitment() eip4844.KZGCommitment {
+	return eip4844.KZGCommitment{0x04}
+}
+
+func (s *testSidecar) GetBeaconBlockHeader() *testHeader {
+	return &testHeader{slot: 3}
+}
+
+type testSidecars []*testSidecar
+
+func (s testSidecars) GetSidecars() []*testSidecar { return s }
+
+// testFeed is a feed backed by a single channel that is handed out to the
+// first subscriber.
+type testFeed[EventT any] struct {
+	ch           chan EventT
+	unsubscribed chan struct{}
+}
+
+func newTestFeed[EventT any]() *testFeed[EventT] {
+	return &testFeed[EventT]{
+		ch:           make(chan EventT, defaultQueueSize),
+		unsubscribed: make(chan struct{}),
+	}
+}
+
+func (f *testFeed[EventT]) Subscribe(
+	...broker.SubscribeOption,
+) (chan EventT, error) {
+	return f.ch, nil
+}
+
+func (f *testFeed[EventT]) Unsubscribe(chan EventT) {
+	close(f.unsubscribed)
+}
+
+type written struct {
+	event string
+	data  string
+}
+
+// testWriter records the events written to it. If block is set, writes
+// wait until it is closed.
+type testWriter struct {
+	mu       sync.Mutex
+	events   []written
+	comments []string
+	block    chan struct{}
+	notify   chan struct{}
+}
+
+func newTestWriter() *testWriter {
+	return &testWriter{notify: make(chan struct{}, defaultQueueSize)}
+}
+
+func (w *testWriter) WriteEvent(event string, data []byte) error {
+	if w.block != nil {
+		<-w.block
+	}
+	w.mu.Lock()
+	w.events = append(w.events, written{event: event, data: string(data)})
+	w.mu.Unlock()
+	w.notify <- struct{}{}
+	return nil
+}
+
+func (w *testWriter) WriteComment(comment string) error {
+	w.mu.Lock()
+	w.comments = append(w.comments, comment)
+	w.mu.Unlock()
+	w.notify <- struct{}{}
+	return nil
+}
+
+func (w *testWriter) wait(t *testing.T, n int) {
+	t.Helper()
+	for range n {
+		select {
+		case <-w.notify:
+		case <-time.After(testTimeout):
+			t.Fatal("timed out waiting for write")
+		}
+	}
+}
+
+func (w *testWriter) written() []written {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]written(nil), w.events...)
+}
+
+type testContext struct {
+	topics []string
+}
+
+func (c *testContext) Bind(req any) error {
+	//nolint:errcheck // only used with the events request.
+	req.(*types.GetEventsRequest).Topics = c.topics
+	return nil
+}
+
+func (c *testContext) Validate(any) error { return nil }
+
+type testHandler = Handler[
+	*testHeader, *testBlock, *testSidecar, testSidecars, *testContext,
+]
+
+type testFeeds struct {
+	blocks     *testFeed[*asynctypes.Event[*testBlock]]
+	sidecars   *testFeed[*asynctypes.Event[testSidecars]]
+	valUpdates *testFeed[*asynctypes.Event[transition.ValidatorUpdates]]
+}
+
+func newTestHandler() (*testHandler, *testFeeds) {
+	feeds := &testFeeds{
+		blocks:     newTestFeed[*asynctypes.Event[*testBlock]](),
+		sidecars:   newTestFeed[*asynctypes.Event[testSidecars]](),
+		valUpdates: newTestFeed[*asynctypes.Event[transition.ValidatorUpdates]](),
+	}
+	h := NewHandler[
+		*testHeader, *testBlock, *testSidecar, testSidecars, *testContext,
+	](feeds.blocks, feeds.sidecars, feeds.valUpdates, 4)
+	h.SetLogger(noop.NewLogger[any]())
+	return h, feeds
+}
+
+// openStream requests a stream for the given topics and runs it in the
+// background. The returned channel yields the result of the stream.
+func openStream(
+	t *testing.T,
+	ctx context.Context,
+	h *testHandler,
+	w apitypes.EventWriter,
+	topics ...string,
+) <-chan error {
+	t.Helper()
+	res, err := h.GetEvents(&testContext{topics: topics})
+	require.NoError(t, err)
+	stream, ok := res.(apitypes.Stream)
+	require.True(t, ok)
+
+	done := make(chan error, 1)
+	go func() { done <- stream.Stream(ctx, w) }()
+	return done
+}
+
+func finalized(slot math.Slot) *asynctypes.Event[*testBlock] {
+	return asynctypes.NewEvent(
+		context.Background(), events.BeaconBlockFinalized,
+		&testBlock{slot: slot},
+	)
+}
+
+func TestGetEventsTopics(t *testing.T) {
+	h, _ := newTestHandler()
+
+	res, err := h.GetEvents(&testContext{
+		topics: []string{"head, block", TopicBlobSidecar},
+	})
+	require.NoError(t, err)
+	s, ok := res.(*stream[
+		*testHeader, *testBlock, *testSidecar, testSidecars, *testContext,
+	])
+	require.True(t, ok)
+	require.Equal(t, map[string]struct{}{
+		TopicHead:        {},
+		TopicBlock:       {},
+		TopicBlobSidecar: {},
+	}, s.topics)
+
+	_, err = h.GetEvents(&testContext{topics: []string{"head,attestation"}})
+	require.ErrorIs(t, err, apitypes.ErrInvalidRequest)
+	require.ErrorContains(t, err, ErrUnsupportedTopic.Error())
+}
+
+func TestStreamFiltersTopics(t *testing.T) {
+	h, feeds := newTestHandler()
+	w := newTestWriter()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := openStream(t, ctx, h, w, TopicHead)
+
+	// Events other than finalized blocks and failed events are ignored.
+	feeds.blocks.ch <- asynctypes.NewEvent(
+		context.Background(), events.BeaconBlockFinalizedRequest,
+		&testBlock{slot: 1},
+	)
+	feeds.blocks.ch <- asynctypes.NewEvent(
+		context.Background(), events.BeaconBlockFinalized,
+		&testBlock{slot: 2}, errors.New("failed"),
+	)
+	feeds.blocks.ch <- finalized(8)
+	w.wait(t, 1)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	got := w.written()
+	require.Len(t, got, 1)
+	require.Equal(t, TopicHead, got[0].event)
+	var data types.HeadEventData
+	require.NoError(t, json.Unmarshal([]byte(got[0].data), &data))
+	require.Equal(t, uint64(8), data.Slot)
+	require.True(t, data.EpochTransition)
+	require.Equal(t, common.Root{0x02}, data.State)
+}
+
+func TestStreamAllBlockTopics(t *testing.T) {
+	h, feeds := newTestHandler()
+	w := newTestWriter()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := openStream(
+		t, ctx, h, w, TopicBlock, TopicHead, TopicFinalizedCheckpoint,
+		TopicBlobSidecar,
+	)
+
+	feeds.blocks.ch <- finalized(9)
+	w.wait(t, 3)
+	feeds.sidecars.ch <- asynctypes.NewEvent(
+		context.Background(), events.BlobSidecarsProcessed,
+		testSidecars{{index: 0}, {index: 1}},
+	)
+	w.wait(t, 2)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	got := w.written()
+	topics := make([]string, 0, len(got))
+	for _, ev := range got {
+		topics = append(topics, ev.event)
+	}
+	require.Equal(t, []string{
+		TopicBlock, TopicHead, TopicFinalizedCheckpoint,
+		TopicBlobSidecar, TopicBlobSidecar,
+	}, topics)
+
+	var checkpoint types.FinalizedCheckpointEventData
+	require.NoError(t, json.Unmarshal([]byte(got[2].data), &checkpoint))
+	require.Equal(t, uint64(2), checkpoint.Epoch)
+
+	var sidecar types.BlobSidecarEventData
+	require.NoError(t, json.Unmarshal([]byte(got[4].data), &sidecar))
+	require.Equal(t, uint64(1), sidecar.Index)
+	require.Equal(t, uint64(3), sidecar.Slot)
+	require.Equal(
+		t,
+		common.ExecutionHash(eip4844.KZGCommitment{0x04}.ToVersionedHash()),
+		sidecar.VersionedHash,
+	)
+}
+
+func TestStreamDisconnectsSlowClient(t *testing.T) {
+	h, feeds := newTestHandler()
+	h.queueSize = 1
+	w := newTestWriter()
+	w.block = make(chan struct{})
+	done := openStream(
+		t, context.Background(), h, w,
+		TopicBlock, TopicHead, TopicFinalizedCheckpoint,
+	)
+
+	// The first event is held by the blocked writer and the second fills
+	// the queue, so the third overflows it.
+	feeds.blocks.ch <- finalized(1)
+	select {
+	case <-feeds.blocks.unsubscribed:
+		t.Fatal("unsubscribed before the writer was released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(w.block)
+
+	select {
+	case err := <-done:
+		require.ErrorIs(t, err, ErrSlowClient)
+	case <-time.After(testTimeout):
+		t.Fatal("slow client was not disconnected")
+	}
+	<-feeds.blocks.unsubscribed
+}
+
+func TestStreamEndsWhenFeedCloses(t *testing.T) {
+	h, feeds := newTestHandler()
+	done := openStream(
+		t, context.Background(), h, newTestWriter(), TopicValidatorSetUpdated,
+	)
+
+	close(feeds.valUpdates.ch)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(testTimeout):
+		t.Fatal("stream did not end")
+	}
+}
+
+func TestStreamHeartbeat(t *testing.T) {
+	h, _ := newTestHandler()
+	h.heartbeatInterval = 10 * time.Millisecond
+	w := newTestWriter()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := openStream(t, ctx, h, w, TopicHead)
+
+	w.wait(t, 2)
+	cancel()
+	require.NoError(t, <-done)
+	require.Empty(t, w.written())
+	require.Equal(t, "heartbeat", w.comments[0])
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface for a beacon block streamed by the events API.
+type BeaconBlock interface {
+	GetSlot() math.Slot
+	GetStateRoot() common.Root
+	HashTreeRoot() common.Root
+}
+
+// BeaconBlockHeader is the interface for the beacon block header of a blob
+// sidecar.
+type BeaconBlockHeader interface {
+	GetSlot() math.Slot
+	HashTreeRoot() common.Root
+}
+
+// BlobSidecar is the interface for a blob sidecar streamed by the events API.
+type BlobSidecar[BeaconBlockHeaderT BeaconBlockHeader] interface {
+	GetIndex() uint64
+	GetKzgCommitment() eip4844.KZGCommitment
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+}
+
+// BlobSidecars is the interface for the blob sidecars of a block.
+type BlobSidecars[BlobSidecarT any] interface {
+	GetSidecars() []BlobSidecarT
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Backend is the interface for backend of the node API.
+type Backend interface {
+	// GetHeadSlot returns the slot of the latest committed beacon state.
+	GetHeadSlot() (math.Slot, error)
+}
+
+// ConsensusClient reports the sync status of the consensus engine.
+type ConsensusClient interface {
+	// SyncStatus returns the latest block height of the consensus engine,
+	// the latest block height of the network and whether the consensus
+	// engine is still catching up with the network.
+	SyncStatus(ctx context.Context) (int64, int64, bool, error)
+}
+
+// ExecutionClient reports the status of the execution client.
+type ExecutionClient interface {
+	// IsConnected returns true if the execution client is reachable.
+	IsConnected() bool
+}
+
+// ExecutionEngine reports the status of the execution payloads imported by
+// the node.
+type ExecutionEngine interface {
+	// IsOptimistic returns true if the head of the execution client has only
+	// been accepted optimistically.
+	IsOptimistic() bool
+}
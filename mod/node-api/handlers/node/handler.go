@@ -25,15 +25,34 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/server/context"
 )
 
+// Handler is the handler for the node API.
 type Handler[ContextT context.Context] struct {
 	*handlers.BaseHandler[ContextT]
+	backend         Backend
+	consensusClient ConsensusClient
+	executionClient ExecutionClient
+	executionEngine ExecutionEngine
+	// version is the version of the running node.
+	version string
 }
 
-func NewHandler[ContextT context.Context]() *Handler[ContextT] {
+// NewHandler creates a new handler for the node API.
+func NewHandler[ContextT context.Context](
+	backend Backend,
+	consensusClient ConsensusClient,
+	executionClient ExecutionClient,
+	executionEngine ExecutionEngine,
+	version string,
+) *Handler[ContextT] {
 	h := &Handler[ContextT]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
 		),
+		backend:         backend,
+		consensusClient: consensusClient,
+		executionClient: executionClient,
+		executionEngine: executionEngine,
+		version:         version,
 	}
 	return h
 }
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// syncStatusTimeout is the timeout for querying the consensus engine for its
+// sync status.
+const syncStatusTimeout = 2 * time.Second
+
+// maxSyncedDistance is the number of blocks a node may lag behind the
+// network, e.g. while a block is being committed, and still be considered
+// synced.
+const maxSyncedDistance = 1
+
+// Syncing reports the head slot of the node and how far it is behind the
+// latest block of the network.
+func (h *Handler[ContextT]) Syncing(c ContextT) (any, error) {
+	headSlot, err := h.backend.GetHeadSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(requestContext(c), syncStatusTimeout)
+	defer cancel()
+	_, networkHeight, catchingUp, err := h.consensusClient.SyncStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var syncDistance uint64
+	//#nosec:G701 // heights are never negative.
+	if network := uint64(networkHeight); network > headSlot.Unwrap() {
+		syncDistance = network - headSlot.Unwrap()
+	}
+
+	return apitypes.Wrap(&types.SyncingData{
+		HeadSlot:     headSlot.Unwrap(),
+		SyncDistance: syncDistance,
+		IsSyncing:    catchingUp || syncDistance > maxSyncedDistance,
+		IsOptimistic: h.executionEngine.IsOptimistic(),
+		ELOffline:    !h.executionClient.IsConnected(),
+	}), nil
+}
+
+// requestContext returns the context of the request, if the handler context
+// carries one.
+func requestContext(c any) context.Context {
+	if rc, ok := c.(interface{ Request() *http.Request }); ok {
+		return rc.Request().Context()
+	}
+	return context.Background()
+}
+
+// Version reports the version of the running node.
+func (h *Handler[ContextT]) Version(ContextT) (any, error) {
+	return apitypes.Wrap(&types.VersionData{
+		Version: fmt.Sprintf(
+			"BeaconKit/%s (%s/%s)", h.version, runtime.GOOS, runtime.GOARCH,
+		),
+	}), nil
+}
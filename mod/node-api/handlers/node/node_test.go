@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/node"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/node/types"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type testContext struct {
+	req *http.Request
+}
+
+func newTestContext(ctx context.Context) *testContext {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	return &testContext{req: req}
+}
+
+func (c *testContext) Bind(any) error         { return nil }
+func (c *testContext) Validate(any) error     { return nil }
+func (c *testContext) Request() *http.Request { return c.req }
+
+type testBackend struct{ head math.Slot }
+
+func (b *testBackend) GetHeadSlot() (math.Slot, error) { return b.head, nil }
+
+type testConsensusClient struct {
+	local, network int64
+	catchingUp     bool
+}
+
+func (c *testConsensusClient) SyncStatus(
+	ctx context.Context,
+) (int64, int64, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, 0, false, err
+	}
+	return c.local, c.network, c.catchingUp, nil
+}
+
+type testExecution struct{ connected, optimistic bool }
+
+func (e *testExecution) IsConnected() bool  { return e.connected }
+func (e *testExecution) IsOptimistic() bool { return e.optimistic }
+
+func syncing(
+	t *testing.T,
+	h *node.Handler[*testContext],
+) *types.SyncingData {
+	t.Helper()
+	res, err := h.Syncing(newTestContext(context.Background()))
+	require.NoError(t, err)
+	wrapped, ok := res.(apitypes.DataResponse)
+	require.True(t, ok)
+	data, ok := wrapped.Data.(*types.SyncingData)
+	require.True(t, ok)
+	return data
+}
+
+func TestSyncing(t *testing.T) {
+	cases := []struct {
+		name     string
+		head     math.Slot
+		client   *testConsensusClient
+		exec     *testExecution
+		expected *types.SyncingData
+	}{
+		{
+			name: "lagging behind the network",
+			head: 10,
+			client: &testConsensusClient{
+				local: 10, network: 50, catchingUp: true,
+			},
+			exec: &testExecution{connected: true, optimistic: true},
+			expected: &types.SyncingData{
+				HeadSlot:     10,
+				SyncDistance: 40,
+				IsSyncing:    true,
+				IsOptimistic: true,
+			},
+		},
+		{
+			name: "lagging while not catching up",
+			head: 10,
+			client: &testConsensusClient{
+				local: 10, network: 20,
+			},
+			exec: &testExecution{connected: true},
+			expected: &types.SyncingData{
+				HeadSlot:     10,
+				SyncDistance: 10,
+				IsSyncing:    true,
+			},
+		},
+		{
+			name: "at the head of the network",
+			head: 50,
+			client: &testConsensusClient{
+				local: 50, network: 51,
+			},
+			exec: &testExecution{connected: true},
+			expected: &types.SyncingData{
+				HeadSlot:     50,
+				SyncDistance: 1,
+			},
+		},
+		{
+			name: "ahead of the reported network height",
+			head: 50,
+			client: &testConsensusClient{
+				local: 50, network: 50,
+			},
+			exec: &testExecution{},
+			expected: &types.SyncingData{
+				HeadSlot:  50,
+				ELOffline: true,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := node.NewHandler[*testContext](
+				&testBackend{head: tc.head}, tc.client, tc.exec, tc.exec,
+				"v1.0.0",
+			)
+			require.Equal(
+				t, tc.expected, syncing(t, h),
+			)
+		})
+	}
+}
+
+func TestSyncingUsesRequestContext(t *testing.T) {
+	exec := &testExecution{connected: true}
+	h := node.NewHandler[*testContext](
+		&testBackend{}, &testConsensusClient{}, exec, exec, "v1.0.0",
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := h.Syncing(newTestContext(ctx))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestVersion(t *testing.T) {
+	exec := &testExecution{}
+	h := node.NewHandler[*testContext](
+		&testBackend{}, &testConsensusClient{}, exec, exec, "v1.2.3",
+	)
+	res, err := h.Version(newTestContext(context.Background()))
+	require.NoError(t, err)
+	require.Equal(t, apitypes.Wrap(&types.VersionData{
+		Version: fmt.Sprintf(
+			"BeaconKit/v1.2.3 (%s/%s)", runtime.GOOS, runtime.GOARCH,
+		),
+	}), res)
+}
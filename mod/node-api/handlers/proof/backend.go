@@ -21,17 +21,24 @@
 package proof
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // Backend is the interface for backend of the proof API.
-type Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT any] interface {
-	BlockBackend[BeaconBlockHeaderT]
+type Backend[
+	BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, ValidatorT any,
+] interface {
+	BlockBackend[BeaconBlockT, BeaconBlockHeaderT]
 	StateBackend[BeaconStateT]
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 }
 
-type BlockBackend[BeaconBlockHeaderT any] interface {
+type BlockBackend[BeaconBlockT, BeaconBlockHeaderT any] interface {
+	BlockAtSlot(slot math.Slot) (BeaconBlockT, error)
 	BlockHeaderAtSlot(slot math.Slot) (BeaconBlockHeaderT, error)
 }
 
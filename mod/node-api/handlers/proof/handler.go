@@ -30,6 +30,7 @@ import (
 
 // Handler is the handler for the proof API.
 type Handler[
+	BeaconBlockT types.BeaconBlock,
 	BeaconBlockHeaderT types.BeaconBlockHeader,
 	BeaconStateT types.BeaconState[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
@@ -40,11 +41,14 @@ type Handler[
 	ValidatorT types.Validator,
 ] struct {
 	*handlers.BaseHandler[ContextT]
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT]
+	backend Backend[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, ValidatorT,
+	]
 }
 
 // NewHandler creates a new handler for the proof API.
 func NewHandler[
+	BeaconBlockT types.BeaconBlock,
 	BeaconBlockHeaderT types.BeaconBlockHeader,
 	BeaconStateT types.BeaconState[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
@@ -54,14 +58,17 @@ func NewHandler[
 	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
 	ValidatorT types.Validator,
 ](
-	backend Backend[BeaconBlockHeaderT, BeaconStateT, ValidatorT],
+	backend Backend[
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, ValidatorT,
+	],
 ) *Handler[
-	BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
+	BeaconBlockT, BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
 	ContextT, ExecutionPayloadHeaderT, ValidatorT,
 ] {
 	h := &Handler[
-		BeaconBlockHeaderT, BeaconStateT, BeaconStateMarshallableT,
-		ContextT, ExecutionPayloadHeaderT, ValidatorT,
+		BeaconBlockT, BeaconBlockHeaderT, BeaconStateT,
+		BeaconStateMarshallableT, ContextT, ExecutionPayloadHeaderT,
+		ValidatorT,
 	]{
 		BaseHandler: handlers.NewBaseHandler(
 			handlers.NewRouteSet[ContextT](""),
@@ -74,19 +81,33 @@ func NewHandler[
 // Get the slot from the given input of execution id, beacon state, and beacon
 // block header for the resolved slot.
 func (h *Handler[
-	BeaconBlockHeaderT, BeaconStateT, _, _, _, _,
+	_, BeaconBlockHeaderT, BeaconStateT, _, _, _, _,
 ]) resolveExecutionID(executionID string) (
 	math.Slot, BeaconStateT, BeaconBlockHeaderT, error,
 ) {
-	var (
-		beaconState BeaconStateT
-		blockHeader BeaconBlockHeaderT
-	)
-
 	slot, err := utils.SlotFromExecutionID(executionID, h.backend)
 	if err != nil {
+		var (
+			beaconState BeaconStateT
+			blockHeader BeaconBlockHeaderT
+		)
 		return 0, beaconState, blockHeader, err
 	}
+	return h.resolveSlot(slot)
+}
+
+// Get the beacon state and beacon block header for the given slot, along
+// with the resolved slot.
+func (h *Handler[
+	_, BeaconBlockHeaderT, BeaconStateT, _, _, _, _,
+]) resolveSlot(slot math.Slot) (
+	math.Slot, BeaconStateT, BeaconBlockHeaderT, error,
+) {
+	var (
+		beaconState BeaconStateT
+		blockHeader BeaconBlockHeaderT
+		err         error
+	)
 
 	beaconState, slot, err = h.backend.StateFromSlotForProof(slot)
 	if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // ProveProposerInBlock generates a proof for the proposer pubkey in the
@@ -43,10 +44,15 @@ func ProveProposerInBlock[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 ) ([]common.Root, common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
 	// Get the proof of the proposer pubkey in the beacon state.
 	proposerOffset := ValidatorPubkeyGIndexOffset * bbh.GetProposerIndex()
 	valPubkeyInStateProof, leaf, err := ProveProposerPubkeyInState(
-		bs, proposerOffset,
+		bsm, proposerOffset,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -63,7 +69,7 @@ func ProveProposerInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(valPubkeyInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyProposerInBlock(
-		bbh, proposerOffset, combinedProof, leaf,
+		bbh, bsm.Version(), proposerOffset, combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -74,27 +80,18 @@ func ProveProposerInBlock[
 
 // ProveProposerPubkeyInState generates a proof for the proposer pubkey
 // in the beacon state. It uses the fastssz library to generate the proof.
-func ProveProposerPubkeyInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+func ProveProposerPubkeyInState(
+	bsm types.BeaconStateMarshallable,
 	proposerOffset math.U64,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
+	stateGIndex, _ := zeroValidatorPubkeyGIndices(bsm.Version())
 	//#nosec:G701 // max proposer offset is 8 * (2^40 - 1).
-	gIndex := ZeroValidatorPubkeyGIndexDenebState + int(proposerOffset)
+	gIndex := stateGIndex + int(proposerOffset)
 	valPubkeyInStateProof, err := stateProofTree.Prove(gIndex)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -108,18 +105,21 @@ func ProveProposerPubkeyInState[
 }
 
 // verifyProposerInBlock verifies the proposer pubkey in the beacon block,
-// returning the beacon block root used to verify against.
+// given the fork version of the beacon state, returning the beacon block root
+// used to verify against.
 //
 // TODO: verifying the proof is not absolutely necessary.
 func verifyProposerInBlock(
 	bbh types.BeaconBlockHeader,
+	forkVersion uint32,
 	valOffset math.U64,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
+	_, blockGIndex := zeroValidatorPubkeyGIndices(forkVersion)
 	if beaconRootVerified, err := merkle.VerifyProof(
-		merkle.GeneralizedIndex(ZeroValidatorPubkeyGIndexDenebBlock+valOffset),
+		merkle.GeneralizedIndex(blockGIndex+valOffset.Unwrap()),
 		leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
@@ -131,3 +131,15 @@ func verifyProposerInBlock(
 
 	return beaconRoot, nil
 }
+
+// zeroValidatorPubkeyGIndices returns the generalized indices of the 0
+// validator's pubkey in the beacon state and in the beacon block, for the
+// layout of the beacon state of the given fork version.
+func zeroValidatorPubkeyGIndices(forkVersion uint32) (int, uint64) {
+	if forkVersion >= version.DenebPlus {
+		return ZeroValidatorPubkeyGIndexDenebPlusState,
+			ZeroValidatorPubkeyGIndexDenebPlusBlock
+	}
+	return ZeroValidatorPubkeyGIndexDenebState,
+		ZeroValidatorPubkeyGIndexDenebBlock
+}
@@ -64,4 +64,40 @@ const (
 	// in the Deneb fork. This is calculated by concatenating the
 	// (ExecutionFeeRecipientGIndexDenebState, StateGIndexDenebBlock) GIndices.
 	ExecutionFeeRecipientGIndexDenebBlock = 5889
+
+	// ZeroValidatorPubkeyGIndexDenebPlusState is the generalized index of the
+	// 0 validator's pubkey in the beacon state from the Deneb+ fork onwards,
+	// in which the beacon state has more than 16 fields. The Electra state
+	// shares the same depth.
+	ZeroValidatorPubkeyGIndexDenebPlusState = 721279627821056
+
+	// ZeroValidatorPubkeyGIndexDenebPlusBlock is the generalized index of the
+	// 0 validator's pubkey in the beacon block from the Deneb+ fork onwards.
+	// This is calculated by concatenating the
+	// (ZeroValidatorPubkeyGIndexDenebPlusState, StateGIndexDenebBlock)
+	// GIndices.
+	ZeroValidatorPubkeyGIndexDenebPlusBlock = 6350779162034176
+
+	// ExecutionNumberGIndexDenebPlusState is the generalized index of the
+	// number in the latest execution payload header in the beacon state from
+	// the Deneb+ fork onwards.
+	ExecutionNumberGIndexDenebPlusState = 1286
+
+	// ExecutionNumberGIndexDenebPlusBlock is the generalized index of the
+	// number in the latest execution payload header in the beacon block from
+	// the Deneb+ fork onwards. This is calculated by concatenating the
+	// (ExecutionNumberGIndexDenebPlusState, StateGIndexDenebBlock) GIndices.
+	ExecutionNumberGIndexDenebPlusBlock = 11526
+
+	// ExecutionFeeRecipientGIndexDenebPlusState is the generalized index of
+	// the fee recipient in the latest execution payload header in the beacon
+	// state from the Deneb+ fork onwards.
+	ExecutionFeeRecipientGIndexDenebPlusState = 1281
+
+	// ExecutionFeeRecipientGIndexDenebPlusBlock is the generalized index of
+	// the fee recipient in the latest execution payload header in the beacon
+	// block from the Deneb+ fork onwards. This is calculated by concatenating
+	// the (ExecutionFeeRecipientGIndexDenebPlusState, StateGIndexDenebBlock)
+	// GIndices.
+	ExecutionFeeRecipientGIndexDenebPlusBlock = 11521
 )
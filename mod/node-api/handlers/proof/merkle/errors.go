@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrInvalidObjectPath is returned when an object path does not resolve
+	// in the schema of the object.
+	ErrInvalidObjectPath = errors.New("invalid object path")
+
+	// ErrDuplicateObjectPath is returned when multiple object paths resolve
+	// to the same generalized index.
+	ErrDuplicateObjectPath = errors.New("duplicate object path")
+
+	// ErrNodeNotFound is returned when a generalized index is not part of
+	// the tree of the object, e.g. an index past the length of a list.
+	ErrNodeNotFound = errors.New("node not found in tree")
+
+	// ErrUnsupportedForkVersion is returned when there is no schema for the
+	// fork version of an object.
+	ErrUnsupportedForkVersion = errors.New("unsupported fork version")
+
+	// ErrStateRootMismatch is returned when the beacon state does not match
+	// the state root of the beacon block header.
+	ErrStateRootMismatch = errors.New(
+		"beacon state does not match the state root of the block header",
+	)
+)
@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // ProveExecutionFeeRecipientInBlock generates a proof for the fee recipient in
@@ -44,8 +45,15 @@ func ProveExecutionFeeRecipientInBlock[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 ) ([]common.Root, common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
 	// Get the proof of the execution fee recipient in the beacon state.
-	feeRecipientInStateProof, leaf, err := ProveExecutionFeeRecipientInState(bs)
+	feeRecipientInStateProof, leaf, err := ProveExecutionFeeRecipientInState(
+		bsm,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -61,7 +69,7 @@ func ProveExecutionFeeRecipientInBlock[
 	//nolint:gocritic // ok.
 	combinedProof := append(feeRecipientInStateProof, stateInBlockProof...)
 	beaconRoot, err := verifyExecutionFeeRecipientInBlock(
-		bbh, combinedProof, leaf,
+		bbh, bsm.Version(), combinedProof, leaf,
 	)
 	if err != nil {
 		return nil, common.Root{}, err
@@ -73,27 +81,16 @@ func ProveExecutionFeeRecipientInBlock[
 // ProveExecutionFeeRecipientInState generates a proof for the execution fee
 // recipient in the beacon state. It uses the fastssz library to generate the
 // proof.
-func ProveExecutionFeeRecipientInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+func ProveExecutionFeeRecipientInState(
+	bsm types.BeaconStateMarshallable,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
-	feeRecipientInStateProof, err := stateProofTree.Prove(
-		ExecutionFeeRecipientGIndexDenebState,
-	)
+	stateGIndex, _ := executionFeeRecipientGIndices(bsm.Version())
+	feeRecipientInStateProof, err := stateProofTree.Prove(stateGIndex)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -106,18 +103,20 @@ func ProveExecutionFeeRecipientInState[
 }
 
 // verifyExecutionFeeRecipientInBlock verifies the execution fee recipient in
-// the
-// beacon block, returning the beacon block root used to verify against.
+// the beacon block, given the fork version of the beacon state, returning the
+// beacon block root used to verify against.
 //
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionFeeRecipientInBlock(
 	bbh types.BeaconBlockHeader,
+	forkVersion uint32,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
+	_, blockGIndex := executionFeeRecipientGIndices(forkVersion)
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionFeeRecipientGIndexDenebBlock, leaf, proof, beaconRoot,
+		merkle.GeneralizedIndex(blockGIndex), leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
@@ -129,3 +128,16 @@ func verifyExecutionFeeRecipientInBlock(
 
 	return beaconRoot, nil
 }
+
+// executionFeeRecipientGIndices returns the generalized indices of the fee
+// recipient in the latest execution payload header in the beacon state and in
+// the beacon block, for the layout of the beacon state of the given fork
+// version.
+func executionFeeRecipientGIndices(forkVersion uint32) (int, uint64) {
+	if forkVersion >= version.DenebPlus {
+		return ExecutionFeeRecipientGIndexDenebPlusState,
+			ExecutionFeeRecipientGIndexDenebPlusBlock
+	}
+	return ExecutionFeeRecipientGIndexDenebState,
+		ExecutionFeeRecipientGIndexDenebBlock
+}
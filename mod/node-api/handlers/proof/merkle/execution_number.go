@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // ProveExecutionNumberInBlock generates a proof for the block number of the
@@ -44,8 +45,13 @@ func ProveExecutionNumberInBlock[
 		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
 	],
 ) ([]common.Root, common.Root, error) {
+	bsm, err := bs.GetMarshallable()
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
 	// Get the proof of the execution number in the beacon state.
-	numberInStateProof, leaf, err := ProveExecutionNumberInState(bs)
+	numberInStateProof, leaf, err := ProveExecutionNumberInState(bsm)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -60,7 +66,9 @@ func ProveExecutionNumberInBlock[
 	//
 	//nolint:gocritic // ok.
 	combinedProof := append(numberInStateProof, stateInBlockProof...)
-	beaconRoot, err := verifyExecutionNumberInBlock(bbh, combinedProof, leaf)
+	beaconRoot, err := verifyExecutionNumberInBlock(
+		bbh, bsm.Version(), combinedProof, leaf,
+	)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -70,27 +78,16 @@ func ProveExecutionNumberInBlock[
 
 // ProveExecutionNumberInState generates a proof for the block number of the
 // execution payload in the beacon state. It uses the fastssz library.
-func ProveExecutionNumberInState[
-	BeaconStateMarshallableT types.BeaconStateMarshallable,
-	ExecutionPayloadHeaderT types.ExecutionPayloadHeader,
-	ValidatorT any,
-](
-	bs types.BeaconState[
-		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorT,
-	],
+func ProveExecutionNumberInState(
+	bsm types.BeaconStateMarshallable,
 ) ([]common.Root, common.Root, error) {
-	bsm, err := bs.GetMarshallable()
-	if err != nil {
-		return nil, common.Root{}, err
-	}
 	stateProofTree, err := bsm.GetTree()
 	if err != nil {
 		return nil, common.Root{}, err
 	}
 
-	numberInStateProof, err := stateProofTree.Prove(
-		ExecutionNumberGIndexDenebState,
-	)
+	stateGIndex, _ := executionNumberGIndices(bsm.Version())
+	numberInStateProof, err := stateProofTree.Prove(stateGIndex)
 	if err != nil {
 		return nil, common.Root{}, err
 	}
@@ -103,17 +100,20 @@ func ProveExecutionNumberInState[
 }
 
 // verifyExecutionNumberInBlock verifies the execution number in the beacon
-// block, returning the beacon block root used to verify against.
+// block, given the fork version of the beacon state, returning the beacon
+// block root used to verify against.
 //
 // TODO: verifying the proof is not absolutely necessary.
 func verifyExecutionNumberInBlock(
 	bbh types.BeaconBlockHeader,
+	forkVersion uint32,
 	proof []common.Root,
 	leaf common.Root,
 ) (common.Root, error) {
 	beaconRoot := bbh.HashTreeRoot()
+	_, blockGIndex := executionNumberGIndices(forkVersion)
 	if beaconRootVerified, err := merkle.VerifyProof(
-		ExecutionNumberGIndexDenebBlock, leaf, proof, beaconRoot,
+		merkle.GeneralizedIndex(blockGIndex), leaf, proof, beaconRoot,
 	); err != nil {
 		return common.Root{}, err
 	} else if !beaconRootVerified {
@@ -125,3 +125,14 @@ func verifyExecutionNumberInBlock(
 
 	return beaconRoot, nil
 }
+
+// executionNumberGIndices returns the generalized indices of the number in
+// the latest execution payload header in the beacon state and in the beacon
+// block, for the layout of the beacon state of the given fork version.
+func executionNumberGIndices(forkVersion uint32) (int, uint64) {
+	if forkVersion >= version.DenebPlus {
+		return ExecutionNumberGIndexDenebPlusState,
+			ExecutionNumberGIndexDenebPlusBlock
+	}
+	return ExecutionNumberGIndexDenebState, ExecutionNumberGIndexDenebBlock
+}
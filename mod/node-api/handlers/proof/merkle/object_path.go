@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	fastssz "github.com/ferranbt/fastssz"
+)
+
+// ProveObjectPaths generates a multiproof for the given SSZ object paths in
+// the tree of an object of the given schema. The multiproof consists of the
+// nodes at the helper indices of the paths, in decreasing order of
+// generalized index. The multiproof is verified against the root of the
+// tree as a sanity check. Returns the proven leaves along with the
+// multiproof and the root of the tree.
+func ProveObjectPaths(
+	tree *fastssz.Node,
+	typ schema.SSZType,
+	paths []string,
+) ([]types.ObjectLeaf, []common.Root, common.Root, error) {
+	var (
+		leaves      = make([]types.ObjectLeaf, len(paths))
+		leafRoots   = make([]common.Root, len(paths))
+		gIndices    = make(merkle.GeneralizedIndices, len(paths))
+		root        = common.Root(tree.Hash())
+		seenIndices = make(map[merkle.GeneralizedIndex]struct{}, len(paths))
+	)
+	for i, path := range paths {
+		_, gIndex, offset, err := merkle.ObjectPath[
+			merkle.GeneralizedIndex, common.Root,
+		](path).GetGeneralizedIndex(typ)
+		if err != nil {
+			return nil, nil, common.Root{}, errors.Wrapf(
+				ErrInvalidObjectPath, "%s: %v", path, err,
+			)
+		}
+		if _, ok := seenIndices[gIndex]; ok {
+			return nil, nil, common.Root{}, errors.Wrapf(
+				ErrDuplicateObjectPath, "%s", path,
+			)
+		}
+		seenIndices[gIndex] = struct{}{}
+
+		if leafRoots[i], err = nodeRoot(tree, gIndex); err != nil {
+			return nil, nil, common.Root{}, errors.Wrapf(err, "%s", path)
+		}
+		gIndices[i] = gIndex
+		leaves[i] = types.ObjectLeaf{
+			Path:             path,
+			GeneralizedIndex: math.U64(gIndex),
+			Leaf:             leafRoots[i],
+			Offset:           offset,
+		}
+	}
+
+	helperIndices := gIndices.GetHelperIndices()
+	proof := make([]common.Root, len(helperIndices))
+	for i, gIndex := range helperIndices {
+		var err error
+		if proof[i], err = nodeRoot(tree, gIndex); err != nil {
+			return nil, nil, common.Root{}, err
+		}
+	}
+
+	if !merkle.VerifyMultiproof(gIndices, leafRoots, proof, root) {
+		return nil, nil, common.Root{}, errors.New(
+			"multiproof failed to verify against root",
+		)
+	}
+	return leaves, proof, root, nil
+}
+
+// GraftTree returns a copy of the tree in which the node at the given
+// generalized index is replaced by the given subtree. The nodes of the
+// original tree are shared but not modified.
+func GraftTree(
+	tree *fastssz.Node,
+	gIndex merkle.GeneralizedIndex,
+	subtree *fastssz.Node,
+) (*fastssz.Node, error) {
+	for ; gIndex > 1; gIndex = gIndex.Parent() {
+		//#nosec:G701 // generalized indices of the tree fit in an int.
+		sibling, err := tree.Get(int(gIndex.Sibling()))
+		if err != nil {
+			return nil, err
+		}
+		if gIndex%2 == 0 {
+			subtree = fastssz.NewNodeWithLR(subtree, sibling)
+		} else {
+			subtree = fastssz.NewNodeWithLR(sibling, subtree)
+		}
+	}
+	return subtree, nil
+}
+
+// nodeRoot returns the root of the node at the given generalized index.
+func nodeRoot(
+	tree *fastssz.Node,
+	gIndex merkle.GeneralizedIndex,
+) (common.Root, error) {
+	//#nosec:G701 // generalized indices of the tree fit in an int.
+	node, err := tree.Get(int(gIndex))
+	if err != nil {
+		return common.Root{}, errors.Wrapf(
+			ErrNodeNotFound, "generalized index %d", gIndex,
+		)
+	}
+	return common.Root(node.Hash()), nil
+}
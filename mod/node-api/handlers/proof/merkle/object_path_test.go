@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	ssz "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaMatchesDenebGIndices(t *testing.T) {
+	cases := []struct {
+		typ    schema.SSZType
+		path   string
+		gIndex uint64
+	}{
+		{
+			typ:    merkle.BeaconStateSchemaDeneb(),
+			path:   "validators/0/pubkey",
+			gIndex: merkle.ZeroValidatorPubkeyGIndexDenebState,
+		},
+		{
+			typ:    merkle.BeaconStateSchemaDeneb(),
+			path:   "latest_execution_payload_header/block_number",
+			gIndex: merkle.ExecutionNumberGIndexDenebState,
+		},
+		{
+			typ:    merkle.BeaconStateSchemaDeneb(),
+			path:   "latest_execution_payload_header/fee_recipient",
+			gIndex: merkle.ExecutionFeeRecipientGIndexDenebState,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDeneb(),
+			path:   "state_root",
+			gIndex: merkle.StateGIndexDenebBlock,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDeneb(),
+			path:   "state_root/validators/0/pubkey",
+			gIndex: merkle.ZeroValidatorPubkeyGIndexDenebBlock,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDeneb(),
+			path:   "state_root/latest_execution_payload_header/block_number",
+			gIndex: merkle.ExecutionNumberGIndexDenebBlock,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			_, gIndex, _, err := ssz.ObjectPath[uint64, common.Root](
+				tc.path,
+			).GetGeneralizedIndex(tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.gIndex, gIndex)
+		})
+	}
+}
+
+func TestSchemaMatchesDenebPlusGIndices(t *testing.T) {
+	cases := []struct {
+		typ    schema.SSZType
+		path   string
+		gIndex uint64
+	}{
+		{
+			typ:    merkle.BeaconStateSchemaDenebPlus(),
+			path:   "validators/0/pubkey",
+			gIndex: merkle.ZeroValidatorPubkeyGIndexDenebPlusState,
+		},
+		{
+			typ:    merkle.BeaconStateSchemaDenebPlus(),
+			path:   "latest_execution_payload_header/block_number",
+			gIndex: merkle.ExecutionNumberGIndexDenebPlusState,
+		},
+		{
+			typ:    merkle.BeaconStateSchemaDenebPlus(),
+			path:   "latest_execution_payload_header/fee_recipient",
+			gIndex: merkle.ExecutionFeeRecipientGIndexDenebPlusState,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDenebPlus(),
+			path:   "state_root",
+			gIndex: merkle.StateGIndexDenebBlock,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDenebPlus(),
+			path:   "state_root/validators/0/pubkey",
+			gIndex: merkle.ZeroValidatorPubkeyGIndexDenebPlusBlock,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDenebPlus(),
+			path:   "state_root/latest_execution_payload_header/block_number",
+			gIndex: merkle.ExecutionNumberGIndexDenebPlusBlock,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaDenebPlus(),
+			path:   "state_root/latest_execution_payload_header/fee_recipient",
+			gIndex: merkle.ExecutionFeeRecipientGIndexDenebPlusBlock,
+		},
+		// The Electra state fits in the same tree depth as the Deneb+ one.
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaElectra(),
+			path:   "state_root/validators/0/pubkey",
+			gIndex: merkle.ZeroValidatorPubkeyGIndexDenebPlusBlock,
+		},
+		{
+			typ:    merkle.BeaconBlockHeaderSchemaElectra(),
+			path:   "state_root/latest_execution_payload_header/block_number",
+			gIndex: merkle.ExecutionNumberGIndexDenebPlusBlock,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			_, gIndex, _, err := ssz.ObjectPath[uint64, common.Root](
+				tc.path,
+			).GetGeneralizedIndex(tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.gIndex, gIndex)
+		})
+	}
+}
+
+// testObject returns the schema and tree of a container with a uint64, a
+// list of 5 uint64s with a limit of 8, and a nested container.
+func testObject(t *testing.T) (schema.SSZType, *fastssz.Node) {
+	t.Helper()
+	typ := schema.DefineContainer(
+		schema.NewField("a", schema.U64()),
+		schema.NewField("b", schema.DefineList(schema.U64(), 8)),
+		schema.NewField("c", schema.DefineContainer(
+			schema.NewField("x", schema.B32()),
+			schema.NewField("y", schema.U64()),
+		)),
+	)
+
+	list, err := fastssz.TreeFromNodesWithMixin(
+		[]*fastssz.Node{
+			fastssz.LeafFromBytes([]byte{1, 0, 0, 0, 0, 0, 0, 0, 2}),
+			fastssz.LeafFromBytes([]byte{5}),
+		}, 5, 2,
+	)
+	require.NoError(t, err)
+	nested, err := fastssz.TreeFromNodes([]*fastssz.Node{
+		fastssz.LeafFromBytes([]byte{0xaa}),
+		fastssz.LeafFromUint64(7),
+	}, 2)
+	require.NoError(t, err)
+	tree, err := fastssz.TreeFromNodes([]*fastssz.Node{
+		fastssz.LeafFromUint64(3), list, nested,
+	}, 4)
+	require.NoError(t, err)
+	return typ, tree
+}
+
+func TestProveObjectPaths(t *testing.T) {
+	typ, tree := testObject(t)
+
+	paths := []string{"a", "b/4", "b/__len__", "c/x"}
+	leaves, proof, root, err := merkle.ProveObjectPaths(tree, typ, paths)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(tree.Hash()), root)
+	require.Len(t, leaves, len(paths))
+
+	indices := make(ssz.GeneralizedIndices, len(leaves))
+	leafRoots := make([]common.Root, len(leaves))
+	for i, leaf := range leaves {
+		require.Equal(t, paths[i], leaf.Path)
+		indices[i] = ssz.GeneralizedIndex(leaf.GeneralizedIndex)
+		leafRoots[i] = leaf.Leaf
+	}
+	require.Equal(t, ssz.GeneralizedIndices{4, 21, 11, 12}, indices)
+	require.Equal(t, uint8(0), leaves[1].Offset)
+	require.Equal(t, common.Root{5}, leaves[1].Leaf)
+	require.Equal(t, common.Root{0xaa}, leaves[3].Leaf)
+	require.Len(t, proof, len(indices.GetHelperIndices()))
+	require.True(t, ssz.VerifyMultiproof(indices, leafRoots, proof, root))
+}
+
+func TestProveObjectPaths_SingleMatchesBranch(t *testing.T) {
+	typ, tree := testObject(t)
+
+	leaves, proof, root, err := merkle.ProveObjectPaths(
+		tree, typ, []string{"c/y"},
+	)
+	require.NoError(t, err)
+
+	branch, err := tree.Prove(int(leaves[0].GeneralizedIndex))
+	require.NoError(t, err)
+	require.Len(t, proof, len(branch.Hashes))
+	for i, hash := range branch.Hashes {
+		require.Equal(t, common.Root(hash), proof[i])
+	}
+
+	ok, err := ssz.VerifyProof(
+		ssz.GeneralizedIndex(leaves[0].GeneralizedIndex),
+		leaves[0].Leaf, proof, root,
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestProveObjectPaths_Errors(t *testing.T) {
+	typ, tree := testObject(t)
+
+	_, _, _, err := merkle.ProveObjectPaths(tree, typ, []string{"d"})
+	require.ErrorIs(t, err, merkle.ErrInvalidObjectPath)
+
+	_, _, _, err = merkle.ProveObjectPaths(
+		tree, typ, []string{"c/x", "c/x"},
+	)
+	require.ErrorIs(t, err, merkle.ErrDuplicateObjectPath)
+
+	_, _, _, err = merkle.ProveObjectPaths(
+		tree, typ, []string{"c/x/0/0"},
+	)
+	require.Error(t, err)
+}
+
+func TestGraftTree(t *testing.T) {
+	typ, tree := testObject(t)
+	original := common.Root(tree.Hash())
+
+	subtree, err := fastssz.TreeFromNodes([]*fastssz.Node{
+		fastssz.LeafFromBytes([]byte{0xbb}),
+		fastssz.LeafFromUint64(9),
+	}, 2)
+	require.NoError(t, err)
+	grafted, err := merkle.GraftTree(tree, 6, subtree)
+	require.NoError(t, err)
+
+	// The original tree is left untouched.
+	require.Equal(t, original, common.Root(tree.Hash()))
+	require.NotEqual(t, original, common.Root(grafted.Hash()))
+
+	leaves, _, root, err := merkle.ProveObjectPaths(
+		grafted, typ, []string{"a", "c/x"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, common.Root(grafted.Hash()), root)
+	require.Equal(t, common.Root{0xbb}, leaves[1].Leaf)
+}
@@ -0,0 +1,511 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package merkle
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// historicalRootsLimit is the limit of the block and state roots lists
+	// in the beacon state.
+	historicalRootsLimit = 8192
+
+	// randaoMixesLimit is the limit of the randao mixes list in the beacon
+	// state.
+	randaoMixesLimit = 65536
+
+	// registryLimit is the limit of the lists of the beacon state with an
+	// entry per validator, and of the slashings list.
+	registryLimit = 1 << 40
+
+	// extraDataLimit is the limit of the extra data in the execution payload
+	// header.
+	extraDataLimit = 32
+
+	// blobCommitmentsLimit is the limit of the blob KZG commitments list in
+	// the beacon block body.
+	blobCommitmentsLimit = 16
+)
+
+// BeaconBlockHeaderSchema returns the SSZ schema of the beacon block header
+// in the given fork version.
+func BeaconBlockHeaderSchema(forkVersion uint32) (schema.SSZType, error) {
+	switch forkVersion {
+	case version.Deneb:
+		return BeaconBlockHeaderSchemaDeneb(), nil
+	case version.DenebPlus:
+		return BeaconBlockHeaderSchemaDenebPlus(), nil
+	case version.Electra:
+		return BeaconBlockHeaderSchemaElectra(), nil
+	default:
+		return nil, ErrUnsupportedForkVersion
+	}
+}
+
+// BeaconBlockHeaderSchemaDeneb returns the SSZ schema of the beacon block
+// header in the Deneb fork. The state and body roots are typed as the beacon
+// state and block body, so that object paths can descend from the beacon
+// block root into either of them.
+func BeaconBlockHeaderSchemaDeneb() schema.SSZType {
+	return beaconBlockHeaderSchema(
+		BeaconStateSchemaDeneb(), BeaconBlockBodySchemaDeneb(),
+	)
+}
+
+// BeaconBlockHeaderSchemaDenebPlus returns the SSZ schema of the beacon block
+// header in the Deneb+ fork. The state and body roots are typed as the beacon
+// state and block body, so that object paths can descend from the beacon
+// block root into either of them.
+func BeaconBlockHeaderSchemaDenebPlus() schema.SSZType {
+	return beaconBlockHeaderSchema(
+		BeaconStateSchemaDenebPlus(), BeaconBlockBodySchemaDenebPlus(),
+	)
+}
+
+// BeaconBlockHeaderSchemaElectra returns the SSZ schema of the beacon block
+// header in the Electra fork. The state and body roots are typed as the
+// beacon state and block body, so that object paths can descend from the
+// beacon block root into either of them.
+func BeaconBlockHeaderSchemaElectra() schema.SSZType {
+	return beaconBlockHeaderSchema(
+		BeaconStateSchemaElectra(), BeaconBlockBodySchemaElectra(),
+	)
+}
+
+// beaconBlockHeaderSchema returns the SSZ schema of the beacon block header
+// with the state and body roots typed as the given state and body.
+func beaconBlockHeaderSchema(state, body schema.SSZType) schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField("state_root", state),
+		schema.NewField("body_root", body),
+	)
+}
+
+// BeaconBlockBodySchemaDeneb returns the SSZ schema of the beacon block body
+// in the Deneb fork.
+func BeaconBlockBodySchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(beaconBlockBodyFieldsDeneb()...)
+}
+
+// BeaconBlockBodySchemaDenebPlus returns the SSZ schema of the beacon block
+// body in the Deneb+ fork, which appends the slashing operations and the
+// voluntary exits to the Deneb body.
+func BeaconBlockBodySchemaDenebPlus() schema.SSZType {
+	return schema.DefineContainer(append(
+		beaconBlockBodyFieldsDeneb(),
+		schema.NewField("slashings", slashingOperationsSchema()),
+		schema.NewField("voluntary_exits", voluntaryExitsSchema()),
+	)...)
+}
+
+// BeaconBlockBodySchemaElectra returns the SSZ schema of the beacon block
+// body in the Electra fork, which nests the slashing operations and the
+// voluntary exits of the Deneb+ body in a single field and appends the
+// execution layer requests.
+func BeaconBlockBodySchemaElectra() schema.SSZType {
+	return schema.DefineContainer(append(
+		beaconBlockBodyFieldsDeneb(),
+		schema.NewField("operations", schema.DefineContainer(
+			schema.NewField("slashings", slashingOperationsSchema()),
+			schema.NewField("voluntary_exits", voluntaryExitsSchema()),
+		)),
+		schema.NewField("execution_requests", executionRequestsSchema()),
+	)...)
+}
+
+// beaconBlockBodyFieldsDeneb returns the fields of the beacon block body in
+// the Deneb fork.
+func beaconBlockBodyFieldsDeneb() []*schema.Field[schema.SSZType] {
+	return []*schema.Field[schema.SSZType]{
+		schema.NewField("randao_reveal", schema.B96()),
+		schema.NewField("eth1_data", eth1DataSchema()),
+		schema.NewField("graffiti", schema.B32()),
+		schema.NewField(
+			"deposits",
+			schema.DefineList(depositSchema(), constants.MaxDepositsPerBlock),
+		),
+		schema.NewField("execution_payload", executionPayloadSchemaDeneb()),
+		schema.NewField(
+			"blob_kzg_commitments",
+			schema.DefineList(schema.B48(), blobCommitmentsLimit),
+		),
+	}
+}
+
+// BeaconStateSchema returns the SSZ schema of the beacon state in the given
+// fork version.
+func BeaconStateSchema(forkVersion uint32) (schema.SSZType, error) {
+	switch forkVersion {
+	case version.Deneb:
+		return BeaconStateSchemaDeneb(), nil
+	case version.DenebPlus:
+		return BeaconStateSchemaDenebPlus(), nil
+	case version.Electra:
+		return BeaconStateSchemaElectra(), nil
+	default:
+		return nil, ErrUnsupportedForkVersion
+	}
+}
+
+// BeaconStateSchemaDeneb returns the SSZ schema of the beacon state in the
+// Deneb fork.
+func BeaconStateSchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(beaconStateFieldsDeneb()...)
+}
+
+// BeaconStateSchemaDenebPlus returns the SSZ schema of the beacon state in
+// the Deneb+ fork, which appends the participation, the inactivity scores and
+// the voting powers of the validators and the eth1 data votes to the Deneb
+// state.
+func BeaconStateSchemaDenebPlus() schema.SSZType {
+	return schema.DefineContainer(beaconStateFieldsDenebPlus()...)
+}
+
+// BeaconStateSchemaElectra returns the SSZ schema of the beacon state in the
+// Electra fork, which appends the state of the execution layer requests to
+// the Deneb+ state.
+func BeaconStateSchemaElectra() schema.SSZType {
+	return schema.DefineContainer(append(
+		beaconStateFieldsDenebPlus(),
+		schema.NewField("deposit_requests_start_index", schema.U64()),
+		schema.NewField("pending_consolidations", schema.DefineList(
+			pendingConsolidationSchema(),
+			constants.PendingConsolidationsLimit,
+		)),
+	)...)
+}
+
+// beaconStateFieldsDenebPlus returns the fields of the beacon state in the
+// Deneb+ fork.
+func beaconStateFieldsDenebPlus() []*schema.Field[schema.SSZType] {
+	return append(
+		beaconStateFieldsDeneb(),
+		schema.NewField(
+			"epoch_participation",
+			schema.DefineList(schema.U64(), registryLimit),
+		),
+		schema.NewField(
+			"inactivity_scores",
+			schema.DefineList(schema.U64(), registryLimit),
+		),
+		schema.NewField(
+			"validator_powers",
+			schema.DefineList(schema.U64(), registryLimit),
+		),
+		schema.NewField(
+			"eth1_data_votes",
+			schema.DefineList(eth1DataSchema(), constants.MaxEth1DataVotes),
+		),
+	)
+}
+
+// beaconStateFieldsDeneb returns the fields of the beacon state in the Deneb
+// fork.
+func beaconStateFieldsDeneb() []*schema.Field[schema.SSZType] {
+	return []*schema.Field[schema.SSZType]{
+		schema.NewField("genesis_validators_root", schema.B32()),
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("fork", forkSchema()),
+		schema.NewField("latest_block_header", blockHeaderSchema()),
+		schema.NewField(
+			"block_roots",
+			schema.DefineList(schema.B32(), historicalRootsLimit),
+		),
+		schema.NewField(
+			"state_roots",
+			schema.DefineList(schema.B32(), historicalRootsLimit),
+		),
+		schema.NewField("eth1_data", eth1DataSchema()),
+		schema.NewField("eth1_deposit_index", schema.U64()),
+		schema.NewField(
+			"latest_execution_payload_header",
+			executionPayloadHeaderSchemaDeneb(),
+		),
+		schema.NewField(
+			"validators",
+			schema.DefineList(validatorSchema(), registryLimit),
+		),
+		schema.NewField(
+			"balances", schema.DefineList(schema.U64(), registryLimit),
+		),
+		schema.NewField(
+			"randao_mixes",
+			schema.DefineList(schema.B32(), randaoMixesLimit),
+		),
+		schema.NewField("next_withdrawal_index", schema.U64()),
+		schema.NewField("next_withdrawal_validator_index", schema.U64()),
+		schema.NewField(
+			"slashings", schema.DefineList(schema.U64(), registryLimit),
+		),
+		schema.NewField("total_slashing", schema.U64()),
+	}
+}
+
+// blockHeaderSchema returns the SSZ schema of a beacon block header.
+func blockHeaderSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("proposer_index", schema.U64()),
+		schema.NewField("parent_root", schema.B32()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("body_root", schema.B32()),
+	)
+}
+
+// pendingConsolidationSchema returns the SSZ schema of a pending
+// consolidation.
+func pendingConsolidationSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("source_index", schema.U64()),
+		schema.NewField("target_index", schema.U64()),
+	)
+}
+
+// forkSchema returns the SSZ schema of a fork.
+func forkSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("previous_version", schema.B4()),
+		schema.NewField("current_version", schema.B4()),
+		schema.NewField("epoch", schema.U64()),
+	)
+}
+
+// eth1DataSchema returns the SSZ schema of the eth1 data.
+func eth1DataSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("deposit_root", schema.B32()),
+		schema.NewField("deposit_count", schema.U64()),
+		schema.NewField("block_hash", schema.B32()),
+	)
+}
+
+// executionPayloadHeaderSchemaDeneb returns the SSZ schema of the
+// execution payload header in the Deneb fork.
+func executionPayloadHeaderSchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("parent_hash", schema.B32()),
+		schema.NewField("fee_recipient", schema.B20()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("receipts_root", schema.B32()),
+		schema.NewField("logs_bloom", schema.B256()),
+		schema.NewField("prev_randao", schema.B32()),
+		schema.NewField("block_number", schema.U64()),
+		schema.NewField("gas_limit", schema.U64()),
+		schema.NewField("gas_used", schema.U64()),
+		schema.NewField("timestamp", schema.U64()),
+		schema.NewField(
+			"extra_data", schema.DefineByteList(extraDataLimit),
+		),
+		schema.NewField("base_fee_per_gas", schema.U256()),
+		schema.NewField("block_hash", schema.B32()),
+		schema.NewField("transactions_root", schema.B32()),
+		schema.NewField("withdrawals_root", schema.B32()),
+		schema.NewField("blob_gas_used", schema.U64()),
+		schema.NewField("excess_blob_gas", schema.U64()),
+	)
+}
+
+// validatorSchema returns the SSZ schema of a validator.
+func validatorSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("withdrawal_credentials", schema.B32()),
+		schema.NewField("effective_balance", schema.U64()),
+		schema.NewField("slashed", schema.Bool()),
+		schema.NewField("activation_eligibility_epoch", schema.U64()),
+		schema.NewField("activation_epoch", schema.U64()),
+		schema.NewField("exit_epoch", schema.U64()),
+		schema.NewField("withdrawable_epoch", schema.U64()),
+	)
+}
+
+// depositSchema returns the SSZ schema of a deposit.
+func depositSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("pubkey", schema.B48()),
+		schema.NewField("withdrawal_credentials", schema.B32()),
+		schema.NewField("amount", schema.U64()),
+		schema.NewField("signature", schema.B96()),
+		schema.NewField("index", schema.U64()),
+		schema.NewField("proof", schema.DefineVector(
+			schema.B32(), uint64(constants.DepositContractDepth)+1,
+		)),
+	)
+}
+
+// executionPayloadSchemaDeneb returns the SSZ schema of the execution
+// payload in the Deneb fork.
+func executionPayloadSchemaDeneb() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("parent_hash", schema.B32()),
+		schema.NewField("fee_recipient", schema.B20()),
+		schema.NewField("state_root", schema.B32()),
+		schema.NewField("receipts_root", schema.B32()),
+		schema.NewField("logs_bloom", schema.B256()),
+		schema.NewField("prev_randao", schema.B32()),
+		schema.NewField("block_number", schema.U64()),
+		schema.NewField("gas_limit", schema.U64()),
+		schema.NewField("gas_used", schema.U64()),
+		schema.NewField("timestamp", schema.U64()),
+		schema.NewField(
+			"extra_data", schema.DefineByteList(extraDataLimit),
+		),
+		schema.NewField("base_fee_per_gas", schema.U256()),
+		schema.NewField("block_hash", schema.B32()),
+		schema.NewField("transactions", schema.DefineList(
+			schema.DefineByteList(constants.MaxBytesPerTx),
+			constants.MaxTxsPerPayload,
+		)),
+		schema.NewField("withdrawals", schema.DefineList(
+			withdrawalSchema(), constants.MaxWithdrawalsPerPayload,
+		)),
+		schema.NewField("blob_gas_used", schema.U64()),
+		schema.NewField("excess_blob_gas", schema.U64()),
+	)
+}
+
+// withdrawalSchema returns the SSZ schema of a withdrawal.
+func withdrawalSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("index", schema.U64()),
+		schema.NewField("validator_index", schema.U64()),
+		schema.NewField("address", schema.B20()),
+		schema.NewField("amount", schema.U64()),
+	)
+}
+
+// slashingOperationsSchema returns the SSZ schema of the slashing operations
+// of a beacon block body.
+func slashingOperationsSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("proposer_slashings", schema.DefineList(
+			proposerSlashingSchema(), constants.MaxProposerSlashingsPerBlock,
+		)),
+		schema.NewField("attester_slashings", schema.DefineList(
+			attesterSlashingSchema(), constants.MaxAttesterSlashingsPerBlock,
+		)),
+		schema.NewField("slashing_info", schema.DefineList(
+			slashingInfoSchema(), constants.MaxSlashingInfoPerBlock,
+		)),
+		schema.NewField("attestations", schema.DefineList(
+			attestationDataSchema(), constants.MaxAttestationsPerBlock,
+		)),
+	)
+}
+
+// proposerSlashingSchema returns the SSZ schema of a proposer slashing.
+func proposerSlashingSchema() schema.SSZType {
+	signedHeader := schema.DefineContainer(
+		schema.NewField("message", blockHeaderSchema()),
+		schema.NewField("signature", schema.B96()),
+	)
+	return schema.DefineContainer(
+		schema.NewField("signed_header_1", signedHeader),
+		schema.NewField("signed_header_2", signedHeader),
+	)
+}
+
+// attesterSlashingSchema returns the SSZ schema of an attester slashing.
+func attesterSlashingSchema() schema.SSZType {
+	signedAttestation := schema.DefineContainer(
+		schema.NewField("data", attestationDataSchema()),
+		schema.NewField("signature", schema.B96()),
+	)
+	return schema.DefineContainer(
+		schema.NewField("attestation_1", signedAttestation),
+		schema.NewField("attestation_2", signedAttestation),
+	)
+}
+
+// attestationDataSchema returns the SSZ schema of an attestation data.
+func attestationDataSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("index", schema.U64()),
+		schema.NewField("beacon_block_root", schema.B32()),
+	)
+}
+
+// slashingInfoSchema returns the SSZ schema of a slashing info.
+func slashingInfoSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("slot", schema.U64()),
+		schema.NewField("index", schema.U64()),
+	)
+}
+
+// signedVoluntaryExitSchema returns the SSZ schema of a signed voluntary exit.
+func signedVoluntaryExitSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("message", schema.DefineContainer(
+			schema.NewField("epoch", schema.U64()),
+			schema.NewField("validator_index", schema.U64()),
+		)),
+		schema.NewField("signature", schema.B96()),
+	)
+}
+
+// voluntaryExitsSchema returns the SSZ schema of the voluntary exits of a
+// beacon block body.
+func voluntaryExitsSchema() schema.SSZType {
+	return schema.DefineList(
+		signedVoluntaryExitSchema(), constants.MaxVoluntaryExitsPerBlock,
+	)
+}
+
+// executionRequestsSchema returns the SSZ schema of the execution layer
+// requests.
+func executionRequestsSchema() schema.SSZType {
+	return schema.DefineContainer(
+		schema.NewField("deposits", schema.DefineList(
+			schema.DefineContainer(
+				schema.NewField("pubkey", schema.B48()),
+				schema.NewField("withdrawal_credentials", schema.B32()),
+				schema.NewField("amount", schema.U64()),
+				schema.NewField("signature", schema.B96()),
+				schema.NewField("index", schema.U64()),
+			),
+			constants.MaxDepositRequestsPerPayload,
+		)),
+		schema.NewField("withdrawals", schema.DefineList(
+			schema.DefineContainer(
+				schema.NewField("source_address", schema.B20()),
+				schema.NewField("validator_pubkey", schema.B48()),
+				schema.NewField("amount", schema.U64()),
+			),
+			constants.MaxWithdrawalRequestsPerPayload,
+		)),
+		schema.NewField("consolidations", schema.DefineList(
+			schema.DefineContainer(
+				schema.NewField("source_address", schema.B20()),
+				schema.NewField("source_pubkey", schema.B48()),
+				schema.NewField("target_pubkey", schema.B48()),
+			),
+			constants.MaxConsolidationRequestsPerPayload,
+		)),
+	)
+}
@@ -0,0 +1,701 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package merkle_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	ssz "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/schema"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	fastssz "github.com/ferranbt/fastssz"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaCase is an object path along with the root expected at the
+// generalized index the schema resolves the path to.
+type schemaCase struct {
+	path string
+	root common.Root
+}
+
+// requireSchemaMatchesTree checks that every path resolves, through the
+// schema, to a node of the tree with the expected root.
+func requireSchemaMatchesTree(
+	t *testing.T,
+	typ schema.SSZType,
+	tree *fastssz.Node,
+	cases []schemaCase,
+) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			_, gIndex, _, err := ssz.ObjectPath[uint64, common.Root](
+				tc.path,
+			).GetGeneralizedIndex(typ)
+			require.NoError(t, err)
+			node, err := tree.Get(int(gIndex))
+			require.NoError(t, err)
+			require.Equal(t, tc.root, common.Root(node.Hash()))
+		})
+	}
+}
+
+// u64Leaf returns the leaf of a uint64.
+func u64Leaf(v uint64) common.Root {
+	return packedLeaf(v)
+}
+
+// packedLeaf returns the leaf of uint64s packed into a single chunk.
+func packedLeaf(vs ...uint64) common.Root {
+	var leaf common.Root
+	for i, v := range vs {
+		binary.LittleEndian.PutUint64(leaf[8*i:], v)
+	}
+	return leaf
+}
+
+// bytesRoot returns the root of a byte vector.
+func bytesRoot(t *testing.T, bz []byte) common.Root {
+	t.Helper()
+	hh := fastssz.NewHasher()
+	hh.PutBytes(bz)
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	return root
+}
+
+// byteListRoot returns the root of a byte list with the given limit.
+func byteListRoot(t *testing.T, bz []byte, limit uint64) common.Root {
+	t.Helper()
+	hh := fastssz.NewHasher()
+	indx := hh.Index()
+	hh.Append(bz)
+	hh.MerkleizeWithMixin(indx, uint64(len(bz)), (limit+31)/32)
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	return root
+}
+
+// u256Root returns the leaf of a uint256.
+func u256Root(t *testing.T, v *math.U256) common.Root {
+	t.Helper()
+	bz, err := v.MarshalSSZ()
+	require.NoError(t, err)
+	return common.Root(bz)
+}
+
+func testBeaconState() *types.BeaconState[
+	*types.BeaconBlockHeader, *types.Eth1Data, *types.ExecutionPayloadHeader,
+	*types.Fork, *types.Validator, types.BeaconBlockHeader, types.Eth1Data,
+	types.ExecutionPayloadHeader, types.Fork, types.Validator,
+] {
+	return &types.BeaconState[
+		*types.BeaconBlockHeader, *types.Eth1Data,
+		*types.ExecutionPayloadHeader, *types.Fork, *types.Validator,
+		types.BeaconBlockHeader, types.Eth1Data, types.ExecutionPayloadHeader,
+		types.Fork, types.Validator,
+	]{
+		GenesisValidatorsRoot: common.Root{0x01},
+		Slot:                  2,
+		Fork: &types.Fork{
+			PreviousVersion: common.Version{0x03},
+			CurrentVersion:  common.Version{0x04},
+			Epoch:           5,
+		},
+		LatestBlockHeader: &types.BeaconBlockHeader{
+			Slot:            6,
+			ProposerIndex:   7,
+			ParentBlockRoot: common.Root{0x08},
+			StateRoot:       common.Root{0x09},
+			BodyRoot:        common.Root{0x0a},
+		},
+		BlockRoots: []common.Root{{0x0b}, {0x0c}},
+		StateRoots: []common.Root{{0x0d}, {0x0e}},
+		Eth1Data: &types.Eth1Data{
+			DepositRoot:  common.Root{0x0f},
+			DepositCount: 16,
+			BlockHash:    common.ExecutionHash{0x11},
+		},
+		Eth1DepositIndex: 18,
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeader{
+			ParentHash:       common.ExecutionHash{0x13},
+			FeeRecipient:     common.ExecutionAddress{0x14},
+			StateRoot:        common.Bytes32{0x15},
+			ReceiptsRoot:     common.Bytes32{0x16},
+			LogsBloom:        [256]byte{0x17, 255: 0x18},
+			Random:           common.Bytes32{0x19},
+			Number:           26,
+			GasLimit:         27,
+			GasUsed:          28,
+			Timestamp:        29,
+			ExtraData:        []byte{0x1e, 0x1f},
+			BaseFeePerGas:    math.NewU256(32),
+			BlockHash:        common.ExecutionHash{0x21},
+			TransactionsRoot: common.Root{0x22},
+			WithdrawalsRoot:  common.Root{0x23},
+			BlobGasUsed:      36,
+			ExcessBlobGas:    37,
+		},
+		Validators: []*types.Validator{
+			{Pubkey: [48]byte{0x26}},
+			{
+				Pubkey:                     [48]byte{0x27, 47: 0x28},
+				WithdrawalCredentials:      [32]byte{0x29},
+				EffectiveBalance:           42,
+				Slashed:                    true,
+				ActivationEligibilityEpoch: 43,
+				ActivationEpoch:            44,
+				ExitEpoch:                  45,
+				WithdrawableEpoch:          46,
+			},
+		},
+		Balances:                     []uint64{47, 48, 49, 50, 51},
+		RandaoMixes:                  []common.Bytes32{{0x34}, {0x35}},
+		NextWithdrawalIndex:          54,
+		NextWithdrawalValidatorIndex: 55,
+		Slashings:                    []uint64{56, 57},
+		TotalSlashing:                58,
+	}
+}
+
+func TestBeaconStateSchemaMatchesStateTree(t *testing.T) {
+	st := testBeaconState()
+	tree, err := st.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), common.Root(tree.Hash()))
+
+	header := st.LatestExecutionPayloadHeader
+	val := st.Validators[1]
+
+	requireSchemaMatchesTree(t, merkle.BeaconStateSchemaDeneb(), tree, []schemaCase{
+		{"genesis_validators_root", st.GenesisValidatorsRoot},
+		{"slot", u64Leaf(2)},
+		{"fork", st.Fork.HashTreeRoot()},
+		{"fork/previous_version", common.Root{0x03}},
+		{"fork/current_version", common.Root{0x04}},
+		{"fork/epoch", u64Leaf(5)},
+		{"latest_block_header", st.LatestBlockHeader.HashTreeRoot()},
+		{"latest_block_header/slot", u64Leaf(6)},
+		{"latest_block_header/proposer_index", u64Leaf(7)},
+		{"latest_block_header/parent_root", common.Root{0x08}},
+		{"latest_block_header/state_root", common.Root{0x09}},
+		{"latest_block_header/body_root", common.Root{0x0a}},
+		{"block_roots/1", common.Root{0x0c}},
+		{"block_roots/__len__", u64Leaf(2)},
+		{"state_roots/1", common.Root{0x0e}},
+		{"state_roots/__len__", u64Leaf(2)},
+		{"eth1_data", st.Eth1Data.HashTreeRoot()},
+		{"eth1_data/deposit_root", common.Root{0x0f}},
+		{"eth1_data/deposit_count", u64Leaf(16)},
+		{"eth1_data/block_hash", common.Root{0x11}},
+		{"eth1_deposit_index", u64Leaf(18)},
+		{"latest_execution_payload_header", header.HashTreeRoot()},
+		{"latest_execution_payload_header/parent_hash", common.Root{0x13}},
+		{"latest_execution_payload_header/fee_recipient", common.Root{0x14}},
+		{"latest_execution_payload_header/state_root", common.Root{0x15}},
+		{"latest_execution_payload_header/receipts_root", common.Root{0x16}},
+		{
+			"latest_execution_payload_header/logs_bloom",
+			bytesRoot(t, header.LogsBloom[:]),
+		},
+		{"latest_execution_payload_header/prev_randao", common.Root{0x19}},
+		{"latest_execution_payload_header/block_number", u64Leaf(26)},
+		{"latest_execution_payload_header/gas_limit", u64Leaf(27)},
+		{"latest_execution_payload_header/gas_used", u64Leaf(28)},
+		{"latest_execution_payload_header/timestamp", u64Leaf(29)},
+		{
+			"latest_execution_payload_header/extra_data",
+			byteListRoot(t, header.ExtraData, 32),
+		},
+		{
+			"latest_execution_payload_header/base_fee_per_gas",
+			u256Root(t, header.BaseFeePerGas),
+		},
+		{"latest_execution_payload_header/block_hash", common.Root{0x21}},
+		{
+			"latest_execution_payload_header/transactions_root",
+			common.Root{0x22},
+		},
+		{
+			"latest_execution_payload_header/withdrawals_root",
+			common.Root{0x23},
+		},
+		{"latest_execution_payload_header/blob_gas_used", u64Leaf(36)},
+		{"latest_execution_payload_header/excess_blob_gas", u64Leaf(37)},
+		{"validators/1", val.HashTreeRoot()},
+		{"validators/1/pubkey", bytesRoot(t, val.Pubkey[:])},
+		{"validators/1/withdrawal_credentials", common.Root{0x29}},
+		{"validators/1/effective_balance", u64Leaf(42)},
+		{"validators/1/slashed", common.Root{0x01}},
+		{"validators/1/activation_eligibility_epoch", u64Leaf(43)},
+		{"validators/1/activation_epoch", u64Leaf(44)},
+		{"validators/1/exit_epoch", u64Leaf(45)},
+		{"validators/1/withdrawable_epoch", u64Leaf(46)},
+		{"validators/__len__", u64Leaf(2)},
+		{"balances/2", packedLeaf(47, 48, 49, 50)},
+		{"balances/4", packedLeaf(51)},
+		{"balances/__len__", u64Leaf(5)},
+		{"randao_mixes/1", common.Root{0x35}},
+		{"randao_mixes/__len__", u64Leaf(2)},
+		{"next_withdrawal_index", u64Leaf(54)},
+		{"next_withdrawal_validator_index", u64Leaf(55)},
+		{"slashings/1", packedLeaf(56, 57)},
+		{"slashings/__len__", u64Leaf(2)},
+		{"total_slashing", u64Leaf(58)},
+	})
+}
+
+// testVersionedBeaconState returns the test beacon state in the layout of the
+// given fork version.
+func testVersionedBeaconState(
+	t *testing.T, forkVersion uint32,
+) *types.BeaconState[
+	*types.BeaconBlockHeader, *types.Eth1Data, *types.ExecutionPayloadHeader,
+	*types.Fork, *types.Validator, types.BeaconBlockHeader, types.Eth1Data,
+	types.ExecutionPayloadHeader, types.Fork, types.Validator,
+] {
+	t.Helper()
+	st := testBeaconState()
+	st, err := st.New(
+		forkVersion,
+		st.GenesisValidatorsRoot,
+		st.Slot,
+		st.Fork,
+		st.LatestBlockHeader,
+		st.BlockRoots,
+		st.StateRoots,
+		st.Eth1Data,
+		st.Eth1DepositIndex,
+		st.LatestExecutionPayloadHeader,
+		st.Validators,
+		st.Balances,
+		st.RandaoMixes,
+		st.NextWithdrawalIndex,
+		st.NextWithdrawalValidatorIndex,
+		st.Slashings,
+		st.TotalSlashing,
+		[]uint64{59, 60},
+		[]uint64{61, 62},
+		[]uint64{63},
+		[]*types.Eth1Data{
+			{DepositRoot: common.Root{0x44}, DepositCount: 68},
+			{
+				DepositRoot:  common.Root{0x45},
+				DepositCount: 69,
+				BlockHash:    common.ExecutionHash{0x46},
+			},
+		},
+		64,
+		map[math.ValidatorIndex]math.ValidatorIndex{65: 66, 0: 67},
+	)
+	require.NoError(t, err)
+	return st
+}
+
+func TestBeaconStateSchemaDenebPlusMatchesStateTree(t *testing.T) {
+	st := testVersionedBeaconState(t, version.DenebPlus)
+	tree, err := st.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), common.Root(tree.Hash()))
+
+	val := st.Validators[1]
+	typ, err := merkle.BeaconStateSchema(st.Version())
+	require.NoError(t, err)
+	requireSchemaMatchesTree(t, typ, tree, []schemaCase{
+		{"genesis_validators_root", st.GenesisValidatorsRoot},
+		{"latest_execution_payload_header/block_number", u64Leaf(26)},
+		{"latest_execution_payload_header/fee_recipient", common.Root{0x14}},
+		{"validators/1/pubkey", bytesRoot(t, val.Pubkey[:])},
+		{"total_slashing", u64Leaf(58)},
+		{"epoch_participation/1", packedLeaf(59, 60)},
+		{"epoch_participation/__len__", u64Leaf(2)},
+		{"inactivity_scores/0", packedLeaf(61, 62)},
+		{"inactivity_scores/__len__", u64Leaf(2)},
+		{"validator_powers/0", packedLeaf(63)},
+		{"validator_powers/__len__", u64Leaf(1)},
+		{"eth1_data_votes/0", st.Eth1DataVotes[0].HashTreeRoot()},
+		{"eth1_data_votes/1/deposit_root", common.Root{0x45}},
+		{"eth1_data_votes/1/deposit_count", u64Leaf(69)},
+		{"eth1_data_votes/1/block_hash", common.Root{0x46}},
+		{"eth1_data_votes/__len__", u64Leaf(2)},
+	})
+}
+
+func TestBeaconStateSchemaElectraMatchesStateTree(t *testing.T) {
+	st := testVersionedBeaconState(t, version.Electra)
+	tree, err := st.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, st.HashTreeRoot(), common.Root(tree.Hash()))
+
+	typ, err := merkle.BeaconStateSchema(st.Version())
+	require.NoError(t, err)
+	requireSchemaMatchesTree(t, typ, tree, []schemaCase{
+		{"latest_execution_payload_header/block_number", u64Leaf(26)},
+		{"validator_powers/0", packedLeaf(63)},
+		{"eth1_data_votes/0/deposit_count", u64Leaf(68)},
+		{"deposit_requests_start_index", u64Leaf(64)},
+		{
+			"pending_consolidations/0",
+			st.PendingConsolidations[0].HashTreeRoot(),
+		},
+		{"pending_consolidations/0/target_index", u64Leaf(67)},
+		{"pending_consolidations/1/source_index", u64Leaf(65)},
+		{"pending_consolidations/__len__", u64Leaf(2)},
+	})
+}
+
+func testBeaconBlockBody() *types.BeaconBlockBody {
+	return &types.BeaconBlockBody{
+		RandaoReveal: [96]byte{0x01, 95: 0x02},
+		Eth1Data: &types.Eth1Data{
+			DepositRoot:  common.Root{0x03},
+			DepositCount: 4,
+			BlockHash:    common.ExecutionHash{0x05},
+		},
+		Graffiti: [32]byte{0x06},
+		Deposits: []*types.Deposit{{
+			Pubkey:      [48]byte{0x07, 47: 0x08},
+			Credentials: types.WithdrawalCredentials{0x09},
+			Amount:      10,
+			Signature:   [96]byte{0x0b},
+			Index:       12,
+			Proof: [types.DepositProofLength]common.Root{
+				{0x0d}, 32: {0x0e},
+			},
+		}},
+		ExecutionPayload: &types.ExecutionPayload{
+			ParentHash:    common.ExecutionHash{0x0f},
+			FeeRecipient:  common.ExecutionAddress{0x10},
+			StateRoot:     common.Bytes32{0x11},
+			ReceiptsRoot:  common.Bytes32{0x12},
+			LogsBloom:     [256]byte{0x13},
+			Random:        common.Bytes32{0x14},
+			Number:        21,
+			GasLimit:      22,
+			GasUsed:       23,
+			Timestamp:     24,
+			ExtraData:     []byte{0x19},
+			BaseFeePerGas: math.NewU256(26),
+			BlockHash:     common.ExecutionHash{0x1b},
+			Transactions:  [][]byte{{0x1c}, {0x1d, 0x1e}},
+			Withdrawals: []*engineprimitives.Withdrawal{{
+				Index:     31,
+				Validator: 32,
+				Address:   common.ExecutionAddress{0x21},
+				Amount:    34,
+			}},
+			BlobGasUsed:   35,
+			ExcessBlobGas: 36,
+		},
+		BlobKzgCommitments: []eip4844.KZGCommitment{{0x25}, {0x26}},
+	}
+}
+
+// bodyCases returns the cases shared by the beacon block bodies of all forks,
+// prefixed with the given path.
+func bodyCases(
+	t *testing.T,
+	prefix string,
+	body *types.BeaconBlockBody,
+) []schemaCase {
+	t.Helper()
+	var (
+		deposit    = body.Deposits[0]
+		payload    = body.ExecutionPayload
+		withdrawal = payload.Withdrawals[0]
+	)
+	cases := []schemaCase{
+		{"randao_reveal", bytesRoot(t, body.RandaoReveal[:])},
+		{"eth1_data", body.Eth1Data.HashTreeRoot()},
+		{"eth1_data/deposit_root", common.Root{0x03}},
+		{"eth1_data/deposit_count", u64Leaf(4)},
+		{"eth1_data/block_hash", common.Root{0x05}},
+		{"graffiti", common.Root{0x06}},
+		{"deposits/0", deposit.HashTreeRoot()},
+		{"deposits/0/pubkey", bytesRoot(t, deposit.Pubkey[:])},
+		{"deposits/0/withdrawal_credentials", common.Root{0x09}},
+		{"deposits/0/amount", u64Leaf(10)},
+		{"deposits/0/signature", bytesRoot(t, deposit.Signature[:])},
+		{"deposits/0/index", u64Leaf(12)},
+		{"deposits/0/proof/0", common.Root{0x0d}},
+		{"deposits/0/proof/32", common.Root{0x0e}},
+		{"deposits/__len__", u64Leaf(1)},
+		{"execution_payload", payload.HashTreeRoot()},
+		{"execution_payload/parent_hash", common.Root{0x0f}},
+		{"execution_payload/fee_recipient", common.Root{0x10}},
+		{"execution_payload/state_root", common.Root{0x11}},
+		{"execution_payload/receipts_root", common.Root{0x12}},
+		{
+			"execution_payload/logs_bloom",
+			bytesRoot(t, payload.LogsBloom[:]),
+		},
+		{"execution_payload/prev_randao", common.Root{0x14}},
+		{"execution_payload/block_number", u64Leaf(21)},
+		{"execution_payload/gas_limit", u64Leaf(22)},
+		{"execution_payload/gas_used", u64Leaf(23)},
+		{"execution_payload/timestamp", u64Leaf(24)},
+		{
+			"execution_payload/extra_data",
+			byteListRoot(t, payload.ExtraData, 32),
+		},
+		{
+			"execution_payload/base_fee_per_gas",
+			u256Root(t, payload.BaseFeePerGas),
+		},
+		{"execution_payload/block_hash", common.Root{0x1b}},
+		{
+			"execution_payload/transactions/1",
+			byteListRoot(
+				t, payload.Transactions[1], constants.MaxBytesPerTx,
+			),
+		},
+		{"execution_payload/transactions/__len__", u64Leaf(2)},
+		{"execution_payload/withdrawals/0", withdrawal.HashTreeRoot()},
+		{"execution_payload/withdrawals/0/index", u64Leaf(31)},
+		{"execution_payload/withdrawals/0/validator_index", u64Leaf(32)},
+		{"execution_payload/withdrawals/0/address", common.Root{0x21}},
+		{"execution_payload/withdrawals/0/amount", u64Leaf(34)},
+		{"execution_payload/withdrawals/__len__", u64Leaf(1)},
+		{"execution_payload/blob_gas_used", u64Leaf(35)},
+		{"execution_payload/excess_blob_gas", u64Leaf(36)},
+		{
+			"blob_kzg_commitments/1",
+			bytesRoot(t, body.BlobKzgCommitments[1][:]),
+		},
+		{"blob_kzg_commitments/__len__", u64Leaf(2)},
+	}
+	for i := range cases {
+		cases[i].path = prefix + cases[i].path
+	}
+	return cases
+}
+
+func TestBeaconBlockBodySchemaMatchesBodyTree(t *testing.T) {
+	t.Run("deneb", func(t *testing.T) {
+		body := testBeaconBlockBody()
+		tree, err := body.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+
+		requireSchemaMatchesTree(
+			t, merkle.BeaconBlockBodySchemaDeneb(), tree,
+			bodyCases(t, "", body),
+		)
+	})
+
+	t.Run("deneb+", func(t *testing.T) {
+		body := testBeaconBlockBody()
+		body.Slashings = &types.SlashingOperations{
+			ProposerSlashings: []*types.ProposerSlashing{{
+				SignedHeader1: &types.SignedBeaconBlockHeader{
+					Header:    &types.BeaconBlockHeader{Slot: 40},
+					Signature: [96]byte{0x29},
+				},
+				SignedHeader2: &types.SignedBeaconBlockHeader{
+					Header: &types.BeaconBlockHeader{
+						BodyRoot: common.Root{0x2a},
+					},
+				},
+			}},
+			AttesterSlashings: []*types.AttesterSlashing{{
+				Attestation1: &types.SignedAttestationData{
+					Data: &types.AttestationData{Slot: 43},
+				},
+				Attestation2: &types.SignedAttestationData{
+					Data: &types.AttestationData{
+						BeaconBlockRoot: common.Root{0x2c},
+					},
+					Signature: [96]byte{0x2d},
+				},
+			}},
+			SlashingInfo: []*types.SlashingInfo{
+				{Slot: 46, Index: 47}, {Slot: 48, Index: 49},
+			},
+			Attestations: []*types.AttestationData{{
+				Slot: 52, Index: 53, BeaconBlockRoot: common.Root{0x36},
+			}},
+		}
+		body.VoluntaryExits = []*types.SignedVoluntaryExit{{
+			Message:   &types.VoluntaryExit{Epoch: 50, ValidatorIndex: 51},
+			Signature: [96]byte{0x34},
+		}}
+		tree, err := body.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+
+		var (
+			proposer = body.Slashings.ProposerSlashings[0]
+			attester = body.Slashings.AttesterSlashings[0]
+		)
+		requireSchemaMatchesTree(
+			t, merkle.BeaconBlockBodySchemaDenebPlus(), tree,
+			append(bodyCases(t, "", body), []schemaCase{
+				{"slashings", body.Slashings.HashTreeRoot()},
+				{"slashings/proposer_slashings/0", proposer.HashTreeRoot()},
+				{
+					"slashings/proposer_slashings/0/signed_header_1/" +
+						"message/slot",
+					u64Leaf(40),
+				},
+				{
+					"slashings/proposer_slashings/0/signed_header_1/" +
+						"signature",
+					bytesRoot(t, proposer.SignedHeader1.Signature[:]),
+				},
+				{
+					"slashings/proposer_slashings/0/signed_header_2/" +
+						"message/body_root",
+					common.Root{0x2a},
+				},
+				{"slashings/proposer_slashings/__len__", u64Leaf(1)},
+				{"slashings/attester_slashings/0", attester.HashTreeRoot()},
+				{
+					"slashings/attester_slashings/0/attestation_1/data/slot",
+					u64Leaf(43),
+				},
+				{
+					"slashings/attester_slashings/0/attestation_2/data/" +
+						"beacon_block_root",
+					common.Root{0x2c},
+				},
+				{
+					"slashings/attester_slashings/0/attestation_2/" +
+						"signature",
+					bytesRoot(t, attester.Attestation2.Signature[:]),
+				},
+				{"slashings/attester_slashings/__len__", u64Leaf(1)},
+				{"slashings/slashing_info/1/slot", u64Leaf(48)},
+				{"slashings/slashing_info/1/index", u64Leaf(49)},
+				{"slashings/slashing_info/__len__", u64Leaf(2)},
+				{"slashings/attestations/0/index", u64Leaf(53)},
+				{
+					"slashings/attestations/0/beacon_block_root",
+					common.Root{0x36},
+				},
+				{"slashings/attestations/__len__", u64Leaf(1)},
+				{
+					"voluntary_exits/0",
+					body.VoluntaryExits[0].HashTreeRoot(),
+				},
+				{"voluntary_exits/0/message/epoch", u64Leaf(50)},
+				{"voluntary_exits/0/message/validator_index", u64Leaf(51)},
+				{
+					"voluntary_exits/0/signature",
+					bytesRoot(t, body.VoluntaryExits[0].Signature[:]),
+				},
+				{"voluntary_exits/__len__", u64Leaf(1)},
+			}...),
+		)
+	})
+
+	t.Run("electra", func(t *testing.T) {
+		body := testBeaconBlockBody()
+		body.Slashings = &types.SlashingOperations{
+			SlashingInfo: []*types.SlashingInfo{{Slot: 46, Index: 47}},
+		}
+		body.VoluntaryExits = []*types.SignedVoluntaryExit{{
+			Message:   &types.VoluntaryExit{Epoch: 50, ValidatorIndex: 51},
+			Signature: [96]byte{0x34},
+		}}
+		body.ExecutionRequests = &types.ExecutionRequests{
+			Deposits: []*types.DepositRequest{{
+				Pubkey: [48]byte{0x3c},
+				Amount: 60,
+				Index:  61,
+			}},
+			Withdrawals: []*types.WithdrawalRequest{{
+				SourceAddress: common.ExecutionAddress{0x3e},
+				Amount:        62,
+			}},
+			Consolidations: []*types.ConsolidationRequest{
+				{TargetPubkey: [48]byte{0x3f}}, {},
+			},
+		}
+		tree, err := body.GetTree()
+		require.NoError(t, err)
+		require.Equal(t, body.HashTreeRoot(), common.Root(tree.Hash()))
+
+		requests := body.ExecutionRequests
+		requireSchemaMatchesTree(
+			t, merkle.BeaconBlockBodySchemaElectra(), tree,
+			append(bodyCases(t, "", body), []schemaCase{
+				{
+					"operations/slashings/slashing_info/0/index",
+					u64Leaf(47),
+				},
+				{"operations/voluntary_exits/0/message/epoch", u64Leaf(50)},
+				{"operations/voluntary_exits/__len__", u64Leaf(1)},
+				{"execution_requests", requests.HashTreeRoot()},
+				{
+					"execution_requests/deposits/0",
+					requests.Deposits[0].HashTreeRoot(),
+				},
+				{"execution_requests/deposits/0/amount", u64Leaf(60)},
+				{"execution_requests/deposits/0/index", u64Leaf(61)},
+				{"execution_requests/withdrawals/0/amount", u64Leaf(62)},
+				{
+					"execution_requests/consolidations/0/target_pubkey",
+					bytesRoot(t, requests.Consolidations[0].TargetPubkey[:]),
+				},
+				{"execution_requests/consolidations/__len__", u64Leaf(2)},
+			}...),
+		)
+	})
+}
+
+func TestBeaconBlockHeaderSchemaMatchesBlockTree(t *testing.T) {
+	body := testBeaconBlockBody()
+	body.Slashings = &types.SlashingOperations{
+		SlashingInfo: []*types.SlashingInfo{{Slot: 1, Index: 2}},
+	}
+	blk := &types.BeaconBlock{
+		Slot:          3,
+		ProposerIndex: 4,
+		ParentRoot:    common.Root{0x05},
+		StateRoot:     common.Root{0x06},
+		Body:          body,
+	}
+	tree, err := blk.GetTree()
+	require.NoError(t, err)
+	require.Equal(t, blk.GetHeader().HashTreeRoot(), common.Root(tree.Hash()))
+
+	// The tree of the block is the tree of its header with the body root
+	// expanded, so paths descend from the header into the body.
+	requireSchemaMatchesTree(
+		t, merkle.BeaconBlockHeaderSchemaDenebPlus(), tree,
+		append(bodyCases(t, "body_root/", body), []schemaCase{
+			{"slot", u64Leaf(3)},
+			{"proposer_index", u64Leaf(4)},
+			{"parent_root", common.Root{0x05}},
+			{"state_root", common.Root{0x06}},
+			{"body_root", body.HashTreeRoot()},
+			{"body_root/slashings/slashing_info/0/index", u64Leaf(2)},
+		}...),
+	)
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package proof
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/merkle"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/proof/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/utils"
+	ssz "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz/merkle"
+)
+
+// bodyRootField is the field of the beacon block header holding the root of
+// the block body.
+const bodyRootField = "body_root"
+
+// GetStateProof returns the leaves at the requested SSZ object paths of the
+// beacon state for the given state id, along with a multiproof that can be
+// verified against the beacon state root.
+func (h *Handler[
+	_, BeaconBlockHeaderT, _, _, ContextT, _, _,
+]) GetStateProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.StateProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromStateID(params.StateID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	stateTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	stateSchema, err := merkle.BeaconStateSchema(bsm.Version())
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info(
+		"Generating beacon state proof", "slot", slot, "paths", params.Paths,
+	)
+	leaves, proof, stateRoot, err := merkle.ProveObjectPaths(
+		stateTree, stateSchema, params.Paths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.ObjectProofResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader: blockHeader,
+		Root:              stateRoot,
+		Leaves:            leaves,
+		Proof:             proof,
+	}, nil
+}
+
+// GetBlockProof returns the leaves at the requested SSZ object paths of the
+// beacon block header for the given block id, along with a multiproof that
+// can be verified against the beacon block root. Paths descend into the
+// beacon state through the `state_root` field of the header, and into the
+// block body through the `body_root` field.
+func (h *Handler[
+	_, BeaconBlockHeaderT, _, _, ContextT, _, _,
+]) GetBlockProof(c ContextT) (any, error) {
+	params, err := utils.BindAndValidate[types.BlockProofRequest](
+		c, h.Logger(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := utils.SlotFromBlockID(params.BlockID, h.backend)
+	if err != nil {
+		return nil, err
+	}
+	slot, beaconState, blockHeader, err := h.resolveSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	bsm, err := beaconState.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	stateTree, err := bsm.GetTree()
+	if err != nil {
+		return nil, err
+	}
+
+	// The tree of the block is the tree of its header with the body root
+	// expanded, so it is only loaded when a path descends into the body.
+	headerSchema, err := merkle.BeaconBlockHeaderSchema(bsm.Version())
+	if err != nil {
+		return nil, err
+	}
+	headerTree, err := blockHeader.GetTree()
+	if err != nil {
+		return nil, err
+	}
+	if descendsIntoBody(params.Paths) {
+		blk, blkErr := h.backend.BlockAtSlot(slot)
+		if blkErr != nil {
+			return nil, blkErr
+		}
+		if headerSchema, err = merkle.BeaconBlockHeaderSchema(
+			blk.Version(),
+		); err != nil {
+			return nil, err
+		}
+		if headerTree, err = blk.GetTree(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Graft the beacon state tree under the state root of the header, so
+	// that the proof spans from the beacon block root into the state.
+	blockTree, err := merkle.GraftTree(
+		headerTree, ssz.GeneralizedIndex(merkle.StateGIndexDenebBlock),
+		stateTree,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	h.Logger().Info(
+		"Generating beacon block proof", "slot", slot, "paths", params.Paths,
+	)
+	leaves, proof, beaconBlockRoot, err := merkle.ProveObjectPaths(
+		blockTree, headerSchema, params.Paths,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if beaconBlockRoot != blockHeader.HashTreeRoot() {
+		return nil, merkle.ErrStateRootMismatch
+	}
+
+	return types.ObjectProofResponse[BeaconBlockHeaderT]{
+		BeaconBlockHeader: blockHeader,
+		Root:              beaconBlockRoot,
+		Leaves:            leaves,
+		Proof:             proof,
+	}, nil
+}
+
+// descendsIntoBody returns whether any of the paths descends into the body
+// of the beacon block.
+func descendsIntoBody(paths []string) bool {
+	for _, path := range paths {
+		if strings.HasPrefix(path, bodyRootField+"/") {
+			return true
+		}
+	}
+	return false
+}
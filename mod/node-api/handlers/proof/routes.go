@@ -28,7 +28,7 @@ import (
 )
 
 func (
-	h *Handler[_, _, _, ContextT, _, _],
+	h *Handler[_, _, _, _, ContextT, _, _],
 ) RegisterRoutes(logger log.Logger[any]) {
 	h.SetLogger(logger)
 	h.BaseHandler.AddRoutes([]*handlers.Route[ContextT]{
@@ -47,5 +47,15 @@ func (
 			Path:    "bkit/v1/proof/execution_fee_recipient/:execution_id",
 			Handler: h.GetExecutionFeeRecipient,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/state/:state_id",
+			Handler: h.GetStateProof,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "bkit/v1/proof/block/:block_id",
+			Handler: h.GetBlockProof,
+		},
 	})
 }
@@ -39,3 +39,17 @@ type ExecutionNumberRequest struct {
 type ExecutionFeeRecipientRequest struct {
 	types.ExecutionIDRequest
 }
+
+// StateProofRequest is the request for the `/proof/state/{state_id}`
+// endpoint.
+type StateProofRequest struct {
+	types.StateIDRequest
+	Paths []string `query:"path" validate:"required,max=64,dive,required"`
+}
+
+// BlockProofRequest is the request for the `/proof/block/{block_id}`
+// endpoint.
+type BlockProofRequest struct {
+	types.BlockIDRequest
+	Paths []string `query:"path" validate:"required,max=64,dive,required"`
+}
@@ -42,7 +42,8 @@ type BlockProposerResponse[BeaconBlockHeaderT any] struct {
 	// ValidatorPubkeyProof can be verified against the beacon block root. Use
 	// a Generalized Index of `z + (8 * ValidatorIndex)`, where z is the
 	// Generalized Index of the 0 validator pubkey in the beacon block. In
-	// the Deneb fork, z is 3254554418216960.
+	// the Deneb fork, z is 3254554418216960, and from the Deneb+ fork
+	// onwards z is 6350779162034176.
 	ValidatorPubkeyProof []common.Root `json:"validator_pubkey_proof"`
 }
 
@@ -60,7 +61,8 @@ type ExecutionNumberResponse[BeaconBlockHeaderT any] struct {
 	ExecutionNumber math.U64 `json:"execution_number"`
 
 	// ExecutionNumberProof can be verified against the beacon block root using
-	// a Generalized Index of 5894 in the Deneb fork.
+	// a Generalized Index of 5894 in the Deneb fork, and of 11526 from the
+	// Deneb+ fork onwards.
 	ExecutionNumberProof []common.Root `json:"execution_number_proof"`
 }
 
@@ -80,6 +82,44 @@ type ExecutionFeeRecipientResponse[BeaconBlockHeaderT any] struct {
 	ExecutionFeeRecipient common.ExecutionAddress `json:"execution_fee_recipient"`
 
 	// ExecutionFeeRecipientProof can be verified against the beacon block root
-	// using a Generalized Index of 5894 in the Deneb fork.
+	// using a Generalized Index of 5889 in the Deneb fork, and of 11521 from
+	// the Deneb+ fork onwards.
 	ExecutionFeeRecipientProof []common.Root `json:"execution_fee_recipient_proof"`
 }
+
+// ObjectProofResponse is the response for the `/proof/state/{state_id}` and
+// `/proof/block/{block_id}` endpoints.
+type ObjectProofResponse[BeaconBlockHeaderT any] struct {
+	// BeaconBlockHeader is the block header of the requested slot.
+	BeaconBlockHeader BeaconBlockHeaderT `json:"beacon_block_header"`
+
+	// Root is the root the proof verifies against, which is the beacon state
+	// root for state proofs and the beacon block root for block proofs.
+	Root common.Root `json:"root"`
+
+	// Leaves are the proven leaves, in the order of the requested paths.
+	Leaves []ObjectLeaf `json:"leaves"`
+
+	// Proof is the multiproof of the leaves, made of the nodes at the helper
+	// indices of the leaves in decreasing order of generalized index. For a
+	// single leaf, it is a regular Merkle branch.
+	Proof []common.Root `json:"proof"`
+}
+
+// ObjectLeaf is a leaf of the Merkle tree of an object, proven by an
+// ObjectProofResponse.
+type ObjectLeaf struct {
+	// Path is the SSZ object path of the leaf.
+	Path string `json:"path"`
+
+	// GeneralizedIndex is the generalized index of the leaf.
+	GeneralizedIndex math.U64 `json:"generalized_index"`
+
+	// Leaf is the 32 byte chunk at the generalized index. For composite
+	// objects it is the hash tree root of the object.
+	Leaf common.Root `json:"leaf"`
+
+	// Offset is the byte offset of the object within the leaf, for basic
+	// objects packed together in a single chunk.
+	Offset uint8 `json:"offset"`
+}
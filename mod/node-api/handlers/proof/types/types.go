@@ -28,6 +28,14 @@ import (
 	fastssz "github.com/ferranbt/fastssz"
 )
 
+// BeaconBlock is the interface for a beacon block.
+type BeaconBlock interface {
+	// GetTree is kept for FastSSZ compatibility.
+	GetTree() (*fastssz.Node, error)
+	// Version returns the fork version of the beacon block.
+	Version() uint32
+}
+
 // BeaconBlockHeader is the interface for a beacon block header.
 type BeaconBlockHeader interface {
 	constraints.SSZRootable
@@ -55,6 +63,8 @@ type BeaconState[
 type BeaconStateMarshallable interface {
 	// GetTree is kept for FastSSZ compatibility.
 	GetTree() (*fastssz.Node, error)
+	// Version returns the fork version of the layout of the beacon state.
+	Version() uint32
 }
 
 // ExecutionPayloadHeader is the interface for an execution payload header.
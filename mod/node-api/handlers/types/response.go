@@ -20,6 +20,31 @@
 
 package types
 
+const (
+	// ContentTypeSSZ is the content type of SSZ encoded responses.
+	ContentTypeSSZ = "application/octet-stream"
+	// HeaderConsensusVersion is the header carrying the fork version of a
+	// versioned response.
+	HeaderConsensusVersion = "Eth-Consensus-Version"
+)
+
+// SSZMarshaler is an object that can be SSZ encoded.
+type SSZMarshaler interface {
+	MarshalSSZ() ([]byte, error)
+}
+
+// VersionedResponse is the response for a fork versioned object. The object
+// is written as SSZ if the client accepts ContentTypeSSZ and as JSON
+// otherwise. In both cases the HeaderConsensusVersion header is set.
+type VersionedResponse struct {
+	// Version is the name of the fork of the object.
+	Version string
+	// JSON is the response written when the client requests JSON.
+	JSON any
+	// SSZ is the object written when the client requests SSZ.
+	SSZ SSZMarshaler
+}
+
 type DataResponse struct {
 	Data any `json:"data"`
 }
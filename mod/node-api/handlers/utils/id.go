@@ -54,9 +54,11 @@ func SlotFromStateID[StorageBackendT interface {
 // SlotFromBlockID returns a slot from the block ID.
 //
 // NOTE: `blockID` shares the same semantics as `stateID`, with the modification
-// of being able to query by beacon <blockRoot> instead of <stateRoot>.
+// of being able to query by beacon <blockRoot> instead of <stateRoot>. If no
+// beacon block matches the root, it is looked up as an execution block hash.
 func SlotFromBlockID[StorageBackendT interface {
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
 }](blockID string, storage StorageBackendT) (math.Slot, error) {
 	if slot, err := slotFromStateID(blockID); err == nil {
 		return slot, nil
@@ -67,7 +69,16 @@ func SlotFromBlockID[StorageBackendT interface {
 	if err != nil {
 		return 0, err
 	}
-	return storage.GetSlotByBlockRoot(root)
+	slot, err := storage.GetSlotByBlockRoot(root)
+	if err == nil {
+		return slot, nil
+	}
+	if slot, hashErr := storage.GetSlotByExecutionHash(
+		common.ExecutionHash(root),
+	); hashErr == nil {
+		return slot, nil
+	}
+	return 0, err
 }
 
 // SlotFromExecutionID returns a slot from the execution number ID.
@@ -80,12 +91,23 @@ func SlotFromBlockID[StorageBackendT interface {
 // number in decimal notation. For example 'n1722463215' corresponds to
 // the slot with execution number 1722463215. Providing just the string
 // '1722463215' (without the prefix 'n') will query for the beacon block with
-// slot 1722463215.
+// slot 1722463215. A hex encoded 32 byte root is looked up as an execution
+// block hash.
 func SlotFromExecutionID[StorageBackendT interface {
+	GetSlotByExecutionHash(hash common.ExecutionHash) (math.Slot, error)
 	GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
 }](executionID string, storage StorageBackendT) (math.Slot, error) {
 	if !IsExecutionNumberPrefix(executionID) {
-		return slotFromStateID(executionID)
+		if slot, err := slotFromStateID(executionID); err == nil {
+			return slot, nil
+		}
+
+		// We assume that the execution ID is an execution block hash.
+		hash, err := common.NewRootFromHex(executionID)
+		if err != nil {
+			return 0, err
+		}
+		return storage.GetSlotByExecutionHash(common.ExecutionHash(hash))
 	}
 
 	// Parse the execution number from the executionID.
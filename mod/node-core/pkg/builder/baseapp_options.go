@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/store"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
@@ -69,6 +70,22 @@ func WithProcessProposal(
 	}
 }
 
+// WithSnapshotExtensions registers extensions to the state sync snapshots of
+// the baseapp, if snapshots are enabled.
+func WithSnapshotExtensions(
+	extensions ...snapshottypes.ExtensionSnapshotter,
+) func(bApp *baseapp.BaseApp) {
+	return func(bApp *baseapp.BaseApp) {
+		manager := bApp.SnapshotManager()
+		if manager == nil {
+			return
+		}
+		if err := manager.RegisterExtensions(extensions...); err != nil {
+			panic(err)
+		}
+	}
+}
+
 // DefaultBaseappOptions returns the default baseapp options provided by the
 // Cosmos SDK.
 func DefaultBaseappOptions(
@@ -85,6 +102,15 @@ func DefaultBaseappOptions(
 		panic(err)
 	}
 
+	snapshotStore, err := server.GetSnapshotStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	snapshotOptions := snapshottypes.NewSnapshotOptions(
+		cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval)),
+		cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent)),
+	)
+
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
 	chainID := cast.ToString(appOpts.Get(flags.FlagChainID))
 	var reader *os.File
@@ -123,5 +149,6 @@ func DefaultBaseappOptions(
 			true,
 		),
 		baseapp.SetChainID(chainID),
+		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 	}
 }
@@ -32,7 +32,9 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/baseapp"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
+	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	dbm "github.com/cosmos/cosmos-db"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -97,6 +99,9 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		serviceRegistry *service.Registry
 		consensusEngine *components.ConsensusEngine
 		apiBackend      *components.NodeAPIBackend
+		stateArchive    *components.StateArchive
+		blockStore      *components.BlockStore
+		blobReactor     *components.BlobReactor
 		storeKey        = new(storetypes.KVStoreKey)
 		storeKeyDblPtr  = &storeKey
 	)
@@ -122,6 +127,9 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 		&serviceRegistry,
 		&consensusEngine,
 		&apiBackend,
+		&stateArchive,
+		&blockStore,
+		&blobReactor,
 	); err != nil {
 		panic(err)
 	}
@@ -135,12 +143,15 @@ func (nb *NodeBuilder[NodeT, LoggerT, LoggerConfigT]) Build(
 				WithCometParamStore(chainSpec),
 				WithPrepareProposal(consensusEngine.PrepareProposal),
 				WithProcessProposal(consensusEngine.ProcessProposal),
+				baseapp.AddClosers(stateArchive),
+				WithSnapshotExtensions(blockStore),
 			)...,
 		),
 	)
 	// TODO: so hood
 	apiBackend.AttachNode(nb.node)
 	nb.node.SetServiceRegistry(serviceRegistry)
+	nb.node.RegisterReactor(rp2p.BlobReactorName, blobReactor)
 
 	// TODO: put this in some post node creation hook/listener.
 	if err := nb.node.Start(context.Background()); err != nil {
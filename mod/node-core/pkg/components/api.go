@@ -41,9 +41,11 @@ func ProvideNodeAPIEngine() *NodeAPIEngine {
 type NodeAPIBackendInput struct {
 	depinject.In
 
-	ChainSpec      common.ChainSpec
-	StateProcessor *StateProcessor
-	StorageBackend *StorageBackend
+	ChainSpec         common.ChainSpec
+	StateArchive      *StateArchive
+	StateProcessor    *StateProcessor
+	StorageBackend    *StorageBackend
+	VoluntaryExitPool *VoluntaryExitPool
 }
 
 func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
@@ -67,12 +69,15 @@ func ProvideNodeAPIBackend(in NodeAPIBackendInput) *NodeAPIBackend {
 		*StorageBackend,
 		*Validator,
 		Validators,
+		*SignedVoluntaryExit,
 		*Withdrawal,
 		WithdrawalCredentials,
 	](
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.StateArchive,
+		in.VoluntaryExitPool,
 	)
 }
 
@@ -30,6 +30,7 @@ import (
 	eventsapi "github.com/berachain/beacon-kit/mod/node-api/handlers/events"
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 type NodeAPIHandlersInput struct {
@@ -60,7 +61,10 @@ func ProvideNodeAPIHandlers(
 
 func ProvideNodeAPIBeaconHandler(b *NodeAPIBackend) *BeaconAPIHandler {
 	return beaconapi.NewHandler[
+		*BeaconBlock,
 		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
 		NodeAPIContext,
 		*Fork,
 		*Validator,
@@ -75,22 +79,65 @@ func ProvideNodeAPIConfigHandler() *ConfigAPIHandler {
 	return configapi.NewHandler[NodeAPIContext]()
 }
 
-func ProvideNodeAPIDebugHandler() *DebugAPIHandler {
-	return debugapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPIDebugHandler(b *NodeAPIBackend) *DebugAPIHandler {
+	return debugapi.NewHandler[
+		*BeaconState, *BeaconStateMarshallable, NodeAPIContext,
+	](b)
+}
+
+// NodeAPIEventsHandlerInput is the input for the events API handler.
+type NodeAPIEventsHandlerInput struct {
+	depinject.In
+
+	BlockBroker           *BlockBroker
+	ChainSpec             common.ChainSpec
+	SidecarsBroker        *SidecarsBroker
+	ValidatorUpdateBroker *ValidatorUpdateBroker
+}
+
+func ProvideNodeAPIEventsHandler(
+	in NodeAPIEventsHandlerInput,
+) *EventsAPIHandler {
+	return eventsapi.NewHandler[
+		*BeaconBlockHeader,
+		*BeaconBlock,
+		*BlobSidecar,
+		*BlobSidecars,
+		NodeAPIContext,
+	](
+		in.BlockBroker,
+		in.SidecarsBroker,
+		in.ValidatorUpdateBroker,
+		in.ChainSpec.SlotsPerEpoch(),
+	)
 }
 
-func ProvideNodeAPIEventsHandler() *EventsAPIHandler {
-	return eventsapi.NewHandler[NodeAPIContext]()
+// NodeAPINodeHandlerInput is the input for the node API handler.
+type NodeAPINodeHandlerInput struct {
+	depinject.In
+
+	Backend              *NodeAPIBackend
+	CometBFTStatusClient *CometBFTStatusClient
+	EngineClient         *EngineClient
+	ExecutionEngine      *ExecutionEngine
+	ReportingService     *ReportingService
 }
 
-func ProvideNodeAPINodeHandler() *NodeAPIHandler {
-	return nodeapi.NewHandler[NodeAPIContext]()
+func ProvideNodeAPINodeHandler(in NodeAPINodeHandlerInput) *NodeAPIHandler {
+	return nodeapi.NewHandler[NodeAPIContext](
+		in.Backend,
+		in.CometBFTStatusClient,
+		in.EngineClient,
+		in.ExecutionEngine,
+		in.ReportingService.Version(),
+	)
 }
 
 func ProvideNodeAPIProofHandler(b *NodeAPIBackend) *ProofAPIHandler {
 	return proofapi.NewHandler[
-		*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-		NodeAPIContext, *ExecutionPayloadHeader, *Validator,
+		*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+		*BeaconStateMarshallable, NodeAPIContext, *ExecutionPayloadHeader,
+		*Validator,
 	](b)
 }
 
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package components
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/depinject"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/archive"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// StateArchiveInput is the input for the dep inject framework.
+type StateArchiveInput struct {
+	depinject.In
+
+	AppOpts        servertypes.AppOptions
+	BlockStore     *BlockStore
+	ChainSpec      common.ChainSpec
+	Config         *config.Config
+	StateProcessor *StateProcessor
+}
+
+// ProvideStateArchive is a function that provides the archive of historical
+// beacon states to the application.
+func ProvideStateArchive(in StateArchiveInput) (*StateArchive, error) {
+	var store *archive.KVStore
+	if in.Config.Archive.Enabled {
+		if !in.Config.BlockStoreService.Enabled {
+			return nil, errors.New(
+				"archive mode requires the block store service",
+			)
+		}
+
+		dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+		kvp, err := storev2.NewDB(
+			storev2.DBTypePebbleDB, archive.StoreName, dir, nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		store = archive.NewStore(storage.NewKVStoreProvider(kvp), kvp)
+	}
+
+	return archive.New[
+		*BeaconBlock,
+		*BeaconState,
+		*BeaconStateMarshallable,
+		*BlockStore,
+	](
+		in.Config.Archive,
+		store,
+		in.BlockStore,
+		in.StateProcessor,
+		archivedStateFactory(in.ChainSpec),
+	)
+}
+
+// archivedStateFactory returns a factory that builds in-memory beacon states
+// from the encodings of archived states.
+func archivedStateFactory(
+	cs common.ChainSpec,
+) archive.StateFactory[*BeaconState] {
+	return func(bz []byte) (*BeaconState, error) {
+		snapshot := new(BeaconStateMarshallable)
+		if err := snapshot.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+
+		kvStore := beacondb.New[
+			*BeaconBlockHeader,
+			*Eth1Data,
+			*ExecutionPayloadHeader,
+			*Fork,
+			*Validator,
+			Validators,
+		](
+			storage.NewKVStoreProvider(storev2.NewMemDB()),
+			&encoding.SSZInterfaceCodec[*ExecutionPayloadHeader]{},
+		).WithContext(context.Background())
+
+		st := new(BeaconState).NewFromDB(kvStore, cs)
+		return st, RestoreBeaconState(st, snapshot)
+	}
+}
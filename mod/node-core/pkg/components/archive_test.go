@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package components_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/config/pkg/spec"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	archiveNumValidators = 4
+	archiveNumSlots      = 68
+	archiveInterval      = 8
+)
+
+type appOptions map[string]any
+
+func (o appOptions) Get(key string) any { return o[key] }
+
+type kvStoreService struct {
+	corestore.KVStoreWithBatch
+}
+
+func (s *kvStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return s.KVStoreWithBatch
+}
+
+// newGenesisState returns a genesis state for archiveNumValidators
+// validators, in the fork version active at genesis.
+func newGenesisState(
+	t *testing.T, cs common.ChainSpec, sp *components.StateProcessor,
+) *components.BeaconState {
+	t.Helper()
+	kvStore := beacondb.New[
+		*components.BeaconBlockHeader,
+		*components.Eth1Data,
+		*components.ExecutionPayloadHeader,
+		*components.Fork,
+		*components.Validator,
+		components.Validators,
+	](
+		&kvStoreService{storev2.NewMemDB()},
+		&encoding.SSZInterfaceCodec[*components.ExecutionPayloadHeader]{},
+	).WithContext(context.Background())
+	st := new(components.BeaconState).NewFromDB(kvStore, cs)
+
+	deposits := make([]*components.Deposit, archiveNumValidators)
+	for i := range deposits {
+		deposits[i] = types.NewDeposit(
+			crypto.BLSPubkey{byte(i + 1)},
+			types.NewCredentialsFromExecutionAddress(
+				common.ExecutionAddress{byte(i + 1)},
+			),
+			math.Gwei(cs.MaxEffectiveBalance()),
+			crypto.BLSSignature{},
+			uint64(i),
+		)
+	}
+	header, err := types.DefaultGenesisExecutionPayloadHeaderDeneb()
+	require.NoError(t, err)
+	_, err = sp.InitializePreminedBeaconStateFromEth1(
+		st, deposits, header,
+		version.FromUint32[common.Version](cs.ActiveForkVersionForEpoch(0)),
+	)
+	require.NoError(t, err)
+	return st
+}
+
+// proposeBlock advances the state to the given slot and applies a new block
+// for that slot to it, returning the block with its state root set. From
+// Deneb+ onwards the block carries the votes of all validators but the last
+// one, and from Electra onwards the given execution requests.
+func proposeBlock(
+	t *testing.T,
+	cs common.ChainSpec,
+	sp *components.StateProcessor,
+	st *components.BeaconState,
+	slot math.Slot,
+	requests *components.ExecutionRequests,
+) *components.BeaconBlock {
+	t.Helper()
+	_, err := sp.ProcessSlots(st, slot)
+	require.NoError(t, err)
+
+	parent, err := st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	eth1Data, err := st.GetEth1Data()
+	require.NoError(t, err)
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	withdrawals, err := st.ExpectedWithdrawals()
+	require.NoError(t, err)
+
+	forkVersion := cs.ActiveForkVersionForSlot(slot)
+	blk, err := (&components.BeaconBlock{}).NewWithVersion(
+		slot, 0, parent.HashTreeRoot(), forkVersion,
+	)
+	require.NoError(t, err)
+	blk.Body.Eth1Data = eth1Data
+	blk.Body.RandaoReveal = crypto.BLSSignature{byte(slot)}
+	blk.Body.ExecutionPayload = &components.ExecutionPayload{
+		ParentHash:    lph.GetBlockHash(),
+		Number:        slot,
+		BlockHash:     common.ExecutionHash{byte(slot)},
+		ExtraData:     make([]byte, types.ExtraDataSize),
+		BaseFeePerGas: math.NewU256(0),
+		Withdrawals:   withdrawals,
+	}
+	if forkVersion >= version.DenebPlus {
+		attestations := make(
+			[]*components.AttestationData, archiveNumValidators-1,
+		)
+		for i := range attestations {
+			attestations[i] = (&components.AttestationData{}).New(
+				slot, math.U64(i), parent.HashTreeRoot(),
+			)
+		}
+		blk.Body.SetAttestations(attestations)
+	}
+	if forkVersion >= version.Electra {
+		blk.Body.SetExecutionRequests(requests)
+	}
+
+	_, err = sp.Transition(&components.Context{
+		Context:                 context.Background(),
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+		SkipValidateVotes:       true,
+	}, st, blk)
+	require.NoError(t, err)
+	blk.SetStateRoot(st.HashTreeRoot())
+	return blk
+}
+
+// requireReplay builds a chain across two epoch boundaries, with the given
+// execution requests in the blocks of their slots, and checks that every
+// state regenerated by the archive has the state root committed to by its
+// block.
+func requireReplay(
+	t *testing.T,
+	cs common.ChainSpec,
+	requests map[math.Slot]*components.ExecutionRequests,
+) *components.BeaconState {
+	t.Helper()
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().VerifySignature(
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil).Maybe()
+	sp := components.ProvideStateProcessor(components.StateProcessorInput{
+		ChainSpec: cs,
+		Signer:    signer,
+	})
+	blockStore := block.NewStore[*components.BeaconBlock](
+		&kvStoreService{storev2.NewMemDB()}, cs, noop.NewLogger[any](),
+	)
+
+	cfg := config.DefaultConfig()
+	cfg.BlockStoreService.Enabled = true
+	cfg.Archive.Enabled = true
+	cfg.Archive.SnapshotInterval = archiveInterval
+	stateArchive, err := components.ProvideStateArchive(
+		components.StateArchiveInput{
+			AppOpts:        appOptions{flags.FlagHome: t.TempDir()},
+			BlockStore:     blockStore,
+			ChainSpec:      cs,
+			Config:         cfg,
+			StateProcessor: sp,
+		},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, stateArchive.Close()) })
+
+	// Build the chain, archiving it as the chain service does.
+	st := newGenesisState(t, cs, sp)
+	genesisRoot := st.HashTreeRoot()
+	require.NoError(t, stateArchive.Snapshot(st))
+	blocks := make([]*components.BeaconBlock, archiveNumSlots+1)
+	for slot := math.Slot(1); slot <= archiveNumSlots; slot++ {
+		blocks[slot] = proposeBlock(t, cs, sp, st, slot, requests[slot])
+		require.NoError(t, blockStore.Set(blocks[slot]))
+		require.NoError(t, stateArchive.Snapshot(st))
+	}
+
+	archived, err := stateArchive.StateAtSlot(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, genesisRoot, archived.HashTreeRoot())
+
+	// Every regenerated state, whether read from a snapshot or replayed on
+	// top of one, has the state root committed to by its block.
+	for slot := math.Slot(archiveNumSlots); slot > 0; slot-- {
+		archived, err = stateArchive.StateAtSlot(context.Background(), slot)
+		require.NoError(t, err)
+		require.Equal(
+			t, blocks[slot].GetStateRoot(), archived.HashTreeRoot(),
+			"slot %d", slot,
+		)
+	}
+	return st
+}
+
+func TestStateArchive_Replay(t *testing.T) {
+	requireReplay(t, components.ProvideChainSpec(), nil)
+}
+
+func TestStateArchive_ReplayElectra(t *testing.T) {
+	data := spec.BaseSpec()
+	data.DenebPlusForkEpoch = 0
+	data.ElectraForkEpoch = 0
+	data.ShardCommitteePeriod = 0
+	cs := chain.NewChainSpec(data)
+
+	// A deposit request and a consolidation request are processed in the
+	// first epoch, and the votes of the last validator are missing.
+	st := requireReplay(t, cs, map[math.Slot]*components.ExecutionRequests{
+		2: {
+			Deposits: []*types.DepositRequest{
+				(*types.DepositRequest)(types.NewDeposit(
+					crypto.BLSPubkey{1},
+					types.NewCredentialsFromExecutionAddress(
+						common.ExecutionAddress{1},
+					),
+					1e9,
+					crypto.BLSSignature{},
+					archiveNumValidators,
+				)),
+			},
+			Consolidations: []*components.ConsolidationRequest{
+				types.NewConsolidationRequest(
+					common.ExecutionAddress{2},
+					crypto.BLSPubkey{2},
+					crypto.BLSPubkey{3},
+				),
+			},
+		},
+	})
+
+	// The replayed chain carries the state of the Electra fork.
+	bsm, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, version.Electra, bsm.Version())
+	require.Equal(
+		t, uint64(archiveNumValidators), bsm.DepositRequestsStartIndex,
+	)
+	require.Equal(t, []*types.PendingConsolidation{
+		types.NewPendingConsolidation(1, 2),
+	}, bsm.PendingConsolidations)
+	require.Equal(t, []uint64{5, 5, 5, 0}, bsm.EpochParticipation)
+	require.Equal(t, []uint64{0, 0, 0, 1}, bsm.InactivityScores)
+	require.NotContains(t, bsm.ValidatorPowers, uint64(0))
+
+	// Every block voted for the eth1 data, within a single voting period.
+	require.Len(t, bsm.Eth1DataVotes, archiveNumSlots)
+}
@@ -25,6 +25,8 @@ import (
 	"os"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/config"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -44,6 +46,7 @@ type AvailabilityStoreInput[
 	depinject.In
 	AppOpts   servertypes.AppOptions
 	ChainSpec common.ChainSpec
+	Cfg       *config.Config
 	Logger    LoggerT
 }
 
@@ -53,19 +56,39 @@ func ProvideAvailibilityStore[
 ](
 	in AvailabilityStoreInput[LoggerT],
 ) (*AvailabilityStore, error) {
-	return dastore.New[*BeaconBlockBody](
-		filedb.NewRangeDB(
-			filedb.NewDB(
-				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
-				),
-				filedb.WithFileExtension("ssz"),
-				filedb.WithDirectoryPermissions(os.ModePerm),
-				filedb.WithLogger(in.Logger),
-			),
+	fdb := filedb.NewDB(
+		filedb.WithRootDirectory(
+			cast.ToString(
+				in.AppOpts.Get(flags.FlagHome),
+			)+"/data/blobs",
 		),
+		filedb.WithFileExtension("ssz"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithFsync(in.Cfg.BlobStore.Fsync),
+		filedb.WithLogger(in.Logger),
+	)
+
+	// Clean up after any write interrupted by a crash and set aside entries
+	// that no longer match their checksum.
+	quarantined, err := fdb.Recover()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range quarantined {
+		in.Logger.Warn(
+			"quarantined corrupt blob sidecars",
+			"key", string(key),
+			"dir", filedb.QuarantineDir,
+		)
+	}
+
+	rangeDB := filedb.NewRangeDB(fdb)
+	if err = rangeDB.RebuildIndex(); err != nil {
+		return nil, err
+	}
+
+	return dastore.New[*BeaconBlockBody](
+		rangeDB,
 		in.Logger.With("service", "da-store"),
 		in.ChainSpec,
 	), nil
@@ -80,6 +103,8 @@ type AvailabilityPrunerInput[
 	AvailabilityStore *AvailabilityStore
 	BlockBroker       *BlockBroker
 	ChainSpec         common.ChainSpec
+	Checkpoints       *pruner.Checkpoints
+	Config            *config.Config
 	Logger            LoggerT
 }
 
@@ -96,7 +121,14 @@ func ProvideAvailabilityPruner[
 		return nil, errors.New("availability store does not have a range db")
 	}
 
-	subCh, err := in.BlockBroker.Subscribe()
+	window, err := AvailabilityRetentionWindow(in.Config, in.ChainSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	subCh, err := in.BlockBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
@@ -108,6 +140,8 @@ func ProvideAvailabilityPruner[
 		rangeDB,
 		manager.AvailabilityPrunerName,
 		subCh,
-		dastore.BuildPruneRangeFn[*BeaconBlock](in.ChainSpec),
+		dastore.BuildPruneRangeFn[*BeaconBlock](),
+		in.Checkpoints,
+		window,
 	), nil
 }
@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/flags"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/da"
@@ -30,6 +31,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
 	"github.com/spf13/cast"
@@ -37,38 +39,56 @@ import (
 
 // BlobProofVerifierInput is the input for the
 // dep inject framework.
-type BlobProofVerifierInput struct {
+type BlobProofVerifierInput[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+] struct {
 	depinject.In
 	AppOpts          servertypes.AppOptions
 	JSONTrustedSetup *gokzg4844.JSONTrustedSetup
+	Logger           LoggerT
 }
 
 // ProvideBlobProofVerifier is a function that provides the module to the
 // application.
-func ProvideBlobProofVerifier(
-	in BlobProofVerifierInput,
+func ProvideBlobProofVerifier[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+](
+	in BlobProofVerifierInput[LoggerT],
 ) (kzg.BlobProofVerifier, error) {
-	return kzg.NewBlobProofVerifier(
+	verifier, err := kzg.NewBlobProofVerifier(
 		cast.ToString(in.AppOpts.Get(flags.KZGImplementation)),
 		in.JSONTrustedSetup,
 	)
+	if err != nil {
+		return nil, err
+	}
+	in.Logger.Info(
+		"Using KZG implementation",
+		"implementation", verifier.GetImplementation(),
+	)
+	return verifier, nil
 }
 
 // BlobVerifierInput is the input for the BlobVerifier.
 type BlobVerifierInput struct {
 	depinject.In
 	BlobProofVerifier kzg.BlobProofVerifier
+	Cfg               *config.Config
 	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideBlobVerifier is a function that provides the BlobVerifier to the
 // depinject framework.
-func ProvideBlobVerifier(in BlobVerifierInput) *BlobVerifier {
+func ProvideBlobVerifier(in BlobVerifierInput) (*BlobVerifier, error) {
 	return dablob.NewVerifier[
 		*BeaconBlockHeader,
 		*BlobSidecar,
 		*BlobSidecars,
-	](in.BlobProofVerifier, in.TelemetrySink)
+	](
+		in.BlobProofVerifier,
+		in.Cfg.KZG.VerificationCacheSize,
+		in.TelemetrySink,
+	)
 }
 
 // BlobProcessorIn is the input for the BlobProcessor.
@@ -102,6 +122,42 @@ func ProvideBlobProcessor[
 	)
 }
 
+// BlobReactorIn is the input for the BlobReactor.
+type BlobReactorIn[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+] struct {
+	depinject.In
+
+	AvailabilityStore *AvailabilityStore
+	BlobProcessor     *BlobProcessor
+	BlockStore        *BlockStore
+	ChainSpec         common.ChainSpec
+	Logger            LoggerT
+}
+
+// ProvideBlobReactor is a function that provides the BlobReactor, which
+// gossips and serves blob sidecars over CometBFT p2p channels, to the
+// depinject framework.
+func ProvideBlobReactor[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+](
+	in BlobReactorIn[LoggerT],
+) *BlobReactor {
+	return rp2p.NewBlobReactor[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
+	](
+		in.ChainSpec,
+		in.Logger.With("service", "blob-reactor"),
+		in.AvailabilityStore,
+		in.BlockStore,
+		in.BlobProcessor,
+	)
+}
+
 // DAServiceIn is the input for the BlobService.
 type DAServiceIn[
 	LoggerT log.AdvancedLogger[any, LoggerT],
@@ -23,6 +23,7 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	blockservice "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -75,6 +76,8 @@ type BlockPrunerInput[
 
 	BlockBroker *BlockBroker
 	BlockStore  *BlockStore
+	ChainSpec   common.ChainSpec
+	Checkpoints *pruner.Checkpoints
 	Config      *config.Config
 	Logger      LoggerT
 }
@@ -85,7 +88,14 @@ func ProvideBlockPruner[
 ](
 	in BlockPrunerInput[LoggerT],
 ) (BlockPruner, error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	window, err := BlockRetentionWindow(in.Config, in.ChainSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	subCh, err := in.BlockBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
@@ -96,8 +106,8 @@ func ProvideBlockPruner[
 		in.BlockStore,
 		manager.BlockPrunerName,
 		subCh,
-		blockservice.BuildPruneRangeFn[*BeaconBlock](
-			in.Config.BlockStoreService,
-		),
+		blockservice.BuildPruneRangeFn[*BeaconBlock](),
+		in.Checkpoints,
+		window,
 	), nil
 }
@@ -21,48 +21,67 @@
 package components
 
 import (
+	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 )
 
+// blockReplaySize is the number of most recent block events retained for
+// subscribers resuming from a sequence number.
+const blockReplaySize = 64
+
+// BrokerInput is the input for the brokers.
+type BrokerInput struct {
+	depinject.In
+	TelemetrySink *metrics.TelemetrySink
+}
+
 // ProvideBlobBroker provides a blob feed for the depinject framework.
-func ProvideBlobBroker() *SidecarsBroker {
+func ProvideBlobBroker(in BrokerInput) *SidecarsBroker {
 	return broker.New[*SidecarEvent](
 		"blob-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideBlockBroker provides a block feed for the depinject framework.
-func ProvideBlockBroker() *BlockBroker {
+func ProvideBlockBroker(in BrokerInput) *BlockBroker {
 	return broker.New[*BlockEvent](
 		"blk-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
+		broker.WithReplaySize(blockReplaySize),
 	)
 }
 
 // ProvideGenesisBroker provides a genesis feed for the depinject framework.
-func ProvideGenesisBroker() *GenesisBroker {
+func ProvideGenesisBroker(in BrokerInput) *GenesisBroker {
 	return broker.New[*GenesisEvent](
 		"genesis-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideSlotBroker provides a slot feed for the depinject framework.
-func ProvideSlotBroker() *SlotBroker {
+func ProvideSlotBroker(in BrokerInput) *SlotBroker {
 	return broker.New[*SlotEvent](
 		"slot-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideStatusBroker provides a status feed.
-func ProvideStatusBroker() *StatusBroker {
+func ProvideStatusBroker(in BrokerInput) *StatusBroker {
 	return broker.New[*StatusEvent](
 		"status-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
 // ProvideValidatorUpdateBroker provides a validator updates feed.
-func ProvideValidatorUpdateBroker() *ValidatorUpdateBroker {
+func ProvideValidatorUpdateBroker(in BrokerInput) *ValidatorUpdateBroker {
 	return broker.New[*ValidatorUpdateEvent](
 		"validator-updates-broker",
+		broker.WithTelemetrySink(in.TelemetrySink),
 	)
 }
 
@@ -46,6 +46,7 @@ type ChainServiceInput[
 	LocalBuilder          *LocalBuilder
 	Logger                LoggerT
 	Signer                crypto.BLSSigner
+	StateArchive          *StateArchive
 	StateProcessor        *StateProcessor
 	StorageBackend        *StorageBackend
 	TelemetrySink         *metrics.TelemetrySink
@@ -65,6 +66,8 @@ func ProvideChainService[
 		*BeaconBlockHeader,
 		*BeaconState,
 		*Deposit,
+		*DepositStore,
+		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*Genesis,
@@ -76,6 +79,7 @@ func ProvideChainService[
 		in.ExecutionEngine,
 		in.LocalBuilder,
 		in.StateProcessor,
+		in.StateArchive,
 		in.TelemetrySink,
 		in.GenesisBrocker,
 		in.BlockBroker,
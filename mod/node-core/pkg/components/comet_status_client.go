@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// cometBFTRPCAddrKey is the key of the CometBFT RPC listen address in the
+// CometBFT configuration.
+const cometBFTRPCAddrKey = "rpc.laddr"
+
+// CometBFTStatusClientInput is the input for the CometBFT status client.
+type CometBFTStatusClientInput struct {
+	depinject.In
+
+	AppOpts servertypes.AppOptions
+}
+
+// ProvideCometBFTStatusClient provides a client to query the sync status of
+// the local CometBFT node.
+func ProvideCometBFTStatusClient(
+	in CometBFTStatusClientInput,
+) (*CometBFTStatusClient, error) {
+	rpcAddr := cast.ToString(in.AppOpts.Get(cometBFTRPCAddrKey))
+	if rpcAddr == "" {
+		rpcAddr = cmtcfg.DefaultRPCConfig().ListenAddress
+	}
+	return comet.NewStatusClient(rpcAddr)
+}
@@ -38,6 +38,7 @@ func ProvideConsensusEngine(
 ) (*ConsensusEngine, error) {
 	return cometbft.NewConsensusEngine[
 		*AttestationData,
+		*BeaconBlockHeader,
 		*BeaconState,
 		*SlashingInfo,
 		*SlotData,
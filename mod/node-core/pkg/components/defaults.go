@@ -38,10 +38,12 @@ func DefaultComponents() []any {
 		ProvideBlockStoreService[LoggerT],
 		ProvideBlsSigner,
 		ProvideBlobProcessor[LoggerT],
-		ProvideBlobProofVerifier,
+		ProvideBlobProofVerifier[LoggerT],
+		ProvideBlobReactor[LoggerT],
 		ProvideBlobVerifier,
 		ProvideChainService[LoggerT],
 		ProvideChainSpec,
+		ProvideCometBFTStatusClient,
 		ProvideConfig,
 		ProvideConsensusEngine,
 		ProvideDAService[LoggerT],
@@ -51,17 +53,21 @@ func DefaultComponents() []any {
 		ProvideDepositStore,
 		ProvideEngineClient[LoggerT],
 		ProvideExecutionEngine[LoggerT],
+		ProvideExternalBuilder,
 		ProvideJWTSecret,
 		ProvideLocalBuilder[LoggerT],
+		ProvidePruneCheckpoints[LoggerT],
 		ProvideReportingService[LoggerT],
 		ProvideServiceRegistry[LoggerT],
 		ProvideSidecarFactory,
+		ProvideStateArchive,
 		ProvideStateProcessor,
 		ProvideKVStore,
 		ProvideStorageBackend,
 		ProvideTelemetrySink,
 		ProvideTrustedSetup,
 		ProvideValidatorService[LoggerT],
+		ProvideVoluntaryExitPool,
 		// TODO Hacks
 		ProvideKVStoreService,
 		ProvideKVStoreKey,
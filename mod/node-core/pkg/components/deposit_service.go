@@ -24,6 +24,7 @@ import (
 	"errors"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
@@ -52,7 +53,9 @@ func ProvideDepositService[
 ](
 	in DepositServiceIn[LoggerT],
 ) (*DepositService, error) {
-	blkSub, err := in.BlockBroker.Subscribe()
+	blkSub, err := in.BlockBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, errors.New("failed to subscribe to block feed")
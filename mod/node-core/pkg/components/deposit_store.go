@@ -23,6 +23,8 @@ package components
 import (
 	"cosmossdk.io/depinject"
 	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
@@ -63,6 +65,8 @@ type DepositPrunerInput[
 	depinject.In
 	BlockBroker  *BlockBroker
 	ChainSpec    common.ChainSpec
+	Checkpoints  *pruner.Checkpoints
+	Config       *config.Config
 	DepositStore *DepositStore
 	Logger       LoggerT
 }
@@ -73,7 +77,14 @@ func ProvideDepositPruner[
 ](
 	in DepositPrunerInput[LoggerT],
 ) (DepositPruner, error) {
-	subCh, err := in.BlockBroker.Subscribe()
+	window, err := DepositRetentionWindow(in.Config, in.ChainSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	subCh, err := in.BlockBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to block feed", "err", err)
 		return nil, err
@@ -90,5 +101,7 @@ func ProvideDepositPruner[
 			*Deposit,
 			WithdrawalCredentials,
 		](in.ChainSpec),
+		in.Checkpoints,
+		window,
 	), nil
 }
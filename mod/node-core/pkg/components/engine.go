@@ -52,17 +52,32 @@ func ProvideEngineClient[
 	LoggerT log.AdvancedLogger[any, LoggerT],
 ](
 	in EngineClientInputs[LoggerT],
-) *EngineClient {
+) (*EngineClient, error) {
+	// Load the JWT secrets of the backup endpoints that configure their own.
+	cfg := in.Config.GetEngine()
+	backupJWTSecrets := make([]*jwt.Secret, len(cfg.BackupEndpoints))
+	for i, backup := range cfg.BackupEndpoints {
+		if backup.JWTSecretPath == "" {
+			continue
+		}
+		secret, err := LoadJWTFromFile(backup.JWTSecretPath)
+		if err != nil {
+			return nil, err
+		}
+		backupJWTSecrets[i] = secret
+	}
+
 	return client.New[
 		*ExecutionPayload,
 		*PayloadAttributes,
 	](
-		in.Config.GetEngine(),
+		cfg,
 		in.Logger.With("service", "engine.client"),
 		in.JWTSecret,
+		backupJWTSecrets,
 		in.TelemetrySink,
 		new(big.Int).SetUint64(in.ChainSpec.DepositEth1ChainID()),
-	)
+	), nil
 }
 
 // EngineClientInputs is the input for the EngineClient.
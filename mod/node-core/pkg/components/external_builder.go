@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+)
+
+// ExternalBuilderInput is the input for the dep inject framework.
+type ExternalBuilderInput struct {
+	depinject.In
+	Cfg       *config.Config
+	ChainSpec common.ChainSpec
+	Signer    crypto.BLSSigner
+}
+
+// ProvideExternalBuilder provides the external block builder client, or nil
+// if proposing payloads of an external builder is not enabled.
+func ProvideExternalBuilder(
+	in ExternalBuilderInput,
+) (*ExternalBuilder, error) {
+	if !in.Cfg.Validator.ExternalBuilder.Enabled {
+		return nil, nil
+	}
+	// The remote signer only signs typed requests, which do not cover the
+	// builder API messages.
+	if in.Cfg.Validator.RemoteSigner.Enabled {
+		return nil, relay.ErrUntypedSigningUnsupported
+	}
+	return relay.NewBuilder[*BeaconState](
+		in.Cfg.Validator.ExternalBuilder,
+		in.ChainSpec,
+		in.Signer,
+		in.Cfg.PayloadBuilder.SuggestedFeeRecipient,
+	)
+}
@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -34,6 +35,7 @@ type ABCIMiddlewareInput[
 ] struct {
 	depinject.In
 	BeaconBlockFeed       *BlockBroker
+	BlobReactor           *BlobReactor
 	ChainSpec             common.ChainSpec
 	GenesisBroker         *GenesisBroker
 	Logger                LoggerT
@@ -50,7 +52,9 @@ func ProvideABCIMiddleware[
 ](
 	in ABCIMiddlewareInput[LoggerT],
 ) (*ABCIMiddleware, error) {
-	validatorUpdatesSub, err := in.ValidatorUpdateBroker.Subscribe()
+	validatorUpdatesSub, err := in.ValidatorUpdateBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +64,7 @@ func ProvideABCIMiddleware[
 		in.ChainSpec,
 		in.Logger,
 		in.TelemetrySink,
+		in.BlobReactor,
 		in.GenesisBroker,
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
@@ -21,13 +21,21 @@
 package components
 
 import (
+	"os"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/config"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/payload/pkg/cache"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
 // LocalBuilderInput is an input for the dep inject framework.
@@ -35,11 +43,13 @@ type LocalBuilderInput[
 	LoggerT log.AdvancedLogger[any, LoggerT],
 ] struct {
 	depinject.In
+	AppOpts           servertypes.AppOptions
 	AttributesFactory *AttributesFactory
 	Cfg               *config.Config
 	ChainSpec         common.ChainSpec
 	ExecutionEngine   *ExecutionEngine
 	Logger            LoggerT
+	TelemetrySink     *metrics.TelemetrySink
 }
 
 // ProvideLocalBuilder provides a local payload builder for the
@@ -48,7 +58,11 @@ func ProvideLocalBuilder[
 	LoggerT log.AdvancedLogger[any, LoggerT],
 ](
 	in LocalBuilderInput[LoggerT],
-) *LocalBuilder {
+) (*LocalBuilder, error) {
+	payloadIDCache, err := providePayloadIDCache(in)
+	if err != nil {
+		return nil, err
+	}
 	return payloadbuilder.New[
 		*BeaconState, *ExecutionPayload, *ExecutionPayloadHeader,
 	](
@@ -56,10 +70,55 @@ func ProvideLocalBuilder[
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
-		cache.NewPayloadIDCache[
-			PayloadID,
-			[32]byte, math.Slot,
-		](),
+		payloadIDCache,
 		in.AttributesFactory,
+		in.TelemetrySink,
+	), nil
+}
+
+// providePayloadIDCache provides the payload ID cache of the local builder,
+// which persists the payloads in flight so that they survive a restart.
+func providePayloadIDCache[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+](
+	in LocalBuilderInput[LoggerT],
+) (*cache.PayloadIDCache[PayloadID, [32]byte, math.Slot], error) {
+	fdb := filedb.NewDB(
+		filedb.WithRootDirectory(
+			cast.ToString(
+				in.AppOpts.Get(flags.FlagHome),
+			)+"/data/payload",
+		),
+		filedb.WithFileExtension("bin"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(in.Logger),
 	)
+
+	// Payloads that can not be recovered are requested again when
+	// proposing, so a corrupt cache is dropped.
+	quarantined, err := fdb.Recover()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range quarantined {
+		in.Logger.Warn(
+			"quarantined corrupt payload IDs",
+			"key", string(key),
+			"dir", filedb.QuarantineDir,
+		)
+	}
+
+	payloadIDCache, err := cache.NewPersistentPayloadIDCache[
+		PayloadID, [32]byte, math.Slot,
+	](fdb)
+	if !errors.Is(err, cache.ErrMalformedPayloadIDs) {
+		return payloadIDCache, err
+	}
+	in.Logger.Warn("dropping malformed payload IDs", "error", err)
+	if err = fdb.Delete(cache.PayloadIDsKey); err != nil {
+		return nil, err
+	}
+	return cache.NewPersistentPayloadIDCache[
+		PayloadID, [32]byte, math.Slot,
+	](fdb)
 }
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package components
+
+import (
+	"os"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// PruneCheckpointsInput is the input for the ProvidePruneCheckpoints function
+// for the depinject framework.
+type PruneCheckpointsInput[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+] struct {
+	depinject.In
+	AppOpts servertypes.AppOptions
+	Logger  LoggerT
+}
+
+// ProvidePruneCheckpoints provides the checkpoints of the pruners.
+func ProvidePruneCheckpoints[
+	LoggerT log.AdvancedLogger[any, LoggerT],
+](
+	in PruneCheckpointsInput[LoggerT],
+) (*pruner.Checkpoints, error) {
+	fdb := filedb.NewDB(
+		filedb.WithRootDirectory(
+			cast.ToString(
+				in.AppOpts.Get(flags.FlagHome),
+			)+"/data/pruner",
+		),
+		filedb.WithFileExtension("bin"),
+		filedb.WithDirectoryPermissions(os.ModePerm),
+		filedb.WithLogger(in.Logger),
+	)
+
+	// A corrupt checkpoint is dropped, so that pruning falls back to the
+	// ranges of the blocks finalized from then on.
+	quarantined, err := fdb.Recover()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range quarantined {
+		in.Logger.Warn(
+			"quarantined corrupt prune checkpoint",
+			"key", string(key),
+			"dir", filedb.QuarantineDir,
+		)
+	}
+	return pruner.NewCheckpoints(fdb), nil
+}
+
+// BlockRetentionWindow returns the number of most recent slots of blocks kept
+// in the block store.
+func BlockRetentionWindow(
+	cfg *config.Config,
+	cs common.ChainSpec,
+) (uint64, error) {
+	// The archive replays blocks from the block store, so they are kept.
+	if cfg.Archive.Enabled {
+		return pruner.ArchiveWindow, nil
+	}
+	return cfg.Retention.Blocks.Window(cs.SlotsPerEpoch())
+}
+
+// AvailabilityRetentionWindow returns the number of most recent slots of blob
+// sidecars kept in the availability store.
+func AvailabilityRetentionWindow(
+	cfg *config.Config,
+	cs common.ChainSpec,
+) (uint64, error) {
+	window, err := cfg.Retention.Blobs.Window(cs.SlotsPerEpoch())
+	if err != nil {
+		return 0, err
+	}
+	return dastore.RetentionWindow(cs, window), nil
+}
+
+// DepositRetentionWindow returns the number of most recent slots whose
+// processed deposits are kept in the deposit store.
+func DepositRetentionWindow(
+	cfg *config.Config,
+	cs common.ChainSpec,
+) (uint64, error) {
+	return cfg.Retention.Deposits.Window(cs.SlotsPerEpoch())
+}
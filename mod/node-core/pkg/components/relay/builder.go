@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+// Package relay implements a client of the builder API served by external
+// block builders and MEV-boost relays.
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+const (
+	// validatorsPath is the path validators are registered at.
+	validatorsPath = "/eth/v1/builder/validators"
+	// headerPath is the path of the bid for a slot, parent hash and proposer.
+	headerPath = "/eth/v1/builder/header/%d/%s/%s"
+	// blindedBlocksPath is the path signed blinded blocks are submitted to.
+	blindedBlocksPath = "/eth/v1/builder/blinded_blocks"
+
+	// consensusVersion is the fork name of the data exchanged with the
+	// builder.
+	consensusVersion = "deneb"
+)
+
+// BeaconState is the beacon state the payload of a block is built on.
+type BeaconState interface {
+	// GetLatestExecutionPayloadHeader returns the header of the payload the
+	// next payload is built on.
+	GetLatestExecutionPayloadHeader() (*types.ExecutionPayloadHeader, error)
+	// GetGenesisValidatorsRoot returns the genesis validators root.
+	GetGenesisValidatorsRoot() (common.Root, error)
+}
+
+// Builder is a client of an external block builder. It registers the
+// validator with the builder and proposes the payload of the builder when
+// its bid beats the local payload.
+type Builder[BeaconStateT BeaconState] struct {
+	url          string
+	client       *http.Client
+	timeout      time.Duration
+	gasLimit     uint64
+	minBid       *math.U256
+	feeRecipient common.ExecutionAddress
+	chainSpec    common.ChainSpec
+	signer       crypto.BLSSigner
+}
+
+// NewBuilder creates a new external builder client from the given
+// configuration. Every request to the builder is bounded by the configured
+// timeout, or by the default timeout if none is configured.
+func NewBuilder[BeaconStateT BeaconState](
+	cfg validator.ExternalBuilderConfig,
+	chainSpec common.ChainSpec,
+	signer crypto.BLSSigner,
+	feeRecipient common.ExecutionAddress,
+) (*Builder[BeaconStateT], error) {
+	if cfg.URL == "" {
+		return nil, ErrExternalBuilderURLRequired
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = validator.DefaultExternalBuilderTimeout
+	}
+
+	return &Builder[BeaconStateT]{
+		url:          strings.TrimSuffix(cfg.URL, "/"),
+		client:       &http.Client{},
+		timeout:      timeout,
+		gasLimit:     cfg.GasLimit,
+		minBid:       math.Gwei(cfg.MinBidGwei).ToWei(),
+		feeRecipient: feeRecipient,
+		chainSpec:    chainSpec,
+		signer:       signer,
+	}, nil
+}
+
+// RegisterValidator registers the fee recipient and gas limit of the
+// validator with the builder.
+func (b *Builder[_]) RegisterValidator(ctx context.Context) error {
+	registration := &ValidatorRegistration{
+		FeeRecipient: b.feeRecipient,
+		GasLimit:     b.gasLimit,
+		//#nosec:G115 // the unix time is positive.
+		Timestamp: uint64(time.Now().Unix()),
+		Pubkey:    b.signer.PublicKey(),
+	}
+	signingRoot := types.ComputeSigningRoot(registration, b.builderDomain())
+	signature, err := b.signer.Sign(signingRoot[:])
+	if err != nil {
+		return err
+	}
+
+	return b.do(
+		ctx, http.MethodPost, validatorsPath,
+		[]*SignedValidatorRegistration{{
+			Message:   registration,
+			Signature: signature,
+		}},
+		nil,
+	)
+}
+
+// GetPayload requests a bid for the payload of the given block. If the bid
+// is valid and beats both the minimum bid and the value of the local
+// payload, the blinded block is signed and submitted to the builder, and
+// the payload revealed by the builder is returned once it is verified
+// against the bid.
+func (b *Builder[BeaconStateT]) GetPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	blk *types.BeaconBlock,
+	local engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload],
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	// The blinded blocks of the builder API can not carry the execution layer
+	// requests of Electra blocks.
+	if blk.Version() >= version.Electra {
+		return nil, ErrUnsupportedForkVersion
+	}
+	if local != nil && local.ShouldOverrideBuilder() {
+		return nil, ErrLocalPayloadPreferred
+	}
+
+	parent, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := b.getHeader(ctx, blk.GetSlot(), parent.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
+	if err = b.verifyBid(bid, parent, local); err != nil {
+		return nil, err
+	}
+
+	signedBlk, err := b.signBlindedBlock(st, blk, bid.Message)
+	if err != nil {
+		return nil, err
+	}
+	revealed, err := b.submitBlindedBlock(ctx, signedBlk)
+	if err != nil {
+		return nil, err
+	}
+	if err = b.verifyRevealedPayload(bid.Message, revealed); err != nil {
+		return nil, err
+	}
+
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*types.ExecutionPayload, *BlobsBundle,
+	]{
+		ExecutionPayload: revealed.ExecutionPayload,
+		BlockValue:       bid.Message.Value,
+		BlobsBundle:      revealed.BlobsBundle,
+	}, nil
+}
+
+// getHeader requests the bid of the builder for the payload of the given
+// slot built on top of the given parent hash.
+func (b *Builder[_]) getHeader(
+	ctx context.Context,
+	slot math.Slot,
+	parentHash common.ExecutionHash,
+) (*SignedBuilderBid, error) {
+	var res versionedResponse[*SignedBuilderBid]
+	if err := b.do(
+		ctx, http.MethodGet,
+		fmt.Sprintf(
+			headerPath, slot.Unwrap(), parentHash.Hex(),
+			b.signer.PublicKey().String(),
+		),
+		nil, &res,
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to get bid")
+	}
+	if res.Data == nil || res.Data.Message == nil ||
+		res.Data.Message.Header == nil || res.Data.Message.Value == nil {
+		return nil, errors.Wrap(ErrInvalidBid, "incomplete bid")
+	}
+	return res.Data, nil
+}
+
+// verifyBid verifies that the bid is signed by the builder, builds on the
+// parent payload and beats both the minimum bid and the local payload.
+func (b *Builder[_]) verifyBid(
+	bid *SignedBuilderBid,
+	parent *types.ExecutionPayloadHeader,
+	local engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload],
+) error {
+	msg := bid.Message
+	signingRoot := types.ComputeSigningRoot(msg, b.builderDomain())
+	if err := b.signer.VerifySignature(
+		msg.Pubkey, signingRoot[:], bid.Signature,
+	); err != nil {
+		return errors.Wrap(ErrInvalidBid, err.Error())
+	}
+
+	if msg.Header.GetParentHash() != parent.GetBlockHash() {
+		return errors.Wrapf(
+			ErrInvalidBid, "parent hash %s, expected %s",
+			msg.Header.GetParentHash(), parent.GetBlockHash(),
+		)
+	}
+	if uint64(len(msg.BlobKzgCommitments)) > b.chainSpec.MaxBlobsPerBlock() {
+		return errors.Wrapf(
+			ErrInvalidBid, "%d blob commitments, maximum is %d",
+			len(msg.BlobKzgCommitments), b.chainSpec.MaxBlobsPerBlock(),
+		)
+	}
+
+	if msg.Value.Cmp(b.minBid) < 0 {
+		return errors.Wrapf(
+			ErrBidBelowMinimum, "bid %s, minimum %s",
+			msg.Value.Dec(), b.minBid.Dec(),
+		)
+	}
+	if local != nil && local.GetValue() != nil &&
+		msg.Value.Cmp(local.GetValue()) <= 0 {
+		return errors.Wrapf(
+			ErrBidBelowLocalValue, "bid %s, local %s",
+			msg.Value.Dec(), local.GetValue().Dec(),
+		)
+	}
+	return nil
+}
+
+// signBlindedBlock signs the blinded block committing to the bid for the
+// given block with the proposer domain of the slot.
+func (b *Builder[BeaconStateT]) signBlindedBlock(
+	st BeaconStateT,
+	blk *types.BeaconBlock,
+	bid *BuilderBid,
+) (*SignedBlindedBeaconBlock, error) {
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	blinded := &BlindedBeaconBlock{
+		Slot:                   blk.GetSlot().Unwrap(),
+		ProposerIndex:          blk.GetProposerIndex().Unwrap(),
+		ParentBlockRoot:        blk.GetParentBlockRoot(),
+		ExecutionPayloadHeader: bid.Header,
+		BlobKzgCommitments:     bid.BlobKzgCommitments,
+	}
+	domain := types.NewForkData(
+		version.FromUint32[common.Version](
+			b.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+		),
+		genesisValidatorsRoot,
+	).ComputeDomain(b.chainSpec.DomainTypeProposer())
+
+	signingRoot := types.ComputeSigningRoot(blinded, domain)
+	signature, err := b.signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	return &SignedBlindedBeaconBlock{
+		Message:   blinded,
+		Signature: signature,
+	}, nil
+}
+
+// submitBlindedBlock submits the signed blinded block to the builder, which
+// reveals the payload and blobs bundle of its bid in return.
+func (b *Builder[_]) submitBlindedBlock(
+	ctx context.Context,
+	blk *SignedBlindedBeaconBlock,
+) (*ExecutionPayloadAndBlobsBundle, error) {
+	var res versionedResponse[*ExecutionPayloadAndBlobsBundle]
+	if err := b.do(
+		ctx, http.MethodPost, blindedBlocksPath, blk, &res,
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to submit blinded block")
+	}
+	if res.Data == nil || res.Data.ExecutionPayload == nil ||
+		res.Data.BlobsBundle == nil {
+		return nil, errors.Wrap(ErrPayloadMismatch, "incomplete payload")
+	}
+	return res.Data, nil
+}
+
+// verifyRevealedPayload verifies that the payload and blobs bundle revealed
+// by the builder are the ones committed to by its bid.
+func (b *Builder[_]) verifyRevealedPayload(
+	bid *BuilderBid,
+	revealed *ExecutionPayloadAndBlobsBundle,
+) error {
+	header, err := revealed.ExecutionPayload.ToHeader(
+		b.chainSpec.MaxWithdrawalsPerPayload(),
+		b.chainSpec.DepositEth1ChainID(),
+	)
+	if err != nil {
+		return err
+	}
+	if header.HashTreeRoot() != bid.Header.HashTreeRoot() {
+		return errors.Wrapf(
+			ErrPayloadMismatch, "payload %s, bid %s",
+			header.GetBlockHash(), bid.Header.GetBlockHash(),
+		)
+	}
+
+	bundle := revealed.BlobsBundle
+	if len(bundle.Commitments) != len(bid.BlobKzgCommitments) ||
+		len(bundle.Proofs) != len(bundle.Commitments) ||
+		len(bundle.Blobs) != len(bundle.Commitments) {
+		return errors.Wrap(ErrPayloadMismatch, "blobs bundle size")
+	}
+	for i, commitment := range bundle.Commitments {
+		if commitment != bid.BlobKzgCommitments[i] {
+			return errors.Wrapf(
+				ErrPayloadMismatch, "blob commitment %d", i,
+			)
+		}
+	}
+	return nil
+}
+
+// builderDomain returns the domain of builder API signatures, which is
+// computed with the genesis fork version and an empty genesis validators
+// root so that registrations and bids are valid across forks.
+func (b *Builder[_]) builderDomain() common.Domain {
+	return types.NewForkData(
+		version.FromUint32[common.Version](
+			b.chainSpec.ActiveForkVersionForEpoch(0),
+		),
+		common.Root{},
+	).ComputeDomain(b.chainSpec.DomainTypeApplicationMask())
+}
+
+// do sends a request to the builder and decodes the json response into
+// res, if res is not nil.
+func (b *Builder[_]) do(
+	ctx context.Context,
+	method, path string,
+	req any,
+	res any,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, b.timeout)
+	defer cancel()
+
+	var body []byte
+	if req != nil {
+		var err error
+		if body, err = json.Marshal(req); err != nil {
+			return err
+		}
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx, method, b.url+path, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Accept", "application/json")
+	if req != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+		httpReq.Header.Set("Eth-Consensus-Version", consensusVersion)
+	}
+
+	resp, err := b.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNoContent:
+		return ErrNoBid
+	case resp.StatusCode != http.StatusOK:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return errors.Wrapf(
+			ErrRequestFailed, "status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(msg)),
+		)
+	case res == nil:
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
@@ -0,0 +1,515 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/relay"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+var _ validator.ExternalBuilder[
+	*types.BeaconBlock, *mockState, *types.ExecutionPayload,
+] = (*relay.Builder[*mockState])(nil)
+
+var (
+	testSlot                  = math.Slot(10)
+	testFeeRecipient          = common.ExecutionAddress{0xfe}
+	testGenesisValidatorsRoot = common.Root{0x9e}
+	testParent                = &types.ExecutionPayloadHeader{
+		BlockHash:     common.ExecutionHash{0xaa},
+		BaseFeePerGas: math.NewU256(1),
+	}
+)
+
+// fakeSigner is a BLS signer whose signature of a message is the public key
+// of the signer followed by the message, since real BLS signatures are not
+// available in unit tests.
+type fakeSigner struct {
+	pubKey crypto.BLSPubkey
+}
+
+func (s *fakeSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubKey
+}
+
+func (s *fakeSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	var signature crypto.BLSSignature
+	copy(signature[copy(signature[:], s.pubKey[:]):], msg)
+	return signature, nil
+}
+
+func (*fakeSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	expected, _ := (&fakeSigner{pubKey: pubKey}).Sign(msg)
+	if signature != expected {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// mockState is the beacon state the payload is built on.
+type mockState struct{}
+
+func (*mockState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return testParent, nil
+}
+
+func (*mockState) GetGenesisValidatorsRoot() (common.Root, error) {
+	return testGenesisValidatorsRoot, nil
+}
+
+// mockRelay is a mock external builder serving the builder API.
+type mockRelay struct {
+	t         *testing.T
+	chainSpec common.ChainSpec
+	builder   *fakeSigner
+	proposer  crypto.BLSPubkey
+
+	mu            sync.Mutex
+	registrations []*relay.SignedValidatorRegistration
+	submitted     []*relay.SignedBlindedBeaconBlock
+	bid           *relay.SignedBuilderBid
+	revealed      *relay.ExecutionPayloadAndBlobsBundle
+	delay         time.Duration
+}
+
+func newMockRelay(
+	t *testing.T,
+	chainSpec common.ChainSpec,
+	proposer crypto.BLSPubkey,
+) *mockRelay {
+	t.Helper()
+	return &mockRelay{
+		t:         t,
+		chainSpec: chainSpec,
+		builder:   &fakeSigner{pubKey: crypto.BLSPubkey{0x02}},
+		proposer:  proposer,
+	}
+}
+
+// setBid sets a bid of the given value for the given payload and blobs
+// bundle, signed by the builder.
+func (m *mockRelay) setBid(
+	value uint64,
+	payload *types.ExecutionPayload,
+	bundle *relay.BlobsBundle,
+) {
+	m.t.Helper()
+	header, err := payload.ToHeader(
+		m.chainSpec.MaxWithdrawalsPerPayload(),
+		m.chainSpec.DepositEth1ChainID(),
+	)
+	require.NoError(m.t, err)
+
+	bid := &relay.BuilderBid{
+		Header:             header,
+		BlobKzgCommitments: bundle.Commitments,
+		Value:              math.NewU256(value),
+		Pubkey:             m.builder.PublicKey(),
+	}
+	signingRoot := types.ComputeSigningRoot(bid, builderDomain(m.chainSpec))
+	signature, err := m.builder.Sign(signingRoot[:])
+	require.NoError(m.t, err)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bid = &relay.SignedBuilderBid{Message: bid, Signature: signature}
+	m.revealed = &relay.ExecutionPayloadAndBlobsBundle{
+		ExecutionPayload: payload,
+		BlobsBundle:      bundle,
+	}
+}
+
+func (m *mockRelay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	time.Sleep(m.delay)
+
+	switch {
+	case r.Method == http.MethodPost &&
+		r.URL.Path == "/eth/v1/builder/validators":
+		var regs []*relay.SignedValidatorRegistration
+		if err := json.NewDecoder(r.Body).Decode(&regs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.registrations = append(m.registrations, regs...)
+		w.WriteHeader(http.StatusOK)
+
+	case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf(
+		"/eth/v1/builder/header/%d/%s/%s",
+		testSlot.Unwrap(), testParent.GetBlockHash().Hex(),
+		m.proposer.String(),
+	):
+		if m.bid == nil {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, m.bid)
+
+	case r.Method == http.MethodPost &&
+		r.URL.Path == "/eth/v1/builder/blinded_blocks":
+		var blk relay.SignedBlindedBeaconBlock
+		if err := json.NewDecoder(r.Body).Decode(&blk); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.submitted = append(m.submitted, &blk)
+		writeJSON(w, m.revealed)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (m *mockRelay) submittedBlocks() []*relay.SignedBlindedBeaconBlock {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.submitted
+}
+
+func writeJSON(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"version": "deneb",
+		"data":    data,
+	})
+}
+
+// builderDomain returns the domain of builder API signatures.
+func builderDomain(chainSpec common.ChainSpec) common.Domain {
+	return types.NewForkData(
+		version.FromUint32[common.Version](
+			chainSpec.ActiveForkVersionForEpoch(0),
+		),
+		common.Root{},
+	).ComputeDomain(chainSpec.DomainTypeApplicationMask())
+}
+
+// newTestPayload returns a payload built on the test parent and a blobs
+// bundle with a single blob.
+func newTestPayload() (*types.ExecutionPayload, *relay.BlobsBundle) {
+	return &types.ExecutionPayload{
+		ParentHash:    testParent.GetBlockHash(),
+		FeeRecipient:  common.ExecutionAddress{0xb0},
+		Number:        1,
+		GasLimit:      30_000_000,
+		Timestamp:     100,
+		ExtraData:     []byte{},
+		BaseFeePerGas: math.NewU256(7),
+		BlockHash:     common.ExecutionHash{0xbb},
+		Transactions:  [][]byte{{0x02, 0x01}},
+		Withdrawals:   []*engineprimitives.Withdrawal{},
+	}, &relay.BlobsBundle{
+		Commitments: []eip4844.KZGCommitment{{0xc0}},
+		Proofs:      []eip4844.KZGProof{{0xd0}},
+		Blobs:       []*eip4844.Blob{{0xe0}},
+	}
+}
+
+// localPayload returns a local payload envelope of the given value.
+func localPayload(
+	value uint64,
+	override bool,
+) engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload] {
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		*types.ExecutionPayload, *relay.BlobsBundle,
+	]{
+		ExecutionPayload: &types.ExecutionPayload{},
+		BlockValue:       math.NewU256(value),
+		BlobsBundle:      &relay.BlobsBundle{},
+		Override:         override,
+	}
+}
+
+func newTestBlock(t *testing.T, chainSpec common.ChainSpec) *types.BeaconBlock {
+	t.Helper()
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		testSlot, 3, common.Root{0x0b},
+		chainSpec.ActiveForkVersionForSlot(testSlot),
+	)
+	require.NoError(t, err)
+	return blk
+}
+
+func newTestBuilder(
+	t *testing.T,
+	minBidGwei uint64,
+) (*relay.Builder[*mockState], *mockRelay, *fakeSigner) {
+	t.Helper()
+	chainSpec := components.ProvideChainSpec()
+	proposer := &fakeSigner{pubKey: crypto.BLSPubkey{0x01}}
+
+	mock := newMockRelay(t, chainSpec, proposer.PublicKey())
+	srv := httptest.NewServer(mock)
+	t.Cleanup(srv.Close)
+
+	builder, err := relay.NewBuilder[*mockState](
+		validator.ExternalBuilderConfig{
+			Enabled:    true,
+			URL:        srv.URL + "/",
+			Timeout:    time.Second,
+			MinBidGwei: minBidGwei,
+			GasLimit:   36_000_000,
+		},
+		chainSpec,
+		proposer,
+		testFeeRecipient,
+	)
+	require.NoError(t, err)
+	return builder, mock, proposer
+}
+
+func TestNewBuilder_URLRequired(t *testing.T) {
+	_, err := relay.NewBuilder[*mockState](
+		validator.ExternalBuilderConfig{Enabled: true},
+		components.ProvideChainSpec(),
+		nil,
+		testFeeRecipient,
+	)
+	require.ErrorIs(t, err, relay.ErrExternalBuilderURLRequired)
+}
+
+func TestBuilder_RegisterValidator(t *testing.T) {
+	builder, mock, proposer := newTestBuilder(t, 0)
+	require.NoError(t, builder.RegisterValidator(context.Background()))
+
+	require.Len(t, mock.registrations, 1)
+	reg := mock.registrations[0]
+	require.Equal(t, testFeeRecipient, reg.Message.FeeRecipient)
+	require.Equal(t, uint64(36_000_000), reg.Message.GasLimit)
+	require.Equal(t, proposer.PublicKey(), reg.Message.Pubkey)
+	require.NotZero(t, reg.Message.Timestamp)
+
+	signingRoot := types.ComputeSigningRoot(
+		reg.Message, builderDomain(mock.chainSpec),
+	)
+	require.NoError(t, proposer.VerifySignature(
+		proposer.PublicKey(), signingRoot[:], reg.Signature,
+	))
+}
+
+func TestBuilder_GetPayload(t *testing.T) {
+	builder, mock, proposer := newTestBuilder(t, 1)
+	payload, bundle := newTestPayload()
+	mock.setBid(2e9, payload, bundle)
+	blk := newTestBlock(t, mock.chainSpec)
+
+	envelope, err := builder.GetPayload(
+		context.Background(), &mockState{}, blk, localPayload(1e9, false),
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.GetBlockHash(),
+		envelope.GetExecutionPayload().GetBlockHash())
+	require.Equal(t, payload.HashTreeRoot(),
+		envelope.GetExecutionPayload().HashTreeRoot())
+	require.Equal(t, math.NewU256(2e9), envelope.GetValue())
+	require.Equal(t, bundle.Commitments,
+		envelope.GetBlobsBundle().GetCommitments())
+
+	// The proposer committed to the bid for its block.
+	submitted := mock.submittedBlocks()
+	require.Len(t, submitted, 1)
+	blinded := submitted[0].Message
+	require.Equal(t, blk.GetSlot().Unwrap(), blinded.Slot)
+	require.Equal(t, blk.GetProposerIndex().Unwrap(), blinded.ProposerIndex)
+	require.Equal(t, blk.GetParentBlockRoot(), blinded.ParentBlockRoot)
+	require.Equal(t, mock.bid.Message.Header.HashTreeRoot(),
+		blinded.ExecutionPayloadHeader.HashTreeRoot())
+	require.Equal(t, bundle.Commitments, blinded.BlobKzgCommitments)
+
+	domain := types.NewForkData(
+		version.FromUint32[common.Version](
+			mock.chainSpec.ActiveForkVersionForSlot(testSlot),
+		),
+		testGenesisValidatorsRoot,
+	).ComputeDomain(mock.chainSpec.DomainTypeProposer())
+	signingRoot := types.ComputeSigningRoot(blinded, domain)
+	require.NoError(t, proposer.VerifySignature(
+		proposer.PublicKey(), signingRoot[:], submitted[0].Signature,
+	))
+}
+
+func TestBuilder_GetPayload_NoLocalPayload(t *testing.T) {
+	builder, mock, _ := newTestBuilder(t, 0)
+	payload, bundle := newTestPayload()
+	mock.setBid(1, payload, bundle)
+
+	envelope, err := builder.GetPayload(
+		context.Background(), &mockState{},
+		newTestBlock(t, mock.chainSpec), nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, payload.GetBlockHash(),
+		envelope.GetExecutionPayload().GetBlockHash())
+}
+
+func TestBuilder_GetPayload_Electra(t *testing.T) {
+	builder, mock, _ := newTestBuilder(t, 0)
+	payload, bundle := newTestPayload()
+	mock.setBid(2e9, payload, bundle)
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		testSlot, 3, common.Root{0x0b}, version.Electra,
+	)
+	require.NoError(t, err)
+
+	// The local payload is proposed, as the execution layer requests can
+	// not be committed to through the builder.
+	_, err = builder.GetPayload(
+		context.Background(), &mockState{}, blk, localPayload(1, false),
+	)
+	require.ErrorIs(t, err, relay.ErrUnsupportedForkVersion)
+	require.Empty(t, mock.submittedBlocks())
+}
+
+func TestBuilder_GetPayload_Rejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		minBidGwei  uint64
+		bidValue    uint64
+		local       engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload]
+		setup       func(*mockRelay)
+		expectedErr error
+	}{
+		{
+			name:        "no bid",
+			local:       localPayload(1, false),
+			setup:       func(m *mockRelay) { m.bid = nil },
+			expectedErr: relay.ErrNoBid,
+		},
+		{
+			name:        "below local value",
+			bidValue:    1e9,
+			local:       localPayload(1e9, false),
+			expectedErr: relay.ErrBidBelowLocalValue,
+		},
+		{
+			name:        "below minimum bid",
+			minBidGwei:  2,
+			bidValue:    1e9,
+			local:       localPayload(1, false),
+			expectedErr: relay.ErrBidBelowMinimum,
+		},
+		{
+			name:        "local payload preferred",
+			bidValue:    2e9,
+			local:       localPayload(1, true),
+			expectedErr: relay.ErrLocalPayloadPreferred,
+		},
+		{
+			name:     "invalid bid signature",
+			bidValue: 2e9,
+			local:    localPayload(1, false),
+			setup: func(m *mockRelay) {
+				m.bid.Signature = crypto.BLSSignature{0x01}
+			},
+			expectedErr: relay.ErrInvalidBid,
+		},
+		{
+			name:     "wrong parent",
+			bidValue: 2e9,
+			local:    localPayload(1, false),
+			setup: func(m *mockRelay) {
+				payload, bundle := newTestPayload()
+				payload.ParentHash = common.ExecutionHash{0x01}
+				m.setBid(2e9, payload, bundle)
+			},
+			expectedErr: relay.ErrInvalidBid,
+		},
+		{
+			name:     "revealed payload mismatch",
+			bidValue: 2e9,
+			local:    localPayload(1, false),
+			setup: func(m *mockRelay) {
+				m.revealed.ExecutionPayload.Transactions = [][]byte{{0x03}}
+			},
+			expectedErr: relay.ErrPayloadMismatch,
+		},
+		{
+			name:     "revealed blobs mismatch",
+			bidValue: 2e9,
+			local:    localPayload(1, false),
+			setup: func(m *mockRelay) {
+				m.revealed.BlobsBundle.Commitments = []eip4844.KZGCommitment{
+					{0xc1},
+				}
+			},
+			expectedErr: relay.ErrPayloadMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder, mock, _ := newTestBuilder(t, tt.minBidGwei)
+			payload, bundle := newTestPayload()
+			mock.setBid(tt.bidValue, payload, bundle)
+			if tt.setup != nil {
+				tt.setup(mock)
+			}
+
+			_, err := builder.GetPayload(
+				context.Background(), &mockState{},
+				newTestBlock(t, mock.chainSpec), tt.local,
+			)
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestBuilder_GetPayload_Timeout(t *testing.T) {
+	builder, mock, _ := newTestBuilder(t, 0)
+	payload, bundle := newTestPayload()
+	mock.setBid(2e9, payload, bundle)
+	mock.delay = 2 * time.Second
+
+	start := time.Now()
+	_, err := builder.GetPayload(
+		context.Background(), &mockState{},
+		newTestBlock(t, mock.chainSpec), localPayload(1, false),
+	)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*time.Second)
+	require.Empty(t, mock.submittedBlocks())
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrExternalBuilderURLRequired is returned when the external builder is
+	// enabled without a url.
+	ErrExternalBuilderURLRequired = errors.New("external builder url required")
+
+	// ErrUntypedSigningUnsupported is returned when the external builder is
+	// enabled with a signer that does not sign raw signing roots.
+	ErrUntypedSigningUnsupported = errors.New(
+		"external builder requires a signer of raw signing roots",
+	)
+
+	// ErrRequestFailed is returned when the external builder responds to a
+	// request with an error.
+	ErrRequestFailed = errors.New("external builder request failed")
+
+	// ErrNoBid is returned when the external builder has no bid for a slot.
+	ErrNoBid = errors.New("external builder has no bid")
+
+	// ErrLocalPayloadPreferred is returned when the execution client asks
+	// for the local payload to be proposed instead of a builder payload.
+	ErrLocalPayloadPreferred = errors.New(
+		"execution client prefers the local payload",
+	)
+
+	// ErrInvalidBid is returned when a bid of the external builder is
+	// malformed or does not build on the parent payload.
+	ErrInvalidBid = errors.New("invalid external builder bid")
+
+	// ErrBidBelowMinimum is returned when the value of a bid is below the
+	// configured minimum bid.
+	ErrBidBelowMinimum = errors.New("bid value below minimum bid")
+
+	// ErrBidBelowLocalValue is returned when the value of a bid does not
+	// exceed the value of the local payload.
+	ErrBidBelowLocalValue = errors.New(
+		"bid value does not exceed local payload value",
+	)
+
+	// ErrPayloadMismatch is returned when the payload revealed by the
+	// external builder does not match its bid.
+	ErrPayloadMismatch = errors.New(
+		"revealed payload does not match bid",
+	)
+
+	// ErrUnsupportedForkVersion is returned when a payload is requested for
+	// a block of a fork the external builder does not support.
+	ErrUnsupportedForkVersion = errors.New(
+		"fork version not supported by external builder",
+	)
+)
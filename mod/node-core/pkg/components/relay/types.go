@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package relay
+
+import (
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/karalabe/ssz"
+)
+
+// maxBlobCommitmentsPerBlock is the maximum number of blob commitments of a
+// bid, matching the limit of the beacon block body.
+const maxBlobCommitmentsPerBlock = 16
+
+// BlobsBundle is the blobs bundle revealed by the builder.
+type BlobsBundle = engineprimitives.BlobsBundleV1[
+	eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+]
+
+/* -------------------------------------------------------------------------- */
+/*                            ValidatorRegistration                           */
+/* -------------------------------------------------------------------------- */
+
+// ValidatorRegistration is the registration of the fee recipient and gas
+// limit preferences of a validator with a builder.
+type ValidatorRegistration struct {
+	FeeRecipient common.ExecutionAddress `json:"fee_recipient"`
+	GasLimit     uint64                  `json:"gas_limit,string"`
+	Timestamp    uint64                  `json:"timestamp,string"`
+	Pubkey       crypto.BLSPubkey        `json:"pubkey"`
+}
+
+// SignedValidatorRegistration is a validator registration signed by the
+// validator.
+type SignedValidatorRegistration struct {
+	Message   *ValidatorRegistration `json:"message"`
+	Signature crypto.BLSSignature    `json:"signature"`
+}
+
+// SizeSSZ returns the size of the ValidatorRegistration in SSZ.
+func (*ValidatorRegistration) SizeSSZ() uint32 {
+	//nolint:mnd // 20+8+8+48 = 84.
+	return 84
+}
+
+// DefineSSZ defines the SSZ encoding of the ValidatorRegistration.
+func (r *ValidatorRegistration) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineStaticBytes(codec, &r.FeeRecipient)
+	ssz.DefineUint64(codec, &r.GasLimit)
+	ssz.DefineUint64(codec, &r.Timestamp)
+	ssz.DefineStaticBytes(codec, &r.Pubkey)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the ValidatorRegistration.
+func (r *ValidatorRegistration) HashTreeRoot() common.Root {
+	return ssz.HashSequential(r)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 BuilderBid                                 */
+/* -------------------------------------------------------------------------- */
+
+// BuilderBid is the bid of a builder to build the payload of a block.
+type BuilderBid struct {
+	Header             *types.ExecutionPayloadHeader `json:"header"`
+	BlobKzgCommitments []eip4844.KZGCommitment       `json:"blob_kzg_commitments"`
+	Value              *math.U256                    `json:"value"`
+	Pubkey             crypto.BLSPubkey              `json:"pubkey"`
+}
+
+// SignedBuilderBid is a builder bid signed by the builder.
+type SignedBuilderBid struct {
+	Message   *BuilderBid         `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// SizeSSZ returns the size of the BuilderBid in SSZ.
+func (b *BuilderBid) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // 4+4+32+48 = 88.
+	var size uint32 = 88
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.Header)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding of the BuilderBid.
+func (b *BuilderBid) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineDynamicObjectOffset(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+	ssz.DefineUint256(codec, &b.Value)
+	ssz.DefineStaticBytes(codec, &b.Pubkey)
+
+	ssz.DefineDynamicObjectContent(codec, &b.Header)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BuilderBid.
+func (b *BuilderBid) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                             BlindedBeaconBlock                             */
+/* -------------------------------------------------------------------------- */
+
+// BlindedBeaconBlock is the proposal a proposer signs to have a builder
+// reveal the payload of its bid. It commits to the payload header and blob
+// commitments of the bid for the slot of the proposal. Unlike an Ethereum
+// blinded block it carries no state root or body, since the post state of
+// a block can only be computed once its payload is revealed.
+type BlindedBeaconBlock struct {
+	Slot                   uint64                        `json:"slot,string"`
+	ProposerIndex          uint64                        `json:"proposer_index,string"`
+	ParentBlockRoot        common.Root                   `json:"parent_root"`
+	ExecutionPayloadHeader *types.ExecutionPayloadHeader `json:"execution_payload_header"`
+	BlobKzgCommitments     []eip4844.KZGCommitment       `json:"blob_kzg_commitments"`
+}
+
+// SignedBlindedBeaconBlock is a blinded beacon block signed by the proposer.
+type SignedBlindedBeaconBlock struct {
+	Message   *BlindedBeaconBlock `json:"message"`
+	Signature crypto.BLSSignature `json:"signature"`
+}
+
+// SizeSSZ returns the size of the BlindedBeaconBlock in SSZ.
+func (b *BlindedBeaconBlock) SizeSSZ(fixed bool) uint32 {
+	//nolint:mnd // 8+8+32+4+4 = 56.
+	var size uint32 = 56
+	if fixed {
+		return size
+	}
+	size += ssz.SizeDynamicObject(b.ExecutionPayloadHeader)
+	size += ssz.SizeSliceOfStaticBytes(b.BlobKzgCommitments)
+	return size
+}
+
+// DefineSSZ defines the SSZ encoding of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) DefineSSZ(codec *ssz.Codec) {
+	ssz.DefineUint64(codec, &b.Slot)
+	ssz.DefineUint64(codec, &b.ProposerIndex)
+	ssz.DefineStaticBytes(codec, &b.ParentBlockRoot)
+	ssz.DefineDynamicObjectOffset(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesOffset(
+		codec, &b.BlobKzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+
+	ssz.DefineDynamicObjectContent(codec, &b.ExecutionPayloadHeader)
+	ssz.DefineSliceOfStaticBytesContent(
+		codec, &b.BlobKzgCommitments, maxBlobCommitmentsPerBlock,
+	)
+}
+
+// HashTreeRoot computes the SSZ hash tree root of the BlindedBeaconBlock.
+func (b *BlindedBeaconBlock) HashTreeRoot() common.Root {
+	return ssz.HashSequential(b)
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                  Responses                                 */
+/* -------------------------------------------------------------------------- */
+
+// ExecutionPayloadAndBlobsBundle is the payload and blobs bundle revealed by
+// the builder for a signed blinded block.
+type ExecutionPayloadAndBlobsBundle struct {
+	ExecutionPayload *types.ExecutionPayload `json:"execution_payload"`
+	BlobsBundle      *BlobsBundle            `json:"blobs_bundle"`
+}
+
+// versionedResponse is a response of the builder API carrying the fork
+// version of its data.
+type versionedResponse[T any] struct {
+	Version string `json:"version"`
+	Data    T      `json:"data"`
+}
@@ -24,6 +24,7 @@ import (
 	"path/filepath"
 
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -36,11 +37,15 @@ import (
 type BlsSignerInput struct {
 	depinject.In
 	AppOpts servertypes.AppOptions
+	Cfg     *config.Config
 	PrivKey LegacyKey `optional:"true"`
 }
 
 // ProvideBlsSigner is a function that provides the module to the application.
 func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	if in.Cfg.Validator.RemoteSigner.Enabled {
+		return signer.NewRemoteSigner(in.Cfg.Validator.RemoteSigner)
+	}
 	if in.PrivKey == [constants.BLSSecretKeyLength]byte{} {
 		// if no private key is provided, use privval signer
 		homeDir := cast.ToString(in.AppOpts.Get(clientFlags.FlagHome))
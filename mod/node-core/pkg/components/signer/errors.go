@@ -38,4 +38,28 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrUntypedSigningRequest is returned when a raw message is requested to
+	// be signed by a signer that only signs typed requests.
+	ErrUntypedSigningRequest = errors.New(
+		"signer only signs typed signing requests",
+	)
+
+	// ErrRemoteSignerURLRequired is returned when the remote signer is
+	// enabled without a url.
+	ErrRemoteSignerURLRequired = errors.New("remote signer url required")
+
+	// ErrRemoteSignerRequestFailed is returned when the remote signer
+	// responds to a request with an error.
+	ErrRemoteSignerRequestFailed = errors.New("remote signer request failed")
+
+	// ErrAmbiguousRemoteSignerKey is returned when no public key is
+	// configured and the remote signer does not hold exactly one key.
+	ErrAmbiguousRemoteSignerKey = errors.New(
+		"remote signer must hold exactly one key if none is configured",
+	)
+
+	// ErrInvalidTLSCACert is returned when the tls ca certificate contains no
+	// valid certificate.
+	ErrInvalidTLSCACert = errors.New("invalid tls ca certificate")
 )
@@ -0,0 +1,304 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+const (
+	// publicKeysPath is the path listing the keys held by the remote signer.
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	// signPath is the path of the signing endpoint, suffixed by the public
+	// key of the signing key.
+	signPath = "/api/v1/eth2/sign/"
+
+	// signingTypeRandaoReveal is the signing type of a randao reveal.
+	signingTypeRandaoReveal = "RANDAO_REVEAL"
+)
+
+// RemoteSigner is a BLS signer that delegates signing to a remote signing
+// service implementing the Web3Signer ETH2 API. The validator key never
+// leaves the remote signer.
+type RemoteSigner struct {
+	url     string
+	pubKey  crypto.BLSPubkey
+	client  *http.Client
+	timeout time.Duration
+}
+
+// NewRemoteSigner creates a new remote signer from the given configuration.
+// If no public key is configured, the key is discovered from the remote
+// signer, which must then hold exactly one key. Every request to the remote
+// signer is bounded by the configured timeout, or by the default timeout if
+// none is configured.
+func NewRemoteSigner(
+	cfg validator.RemoteSignerConfig,
+) (*RemoteSigner, error) {
+	if cfg.URL == "" {
+		return nil, ErrRemoteSignerURLRequired
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = validator.DefaultRemoteSignerTimeout
+	}
+
+	s := &RemoteSigner{
+		url:     strings.TrimSuffix(cfg.URL, "/"),
+		timeout: timeout,
+		client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+
+	if cfg.PublicKey != "" {
+		if err = s.pubKey.UnmarshalText([]byte(cfg.PublicKey)); err != nil {
+			return nil, errors.Wrap(err, "invalid remote signer public key")
+		}
+		return s, nil
+	}
+
+	if s.pubKey, err = s.fetchPublicKey(context.Background()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// PublicKey returns the public key of the remote signing key.
+func (s *RemoteSigner) PublicKey() crypto.BLSPubkey {
+	return s.pubKey
+}
+
+// Sign always fails, as the remote signer only signs typed requests so it
+// can enforce its slashing protection.
+func (s *RemoteSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrUntypedSigningRequest
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (RemoteSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	if ok := bls12381.PubKey(pubKey[:]).
+		VerifySignature(msg, signature[:]); !ok {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// SignRandaoReveal requests the remote signer to sign the randao reveal
+// for the given epoch.
+func (s *RemoteSigner) SignRandaoReveal(
+	ctx context.Context,
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+	epoch math.Epoch,
+	signingRoot common.Root,
+) (crypto.BLSSignature, error) {
+	return s.sign(ctx, &signingRequest{
+		Type:        signingTypeRandaoReveal,
+		ForkInfo:    newForkInfo(forkVersion, genesisValidatorsRoot),
+		SigningRoot: signingRoot,
+		RandaoReveal: &randaoReveal{
+			Epoch: uint64String(epoch),
+		},
+	})
+}
+
+// sign sends the given signing request to the remote signer.
+func (s *RemoteSigner) sign(
+	ctx context.Context,
+	req *signingRequest,
+) (crypto.BLSSignature, error) {
+	var (
+		res struct {
+			Signature crypto.BLSSignature `json:"signature"`
+		}
+		path = signPath + s.pubKey.String()
+	)
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return crypto.BLSSignature{}, err
+	}
+	if err = s.do(ctx, http.MethodPost, path, body, &res); err != nil {
+		return crypto.BLSSignature{}, errors.Wrapf(
+			err, "failed to sign %s", req.Type,
+		)
+	}
+	return res.Signature, nil
+}
+
+// fetchPublicKey fetches the public key held by the remote signer.
+func (s *RemoteSigner) fetchPublicKey(
+	ctx context.Context,
+) (crypto.BLSPubkey, error) {
+	var keys []crypto.BLSPubkey
+	if err := s.do(ctx, http.MethodGet, publicKeysPath, nil, &keys); err != nil {
+		return crypto.BLSPubkey{}, errors.Wrap(
+			err, "failed to fetch remote signer public keys",
+		)
+	}
+	if len(keys) != 1 {
+		return crypto.BLSPubkey{}, errors.Wrapf(
+			ErrAmbiguousRemoteSignerKey, "found %d keys", len(keys),
+		)
+	}
+	return keys[0], nil
+}
+
+// do sends a request to the remote signer and decodes the json response
+// into res.
+func (s *RemoteSigner) do(
+	ctx context.Context,
+	method, path string,
+	body []byte,
+	res any,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx, method, s.url+path, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return errors.Wrapf(
+			ErrRemoteSignerRequestFailed, "status %d: %s",
+			resp.StatusCode, strings.TrimSpace(string(msg)),
+		)
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// newTLSConfig builds the TLS configuration of the remote signer client.
+func newTLSConfig(cfg validator.RemoteSignerConfig) (*tls.Config, error) {
+	//#nosec:G402 // the minimum version is set.
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.TLSCertPath != "" || cfg.TLSKeyPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load tls client cert")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.TLSCAPath != "" {
+		caCert, err := os.ReadFile(cfg.TLSCAPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read tls ca cert")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, ErrInvalidTLSCACert
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// signingRequest is a typed signing request of the Web3Signer ETH2 API.
+type signingRequest struct {
+	Type         string        `json:"type"`
+	ForkInfo     *forkInfo     `json:"fork_info"`
+	SigningRoot  common.Root   `json:"signingRoot"`
+	RandaoReveal *randaoReveal `json:"randao_reveal,omitempty"`
+}
+
+// forkInfo is the fork information a signing root is computed with.
+type forkInfo struct {
+	Fork                  fork        `json:"fork"`
+	GenesisValidatorsRoot common.Root `json:"genesis_validators_root"`
+}
+
+// fork is the fork of a signing request.
+type fork struct {
+	PreviousVersion common.Version `json:"previous_version"`
+	CurrentVersion  common.Version `json:"current_version"`
+	Epoch           string         `json:"epoch"`
+}
+
+// randaoReveal is the payload of a randao reveal signing request.
+type randaoReveal struct {
+	Epoch string `json:"epoch"`
+}
+
+// newForkInfo returns the fork info of the given fork version. The fork
+// schedule is not tracked, so the version is used as both the previous and
+// current version of the fork.
+func newForkInfo(
+	forkVersion common.Version,
+	genesisValidatorsRoot common.Root,
+) *forkInfo {
+	return &forkInfo{
+		Fork: fork{
+			PreviousVersion: forkVersion,
+			CurrentVersion:  forkVersion,
+			Epoch:           "0",
+		},
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+}
+
+// uint64String formats the given integer as a decimal string.
+func uint64String[T ~uint64](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	_ crypto.BLSSigner       = (*signer.RemoteSigner)(nil)
+	_ validator.RandaoSigner = (*signer.RemoteSigner)(nil)
+)
+
+var (
+	testPubKey    = crypto.BLSPubkey{0x01, 0x02, 0x03}
+	testSignature = crypto.BLSSignature{0x0a, 0x0b, 0x0c}
+)
+
+// mockWeb3Signer is a mock remote signer serving the Web3Signer ETH2 API.
+type mockWeb3Signer struct {
+	mu       sync.Mutex
+	keys     []crypto.BLSPubkey
+	status   int
+	requests []map[string]any
+	paths    []string
+}
+
+func newMockWeb3Signer(keys ...crypto.BLSPubkey) *mockWeb3Signer {
+	return &mockWeb3Signer{keys: keys, status: http.StatusOK}
+}
+
+func (m *mockWeb3Signer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.paths = append(m.paths, r.URL.Path)
+	if m.status != http.StatusOK {
+		http.Error(w, "slashing protection triggered", m.status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodGet && r.URL.Path == "/api/v1/eth2/publicKeys" {
+		_ = json.NewEncoder(w).Encode(m.keys)
+		return
+	}
+
+	var req map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	m.requests = append(m.requests, req)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"signature": testSignature,
+	})
+}
+
+func (m *mockWeb3Signer) lastRequest() map[string]any {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requests[len(m.requests)-1]
+}
+
+func newTestSigner(
+	t *testing.T,
+	url string,
+) *signer.RemoteSigner {
+	t.Helper()
+	s, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+		Enabled:   true,
+		URL:       url,
+		PublicKey: testPubKey.String(),
+		Timeout:   time.Second,
+	})
+	require.NoError(t, err)
+	return s
+}
+
+func TestRemoteSigner_DiscoverPublicKey(t *testing.T) {
+	mock := newMockWeb3Signer(testPubKey)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	s, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+		Enabled: true,
+		URL:     srv.URL + "/",
+	})
+	require.NoError(t, err)
+	require.Equal(t, testPubKey, s.PublicKey())
+}
+
+func TestRemoteSigner_DiscoverPublicKeyAmbiguous(t *testing.T) {
+	for _, keys := range [][]crypto.BLSPubkey{
+		nil,
+		{testPubKey, {0x04}},
+	} {
+		srv := httptest.NewServer(newMockWeb3Signer(keys...))
+		_, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+			Enabled: true,
+			URL:     srv.URL,
+		})
+		require.ErrorIs(t, err, signer.ErrAmbiguousRemoteSignerKey)
+		srv.Close()
+	}
+}
+
+func TestRemoteSigner_URLRequired(t *testing.T) {
+	_, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+		Enabled: true,
+	})
+	require.ErrorIs(t, err, signer.ErrRemoteSignerURLRequired)
+}
+
+func TestRemoteSigner_SignRandaoReveal(t *testing.T) {
+	mock := newMockWeb3Signer()
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	var (
+		s           = newTestSigner(t, srv.URL)
+		forkVersion = version.FromUint32[common.Version](version.Deneb)
+		gvr         = common.Root{0x11}
+		signingRoot = common.Root{0x22}
+	)
+	sig, err := s.SignRandaoReveal(
+		context.Background(), forkVersion, gvr, 7, signingRoot,
+	)
+	require.NoError(t, err)
+	require.Equal(t, testSignature, sig)
+
+	require.Equal(
+		t, "/api/v1/eth2/sign/"+testPubKey.String(), mock.paths[0],
+	)
+	req := mock.lastRequest()
+	require.Equal(t, "RANDAO_REVEAL", req["type"])
+	require.Equal(t, signingRoot.String(), req["signingRoot"])
+	require.Equal(t, map[string]any{"epoch": "7"}, req["randao_reveal"])
+	require.Equal(t, map[string]any{
+		"fork": map[string]any{
+			"previous_version": forkVersion.String(),
+			"current_version":  forkVersion.String(),
+			"epoch":            "0",
+		},
+		"genesis_validators_root": gvr.String(),
+	}, req["fork_info"])
+	require.NotContains(t, req, "beacon_block")
+}
+
+func TestRemoteSigner_DefaultTimeout(t *testing.T) {
+	// The server never responds, so requests only end on their timeout.
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(_ http.ResponseWriter, r *http.Request) { <-r.Context().Done() },
+	))
+	defer srv.Close()
+
+	start := time.Now()
+	_, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+		Enabled: true,
+		URL:     srv.URL,
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 2*validator.DefaultRemoteSignerTimeout)
+}
+
+func TestRemoteSigner_SignError(t *testing.T) {
+	mock := newMockWeb3Signer()
+	mock.status = http.StatusPreconditionFailed
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	_, err := newTestSigner(t, srv.URL).SignRandaoReveal(
+		context.Background(), common.Version{}, common.Root{}, 1,
+		common.Root{},
+	)
+	require.ErrorIs(t, err, signer.ErrRemoteSignerRequestFailed)
+	require.ErrorContains(t, err, "slashing protection triggered")
+}
+
+func TestRemoteSigner_SignUntyped(t *testing.T) {
+	srv := httptest.NewServer(newMockWeb3Signer())
+	defer srv.Close()
+
+	_, err := newTestSigner(t, srv.URL).Sign([]byte("message"))
+	require.ErrorIs(t, err, signer.ErrUntypedSigningRequest)
+}
+
+func TestRemoteSigner_TLSClientCert(t *testing.T) {
+	var (
+		dir              = t.TempDir()
+		ca, caKey        = newTestCert(t, nil, nil, true)
+		serverCert, sKey = newTestCert(t, ca, caKey, false)
+		clientCert, cKey = newTestCert(t, ca, caKey, false)
+		caPath           = writePEM(t, dir, "ca.pem", "CERTIFICATE", ca.Raw)
+		certPath         = writePEM(
+			t, dir, "client.pem", "CERTIFICATE", clientCert.Raw,
+		)
+		keyPath = writePEM(
+			t, dir, "client-key.pem", "EC PRIVATE KEY", marshalKey(t, cKey),
+		)
+		pool = x509.NewCertPool()
+	)
+	pool.AddCert(ca)
+
+	srv := httptest.NewUnstartedServer(newMockWeb3Signer(testPubKey))
+	srv.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{serverCert.Raw},
+			PrivateKey:  sKey,
+		}},
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}
+	srv.StartTLS()
+	defer srv.Close()
+
+	// Without a client certificate the handshake is rejected.
+	_, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+		Enabled:   true,
+		URL:       srv.URL,
+		TLSCAPath: caPath,
+	})
+	require.Error(t, err)
+
+	s, err := signer.NewRemoteSigner(validator.RemoteSignerConfig{
+		Enabled:     true,
+		URL:         srv.URL,
+		TLSCertPath: certPath,
+		TLSKeyPath:  keyPath,
+		TLSCAPath:   caPath,
+	})
+	require.NoError(t, err)
+	require.Equal(t, testPubKey, s.PublicKey())
+}
+
+// newTestCert creates a certificate signed by the given parent, or a self
+// signed CA certificate if parent is nil.
+func newTestCert(
+	t *testing.T,
+	parent *x509.Certificate,
+	parentKey *ecdsa.PrivateKey,
+	isCA bool,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "beacon-kit-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth,
+		},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(
+		rand.Reader, tmpl, parent, &key.PublicKey, parentKey,
+	)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func marshalKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return der
+}
+
+func writePEM(
+	t *testing.T,
+	dir, name, blockType string,
+	der []byte,
+) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(
+		path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}),
+		0o600,
+	))
+	return path
+}
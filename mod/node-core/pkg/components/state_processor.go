@@ -43,21 +43,29 @@ func ProvideStateProcessor(
 	in StateProcessorInput,
 ) *StateProcessor {
 	return core.NewStateProcessor[
+		*AttestationData,
+		*AttesterSlashing,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
 		*BeaconState,
+		*ConsolidationRequest,
 		*Context,
 		*Deposit,
 		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
+		*ExecutionRequests,
 		*Fork,
 		*ForkData,
 		*KVStore,
+		*ProposerSlashing,
+		*SlashingInfo,
 		*Validator,
 		Validators,
+		*SignedVoluntaryExit,
 		*Withdrawal,
+		*WithdrawalRequest,
 		engineprimitives.Withdrawals,
 		WithdrawalCredentials,
 	](
@@ -23,6 +23,8 @@ package components
 import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
 )
@@ -45,3 +47,125 @@ func ProvideKVStore(in KVStoreInput) *KVStore {
 		Validators,
 	](in.KVStoreService, payloadCodec)
 }
+
+// RestoreBeaconState writes every field of the given snapshot to the state.
+// The slashings are written at their positions in the encoded list, and the
+// fields added in later forks only if the snapshot carries them.
+//
+//nolint:gocognit,funlen // flat list of fields.
+func RestoreBeaconState(
+	st *BeaconState, snapshot *BeaconStateMarshallable,
+) error {
+	if err := st.SetGenesisValidatorsRoot(
+		snapshot.GenesisValidatorsRoot,
+	); err != nil {
+		return err
+	}
+	if err := st.SetSlot(snapshot.Slot); err != nil {
+		return err
+	}
+	if err := st.SetFork(snapshot.Fork); err != nil {
+		return err
+	}
+	if err := st.SetLatestBlockHeader(snapshot.LatestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range snapshot.BlockRoots {
+		if err := st.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range snapshot.StateRoots {
+		if err := st.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err := st.SetEth1Data(snapshot.Eth1Data); err != nil {
+		return err
+	}
+	if err := st.SetEth1DepositIndex(snapshot.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err := st.SetLatestExecutionPayloadHeader(
+		snapshot.LatestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+	for i, val := range snapshot.Validators {
+		if err := st.AddValidator(val); err != nil {
+			return err
+		}
+		if err := st.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(snapshot.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+	for i, mix := range snapshot.RandaoMixes {
+		if err := st.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err := st.SetNextWithdrawalIndex(
+		snapshot.NextWithdrawalIndex,
+	); err != nil {
+		return err
+	}
+	if err := st.SetNextWithdrawalValidatorIndex(
+		snapshot.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, amount := range snapshot.Slashings {
+		if err := st.SetSlashingAtIndex(
+			uint64(i), math.Gwei(amount),
+		); err != nil {
+			return err
+		}
+	}
+	if err := st.SetTotalSlashing(snapshot.TotalSlashing); err != nil {
+		return err
+	}
+	for i, participation := range snapshot.EpochParticipation {
+		if err := st.SetEpochParticipation(
+			math.ValidatorIndex(i), participation,
+		); err != nil {
+			return err
+		}
+	}
+	for i, score := range snapshot.InactivityScores {
+		if err := st.SetInactivityScore(
+			math.ValidatorIndex(i), score,
+		); err != nil {
+			return err
+		}
+	}
+	for i, power := range snapshot.ValidatorPowers {
+		if err := st.SetValidatorPower(
+			math.ValidatorIndex(i), math.Gwei(power),
+		); err != nil {
+			return err
+		}
+	}
+	for _, vote := range snapshot.Eth1DataVotes {
+		if err := st.AddEth1DataVote(vote); err != nil {
+			return err
+		}
+	}
+	if snapshot.Version() < version.Electra {
+		return nil
+	}
+	if err := st.SetDepositRequestsStartIndex(
+		snapshot.DepositRequestsStartIndex,
+	); err != nil {
+		return err
+	}
+	for _, consolidation := range snapshot.PendingConsolidations {
+		if err := st.AddPendingConsolidation(
+			consolidation.GetSourceIndex(), consolidation.GetTargetIndex(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	blockstore "github.com/berachain/beacon-kit/mod/beacon/block_store"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/pool"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft"
@@ -48,6 +49,7 @@ import (
 	nodeapi "github.com/berachain/beacon-kit/mod/node-api/handlers/node"
 	proofapi "github.com/berachain/beacon-kit/mod/node-api/handlers/proof"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/relay"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
@@ -56,9 +58,12 @@ import (
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/service"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/middleware"
+	rp2p "github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/archive"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
@@ -80,6 +85,9 @@ type (
 	// AttestationData is a type alias for the attestation data.
 	AttestationData = types.AttestationData
 
+	// AttesterSlashing is a type alias for the attester slashing.
+	AttesterSlashing = types.AttesterSlashing
+
 	// AttributesFactory is a type alias for the attributes factory.
 	AttributesFactory = attributes.Factory[
 		*BeaconState,
@@ -132,6 +140,15 @@ type (
 		*BlobSidecars,
 	]
 
+	// BlobReactor is a type alias for the blob reactor.
+	BlobReactor = rp2p.BlobReactor[
+		*BeaconBlock,
+		*BeaconBlockBody,
+		*BeaconBlockHeader,
+		*BlobSidecar,
+		*BlobSidecars,
+	]
+
 	// BlobSidecar is a type alias for the blob sidecar.
 	BlobSidecar = datypes.BlobSidecar
 
@@ -159,15 +176,21 @@ type (
 		*BeaconBlockHeader,
 		*BeaconState,
 		*Deposit,
+		*DepositStore,
+		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
 		*Genesis,
 		*PayloadAttributes,
 	]
 
+	// CometBFTStatusClient is a type alias for the CometBFT status client.
+	CometBFTStatusClient = comet.StatusClient
+
 	// ConsensusEngine is a type alias for the consensus engine.
 	ConsensusEngine = cometbft.ConsensusEngine[
 		*AttestationData,
+		*BeaconBlockHeader,
 		*BeaconState,
 		*SlashingInfo,
 		*SlotData,
@@ -182,6 +205,9 @@ type (
 		*SlotData,
 	]
 
+	// ConsolidationRequest is a type alias for the consolidation request.
+	ConsolidationRequest = types.ConsolidationRequest
+
 	// Context is a type alias for the transition context.
 	Context = transition.Context
 
@@ -232,10 +258,16 @@ type (
 		engineprimitives.Withdrawals,
 	]
 
+	// ExternalBuilder is a type alias for the external builder.
+	ExternalBuilder = relay.Builder[*BeaconState]
+
 	// ExecutionPayload type aliases.
 	ExecutionPayload       = types.ExecutionPayload
 	ExecutionPayloadHeader = types.ExecutionPayloadHeader
 
+	// ExecutionRequests is a type alias for the execution layer requests.
+	ExecutionRequests = types.ExecutionRequests
+
 	// Fork is a type alias for the fork.
 	Fork = types.Fork
 
@@ -301,6 +333,7 @@ type (
 		*StorageBackend,
 		*Validator,
 		Validators,
+		*SignedVoluntaryExit,
 		*Withdrawal,
 		WithdrawalCredentials,
 	]
@@ -320,6 +353,9 @@ type (
 	// PayloadID is a type alias for the payload ID.
 	PayloadID = engineprimitives.PayloadID
 
+	// ProposerSlashing is a type alias for the proposer slashing.
+	ProposerSlashing = types.ProposerSlashing
+
 	// ReportingService is a type alias for the reporting service.
 	ReportingService = version.ReportingService
 
@@ -330,26 +366,45 @@ type (
 		*BeaconBlockHeader,
 	]
 
+	// SignedVoluntaryExit is a type alias for the signed voluntary exit.
+	SignedVoluntaryExit = types.SignedVoluntaryExit
+
 	// SlashingInfo is a type alias for the slashing info.
 	SlashingInfo = types.SlashingInfo
 
+	// StateArchive is a type alias for the archive of historical states.
+	StateArchive = archive.Archive[
+		*BeaconBlock,
+		*BeaconState,
+		*BeaconStateMarshallable,
+		*BlockStore,
+	]
+
 	// StateProcessor is the type alias for the state processor interface.
 	StateProcessor = core.StateProcessor[
+		*AttestationData,
+		*AttesterSlashing,
 		*BeaconBlock,
 		*BeaconBlockBody,
 		*BeaconBlockHeader,
 		*BeaconState,
+		*ConsolidationRequest,
 		*Context,
 		*Deposit,
 		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
+		*ExecutionRequests,
 		*Fork,
 		*ForkData,
 		*KVStore,
+		*ProposerSlashing,
+		*SlashingInfo,
 		*Validator,
 		Validators,
+		*SignedVoluntaryExit,
 		*Withdrawal,
+		*WithdrawalRequest,
 		engineprimitives.Withdrawals,
 		WithdrawalCredentials,
 	]
@@ -381,9 +436,17 @@ type (
 		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
+		*ExecutionRequests,
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		*SignedVoluntaryExit,
+	]
+
+	// VoluntaryExitPool is a type alias for the voluntary exit pool.
+	VoluntaryExitPool = pool.VoluntaryExitPool[
+		*BeaconState,
+		*SignedVoluntaryExit,
 	]
 
 	// ValidatorUpdate is a type alias for the validator update.
@@ -394,6 +457,9 @@ type (
 
 	// WithdrawalCredentials is a type alias for the withdrawal credentials.
 	WithdrawalCredentials = types.WithdrawalCredentials
+
+	// WithdrawalRequest is a type alias for the withdrawal request.
+	WithdrawalRequest = types.WithdrawalRequest
 )
 
 /* -------------------------------------------------------------------------- */
@@ -466,7 +532,8 @@ type (
 type (
 	// BeaconAPIHandler is a type alias for the beacon handler.
 	BeaconAPIHandler = beaconapi.Handler[
-		*BeaconBlockHeader, NodeAPIContext, *Fork, *Validator,
+		*BeaconBlock, *BeaconBlockHeader, *BlobSidecar, *BlobSidecars,
+		NodeAPIContext, *Fork, *Validator,
 	]
 
 	// BuilderAPIHandler is a type alias for the builder handler.
@@ -476,17 +543,23 @@ type (
 	ConfigAPIHandler = configapi.Handler[NodeAPIContext]
 
 	// DebugAPIHandler is a type alias for the debug handler.
-	DebugAPIHandler = debugapi.Handler[NodeAPIContext]
+	DebugAPIHandler = debugapi.Handler[
+		*BeaconState, *BeaconStateMarshallable, NodeAPIContext,
+	]
 
 	// EventsAPIHandler is a type alias for the events handler.
-	EventsAPIHandler = eventsapi.Handler[NodeAPIContext]
+	EventsAPIHandler = eventsapi.Handler[
+		*BeaconBlockHeader, *BeaconBlock, *BlobSidecar, *BlobSidecars,
+		NodeAPIContext,
+	]
 
 	// NodeAPIHandler is a type alias for the node handler.
 	NodeAPIHandler = nodeapi.Handler[NodeAPIContext]
 
 	// ProofAPIHandler is a type alias for the proof handler.
 	ProofAPIHandler = proofapi.Handler[
-		*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
-		NodeAPIContext, *ExecutionPayloadHeader, *Validator,
+		*BeaconBlock, *BeaconBlockHeader, *BeaconState,
+		*BeaconStateMarshallable, NodeAPIContext, *ExecutionPayloadHeader,
+		*Validator,
 	]
 )
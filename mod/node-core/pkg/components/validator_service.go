@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/async/pkg/broker"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -35,19 +36,21 @@ type ValidatorServiceInput[
 	LoggerT log.AdvancedLogger[any, LoggerT],
 ] struct {
 	depinject.In
-	BeaconBlockFeed *BlockBroker
-	BlobProcessor   *BlobProcessor
-	Cfg             *config.Config
-	ChainSpec       common.ChainSpec
-	LocalBuilder    *LocalBuilder
-	Logger          LoggerT
-	StateProcessor  *StateProcessor
-	StorageBackend  *StorageBackend
-	Signer          crypto.BLSSigner
-	SidecarsFeed    *SidecarsBroker
-	SidecarFactory  *SidecarFactory
-	SlotBroker      *SlotBroker
-	TelemetrySink   *metrics.TelemetrySink
+	BeaconBlockFeed   *BlockBroker
+	BlobProcessor     *BlobProcessor
+	Cfg               *config.Config
+	ChainSpec         common.ChainSpec
+	ExternalBuilder   *ExternalBuilder
+	LocalBuilder      *LocalBuilder
+	Logger            LoggerT
+	StateProcessor    *StateProcessor
+	StorageBackend    *StorageBackend
+	Signer            crypto.BLSSigner
+	SidecarsFeed      *SidecarsBroker
+	SidecarFactory    *SidecarFactory
+	SlotBroker        *SlotBroker
+	TelemetrySink     *metrics.TelemetrySink
+	VoluntaryExitPool *VoluntaryExitPool
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -56,11 +59,21 @@ func ProvideValidatorService[
 ](
 	in ValidatorServiceInput[LoggerT],
 ) (*ValidatorService, error) {
-	slotSubscription, err := in.SlotBroker.Subscribe()
+	slotSubscription, err := in.SlotBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		in.Logger.Error("failed to subscribe to slot feed", "err", err)
 		return nil, err
 	}
+	// The external builder is only set if it is enabled.
+	var externalBuilder validator.ExternalBuilder[
+		*BeaconBlock, *BeaconState, *ExecutionPayload,
+	]
+	if in.ExternalBuilder != nil {
+		externalBuilder = in.ExternalBuilder
+	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*AttestationData,
@@ -73,14 +86,17 @@ func ProvideValidatorService[
 		*Eth1Data,
 		*ExecutionPayload,
 		*ExecutionPayloadHeader,
+		*ExecutionRequests,
 		*ForkData,
 		*SlashingInfo,
 		*SlotData,
+		*SignedVoluntaryExit,
 	](
 		&in.Cfg.Validator,
 		in.Logger.With("service", "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
+		in.VoluntaryExitPool,
 		in.StateProcessor,
 		in.Signer,
 		in.SidecarFactory,
@@ -88,6 +104,7 @@ func ProvideValidatorService[
 		[]validator.PayloadBuilder[*BeaconState, *ExecutionPayload]{
 			in.LocalBuilder,
 		},
+		externalBuilder,
 		in.TelemetrySink,
 		in.BeaconBlockFeed,
 		in.SidecarsFeed,
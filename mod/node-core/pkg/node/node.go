@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/cosmos/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/cometbft/cometbft/p2p"
 )
 
 // Compile-time assertion that node implements the NodeI interface.
@@ -37,6 +38,8 @@ type node struct {
 
 	// registry is the node's service registry.
 	registry *service.Registry
+	// reactors are the node's CometBFT p2p reactors.
+	reactors map[string]p2p.Reactor
 }
 
 // New returns a new node.
@@ -59,3 +62,16 @@ func (n *node) RegisterApp(a types.Application) {
 func (n *node) SetServiceRegistry(registry *service.Registry) {
 	n.registry = registry
 }
+
+// RegisterReactor registers a CometBFT p2p reactor under the given name.
+func (n *node) RegisterReactor(name string, reactor p2p.Reactor) {
+	if n.reactors == nil {
+		n.reactors = make(map[string]p2p.Reactor)
+	}
+	n.reactors[name] = reactor
+}
+
+// Reactors returns the CometBFT p2p reactors of the node, by name.
+func (n *node) Reactors() map[string]p2p.Reactor {
+	return n.reactors
+}
@@ -63,6 +63,11 @@ func (*ReportingService) Name() string {
 	return "reporting"
 }
 
+// Version returns the version of the running chain.
+func (v *ReportingService) Version() string {
+	return v.version
+}
+
 // Start begins the periodic logging of the chain version.
 func (v *ReportingService) Start(ctx context.Context) error {
 	ticker := time.NewTicker(v.reportingInterval)
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	"github.com/cometbft/cometbft/p2p"
 )
 
 // Node defines the API for the node application.
@@ -38,4 +39,8 @@ type Node interface {
 	RegisterApp(app Application)
 	// SetServiceRegistry sets the node's service registry.
 	SetServiceRegistry(registry *service.Registry)
+	// RegisterReactor registers a CometBFT p2p reactor under the given name.
+	RegisterReactor(name string, reactor p2p.Reactor)
+	// Reactors returns the CometBFT p2p reactors of the node, by name.
+	Reactors() map[string]p2p.Reactor
 }
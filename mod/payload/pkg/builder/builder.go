@@ -34,7 +34,7 @@ type PayloadBuilder[
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	PayloadAttributesT PayloadAttributes[PayloadAttributesT, WithdrawalT],
 	PayloadIDT ~[8]byte,
-	WithdrawalT any,
+	WithdrawalT Withdrawal,
 ] struct {
 	// cfg holds the configuration settings for the PayloadBuilder.
 	cfg *Config
@@ -50,6 +50,8 @@ type PayloadBuilder[
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot]
 	// attributesFactory is used to create attributes for the
 	attributesFactory AttributesFactory[BeaconStateT, PayloadAttributesT]
+	// metrics is the metrics for the PayloadBuilder.
+	metrics *payloadBuilderMetrics
 }
 
 // New creates a new service.
@@ -59,7 +61,7 @@ func New[
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	PayloadAttributesT PayloadAttributes[PayloadAttributesT, WithdrawalT],
 	PayloadIDT ~[8]byte,
-	WithdrawalT any,
+	WithdrawalT Withdrawal,
 ](
 	cfg *Config,
 	chainSpec common.ChainSpec,
@@ -67,6 +69,7 @@ func New[
 	ee ExecutionEngine[ExecutionPayloadT, PayloadAttributesT, PayloadIDT],
 	pc PayloadCache[PayloadIDT, [32]byte, math.Slot],
 	af AttributesFactory[BeaconStateT, PayloadAttributesT],
+	telemetrySink TelemetrySink,
 ) *PayloadBuilder[
 	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	PayloadAttributesT, PayloadIDT, WithdrawalT,
@@ -81,6 +84,7 @@ func New[
 		ee:                ee,
 		pc:                pc,
 		attributesFactory: af,
+		metrics:           newPayloadBuilderMetrics(telemetrySink),
 	}
 }
 
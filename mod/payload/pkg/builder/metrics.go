@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// payloadBuilderMetrics is a struct that contains metrics for the payload
+// builder.
+type payloadBuilderMetrics struct {
+	// sink is the sink for the metrics.
+	sink TelemetrySink
+}
+
+// newPayloadBuilderMetrics creates a new payloadBuilderMetrics.
+func newPayloadBuilderMetrics(
+	sink TelemetrySink,
+) *payloadBuilderMetrics {
+	return &payloadBuilderMetrics{
+		sink: sink,
+	}
+}
+
+// markPayloadIDCacheHit increments the counter for the number of times a
+// payload ID was found in the cache.
+func (pm *payloadBuilderMetrics) markPayloadIDCacheHit(slot math.Slot) {
+	pm.sink.IncrementCounter(
+		"beacon_kit.payload_builder.payload_id_cache_hit",
+		"slot",
+		slot.Base10(),
+	)
+}
+
+// markPayloadIDCacheMiss increments the counter for the number of times a
+// payload ID was not found in the cache.
+func (pm *payloadBuilderMetrics) markPayloadIDCacheMiss(slot math.Slot) {
+	pm.sink.IncrementCounter(
+		"beacon_kit.payload_builder.payload_id_cache_miss",
+		"slot",
+		slot.Base10(),
+	)
+}
@@ -22,10 +22,13 @@ package builder
 
 import (
 	"context"
+	"encoding/binary"
 	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -47,9 +50,22 @@ func (pb *PayloadBuilder[
 		return nil, ErrPayloadBuilderDisabled
 	}
 
-	if payloadID, found := pb.pc.Get(slot, parentBlockRoot); found {
-		pb.logger.Warn(
-			"aborting payload build; payload already exists in cache",
+	// Assemble the payload attributes.
+	attrs, err := pb.attributesFactory.
+		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reuse the payload that is already being built with the same
+	// attributes, so that the execution client keeps building it.
+	attributesRoot := attributesRootOf[PayloadAttributesT](attrs)
+	if payloadID, found := pb.pc.GetByAttributes(
+		slot, parentBlockRoot, attributesRoot,
+	); found {
+		pb.metrics.markPayloadIDCacheHit(slot)
+		pb.logger.Info(
+			"Reusing payload already being built with the same attributes",
 			"for_slot",
 			slot.Base10(),
 			"parent_block_root",
@@ -57,13 +73,7 @@ func (pb *PayloadBuilder[
 		)
 		return &payloadID, nil
 	}
-
-	// Assemble the payload attributes.
-	attrs, err := pb.attributesFactory.
-		BuildPayloadAttributes(st, slot, timestamp, parentBlockRoot)
-	if err != nil {
-		return nil, err
-	}
+	pb.metrics.markPayloadIDCacheMiss(slot)
 
 	// Submit the forkchoice update to the execution client.
 	var payloadID *PayloadIDT
@@ -84,7 +94,17 @@ func (pb *PayloadBuilder[
 
 	// Only add to cache if we received back a payload ID.
 	if payloadID != nil {
-		pb.pc.Set(slot, parentBlockRoot, *payloadID)
+		if err = pb.pc.Set(
+			slot, parentBlockRoot, attributesRoot, *payloadID,
+		); err != nil {
+			// The payload is cached in memory regardless, it is only lost
+			// if the node restarts before it is retrieved.
+			pb.logger.Error(
+				"Failed to persist payload ID",
+				"for_slot", slot.Base10(),
+				"error", err,
+			)
+		}
 	}
 
 	return payloadID, nil
@@ -169,8 +189,10 @@ func (pb *PayloadBuilder[
 	// this particular slot and parent block root.
 	payloadID, found := pb.pc.Get(slot, parentBlockRoot)
 	if !found {
+		pb.metrics.markPayloadIDCacheMiss(slot)
 		return nil, ErrPayloadIDNotFound
 	}
+	pb.metrics.markPayloadIDCacheHit(slot)
 
 	envelope, err := pb.ee.GetPayload(
 		ctx,
@@ -260,3 +282,29 @@ func (pb *PayloadBuilder[
 	)
 	return err
 }
+
+// attributesRootOf returns the root the payloads built with the attributes
+// are cached by. It commits to the timestamp, the withdrawals, the fee
+// recipient and the parent beacon block root of the attributes.
+func attributesRootOf[
+	PayloadAttributesT PayloadAttributes[PayloadAttributesT, WithdrawalT],
+	WithdrawalT Withdrawal,
+](attrs PayloadAttributesT) common.Root {
+	withdrawals := attrs.GetWithdrawals()
+	bz := make([]byte, 0, len(withdrawals)*constants.RootLength)
+	for _, withdrawal := range withdrawals {
+		root := withdrawal.HashTreeRoot()
+		bz = append(bz, root[:]...)
+	}
+	withdrawalsHash := sha256.Hash(bz)
+
+	feeRecipient := attrs.GetSuggestedFeeRecipient()
+	parentBeaconBlockRoot := attrs.GetParentBeaconBlockRoot()
+	bz = binary.LittleEndian.AppendUint64(
+		bz[:0], attrs.GetTimestamp().Unwrap(),
+	)
+	bz = append(bz, withdrawalsHash[:]...)
+	bz = append(bz, feeRecipient[:]...)
+	bz = append(bz, parentBeaconBlockRoot[:]...)
+	return sha256.Hash(bz)
+}
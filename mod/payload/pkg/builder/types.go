@@ -52,10 +52,27 @@ type BeaconState[
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 }
 
+// PayloadCache is the cache of the payloads in flight on the execution
+// client.
 type PayloadCache[PayloadIDT, RootT, SlotT any] interface {
-	Get(slot SlotT, stateRoot RootT) (PayloadIDT, bool)
-	Has(slot SlotT, stateRoot RootT) bool
-	Set(slot SlotT, stateRoot RootT, pid PayloadIDT)
+	// Get returns the payload ID of the latest payload requested for the
+	// slot and parent root.
+	Get(slot SlotT, parentRoot RootT) (PayloadIDT, bool)
+	// GetByAttributes returns the payload ID of the payload requested for
+	// the slot, parent root and payload attributes root.
+	GetByAttributes(
+		slot SlotT, parentRoot RootT, attributesRoot RootT,
+	) (PayloadIDT, bool)
+	// Has returns whether a payload was requested for the slot and parent
+	// root.
+	Has(slot SlotT, parentRoot RootT) bool
+	// Set caches the payload ID for the slot, parent root and payload
+	// attributes root.
+	Set(
+		slot SlotT, parentRoot RootT, attributesRoot RootT, pid PayloadIDT,
+	) error
+	// UnsafePrunePrior removes the payload IDs of the slots prior to the
+	// slot.
 	UnsafePrunePrior(slot SlotT)
 }
 
@@ -97,6 +114,12 @@ type PayloadAttributes[
 	WithdrawalT any,
 ] interface {
 	engineprimitives.PayloadAttributer
+	// GetTimestamp returns the timestamp of the payload.
+	GetTimestamp() math.U64
+	// GetWithdrawals returns the withdrawals of the payload.
+	GetWithdrawals() []WithdrawalT
+	// GetParentBeaconBlockRoot returns the root of the parent beacon block.
+	GetParentBeaconBlockRoot() common.Root
 	// New creates a new payload attributes instance.
 	New(
 		uint32,
@@ -124,3 +147,16 @@ type ExecutionEngine[
 		req *engineprimitives.ForkchoiceUpdateRequest[PayloadAttributesT],
 	) (*PayloadIDT, *common.ExecutionHash, error)
 }
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+}
+
+// Withdrawal is the interface for a withdrawal.
+type Withdrawal interface {
+	// HashTreeRoot returns the hash tree root of the withdrawal.
+	HashTreeRoot() common.Root
+}
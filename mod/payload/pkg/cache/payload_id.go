@@ -21,24 +21,61 @@
 package cache
 
 import (
+	"encoding/binary"
+	"slices"
 	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// historicalPayloadIDCacheSize defines the maximum number of slots to
+	// retain in the cache. Beyond this number, older slots will be pruned to
+	// manage memory usage.
+	historicalPayloadIDCacheSize = 2
+	// persistedEntrySize is the size of a persisted entry: the slot, the
+	// parent root, the attributes root and the payload ID.
+	persistedEntrySize = 8 + 32 + 32 + 8
 )
 
-// historicalPayloadIDCacheSize defines the maximum number of slots to retain
-// in the cache. Beyond this number, older slots will be pruned to manage
-// memory usage.
-const historicalPayloadIDCacheSize = 2
+// PayloadIDsKey is the key under which the payload IDs are persisted.
+//
+//nolint:gochecknoglobals // constant.
+var PayloadIDsKey = []byte("payload_ids")
+
+// Store is the store the payload IDs are persisted to, so that builds that
+// are in flight on the execution client survive a restart.
+type Store interface {
+	// Has returns whether the key exists in the store.
+	Has(key []byte) (bool, error)
+	// Get returns the value of the key.
+	Get(key []byte) ([]byte, error)
+	// Set sets the value of the key.
+	Set(key []byte, value []byte) error
+}
+
+// payloadIDEntry is a payload ID along with the root of the payload
+// attributes the payload is built with.
+type payloadIDEntry[PayloadIDT ~[8]byte, RootT ~[32]byte] struct {
+	attributesRoot RootT
+	payloadID      PayloadIDT
+}
 
 // PayloadIDCache provides a mechanism to store and retrieve payload IDs based
-// on slot and parent block hash. It is designed to improve the efficiency of
-// payload ID retrieval by caching recent entries.
+// on slot, parent block root and payload attributes. It is designed to improve
+// the efficiency of payload ID retrieval by caching recent entries.
 type PayloadIDCache[
 	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
 ] struct {
-	// mu protects access to the slotToStateRootToPayloadID map.
+	// mu protects access to the slotToParentRootToPayloadIDs map.
 	mu sync.RWMutex
-	// slotToStateRootToPayloadID is used for storing payload ID mappings
-	slotToStateRootToPayloadID map[SlotT]map[RootT]PayloadIDT
+	// slotToParentRootToPayloadIDs is used for storing payload ID mappings,
+	// in the order in which the payloads were requested.
+	slotToParentRootToPayloadIDs map[SlotT]map[RootT][]payloadIDEntry[
+		PayloadIDT, RootT,
+	]
+	// store persists the cached payload IDs, if set.
+	store Store
 }
 
 // NewPayloadIDCache initializes and returns a new instance of PayloadIDCache.
@@ -48,48 +85,87 @@ func NewPayloadIDCache[
 ]() *PayloadIDCache[PayloadIDT, RootT, SlotT] {
 	return &PayloadIDCache[PayloadIDT, RootT, SlotT]{
 		mu: sync.RWMutex{},
-		slotToStateRootToPayloadID: make(
-			map[SlotT]map[RootT]PayloadIDT,
+		slotToParentRootToPayloadIDs: make(
+			map[SlotT]map[RootT][]payloadIDEntry[PayloadIDT, RootT],
 		),
 	}
 }
 
-// Has retrieves the payload ID associated with a given slot and eth1 hash.
-// Has checks if a payload ID exists for a given slot and eth1 hash.
+// NewPersistentPayloadIDCache initializes a PayloadIDCache that persists its
+// payload IDs to the given store, and loads the payload IDs persisted before
+// the last shutdown.
+func NewPersistentPayloadIDCache[
+	PayloadIDT ~[8]byte, RootT ~[32]byte, SlotT ~uint64,
+](store Store) (*PayloadIDCache[PayloadIDT, RootT, SlotT], error) {
+	p := NewPayloadIDCache[PayloadIDT, RootT, SlotT]()
+	p.store = store
+
+	found, err := store.Has(PayloadIDsKey)
+	if err != nil || !found {
+		return p, err
+	}
+	bz, err := store.Get(PayloadIDsKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = p.load(bz); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Has checks if a payload ID exists for a given slot and parent root.
 func (p *PayloadIDCache[_, RootT, SlotT]) Has(
 	slot SlotT,
-	stateRoot RootT,
+	parentRoot RootT,
 ) bool {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	_, ok := p.slotToStateRootToPayloadID[slot][stateRoot]
-	return ok
+	return len(p.slotToParentRootToPayloadIDs[slot][parentRoot]) > 0
 }
 
-// Get was successful.
+// Get returns the payload ID of the latest payload requested for a given
+// slot and parent root, and whether it was found.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Get(
 	slot SlotT,
-	stateRoot RootT,
+	parentRoot RootT,
 ) (PayloadIDT, bool) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	innerMap, ok := p.slotToStateRootToPayloadID[slot]
-	if !ok {
+	entries := p.slotToParentRootToPayloadIDs[slot][parentRoot]
+	if len(entries) == 0 {
 		return PayloadIDT{}, false
 	}
-	pid, ok := innerMap[stateRoot]
-	if !ok {
-		return PayloadIDT{}, false
+	return entries[len(entries)-1].payloadID, true
+}
+
+// GetByAttributes returns the payload ID of the payload requested for a
+// given slot, parent root and payload attributes root, and whether it was
+// found.
+func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) GetByAttributes(
+	slot SlotT,
+	parentRoot RootT,
+	attributesRoot RootT,
+) (PayloadIDT, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	entries := p.slotToParentRootToPayloadIDs[slot][parentRoot]
+	for _, entry := range entries {
+		if entry.attributesRoot == attributesRoot {
+			return entry.payloadID, true
+		}
 	}
-	return pid, true
+	return PayloadIDT{}, false
 }
 
-// Set updates or inserts a payload ID for a given slot and eth1 hash.
-// It also prunes entries in the cache that are older than the
-// historicalPayloadIDCacheSize limit.
+// Set updates or inserts a payload ID for a given slot, parent root and
+// payload attributes root, making it the latest payload of the slot and
+// parent root. It also prunes entries in the cache that are older than the
+// historicalPayloadIDCacheSize limit, and persists the cache if it has a
+// store.
 func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
-	slot SlotT, stateRoot RootT, pid PayloadIDT,
-) {
+	slot SlotT, parentRoot RootT, attributesRoot RootT, pid PayloadIDT,
+) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
@@ -99,12 +175,12 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Set(
 	}
 
 	// Update the cache with the new payload ID.
-	innerMap, exists := p.slotToStateRootToPayloadID[slot]
-	if !exists {
-		innerMap = make(map[RootT]PayloadIDT)
-		p.slotToStateRootToPayloadID[slot] = innerMap
+	p.set(slot, parentRoot, attributesRoot, pid)
+
+	if p.store == nil {
+		return nil
 	}
-	innerMap[stateRoot] = pid
+	return p.store.Set(PayloadIDsKey, p.encode())
 }
 
 // UnsafePrunePrior removes payload IDs from the cache for slots less than
@@ -117,13 +193,81 @@ func (p *PayloadIDCache[_, _, SlotT]) UnsafePrunePrior(
 	p.prunePrior(slot)
 }
 
+// set inserts the payload ID, replacing the entry of the same attributes
+// root if there is one.
+func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) set(
+	slot SlotT, parentRoot RootT, attributesRoot RootT, pid PayloadIDT,
+) {
+	innerMap, exists := p.slotToParentRootToPayloadIDs[slot]
+	if !exists {
+		innerMap = make(map[RootT][]payloadIDEntry[PayloadIDT, RootT])
+		p.slotToParentRootToPayloadIDs[slot] = innerMap
+	}
+	innerMap[parentRoot] = append(
+		slices.DeleteFunc(
+			innerMap[parentRoot],
+			func(entry payloadIDEntry[PayloadIDT, RootT]) bool {
+				return entry.attributesRoot == attributesRoot
+			},
+		),
+		payloadIDEntry[PayloadIDT, RootT]{
+			attributesRoot: attributesRoot,
+			payloadID:      pid,
+		},
+	)
+}
+
 // Prune removes payload IDs from the cache for slots less than the specified
 // slot. This method helps in managing the memory usage of the cache by
 // discarding outdated entries.
 func (p *PayloadIDCache[_, _, SlotT]) prunePrior(slot SlotT) {
-	for s := range p.slotToStateRootToPayloadID {
+	for s := range p.slotToParentRootToPayloadIDs {
 		if s < slot {
-			delete(p.slotToStateRootToPayloadID, s)
+			delete(p.slotToParentRootToPayloadIDs, s)
 		}
 	}
 }
+
+// encode encodes the cached payload IDs, ordered by slot and then by the
+// order in which they were requested.
+func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) encode() []byte {
+	slots := make([]SlotT, 0, len(p.slotToParentRootToPayloadIDs))
+	for slot := range p.slotToParentRootToPayloadIDs {
+		slots = append(slots, slot)
+	}
+	slices.Sort(slots)
+
+	bz := make([]byte, 0, len(slots)*persistedEntrySize)
+	for _, slot := range slots {
+		for parentRoot, entries := range p.slotToParentRootToPayloadIDs[slot] {
+			for _, entry := range entries {
+				bz = binary.LittleEndian.AppendUint64(bz, uint64(slot))
+				bz = append(bz, parentRoot[:]...)
+				bz = append(bz, entry.attributesRoot[:]...)
+				bz = append(bz, entry.payloadID[:]...)
+			}
+		}
+	}
+	return bz
+}
+
+// load inserts the payload IDs encoded by encode into the cache.
+func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) load(bz []byte) error {
+	if len(bz)%persistedEntrySize != 0 {
+		return errors.Wrapf(
+			ErrMalformedPayloadIDs, "invalid length %d", len(bz),
+		)
+	}
+	for ; len(bz) > 0; bz = bz[persistedEntrySize:] {
+		var (
+			parentRoot, attributesRoot RootT
+			pid                        PayloadIDT
+		)
+		slot := SlotT(binary.LittleEndian.Uint64(bz[:8]))
+		copy(parentRoot[:], bz[8:40])
+		copy(attributesRoot[:], bz[40:72])
+		copy(pid[:], bz[72:persistedEntrySize])
+		p.set(slot, parentRoot, attributesRoot, pid)
+	}
+	return nil
+}
@@ -39,7 +39,7 @@ func FuzzPayloadIDCacheBasic(f *testing.F) {
 		slot := s
 		pid := [8]byte(_p[:8])
 		cacheUnderTest := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
-		cacheUnderTest.Set(slot, r, pid)
+		require.NoError(t, cacheUnderTest.Set(slot, r, r, pid))
 
 		p, ok := cacheUnderTest.Get(slot, r)
 		require.True(t, ok)
@@ -50,7 +50,7 @@ func FuzzPayloadIDCacheBasic(f *testing.F) {
 		for i := range pid {
 			newPid[i] = pid[i] + 1 // Simple mutation for a new PayloadID
 		}
-		cacheUnderTest.Set((slot), r, newPid)
+		require.NoError(t, cacheUnderTest.Set(slot, r, r, newPid))
 
 		p, ok = cacheUnderTest.Get(slot, r)
 		require.True(t, ok)
@@ -82,7 +82,7 @@ func FuzzPayloadIDInvalidInput(f *testing.F) {
 		copy(paddedPayload[:], _p[:min(len(_p), 8)])
 		pid := [8]byte(paddedPayload[:])
 		cacheUnderTest := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
-		cacheUnderTest.Set(slot, r, pid)
+		require.NoError(t, cacheUnderTest.Set(slot, r, r, pid))
 
 		_, ok := cacheUnderTest.Get(slot, r)
 		require.True(t, ok)
@@ -95,7 +95,10 @@ func FuzzPayloadIDCacheConcurrency(f *testing.F) {
 	f.Fuzz(func(t *testing.T, s uint64, _r, _p []byte) {
 		cacheUnderTest := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
 		slot := s
-		var wg sync.WaitGroup
+		var (
+			wg     sync.WaitGroup
+			setErr error
+		)
 		wg.Add(2)
 
 		// Set operation in one goroutine
@@ -106,7 +109,7 @@ func FuzzPayloadIDCacheConcurrency(f *testing.F) {
 			var paddedPayload [8]byte
 			copy(paddedPayload[:], _p[:min(len(_p), 8)])
 			pid := [8]byte(paddedPayload[:])
-			cacheUnderTest.Set((slot), r, pid)
+			setErr = cacheUnderTest.Set(slot, r, r, pid)
 		}()
 
 		// Get operation in another goroutine
@@ -122,6 +125,7 @@ func FuzzPayloadIDCacheConcurrency(f *testing.F) {
 		}()
 
 		wg.Wait()
+		require.NoError(t, setErr)
 		require.True(t, ok)
 	})
 }
@@ -41,7 +41,7 @@ func TestPayloadIDCache(t *testing.T) {
 		slot := uint64(1234)
 		r := [32]byte{1, 2, 3}
 		pid := [8]byte{1, 2, 3, 3, 7, 8, 7, 8}
-		cacheUnderTest.Set(slot, r, pid)
+		require.NoError(t, cacheUnderTest.Set(slot, r, r, pid))
 
 		p, ok := cacheUnderTest.Get(slot, r)
 		require.True(t, ok)
@@ -52,7 +52,7 @@ func TestPayloadIDCache(t *testing.T) {
 		slot := uint64(1234)
 		r := [32]byte{1, 2, 3}
 		newPid := [8]byte{9, 9, 9, 9, 9, 9, 9, 9}
-		cacheUnderTest.Set(slot, r, newPid)
+		require.NoError(t, cacheUnderTest.Set(slot, r, r, newPid))
 
 		p, ok := cacheUnderTest.Get(slot, r)
 		require.True(t, ok)
@@ -63,7 +63,7 @@ func TestPayloadIDCache(t *testing.T) {
 		slot := uint64(9456456)
 		r := [32]byte{4, 5, 6}
 		pid := [8]byte{4, 5, 6, 6, 9, 0, 9, 0}
-		cacheUnderTest.Set(slot, r, pid)
+		require.NoError(t, cacheUnderTest.Set(slot, r, r, pid))
 
 		// Prune and attempt to retrieve pruned entry
 		cacheUnderTest.UnsafePrunePrior(slot + 1)
@@ -80,7 +80,7 @@ func TestPayloadIDCache(t *testing.T) {
 			pid := [8]byte{
 				i, i, i, i, i, i, i, i,
 			}
-			cacheUnderTest.Set(slot, r, pid)
+			require.NoError(t, cacheUnderTest.Set(slot, r, r, pid))
 		}
 
 		// Prune and check if only the last two entries exist
@@ -100,3 +100,117 @@ func TestPayloadIDCache(t *testing.T) {
 		}
 	})
 }
+
+func TestPayloadIDCacheAttributes(t *testing.T) {
+	cacheUnderTest := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
+	slot := uint64(10)
+	r := [32]byte{1, 2, 3}
+	attrsA, attrsB := [32]byte{0xa}, [32]byte{0xb}
+	pidA, pidB := [8]byte{1}, [8]byte{2}
+
+	require.NoError(t, cacheUnderTest.Set(slot, r, attrsA, pidA))
+	require.NoError(t, cacheUnderTest.Set(slot, r, attrsB, pidB))
+
+	// Both builds can be reused with their own attributes.
+	p, ok := cacheUnderTest.GetByAttributes(slot, r, attrsA)
+	require.True(t, ok)
+	require.Equal(t, pidA, p)
+	p, ok = cacheUnderTest.GetByAttributes(slot, r, attrsB)
+	require.True(t, ok)
+	require.Equal(t, pidB, p)
+	_, ok = cacheUnderTest.GetByAttributes(slot, r, [32]byte{0xc})
+	require.False(t, ok)
+
+	// Get returns the latest build.
+	p, ok = cacheUnderTest.Get(slot, r)
+	require.True(t, ok)
+	require.Equal(t, pidB, p)
+
+	// Setting the first attributes again makes them the latest build.
+	newPidA := [8]byte{3}
+	require.NoError(t, cacheUnderTest.Set(slot, r, attrsA, newPidA))
+	p, ok = cacheUnderTest.Get(slot, r)
+	require.True(t, ok)
+	require.Equal(t, newPidA, p)
+	p, ok = cacheUnderTest.GetByAttributes(slot, r, attrsB)
+	require.True(t, ok)
+	require.Equal(t, pidB, p)
+}
+
+func TestPersistentPayloadIDCache(t *testing.T) {
+	store := newMemStore()
+	cacheUnderTest, err := cache.NewPersistentPayloadIDCache[
+		[8]byte, [32]byte, uint64,
+	](store)
+	require.NoError(t, err)
+
+	r := [32]byte{1, 2, 3}
+	attrsA, attrsB := [32]byte{0xa}, [32]byte{0xb}
+	require.NoError(t, cacheUnderTest.Set(1, r, attrsA, [8]byte{1}))
+	require.NoError(t, cacheUnderTest.Set(2, r, attrsA, [8]byte{2}))
+	require.NoError(t, cacheUnderTest.Set(2, r, attrsB, [8]byte{3}))
+
+	// A cache loaded from the store picks up the builds in flight.
+	restarted, err := cache.NewPersistentPayloadIDCache[
+		[8]byte, [32]byte, uint64,
+	](store)
+	require.NoError(t, err)
+	for _, tc := range []struct {
+		slot  uint64
+		attrs [32]byte
+		pid   [8]byte
+	}{
+		{1, attrsA, [8]byte{1}},
+		{2, attrsA, [8]byte{2}},
+		{2, attrsB, [8]byte{3}},
+	} {
+		p, ok := restarted.GetByAttributes(tc.slot, r, tc.attrs)
+		require.True(t, ok)
+		require.Equal(t, tc.pid, p)
+	}
+	p, ok := restarted.Get(2, r)
+	require.True(t, ok)
+	require.Equal(t, [8]byte{3}, p)
+
+	// Pruned slots are no longer persisted.
+	require.NoError(t, restarted.Set(4, r, attrsA, [8]byte{4}))
+	restarted, err = cache.NewPersistentPayloadIDCache[
+		[8]byte, [32]byte, uint64,
+	](store)
+	require.NoError(t, err)
+	require.False(t, restarted.Has(1, r))
+	require.True(t, restarted.Has(2, r))
+	require.True(t, restarted.Has(4, r))
+}
+
+func TestPersistentPayloadIDCacheMalformed(t *testing.T) {
+	store := newMemStore()
+	require.NoError(t, store.Set(cache.PayloadIDsKey, []byte{1, 2, 3}))
+	_, err := cache.NewPersistentPayloadIDCache[
+		[8]byte, [32]byte, uint64,
+	](store)
+	require.ErrorIs(t, err, cache.ErrMalformedPayloadIDs)
+}
+
+// memStore is an in-memory cache.Store.
+type memStore struct {
+	values map[string][]byte
+}
+
+func newMemStore() *memStore {
+	return &memStore{values: make(map[string][]byte)}
+}
+
+func (s *memStore) Has(key []byte) (bool, error) {
+	_, ok := s.values[string(key)]
+	return ok, nil
+}
+
+func (s *memStore) Get(key []byte) ([]byte, error) {
+	return s.values[string(key)], nil
+}
+
+func (s *memStore) Set(key []byte, value []byte) error {
+	s.values[string(key)] = value
+	return nil
+}
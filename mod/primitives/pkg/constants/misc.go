@@ -31,4 +31,23 @@ const (
 	GenesisEpoch uint64 = 0
 	// FarFutureEpoch represents a far future epoch value.
 	FarFutureEpoch = ^uint64(0)
+	// MaxEth1DataVotes is the maximum number of eth1 data votes in the state,
+	// which bounds the number of slots in an eth1 data voting period.
+	MaxEth1DataVotes uint64 = 2048
+)
+
+// Electra constants as defined:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/electra/beacon-chain.md#constants
+//
+//nolint:lll // link.
+const (
+	// UnsetDepositRequestsStartIndex is the value of the deposit requests
+	// start index before the first deposit request is processed.
+	UnsetDepositRequestsStartIndex = ^uint64(0)
+	// FullExitRequestAmount is the amount of a withdrawal request asking for
+	// the exit of the validator.
+	FullExitRequestAmount uint64 = 0
+	// PendingConsolidationsLimit is the maximum number of pending
+	// consolidations in the state.
+	PendingConsolidationsLimit uint64 = 262144
 )
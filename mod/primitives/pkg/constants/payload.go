@@ -34,6 +34,41 @@ const (
 	// MaxDepositsPerBlock is the maximum number of deposits per block.
 	MaxDepositsPerBlock uint64 = 16
 
+	// DepositContractDepth is the depth of the deposit tree.
+	DepositContractDepth uint8 = 32
+
+	// MaxProposerSlashingsPerBlock is the maximum number of proposer
+	// slashings per block.
+	MaxProposerSlashingsPerBlock uint64 = 16
+
+	// MaxAttesterSlashingsPerBlock is the maximum number of attester
+	// slashings per block.
+	MaxAttesterSlashingsPerBlock uint64 = 2
+
+	// MaxSlashingInfoPerBlock is the maximum number of misbehaving
+	// validators reported by the consensus engine per block.
+	MaxSlashingInfoPerBlock uint64 = 1024
+
+	// MaxAttestationsPerBlock is the maximum number of validator votes
+	// reported by the consensus engine per block.
+	MaxAttestationsPerBlock uint64 = 1024
+
+	// MaxVoluntaryExitsPerBlock is the maximum number of voluntary exits per
+	// block.
+	MaxVoluntaryExitsPerBlock uint64 = 16
+
+	// MaxDepositRequestsPerPayload is the maximum number of deposit
+	// requests in an execution payload.
+	MaxDepositRequestsPerPayload uint64 = 8192
+
+	// MaxWithdrawalRequestsPerPayload is the maximum number of withdrawal
+	// requests in an execution payload.
+	MaxWithdrawalRequestsPerPayload uint64 = 16
+
+	// MaxConsolidationRequestsPerPayload is the maximum number of
+	// consolidation requests in an execution payload.
+	MaxConsolidationRequestsPerPayload uint64 = 2
+
 	// MaxWithdrawalsPerPayload is the maximum number of withdrawals in a
 	// execution payload.
 	MaxWithdrawalsPerPayload uint64 = 16
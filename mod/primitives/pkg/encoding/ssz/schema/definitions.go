@@ -84,7 +84,7 @@ func (v vector) ItemPosition(p string) (uint64, uint8, uint8, error) {
 		//#nosec:G701 // can't overflow.
 		uint8(start % constants.BytesPerChunk),
 		//#nosec:G701 // can't overflow.
-		uint8(start%constants.BytesPerChunk + v.ItemLength()), nil
+		uint8(start%constants.BytesPerChunk + v.elementType.ItemLength()), nil
 }
 
 func (v vector) HashChunkCount() uint64 {
@@ -122,7 +122,7 @@ func DefineByteList(limit uint64) SSZType {
 
 func (l list) ID() ID { return List }
 
-func (l list) ItemLength() uint64 { return l.elementType.ItemLength() }
+func (l list) ItemLength() uint64 { return constants.BytesPerChunk }
 
 func (l list) HashChunkCount() uint64 {
 	totalBytes := l.Length() * l.elementType.ItemLength()
@@ -150,7 +150,7 @@ func (l list) ItemPosition(p string) (uint64, uint8, uint8, error) {
 		//#nosec:G701 // can't overflow.
 		uint8(start % constants.BytesPerChunk),
 		//#nosec:G701 // can't overflow.
-		uint8(start%constants.BytesPerChunk + l.ItemLength()), nil
+		uint8(start%constants.BytesPerChunk + l.elementType.ItemLength()), nil
 }
 
 /* -------------------------------------------------------------------------- */
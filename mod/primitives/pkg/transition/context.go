@@ -38,6 +38,18 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// SkipValidateMisbehaviors indicates whether to skip checking the
+	// slashing info of the block against the misbehavior evidence.
+	SkipValidateMisbehaviors bool
+	// Misbehaviors is the misbehavior evidence the consensus engine
+	// committed in the block.
+	Misbehaviors []Misbehavior
+	// SkipValidateVotes indicates whether to skip checking the attestations
+	// of the block against the committed votes.
+	SkipValidateVotes bool
+	// Votes is the votes for the parent block the consensus engine
+	// committed in the block.
+	Votes []Vote
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -65,6 +77,30 @@ func (c *Context) GetSkipValidateResult() bool {
 	return c.SkipValidateResult
 }
 
+// GetSkipValidateMisbehaviors returns whether to skip checking the slashing
+// info of the block against the misbehavior evidence.
+func (c *Context) GetSkipValidateMisbehaviors() bool {
+	return c.SkipValidateMisbehaviors
+}
+
+// GetMisbehaviors returns the misbehavior evidence the consensus engine
+// committed in the block.
+func (c *Context) GetMisbehaviors() []Misbehavior {
+	return c.Misbehaviors
+}
+
+// GetSkipValidateVotes returns whether to skip checking the attestations of
+// the block against the committed votes.
+func (c *Context) GetSkipValidateVotes() bool {
+	return c.SkipValidateVotes
+}
+
+// GetVotes returns the votes for the parent block the consensus engine
+// committed in the block.
+func (c *Context) GetVotes() []Vote {
+	return c.Votes
+}
+
 // Unwrap returns the underlying standard context.
 func (c *Context) Unwrap() context.Context {
 	return c.Context
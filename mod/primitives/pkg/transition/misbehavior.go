@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package transition
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Misbehavior is the evidence, committed by the consensus engine in a block,
+// of a validator misbehaving at a given height.
+type Misbehavior struct {
+	// Address is the consensus address of the misbehaving validator.
+	Address []byte
+	// Height is the height at which the validator misbehaved.
+	Height math.U64
+}
+
+// misbehaviorsKey is the context key of the misbehavior evidence.
+type misbehaviorsKey struct{}
+
+// ContextWithMisbehaviors returns a copy of the given context carrying the
+// misbehavior evidence of the block being processed.
+func ContextWithMisbehaviors(
+	ctx context.Context,
+	misbehaviors []Misbehavior,
+) context.Context {
+	return context.WithValue(ctx, misbehaviorsKey{}, misbehaviors)
+}
+
+// MisbehaviorsFromContext returns the misbehavior evidence carried by the
+// given context, if any.
+func MisbehaviorsFromContext(ctx context.Context) []Misbehavior {
+	misbehaviors, _ := ctx.Value(misbehaviorsKey{}).([]Misbehavior)
+	return misbehaviors
+}
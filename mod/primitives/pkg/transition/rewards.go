@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// AttestationDelta is the change of the balance of a validator at the end of
+// an epoch, for its participation in the votes of the epoch.
+type AttestationDelta struct {
+	// Index is the index of the validator.
+	Index math.ValidatorIndex
+	// Reward is the reward for the blocks that included the vote of the
+	// validator.
+	Reward math.Gwei
+	// Penalty is the penalty for the blocks that did not include the vote of
+	// the validator.
+	Penalty math.Gwei
+	// InactivityPenalty is the penalty for not voting for longer than the
+	// inactivity grace period.
+	InactivityPenalty math.Gwei
+	// IdealReward is the reward the validator would have received had its
+	// vote been included in every block of the epoch.
+	IdealReward math.Gwei
+}
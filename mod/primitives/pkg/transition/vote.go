@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition
+
+import "context"
+
+// Vote is a vote for the parent block, committed by the consensus engine in a
+// block.
+type Vote struct {
+	// Address is the consensus address of the validator that cast the vote.
+	Address []byte
+}
+
+// votesKey is the context key of the committed votes.
+type votesKey struct{}
+
+// ContextWithVotes returns a copy of the given context carrying the votes
+// committed in the block being processed.
+func ContextWithVotes(ctx context.Context, votes []Vote) context.Context {
+	return context.WithValue(ctx, votesKey{}, votes)
+}
+
+// VotesFromContext returns the committed votes carried by the given context,
+// if any.
+func VotesFromContext(ctx context.Context) []Vote {
+	votes, _ := ctx.Value(votesKey{}).([]Vote)
+	return votes
+}
@@ -34,6 +34,30 @@ const (
 	Electra
 )
 
+// Name returns the lowercase name of the given fork version, as used by the
+// Eth-Consensus-Version header of the beacon API. It returns an empty string
+// for unknown versions.
+func Name(version uint32) string {
+	switch version {
+	case Phase0:
+		return "phase0"
+	case Altair:
+		return "altair"
+	case Bellatrix:
+		return "bellatrix"
+	case Capella:
+		return "capella"
+	case Deneb:
+		return "deneb"
+	case DenebPlus:
+		return "deneb_plus"
+	case Electra:
+		return "electra"
+	default:
+		return ""
+	}
+}
+
 // FromUint32 returns a Version from a uint32.
 func FromUint32[VersionT ~[4]byte](version uint32) VersionT {
 	versionBz := VersionT{}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package comet
+
+import (
+	"context"
+	"encoding/json"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+)
+
+// StatusClient queries the sync status of the local CometBFT node through
+// its RPC endpoint.
+type StatusClient struct {
+	client *rpchttp.HTTP
+}
+
+// NewStatusClient creates a new StatusClient for the CometBFT RPC endpoint
+// listening on the given address.
+func NewStatusClient(rpcAddr string) (*StatusClient, error) {
+	client, err := rpchttp.New(rpcAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &StatusClient{client: client}, nil
+}
+
+// SyncStatus returns the latest block height of the local CometBFT node, the
+// latest block height of the network and whether the node is still catching
+// up. The network height is derived from the heights that the peers of the
+// node are at, and is never below the local height.
+func (c *StatusClient) SyncStatus(
+	ctx context.Context,
+) (int64, int64, bool, error) {
+	status, err := c.client.Status(ctx)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	networkHeight, err := c.peerHeight(ctx)
+	if err != nil {
+		return 0, 0, false, err
+	}
+	localHeight := status.SyncInfo.LatestBlockHeight
+	return localHeight,
+		max(localHeight, networkHeight),
+		status.SyncInfo.CatchingUp,
+		nil
+}
+
+// peerHeight returns the latest block height among the peers of the node, as
+// tracked by its consensus reactor. A peer at consensus height h has
+// committed the block at height h-1.
+func (c *StatusClient) peerHeight(ctx context.Context) (int64, error) {
+	res, err := c.client.DumpConsensusState(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var height int64
+	for _, peer := range res.Peers {
+		var state struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err = json.Unmarshal(peer.PeerState, &state); err != nil {
+			return 0, err
+		}
+		height = max(height, state.RoundState.Height-1)
+	}
+	return height, nil
+}
@@ -428,6 +428,10 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 
 	app.cms.Commit()
 
+	// The snapshot, if one is due at this height, is taken in the
+	// background.
+	app.snapshotManager.SnapshotIfApplicable(header.Height)
+
 	resp := &abci.CommitResponse{
 		RetainHeight: retainHeight,
 	}
@@ -595,6 +599,16 @@ func (app *BaseApp) GetBlockRetentionHeight(commitHeight int64) int64 {
 		retentionHeight = commitHeight - cp.Evidence.MaxAgeNumBlocks
 	}
 
+	if app.snapshotManager != nil {
+		snapshotRetentionHeights := app.snapshotManager.
+			GetSnapshotBlockRetentionHeights()
+		if snapshotRetentionHeights > 0 {
+			retentionHeight = minNonZero(
+				retentionHeight, commitHeight-snapshotRetentionHeights,
+			)
+		}
+	}
+
 	//#nosec:G701 // bet.
 	v := commitHeight - int64(app.minRetainBlocks)
 	retentionHeight = minNonZero(retentionHeight, v)
@@ -24,10 +24,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
@@ -61,6 +63,14 @@ type BaseApp struct {
 	db     dbm.DB                      // common DB backend
 	cms    storetypes.CommitMultiStore // Main (uncached) state
 
+	// snapshotManager takes and restores state sync snapshots, it is nil
+	// if snapshots are not configured.
+	snapshotManager *snapshots.Manager
+
+	// closers release the resources held by the application alongside the
+	// common DB backend.
+	closers []io.Closer
+
 	initChainer     sdk.InitChainer                                                           // ABCI InitChain handler
 	finalizeBlocker func(context.Context, proto.Message) (transition.ValidatorUpdates, error) // (legacy ABCI) EndBlock handler
 	processProposal sdk.ProcessProposalHandler                                                // ABCI ProcessProposal handler
@@ -308,6 +318,19 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	if app.snapshotManager != nil {
+		app.logger.Info("Closing snapshots/metadata.db")
+		if err := app.snapshotManager.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, closer := range app.closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -23,7 +23,6 @@ package baseapp
 import (
 	"context"
 
-	"cosmossdk.io/store/snapshots"
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
@@ -43,43 +42,6 @@ func (BaseApp) Query(
 	return &abci.QueryResponse{}, nil
 }
 
-// ListSnapshots implements the ABCI interface. It delegates to
-// app.snapshotManager if set.
-func (BaseApp) ListSnapshots(
-	_ *abci.ListSnapshotsRequest,
-) (*abci.ListSnapshotsResponse, error) {
-	return &abci.ListSnapshotsResponse{}, nil
-}
-
-// LoadSnapshotChunk implements the ABCI interface. It delegates to
-// app.snapshotManager if set.
-func (BaseApp) LoadSnapshotChunk(
-	_ *abci.LoadSnapshotChunkRequest,
-) (*abci.LoadSnapshotChunkResponse, error) {
-	return &abci.LoadSnapshotChunkResponse{}, nil
-}
-
-// OfferSnapshot implements the ABCI interface. It delegates to
-// app.snapshotManager if set.
-func (BaseApp) OfferSnapshot(
-	_ *abci.OfferSnapshotRequest,
-) (*abci.OfferSnapshotResponse, error) {
-	return &abci.OfferSnapshotResponse{}, nil
-}
-
-// ApplySnapshotChunk implements the ABCI interface. It delegates to
-// app.snapshotManager if set.
-func (BaseApp) ApplySnapshotChunk(
-	_ *abci.ApplySnapshotChunkRequest,
-) (*abci.ApplySnapshotChunkResponse, error) {
-	return &abci.ApplySnapshotChunkResponse{}, nil
-}
-
-// SnapshotManager returns the snapshot manager.
-func (BaseApp) SnapshotManager() *snapshots.Manager {
-	return &snapshots.Manager{}
-}
-
 func (BaseApp) ExtendVote(
 	_ context.Context,
 	_ *abci.ExtendVoteRequest,
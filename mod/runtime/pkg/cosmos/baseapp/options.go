@@ -24,8 +24,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -72,10 +75,42 @@ func SetChainID(chainID string) func(*BaseApp) {
 	return func(app *BaseApp) { app.chainID = chainID }
 }
 
+// SetSnapshot returns a BaseApp option function that sets the store and
+// options of the state sync snapshots. Snapshots are disabled if the store is
+// nil.
+func SetSnapshot(
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
+}
+
+// AddClosers returns a BaseApp option function that registers resources to
+// be closed alongside the application.
+func AddClosers(closers ...io.Closer) func(*BaseApp) {
+	return func(app *BaseApp) { app.closers = append(app.closers, closers...) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	app.name = name
 }
 
+// SetSnapshot sets the store and options of the state sync snapshots.
+// Snapshots are disabled if the store is nil.
+func (app *BaseApp) SetSnapshot(
+	snapshotStore *snapshots.Store,
+	opts snapshottypes.SnapshotOptions,
+) {
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+	app.cms.SetSnapshotInterval(opts.Interval)
+	app.snapshotManager = snapshots.NewManager(
+		snapshotStore, opts, app.cms, nil, app.logger,
+	)
+}
+
 // SetParamStore sets a parameter store on the BaseApp.
 func (app *BaseApp) SetParamStore(ps ParamStore) {
 	app.paramStore = ps
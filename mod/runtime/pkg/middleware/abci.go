@@ -32,6 +32,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cosmos/gogoproto/proto"
 	"golang.org/x/sync/errgroup"
 )
@@ -198,6 +199,16 @@ func (h *ABCIMiddleware[
 
 	defer h.metrics.measureProcessProposalDuration(startTime)
 
+	// Attach the misbehavior evidence and the votes of the proposal so that
+	// the slashing info and the attestations of the block can be checked
+	// against them.
+	ctx = transition.ContextWithMisbehaviors(
+		ctx, misbehaviorsFromABCI(abciReq.Misbehavior),
+	)
+	ctx = transition.ContextWithVotes(
+		ctx, votesFromABCI(abciReq.ProposedLastCommit.Votes),
+	)
+
 	// Request the beacon block.
 	if blk, err = h.beaconBlockGossiper.Request(ctx, abciReq); err != nil {
 		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
@@ -328,6 +339,14 @@ func (h *ABCIMiddleware[
 		return nil, nil
 	}
 
+	// Attach the misbehavior evidence and the votes committed in the block.
+	ctx = transition.ContextWithMisbehaviors(
+		ctx, misbehaviorsFromABCI(abciReq.Misbehavior),
+	)
+	ctx = transition.ContextWithVotes(
+		ctx, votesFromABCI(abciReq.DecidedLastCommit.Votes),
+	)
+
 	// Send the sidecars to the sidecars feed and wait for a response
 	if err = h.processSidecars(ctx, blobs); err != nil {
 		return nil, err
@@ -393,3 +412,32 @@ func (h *ABCIMiddleware[
 		return nil, ctx.Err()
 	}
 }
+
+// misbehaviorsFromABCI converts the misbehavior evidence of an ABCI request
+// into its transition representation.
+func misbehaviorsFromABCI(
+	misbehaviors []cmtabci.Misbehavior,
+) []transition.Misbehavior {
+	res := make([]transition.Misbehavior, len(misbehaviors))
+	for i, misbehavior := range misbehaviors {
+		res[i] = transition.Misbehavior{
+			Address: misbehavior.Validator.Address,
+			//#nosec:G701 // heights are never negative.
+			Height: math.U64(misbehavior.GetHeight()),
+		}
+	}
+	return res
+}
+
+// votesFromABCI converts the votes committed for the parent block in an ABCI
+// request into their transition representation.
+func votesFromABCI(votes []cmtabci.VoteInfo) []transition.Vote {
+	res := make([]transition.Vote, 0, len(votes))
+	for _, vote := range votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		res = append(res, transition.Vote{Address: vote.Validator.Address})
+	}
+	return res
+}
@@ -44,8 +44,8 @@ type ABCIMiddleware[
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
-	// TODO: we will eventually gossip the blobs separately from
-	// CometBFT, but for now, these are no-op gossipers.
+	// blobGossiper gossips the blob sidecars of proposed blocks to peers,
+	// while they are still included in the proposal.
 	blobGossiper p2p.PublisherReceiver[
 		BlobSidecarsT,
 		[]byte,
@@ -96,6 +96,9 @@ func NewABCIMiddleware[
 	chainSpec common.ChainSpec,
 	logger log.Logger[any],
 	telemetrySink TelemetrySink,
+	blobGossiper p2p.PublisherReceiver[
+		BlobSidecarsT, []byte, encoding.ABCIRequest, BlobSidecarsT,
+	],
 	genesisBroker *broker.Broker[*asynctypes.Event[GenesisT]],
 	blkBroker *broker.Broker[*asynctypes.Event[BeaconBlockT]],
 	sidecarsBroker *broker.Broker[*asynctypes.Event[BlobSidecarsT]],
@@ -107,10 +110,8 @@ func NewABCIMiddleware[
 	return &ABCIMiddleware[
 		BeaconBlockT, BlobSidecarsT, GenesisT, SlotDataT,
 	]{
-		chainSpec: chainSpec,
-		blobGossiper: rp2p.NewNoopBlobHandler[
-			BlobSidecarsT, encoding.ABCIRequest,
-		](),
+		chainSpec:    chainSpec,
+		blobGossiper: blobGossiper,
 		beaconBlockGossiper: rp2p.
 			NewNoopBlockGossipHandler[
 			BeaconBlockT, encoding.ABCIRequest,
@@ -144,12 +145,16 @@ func (am *ABCIMiddleware[_, _, _, _]) Name() string {
 func (am *ABCIMiddleware[_, _, _, _]) Start(
 	ctx context.Context,
 ) error {
-	subBlkCh, err := am.blkBroker.Subscribe()
+	subBlkCh, err := am.blkBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		return err
 	}
 
-	subSidecarsCh, err := am.sidecarsBroker.Subscribe()
+	subSidecarsCh, err := am.sidecarsBroker.Subscribe(
+		broker.WithPolicy(broker.PolicyBlock),
+	)
 	if err != nil {
 		return err
 	}
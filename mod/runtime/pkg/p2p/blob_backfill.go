@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// backfillPollInterval is the interval at which the backfill checks whether
+// peers are connected.
+const backfillPollInterval = 5 * time.Second
+
+// backfillOnPeers backfills the missing blob sidecars once the first peer is
+// connected.
+func (r *BlobReactor[_, _, _, _, _]) backfillOnPeers(ctx context.Context) {
+	ticker := time.NewTicker(backfillPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		r.mu.RLock()
+		numPeers := len(r.peers)
+		r.mu.RUnlock()
+		if numPeers > 0 {
+			break
+		}
+	}
+
+	n, err := r.Backfill(ctx)
+	if err != nil {
+		r.logger.Warn("Failed to backfill blob sidecars", "error", err)
+		return
+	}
+	if n > 0 {
+		r.logger.Info("Backfilled blob sidecars", "blocks", n)
+	}
+}
+
+// Backfill requests the blob sidecars of the blocks within the data
+// availability window whose blob sidecars are not stored, such as after a
+// checkpoint sync, and stores them. It returns the number of blocks whose
+// blob sidecars were stored.
+func (r *BlobReactor[_, _, _, _, _]) Backfill(
+	ctx context.Context,
+) (int, error) {
+	head, roots, err := r.missingBlocks(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var stored int
+	for len(roots) > 0 {
+		chunk := roots[:min(len(roots), MaxRequestBlocks)]
+		roots = roots[len(chunk):]
+
+		sidecars, err := r.RequestByRoot(ctx, chunk)
+		if err != nil {
+			return stored, err
+		}
+		for _, sc := range sidecars {
+			if err = r.availabilityStore.Persist(head, sc); err != nil {
+				return stored, err
+			}
+			stored++
+		}
+	}
+	return stored, nil
+}
+
+// missingBlocks returns the latest slot and the roots of the blocks within
+// the data availability window whose blob sidecars are not stored, latest
+// first.
+func (r *BlobReactor[BeaconBlockT, _, _, _, _]) missingBlocks(
+	ctx context.Context,
+) (math.Slot, []common.Root, error) {
+	var (
+		head   = r.headSlot()
+		window = r.chainSpec.MinEpochsForBlobsSidecarsRequest() *
+			r.chainSpec.SlotsPerEpoch()
+		roots []common.Root
+	)
+	err := r.blockStore.WalkReverse(
+		head-min(head, math.Slot(window)), head+1,
+		func(slot math.Slot, blk BeaconBlockT) (bool, error) {
+			if !r.availabilityStore.IsDataAvailable(
+				ctx, slot, blk.GetBody(),
+			) {
+				roots = append(roots, blk.HashTreeRoot())
+			}
+			return ctx.Err() != nil, ctx.Err()
+		},
+	)
+	return head, roots, err
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	stdmath "math"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/p2p"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// handleSidecars verifies gossiped blob sidecars of a canonical block, relays
+// them to the other peers and stores them. Blob sidecars of other blocks,
+// including the block being proposed, whose sidecars are included in the
+// proposal, are ignored, as their headers cannot be trusted.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) handleSidecars(
+	src p2p.Peer, bz []byte,
+) {
+	var sidecars BlobSidecarsT
+	sidecars = sidecars.Empty()
+	if err := sidecars.UnmarshalSSZ(bz); err != nil || sidecars.Len() == 0 {
+		r.penalize(src, malformedMessagePenalty, ErrMalformedMessage)
+		return
+	}
+
+	header := sidecars.Get(0).GetBeaconBlockHeader()
+	root := header.HashTreeRoot()
+	if r.hasSeen(root) {
+		return
+	}
+
+	// Blob sidecars outside the data availability window or of unknown
+	// blocks are ignored.
+	head := r.headSlot()
+	if slot := header.GetSlot(); slot > head ||
+		!r.chainSpec.WithinDAPeriod(slot, head) || !r.isCanonical(header) {
+		return
+	}
+
+	if err := r.verifier.VerifySidecars(sidecars); err != nil {
+		r.penalize(src, invalidSidecarsPenalty, err)
+		return
+	}
+	r.reward(src, validSidecarsReward)
+
+	if !r.markSeen(root) {
+		return
+	}
+	r.gossip(newSidecarsMessage(bz), src.ID())
+
+	if err := r.availabilityStore.Persist(head, sidecars); err != nil {
+		r.logger.Error(
+			"Failed to store gossiped blob sidecars",
+			"slot", header.GetSlot(), "error", err,
+		)
+	}
+}
+
+// gossip sends the message to all connected peers except the given one.
+func (r *BlobReactor[_, _, _, _, _]) gossip(
+	msg *gogotypes.BytesValue, except p2p.ID,
+) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for id, peer := range r.peers {
+		if id == except {
+			continue
+		}
+		peer.TrySend(p2p.Envelope{
+			ChannelID: BlobGossipChannel,
+			Message:   msg,
+		})
+	}
+}
+
+// hasSeen returns true if the blob sidecars of the block were gossiped.
+func (r *BlobReactor[_, _, _, _, _]) hasSeen(root common.Root) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	_, ok := r.seen[root]
+	return ok
+}
+
+// markSeen marks the blob sidecars of the block as gossiped, evicting the
+// oldest block once the cache is full. It returns false if they were
+// already marked.
+func (r *BlobReactor[_, _, _, _, _]) markSeen(root common.Root) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.seen[root]; ok {
+		return false
+	}
+	if len(r.seenOrder) == seenCacheSize {
+		delete(r.seen, r.seenOrder[0])
+		r.seenOrder = r.seenOrder[1:]
+	}
+	r.seen[root] = struct{}{}
+	r.seenOrder = append(r.seenOrder, root)
+	return true
+}
+
+// headSlot returns the slot of the latest block in the block store.
+func (r *BlobReactor[BeaconBlockT, _, _, _, _]) headSlot() math.Slot {
+	var head math.Slot
+	if err := r.blockStore.WalkReverse(
+		0, math.Slot(stdmath.MaxUint64),
+		func(slot math.Slot, _ BeaconBlockT) (bool, error) {
+			head = slot
+			return true, nil
+		},
+	); err != nil {
+		r.logger.Error("Failed to read the latest block", "error", err)
+	}
+	return head
+}
+
+// isCanonical returns true if the block store holds the block of the header.
+func (r *BlobReactor[_, _, BeaconBlockHeaderT, _, _]) isCanonical(
+	header BeaconBlockHeaderT,
+) bool {
+	slot, err := r.blockStore.GetSlotByBlockRoot(header.HashTreeRoot())
+	return err == nil && slot == header.GetSlot()
+}
+
+// verify checks that the blob sidecars are valid and belong to a known
+// block.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) verify(
+	sidecars BlobSidecarsT,
+) error {
+	if sidecars.Len() == 0 {
+		return ErrMalformedMessage
+	}
+	if err := r.verifier.VerifySidecars(sidecars); err != nil {
+		return err
+	}
+	if header := sidecars.Get(0).GetBeaconBlockHeader(); !r.isCanonical(
+		header,
+	) {
+		return errors.Wrapf(
+			ErrUnknownBlock, "slot %d", header.GetSlot(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// messageType is the first byte of every blob reactor message.
+type messageType byte
+
+const (
+	// sidecarsMessage gossips the SSZ encoded blob sidecars of a block.
+	sidecarsMessage messageType = iota
+	// byRangeRequestMessage requests the blob sidecars of the blocks in a
+	// range of slots, encoded as the request id, start slot and count.
+	byRangeRequestMessage
+	// byRootRequestMessage requests the blob sidecars of the blocks with the
+	// given roots, encoded as the request id followed by the roots.
+	byRootRequestMessage
+	// responseMessage answers a request with the SSZ encoded blob sidecars
+	// of one block, encoded as the request id, a done flag and the sidecars.
+	// A response with the done flag set ends the request.
+	responseMessage
+)
+
+const (
+	// idLength is the length of an encoded request id.
+	idLength = 8
+	// byRangeRequestLength is the length of an encoded by-range request.
+	byRangeRequestLength = idLength + 16
+	// responseHeaderLength is the length of an encoded response before the
+	// blob sidecars.
+	responseHeaderLength = idLength + 1
+)
+
+// blobRequest is a decoded by-range or by-root request.
+type blobRequest struct {
+	id    uint64
+	start math.Slot
+	count uint64
+	roots []common.Root
+}
+
+// blobResponse is a decoded response.
+type blobResponse struct {
+	id       uint64
+	done     bool
+	sidecars []byte
+}
+
+// newMessage wraps the payload of a message of the given type.
+func newMessage(typ messageType, payload []byte) *gogotypes.BytesValue {
+	return &gogotypes.BytesValue{
+		Value: append([]byte{byte(typ)}, payload...),
+	}
+}
+
+// newSidecarsMessage returns a gossip message of the SSZ encoded blob
+// sidecars.
+func newSidecarsMessage(sidecars []byte) *gogotypes.BytesValue {
+	return newMessage(sidecarsMessage, sidecars)
+}
+
+// newByRangeRequestMessage returns a request for the blob sidecars of count
+// blocks from the start slot.
+func newByRangeRequestMessage(
+	id uint64, start math.Slot, count uint64,
+) *gogotypes.BytesValue {
+	payload := make([]byte, 0, byRangeRequestLength)
+	payload = binary.BigEndian.AppendUint64(payload, id)
+	payload = binary.BigEndian.AppendUint64(payload, start.Unwrap())
+	payload = binary.BigEndian.AppendUint64(payload, count)
+	return newMessage(byRangeRequestMessage, payload)
+}
+
+// newByRootRequestMessage returns a request for the blob sidecars of the
+// blocks with the given roots.
+func newByRootRequestMessage(
+	id uint64, roots []common.Root,
+) *gogotypes.BytesValue {
+	payload := make([]byte, 0, idLength+len(roots)*len(common.Root{}))
+	payload = binary.BigEndian.AppendUint64(payload, id)
+	for _, root := range roots {
+		payload = append(payload, root[:]...)
+	}
+	return newMessage(byRootRequestMessage, payload)
+}
+
+// newResponseMessage returns a response to the request with the given id.
+func newResponseMessage(
+	id uint64, done bool, sidecars []byte,
+) *gogotypes.BytesValue {
+	payload := make([]byte, 0, responseHeaderLength+len(sidecars))
+	payload = binary.BigEndian.AppendUint64(payload, id)
+	if done {
+		payload = append(payload, 1)
+	} else {
+		payload = append(payload, 0)
+	}
+	return newMessage(responseMessage, append(payload, sidecars...))
+}
+
+// decodeMessage splits a message into its type and payload.
+func decodeMessage(msg any) (messageType, []byte, error) {
+	bv, ok := msg.(*gogotypes.BytesValue)
+	if !ok || len(bv.GetValue()) == 0 {
+		return 0, nil, ErrMalformedMessage
+	}
+	return messageType(bv.GetValue()[0]), bv.GetValue()[1:], nil
+}
+
+// decodeRequest decodes the payload of a by-range or by-root request.
+func decodeRequest(typ messageType, payload []byte) (*blobRequest, error) {
+	switch typ {
+	case byRangeRequestMessage:
+		if len(payload) != byRangeRequestLength {
+			return nil, ErrMalformedMessage
+		}
+		req := &blobRequest{
+			id:    binary.BigEndian.Uint64(payload),
+			start: math.Slot(binary.BigEndian.Uint64(payload[idLength:])),
+			count: binary.BigEndian.Uint64(payload[idLength+8:]),
+		}
+		if req.count > MaxRequestBlocks {
+			return nil, ErrRequestTooLarge
+		}
+		return req, nil
+	case byRootRequestMessage:
+		rootLength := len(common.Root{})
+		if len(payload) < idLength ||
+			(len(payload)-idLength)%rootLength != 0 {
+			return nil, ErrMalformedMessage
+		}
+		req := &blobRequest{id: binary.BigEndian.Uint64(payload)}
+		for bz := payload[idLength:]; len(bz) > 0; bz = bz[rootLength:] {
+			req.roots = append(req.roots, common.Root(bz[:rootLength]))
+		}
+		if len(req.roots) > MaxRequestBlocks {
+			return nil, ErrRequestTooLarge
+		}
+		return req, nil
+	default:
+		return nil, ErrMalformedMessage
+	}
+}
+
+// decodeResponse decodes the payload of a response.
+func decodeResponse(payload []byte) (*blobResponse, error) {
+	if len(payload) < responseHeaderLength || payload[idLength] > 1 {
+		return nil, ErrMalformedMessage
+	}
+	return &blobResponse{
+		id:       binary.BigEndian.Uint64(payload),
+		done:     payload[idLength] == 1,
+		sidecars: payload[responseHeaderLength:],
+	}, nil
+}
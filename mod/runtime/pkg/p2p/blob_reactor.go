@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/encoding"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+const (
+	// BlobReactorName is the name the blob reactor is registered under with
+	// the CometBFT switch.
+	BlobReactorName = "BLOB"
+
+	// BlobGossipChannel is the channel blob sidecars are gossiped on.
+	BlobGossipChannel = byte(0x70)
+	// BlobRequestChannel is the channel blob sidecars are requested and
+	// served on.
+	BlobRequestChannel = byte(0x71)
+
+	// MaxRequestBlocks is the maximum number of blocks whose blob sidecars
+	// are requested or served at once.
+	MaxRequestBlocks = 64
+
+	// maxMessageSize is the maximum size of a blob reactor message, which
+	// fits the blob sidecars of a block.
+	maxMessageSize = 8 << 20
+	// seenCacheSize is the number of blocks whose gossiped blob sidecars are
+	// remembered, so that they are relayed only once.
+	seenCacheSize = 1024
+	// maxPeerRequests is the maximum number of requests of a peer that are
+	// served at once.
+	maxPeerRequests = 2
+	// maxGossipVerifications is the maximum number of gossiped blob sidecars
+	// that are verified at once. Gossiped blob sidecars received beyond it
+	// are dropped.
+	maxGossipVerifications = 4
+)
+
+// BlobReactor gossips blob sidecars and serves requests for the blob
+// sidecars of blocks within the data availability window over CometBFT p2p
+// channels. Blob sidecars received from peers are verified before they are
+// stored or relayed, and peers sending invalid blob sidecars are penalized.
+type BlobReactor[
+	BeaconBlockT BeaconBlockWithBody[BeaconBlockBodyT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
+] struct {
+	p2p.BaseReactor
+
+	// chainSpec is the chain specification.
+	chainSpec common.ChainSpec
+	// logger is the logger for the reactor.
+	logger log.Logger[any]
+	// availabilityStore stores the blob sidecars.
+	availabilityStore AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT]
+	// blockStore is used to check that blob sidecars belong to canonical
+	// blocks.
+	blockStore BlockStore[BeaconBlockT]
+	// verifier verifies blob sidecars received from peers.
+	verifier BlobVerifier[BlobSidecarsT]
+
+	// mu protects the fields below.
+	mu sync.RWMutex
+	// peers are the connected peers.
+	peers map[p2p.ID]p2p.Peer
+	// seen is the set of block roots whose blob sidecars were gossiped, in
+	// seenOrder.
+	seen      map[common.Root]struct{}
+	seenOrder []common.Root
+	// pending are the requests awaiting responses, by request id.
+	pending map[uint64]*pendingRequest
+
+	// verifications bounds the gossiped blob sidecars verified at once.
+	verifications chan struct{}
+
+	// nextID is the id of the last request sent.
+	nextID atomic.Uint64
+	// cancel stops the background backfill.
+	cancel context.CancelFunc
+}
+
+// NewBlobReactor returns a new blob reactor.
+func NewBlobReactor[
+	BeaconBlockT BeaconBlockWithBody[BeaconBlockBodyT],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT BeaconBlockHeader,
+	BlobSidecarT BlobSidecar[BeaconBlockHeaderT],
+	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
+](
+	chainSpec common.ChainSpec,
+	logger log.Logger[any],
+	availabilityStore AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
+	blockStore BlockStore[BeaconBlockT],
+	verifier BlobVerifier[BlobSidecarsT],
+) *BlobReactor[
+	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	BlobSidecarT, BlobSidecarsT,
+] {
+	r := &BlobReactor[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+		BlobSidecarT, BlobSidecarsT,
+	]{
+		chainSpec:         chainSpec,
+		logger:            logger,
+		availabilityStore: availabilityStore,
+		blockStore:        blockStore,
+		verifier:          verifier,
+		peers:             make(map[p2p.ID]p2p.Peer),
+		seen:              make(map[common.Root]struct{}),
+		pending:           make(map[uint64]*pendingRequest),
+		verifications:     make(chan struct{}, maxGossipVerifications),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor(BlobReactorName, r)
+	return r
+}
+
+// OnStart starts backfilling the blob sidecars missing within the data
+// availability window.
+func (r *BlobReactor[_, _, _, _, _]) OnStart() error {
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	go r.backfillOnPeers(ctx)
+	return nil
+}
+
+// OnStop stops the backfill.
+func (r *BlobReactor[_, _, _, _, _]) OnStop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+}
+
+// GetChannels returns the gossip and request channels.
+func (r *BlobReactor[_, _, _, _, _]) GetChannels() []*conn.ChannelDescriptor {
+	return []*conn.ChannelDescriptor{
+		{
+			ID:                  BlobGossipChannel,
+			Priority:            5,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: maxMessageSize,
+			MessageType:         &gogotypes.BytesValue{},
+		},
+		{
+			ID:                  BlobRequestChannel,
+			Priority:            3,
+			SendQueueCapacity:   MaxRequestBlocks + 1,
+			RecvMessageCapacity: maxMessageSize,
+			MessageType:         &gogotypes.BytesValue{},
+		},
+	}
+}
+
+// AddPeer adds the peer to the peers blob sidecars are gossiped to and
+// requested from.
+func (r *BlobReactor[_, _, _, _, _]) AddPeer(peer p2p.Peer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.peers[peer.ID()] = peer
+}
+
+// RemovePeer removes the peer.
+func (r *BlobReactor[_, _, _, _, _]) RemovePeer(peer p2p.Peer, _ any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.peers, peer.ID())
+}
+
+// Receive handles a message from a peer. Gossiped blob sidecars are
+// verified and requests are served off the receive routine, within the
+// bounds of maxGossipVerifications and maxPeerRequests.
+func (r *BlobReactor[_, _, _, _, _]) Receive(e p2p.Envelope) {
+	typ, payload, err := decodeMessage(e.Message)
+	if err != nil {
+		r.penalize(e.Src, malformedMessagePenalty, err)
+		return
+	}
+
+	switch {
+	case e.ChannelID == BlobGossipChannel && typ == sidecarsMessage:
+		select {
+		case r.verifications <- struct{}{}:
+			go func() {
+				defer func() { <-r.verifications }()
+				r.handleSidecars(e.Src, payload)
+			}()
+		default:
+			r.logger.Debug(
+				"Dropped gossiped blob sidecars", "peer", e.Src.ID(),
+			)
+		}
+	case e.ChannelID == BlobRequestChannel && typ == responseMessage:
+		r.handleResponse(e.Src, payload)
+	case e.ChannelID == BlobRequestChannel:
+		var req *blobRequest
+		if req, err = decodeRequest(typ, payload); err != nil {
+			r.penalize(e.Src, malformedMessagePenalty, err)
+			return
+		}
+		requests := requestsOf(e.Src)
+		select {
+		case requests <- struct{}{}:
+			go func() {
+				defer func() { <-requests }()
+				r.serve(e.Src, req)
+			}()
+		default:
+			r.penalize(e.Src, tooManyRequestsPenalty, ErrTooManyRequests)
+		}
+	default:
+		r.penalize(e.Src, malformedMessagePenalty, ErrMalformedMessage)
+	}
+}
+
+// Publish gossips the blob sidecars to the connected peers and returns
+// their SSZ encoding, which is still included in the proposal.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) Publish(
+	_ context.Context,
+	sidecars BlobSidecarsT,
+) ([]byte, error) {
+	bz, err := sidecars.MarshalSSZ()
+	if err != nil || sidecars.Len() == 0 {
+		return bz, err
+	}
+
+	root := sidecars.Get(0).GetBeaconBlockHeader().HashTreeRoot()
+	if r.markSeen(root) {
+		r.gossip(newSidecarsMessage(bz), "")
+	}
+	return bz, nil
+}
+
+// Request returns the blob sidecars included in the proposal.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) Request(
+	_ context.Context,
+	req encoding.ABCIRequest,
+) (BlobSidecarsT, error) {
+	return encoding.UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
+		req,
+		1,
+	)
+}
@@ -0,0 +1,404 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p_test
+
+import (
+	"context"
+	"encoding/binary"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/p2p"
+	cmtp2p "github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testHeader is the header of a test block.
+type testHeader struct {
+	slot math.Slot
+	root common.Root
+}
+
+func (h *testHeader) GetSlot() math.Slot        { return h.slot }
+func (h *testHeader) HashTreeRoot() common.Root { return h.root }
+
+// testSidecar is a blob sidecar of a test block.
+type testSidecar struct {
+	header *testHeader
+}
+
+func (s *testSidecar) GetBeaconBlockHeader() *testHeader { return s.header }
+
+// testSidecars are encoded as the slot and root of the header of each
+// sidecar.
+type testSidecars struct {
+	sidecars []*testSidecar
+}
+
+func (*testSidecars) Empty() *testSidecars     { return &testSidecars{} }
+func (s *testSidecars) Len() int               { return len(s.sidecars) }
+func (s *testSidecars) Get(i int) *testSidecar { return s.sidecars[i] }
+
+func (s *testSidecars) MarshalSSZ() ([]byte, error) {
+	var bz []byte
+	for _, sc := range s.sidecars {
+		bz = binary.BigEndian.AppendUint64(bz, sc.header.slot.Unwrap())
+		bz = append(bz, sc.header.root[:]...)
+	}
+	return bz, nil
+}
+
+func (s *testSidecars) UnmarshalSSZ(bz []byte) error {
+	if len(bz)%40 != 0 {
+		return errors.New("invalid length")
+	}
+	for ; len(bz) > 0; bz = bz[40:] {
+		s.sidecars = append(s.sidecars, &testSidecar{header: &testHeader{
+			slot: math.Slot(binary.BigEndian.Uint64(bz)),
+			root: common.Root(bz[8:40]),
+		}})
+	}
+	return nil
+}
+
+// testBlock is a block of the test block store.
+type testBlock struct {
+	header *testHeader
+}
+
+func (b *testBlock) GetBody() *testHeader      { return b.header }
+func (b *testBlock) HashTreeRoot() common.Root { return b.header.root }
+
+// testAvailabilityStore stores blob sidecars by slot.
+type testAvailabilityStore struct {
+	mu       sync.Mutex
+	sidecars map[math.Slot]*testSidecars
+}
+
+func (s *testAvailabilityStore) IsDataAvailable(
+	_ context.Context, slot math.Slot, _ *testHeader,
+) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.sidecars[slot]
+	return ok
+}
+
+func (s *testAvailabilityStore) GetBlobSidecars(
+	slot math.Slot,
+) (*testSidecars, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sidecars, ok := s.sidecars[slot]; ok {
+		return sidecars, nil
+	}
+	return &testSidecars{}, nil
+}
+
+func (s *testAvailabilityStore) Persist(
+	_ math.Slot, sidecars *testSidecars,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sidecars[sidecars.Get(0).header.slot] = sidecars
+	return nil
+}
+
+// stored returns the blob sidecars stored for the slot.
+func (s *testAvailabilityStore) stored(slot math.Slot) *testSidecars {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sidecars[slot]
+}
+
+// testBlockStore stores blocks by slot.
+type testBlockStore map[math.Slot]*testBlock
+
+func (s testBlockStore) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
+	for slot, blk := range s {
+		if blk.header.root == root {
+			return slot, nil
+		}
+	}
+	return 0, errors.New("not found")
+}
+
+func (s testBlockStore) WalkReverse(
+	start, end math.Slot,
+	walkFn func(math.Slot, *testBlock) (bool, error),
+) error {
+	slots := slices.Sorted(maps.Keys(s))
+	slices.Reverse(slots)
+	for _, slot := range slots {
+		if slot < start || slot >= end {
+			continue
+		}
+		if stop, err := walkFn(slot, s[slot]); err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+// testVerifier rejects the blob sidecars of the given blocks.
+type testVerifier map[common.Root]struct{}
+
+func (v testVerifier) VerifySidecars(sidecars *testSidecars) error {
+	if _, ok := v[sidecars.Get(0).header.root]; ok {
+		return errors.New("invalid sidecars")
+	}
+	return nil
+}
+
+type testReactor = p2p.BlobReactor[
+	*testBlock, *testHeader, *testHeader, *testSidecar, *testSidecars,
+]
+
+// testNode is a blob reactor with its stores.
+type testNode struct {
+	*testReactor
+	availabilityStore *testAvailabilityStore
+	blockStore        testBlockStore
+}
+
+func newTestNode(invalid ...common.Root) *testNode {
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:                    4,
+		MinEpochsForBlobsSidecarsRequest: 2,
+	})
+	verifier := make(testVerifier)
+	for _, root := range invalid {
+		verifier[root] = struct{}{}
+	}
+	n := &testNode{
+		availabilityStore: &testAvailabilityStore{
+			sidecars: make(map[math.Slot]*testSidecars),
+		},
+		blockStore: make(testBlockStore),
+	}
+	n.testReactor = p2p.NewBlobReactor[
+		*testBlock, *testHeader, *testHeader, *testSidecar, *testSidecars,
+	](cs, noop.NewLogger[any](), n.availabilityStore, n.blockStore, verifier)
+	return n
+}
+
+// addBlock adds a block with blob sidecars at the slot to the block store,
+// and to the availability store if stored is set.
+func (n *testNode) addBlock(slot math.Slot, stored bool) *testSidecars {
+	header := &testHeader{slot: slot, root: common.Root{byte(slot), 1}}
+	n.blockStore[slot] = &testBlock{header: header}
+	sidecars := &testSidecars{
+		sidecars: []*testSidecar{{header: header}, {header: header}},
+	}
+	if stored {
+		n.availabilityStore.sidecars[slot] = sidecars
+	}
+	return sidecars
+}
+
+// testPeer delivers the messages sent to it to the reactor of the remote
+// node, as sent by the local node.
+type testPeer struct {
+	*mock.Peer
+	remote cmtp2p.Reactor
+	local  cmtp2p.Peer
+}
+
+func (p *testPeer) Send(e cmtp2p.Envelope) bool {
+	p.remote.Receive(cmtp2p.Envelope{
+		Src:       p.local,
+		ChannelID: e.ChannelID,
+		Message:   e.Message,
+	})
+	return true
+}
+
+func (p *testPeer) TrySend(e cmtp2p.Envelope) bool { return p.Send(e) }
+
+// connect connects the reactors of the nodes and returns the peers of a at
+// b and of b at a.
+func connect(a, b *testNode) (*testPeer, *testPeer) {
+	aAtB := &testPeer{Peer: mock.NewPeer(nil), remote: a}
+	bAtA := &testPeer{Peer: mock.NewPeer(nil), remote: b, local: aAtB}
+	aAtB.local = bAtA
+	a.AddPeer(a.InitPeer(bAtA))
+	b.AddPeer(b.InitPeer(aAtB))
+	return aAtB, bAtA
+}
+
+func TestBlobReactor_Gossip(t *testing.T) {
+	a, b, c := newTestNode(), newTestNode(), newTestNode()
+	aAtB, _ := connect(a, b)
+	connect(b, c)
+
+	sidecars := a.addBlock(3, false)
+	b.addBlock(3, false)
+	c.addBlock(3, false)
+	proposed := a.addBlock(4, false)
+	c.addBlock(4, false)
+
+	// The sidecars of the proposed block, unknown to b, are neither relayed
+	// nor stored.
+	_, err := a.Publish(context.Background(), proposed)
+	require.NoError(t, err)
+
+	// The block is known to b and c, which store the gossiped sidecars.
+	bz, err := a.Publish(context.Background(), sidecars)
+	require.NoError(t, err)
+
+	expected, err := sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, bz)
+	require.Eventually(t, func() bool {
+		return c.availabilityStore.stored(3) != nil
+	}, time.Second, time.Millisecond)
+	require.Equal(t, sidecars, b.availabilityStore.stored(3))
+	require.Equal(t, sidecars, c.availabilityStore.stored(3))
+	require.Nil(t, b.availabilityStore.stored(4))
+	require.Nil(t, c.availabilityStore.stored(4))
+	score, ok := b.PeerScore(aAtB.ID())
+	require.True(t, ok)
+	require.Positive(t, score)
+}
+
+func TestBlobReactor_GossipInvalid(t *testing.T) {
+	a := newTestNode()
+	sidecars := a.addBlock(3, false)
+	b := newTestNode(sidecars.Get(0).header.root)
+	c := newTestNode()
+	aAtB, _ := connect(a, b)
+	connect(b, c)
+	b.addBlock(3, false)
+	c.addBlock(3, false)
+
+	_, err := a.Publish(context.Background(), sidecars)
+	require.NoError(t, err)
+
+	// The invalid sidecars are neither stored nor relayed, and the sender is
+	// penalized.
+	require.Eventually(t, func() bool {
+		score, ok := b.PeerScore(aAtB.ID())
+		return ok && score < 0
+	}, time.Second, time.Millisecond)
+	require.Nil(t, b.availabilityStore.stored(3))
+	require.Nil(t, c.availabilityStore.stored(3))
+}
+
+func TestBlobReactor_RequestByRange(t *testing.T) {
+	a, b := newTestNode(), newTestNode()
+	connect(a, b)
+	for slot := range math.Slot(6) {
+		a.addBlock(slot, false)
+		b.addBlock(slot, slot%2 == 0)
+	}
+
+	sidecars, err := a.RequestByRange(context.Background(), 1, 4)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+	require.Equal(t, b.availabilityStore.sidecars[2], sidecars[0])
+	require.Equal(t, b.availabilityStore.sidecars[4], sidecars[1])
+
+	_, err = a.RequestByRange(
+		context.Background(), 0, p2p.MaxRequestBlocks+1,
+	)
+	require.ErrorIs(t, err, p2p.ErrRequestTooLarge)
+}
+
+func TestBlobReactor_TooManyRequests(t *testing.T) {
+	a, b := newTestNode(), newTestNode()
+	aAtB, _ := connect(a, b)
+	a.addBlock(0, false)
+	b.addBlock(0, true)
+
+	// Hold up serving, so that the requests of a pile up at b.
+	b.availabilityStore.mu.Lock()
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = a.RequestByRange(ctx, 0, 1)
+		}()
+	}
+
+	// The request beyond the limit is dropped and a is penalized.
+	require.Eventually(t, func() bool {
+		score, ok := b.PeerScore(aAtB.ID())
+		return ok && score < 0
+	}, time.Second, time.Millisecond)
+	cancel()
+	b.availabilityStore.mu.Unlock()
+	wg.Wait()
+}
+
+func TestBlobReactor_RequestByRootUnknownBlock(t *testing.T) {
+	a, b := newTestNode(), newTestNode()
+	_, bAtA := connect(a, b)
+
+	// b serves sidecars of a block a does not know.
+	sidecars := b.addBlock(2, true)
+	_, err := a.RequestByRoot(
+		context.Background(),
+		[]common.Root{sidecars.Get(0).header.root},
+	)
+	require.ErrorIs(t, err, p2p.ErrUnknownBlock)
+	score, ok := a.PeerScore(bAtA.ID())
+	require.True(t, ok)
+	require.Negative(t, score)
+}
+
+func TestBlobReactor_Backfill(t *testing.T) {
+	a, b := newTestNode(), newTestNode()
+
+	_, err := a.Backfill(context.Background())
+	require.NoError(t, err)
+
+	connect(a, b)
+	for slot := range math.Slot(12) {
+		a.addBlock(slot, slot == 11)
+		b.addBlock(slot, true)
+	}
+
+	// Only the blocks within the data availability window of 8 slots are
+	// backfilled.
+	n, err := a.Backfill(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 8, n)
+	for slot := range math.Slot(12) {
+		require.Equal(
+			t, slot >= 3, a.availabilityStore.IsDataAvailable(
+				context.Background(), slot, nil,
+			),
+		)
+	}
+}
@@ -0,0 +1,250 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cometbft/cometbft/p2p"
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+// requestTimeout is the time a peer has to answer a request.
+const requestTimeout = 10 * time.Second
+
+// pendingRequest is a request awaiting responses from a peer.
+type pendingRequest struct {
+	// peer is the peer the request was sent to.
+	peer p2p.ID
+	// responses receives the responses of the peer.
+	responses chan *blobResponse
+}
+
+// serve answers a request with the stored blob sidecars of each requested
+// block, one response per block, followed by a done response. Blocks whose
+// blob sidecars are not stored are skipped.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) serve(
+	src p2p.Peer, req *blobRequest,
+) {
+	send := func(sidecars BlobSidecarsT, err error) {
+		if err != nil || sidecars.Len() == 0 {
+			return
+		}
+		bz, err := sidecars.MarshalSSZ()
+		if err != nil {
+			r.logger.Error("Failed to encode blob sidecars", "error", err)
+			return
+		}
+		src.Send(p2p.Envelope{
+			ChannelID: BlobRequestChannel,
+			Message:   newResponseMessage(req.id, false, bz),
+		})
+	}
+
+	for i := range req.count {
+		send(r.availabilityStore.GetBlobSidecars(req.start + math.Slot(i)))
+	}
+	for _, root := range req.roots {
+		slot, err := r.blockStore.GetSlotByBlockRoot(root)
+		if err != nil {
+			continue
+		}
+		send(r.availabilityStore.GetBlobSidecars(slot))
+	}
+
+	src.Send(p2p.Envelope{
+		ChannelID: BlobRequestChannel,
+		Message:   newResponseMessage(req.id, true, nil),
+	})
+}
+
+// handleResponse passes a response on to the request it answers.
+func (r *BlobReactor[_, _, _, _, _]) handleResponse(
+	src p2p.Peer, payload []byte,
+) {
+	res, err := decodeResponse(payload)
+	if err != nil {
+		r.penalize(src, malformedMessagePenalty, err)
+		return
+	}
+
+	r.mu.RLock()
+	pending, ok := r.pending[res.id]
+	r.mu.RUnlock()
+	if !ok || pending.peer != src.ID() {
+		r.penalize(src, unsolicitedResponsePenalty, ErrUnexpectedSidecars)
+		return
+	}
+
+	select {
+	case pending.responses <- res:
+	default:
+		r.penalize(src, unsolicitedResponsePenalty, ErrUnexpectedSidecars)
+	}
+}
+
+// RequestByRange requests the blob sidecars of the blocks in the [start,
+// start+count) slot range from the best scored peers, until one answers.
+// The returned blob sidecars are verified and belong to known blocks, but
+// are not stored.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) RequestByRange(
+	ctx context.Context, start math.Slot, count uint64,
+) ([]BlobSidecarsT, error) {
+	if count > MaxRequestBlocks {
+		return nil, ErrRequestTooLarge
+	}
+	return r.request(
+		ctx,
+		func(id uint64) *gogotypes.BytesValue {
+			return newByRangeRequestMessage(id, start, count)
+		},
+		func(sidecars BlobSidecarsT) bool {
+			slot := sidecars.Get(0).GetBeaconBlockHeader().GetSlot()
+			return slot >= start && slot < start+math.Slot(count)
+		},
+	)
+}
+
+// RequestByRoot requests the blob sidecars of the blocks with the given
+// roots from the best scored peers, until one answers. The returned blob
+// sidecars are verified and belong to known blocks, but are not stored.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) RequestByRoot(
+	ctx context.Context, roots []common.Root,
+) ([]BlobSidecarsT, error) {
+	if len(roots) > MaxRequestBlocks {
+		return nil, ErrRequestTooLarge
+	}
+	requested := make(map[common.Root]struct{}, len(roots))
+	for _, root := range roots {
+		requested[root] = struct{}{}
+	}
+	return r.request(
+		ctx,
+		func(id uint64) *gogotypes.BytesValue {
+			return newByRootRequestMessage(id, roots)
+		},
+		func(sidecars BlobSidecarsT) bool {
+			_, ok := requested[sidecars.Get(0).
+				GetBeaconBlockHeader().HashTreeRoot()]
+			return ok
+		},
+	)
+}
+
+// request sends the request to the connected peers, best scored first,
+// until one answers it with valid blob sidecars.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) request(
+	ctx context.Context,
+	msgFn func(id uint64) *gogotypes.BytesValue,
+	expected func(BlobSidecarsT) bool,
+) ([]BlobSidecarsT, error) {
+	err := ErrNoPeers
+	for _, peer := range r.peersByScore() {
+		var sidecars []BlobSidecarsT
+		sidecars, err = r.requestFrom(ctx, peer, msgFn, expected)
+		if err == nil {
+			return sidecars, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		r.logger.Debug(
+			"Blob sidecars request failed", "peer", peer.ID(), "error", err,
+		)
+	}
+	return nil, err
+}
+
+// requestFrom sends the request to the peer and collects its responses.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) requestFrom(
+	ctx context.Context,
+	peer p2p.Peer,
+	msgFn func(id uint64) *gogotypes.BytesValue,
+	expected func(BlobSidecarsT) bool,
+) ([]BlobSidecarsT, error) {
+	id := r.nextID.Add(1)
+	pending := &pendingRequest{
+		peer:      peer.ID(),
+		responses: make(chan *blobResponse, MaxRequestBlocks+1),
+	}
+	r.mu.Lock()
+	r.pending[id] = pending
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.pending, id)
+		r.mu.Unlock()
+	}()
+
+	if !peer.Send(p2p.Envelope{
+		ChannelID: BlobRequestChannel,
+		Message:   msgFn(id),
+	}) {
+		return nil, errors.Wrapf(ErrSendFailed, "peer %s", peer.ID())
+	}
+
+	timer := time.NewTimer(requestTimeout)
+	defer timer.Stop()
+
+	var result []BlobSidecarsT
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			r.penalize(peer, timeoutPenalty, ErrRequestTimeout)
+			return nil, ErrRequestTimeout
+		case res := <-pending.responses:
+			if res.done {
+				return result, nil
+			}
+			sidecars, err := r.decodeSidecars(res.sidecars, expected)
+			if err != nil {
+				r.penalize(peer, invalidSidecarsPenalty, err)
+				return nil, err
+			}
+			r.reward(peer, validSidecarsReward)
+			result = append(result, sidecars)
+		}
+	}
+}
+
+// decodeSidecars decodes and verifies the blob sidecars of a response.
+func (r *BlobReactor[_, _, _, _, BlobSidecarsT]) decodeSidecars(
+	bz []byte, expected func(BlobSidecarsT) bool,
+) (BlobSidecarsT, error) {
+	var sidecars BlobSidecarsT
+	sidecars = sidecars.Empty()
+	if err := sidecars.UnmarshalSSZ(bz); err != nil {
+		return sidecars, errors.Join(ErrMalformedMessage, err)
+	}
+	if err := r.verify(sidecars); err != nil {
+		return sidecars, err
+	}
+	if !expected(sidecars) {
+		return sidecars, ErrUnexpectedSidecars
+	}
+	return sidecars, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import (
+	"slices"
+	"sync/atomic"
+
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// peerScoreKey is the key the score of a peer is stored under.
+	peerScoreKey = "blob-reactor/score"
+	// peerRequestsKey is the key the requests of a peer being served are
+	// stored under.
+	peerRequestsKey = "blob-reactor/requests"
+	// maxPeerScore is the score a peer can at most build up by serving
+	// valid blob sidecars.
+	maxPeerScore = 100
+	// minPeerScore is the score at which a peer is disconnected.
+	minPeerScore = -100
+)
+
+const (
+	// validSidecarsReward is added to the score of a peer for valid blob
+	// sidecars.
+	validSidecarsReward = 1
+	// invalidSidecarsPenalty is deducted from the score of a peer for blob
+	// sidecars that fail verification or belong to an unknown block.
+	invalidSidecarsPenalty = 50
+	// malformedMessagePenalty is deducted from the score of a peer for a
+	// message that cannot be decoded.
+	malformedMessagePenalty = 25
+	// unsolicitedResponsePenalty is deducted from the score of a peer for a
+	// response to a request it was not sent.
+	unsolicitedResponsePenalty = 10
+	// timeoutPenalty is deducted from the score of a peer that does not
+	// answer a request in time.
+	timeoutPenalty = 5
+	// tooManyRequestsPenalty is deducted from the score of a peer for a
+	// request sent while maxPeerRequests of its requests are being served.
+	tooManyRequestsPenalty = 10
+)
+
+// InitPeer sets up the score of the peer and the requests of the peer being
+// served.
+func (r *BlobReactor[_, _, _, _, _]) InitPeer(peer p2p.Peer) p2p.Peer {
+	peer.Set(peerScoreKey, new(atomic.Int64))
+	peer.Set(peerRequestsKey, make(chan struct{}, maxPeerRequests))
+	return peer
+}
+
+// PeerScore returns the score of the connected peer with the given id.
+func (r *BlobReactor[_, _, _, _, _]) PeerScore(id p2p.ID) (int64, bool) {
+	r.mu.RLock()
+	peer, ok := r.peers[id]
+	r.mu.RUnlock()
+	if !ok {
+		return 0, false
+	}
+	return scoreOf(peer).Load(), true
+}
+
+// reward adds to the score of the peer, up to the maximum score.
+func (r *BlobReactor[_, _, _, _, _]) reward(peer p2p.Peer, reward int64) {
+	score := scoreOf(peer)
+	for {
+		current := score.Load()
+		if current >= maxPeerScore ||
+			score.CompareAndSwap(current, min(current+reward, maxPeerScore)) {
+			return
+		}
+	}
+}
+
+// penalize deducts from the score of the peer, and disconnects the peer
+// once its score drops to the minimum score.
+func (r *BlobReactor[_, _, _, _, _]) penalize(
+	peer p2p.Peer, penalty int64, reason error,
+) {
+	score := scoreOf(peer).Add(-penalty)
+	r.logger.Warn(
+		"Penalized peer for blob reactor message",
+		"peer", peer.ID(), "score", score, "reason", reason,
+	)
+	if score <= minPeerScore && r.Switch != nil {
+		r.Switch.StopPeerForError(peer, reason)
+	}
+}
+
+// peersByScore returns the connected peers, best scored first.
+func (r *BlobReactor[_, _, _, _, _]) peersByScore() []p2p.Peer {
+	r.mu.RLock()
+	peers := make([]p2p.Peer, 0, len(r.peers))
+	for _, peer := range r.peers {
+		peers = append(peers, peer)
+	}
+	r.mu.RUnlock()
+
+	slices.SortStableFunc(peers, func(a, b p2p.Peer) int {
+		return int(scoreOf(b).Load() - scoreOf(a).Load())
+	})
+	return peers
+}
+
+// scoreOf returns the score of the peer, which is set up when the peer is
+// initialized.
+func scoreOf(peer p2p.Peer) *atomic.Int64 {
+	if score, ok := peer.Get(peerScoreKey).(*atomic.Int64); ok {
+		return score
+	}
+	score := new(atomic.Int64)
+	peer.Set(peerScoreKey, score)
+	return score
+}
+
+// requestsOf returns the requests of the peer being served, which are set up
+// when the peer is initialized.
+func requestsOf(peer p2p.Peer) chan struct{} {
+	if requests, ok := peer.Get(peerRequestsKey).(chan struct{}); ok {
+		return requests
+	}
+	requests := make(chan struct{}, maxPeerRequests)
+	peer.Set(peerRequestsKey, requests)
+	return requests
+}
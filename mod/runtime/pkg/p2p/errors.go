@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package p2p
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrMalformedMessage is returned when a blob reactor message cannot be
+	// decoded.
+	ErrMalformedMessage = errors.New("malformed blob reactor message")
+
+	// ErrRequestTooLarge is returned when a request asks for more blocks
+	// than are served at once.
+	ErrRequestTooLarge = errors.New("blob sidecars request too large")
+
+	// ErrTooManyRequests is returned when a peer sends a request while the
+	// maximum number of its requests are being served.
+	ErrTooManyRequests = errors.New("too many blob sidecars requests")
+
+	// ErrNoPeers is returned when there is no peer to send a request to.
+	ErrNoPeers = errors.New("no peers to request blob sidecars from")
+
+	// ErrSendFailed is returned when a request cannot be queued for a peer.
+	ErrSendFailed = errors.New("failed to send blob sidecars request")
+
+	// ErrRequestTimeout is returned when a peer does not answer a request in
+	// time.
+	ErrRequestTimeout = errors.New("blob sidecars request timed out")
+
+	// ErrUnknownBlock is returned when blob sidecars belong to a block that
+	// is not in the block store.
+	ErrUnknownBlock = errors.New("blob sidecars of unknown block")
+
+	// ErrUnexpectedSidecars is returned when a response contains blob
+	// sidecars that were not requested.
+	ErrUnexpectedSidecars = errors.New("unexpected blob sidecars in response")
+)
@@ -20,10 +20,72 @@
 
 package p2p
 
-import "github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
 
 type BeaconBlock[SelfT any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[SelfT]
 	NewFromSSZ([]byte, uint32) (SelfT, error)
 }
+
+// AvailabilityStore is the store the blob reactor reads and writes blob
+// sidecars from.
+type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
+	// IsDataAvailable returns true if the blob sidecars of all commitments
+	// in the block body are stored.
+	IsDataAvailable(
+		ctx context.Context, slot math.Slot, body BeaconBlockBodyT,
+	) bool
+	// GetBlobSidecars returns the blob sidecars stored for the slot.
+	GetBlobSidecars(slot math.Slot) (BlobSidecarsT, error)
+	// Persist stores the blob sidecars, given the current slot.
+	Persist(slot math.Slot, sidecars BlobSidecarsT) error
+}
+
+// BeaconBlockHeader is the header of the block blob sidecars belong to.
+type BeaconBlockHeader interface {
+	GetSlot() math.Slot
+	HashTreeRoot() common.Root
+}
+
+// BeaconBlockWithBody is a beacon block as read from the block store.
+type BeaconBlockWithBody[BeaconBlockBodyT any] interface {
+	GetBody() BeaconBlockBodyT
+	HashTreeRoot() common.Root
+}
+
+// BlobSidecar is a single blob sidecar.
+type BlobSidecar[BeaconBlockHeaderT BeaconBlockHeader] interface {
+	GetBeaconBlockHeader() BeaconBlockHeaderT
+}
+
+// BlobSidecars is the list of blob sidecars of a block.
+type BlobSidecars[BlobSidecarsT, BlobSidecarT any] interface {
+	constraints.SSZMarshallable
+	constraints.Empty[BlobSidecarsT]
+	Len() int
+	Get(index int) BlobSidecarT
+}
+
+// BlobVerifier verifies the inclusion and KZG proofs of blob sidecars.
+type BlobVerifier[BlobSidecarsT any] interface {
+	VerifySidecars(sidecars BlobSidecarsT) error
+}
+
+// BlockStore is the store of the canonical beacon blocks.
+type BlockStore[BeaconBlockT any] interface {
+	// GetSlotByBlockRoot returns the slot of the block with the given root.
+	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	// WalkReverse calls walkFn for each block in the [start, end) slot range
+	// in descending order of slots.
+	WalkReverse(
+		start, end math.Slot,
+		walkFn func(slot math.Slot, blk BeaconBlockT) (bool, error),
+	) error
+}
@@ -46,6 +46,19 @@ var (
 	// deposit limit.
 	ErrExceedsBlockDepositLimit = errors.New("block exceeds deposit limit")
 
+	// ErrDepositCountMismatch is returned when the number of deposits in a
+	// block does not match the number of outstanding deposits it must include.
+	ErrDepositCountMismatch = errors.New("deposit count mismatch")
+
+	// ErrInvalidDepositProof is returned when the merkle proof of a deposit
+	// does not verify against the eth1 deposit root.
+	ErrInvalidDepositProof = errors.New("invalid deposit merkle proof")
+
+	// ErrDepositCountDecreased is returned when the eth1 data of a block
+	// reports fewer deposits than have already been processed.
+	ErrDepositCountDecreased = errors.New(
+		"eth1 data deposit count below processed deposit index")
+
 	// ErrRewardsLengthMismatch is returned when the length of the rewards
 	// in a block does not match the expected value.
 	ErrRewardsLengthMismatch = errors.New("rewards length mismatch")
@@ -74,4 +87,69 @@ var (
 	// ErrNumWithdrawalsMismatch is returned when the number of withdrawals
 	// in a block does not match the expected value.
 	ErrNumWithdrawalsMismatch = errors.New("number of withdrawals mismatch")
+
+	// ErrExceedsBlockProposerSlashingLimit is returned when the block exceeds
+	// the proposer slashing limit.
+	ErrExceedsBlockProposerSlashingLimit = errors.New(
+		"block exceeds proposer slashing limit")
+
+	// ErrExceedsBlockAttesterSlashingLimit is returned when the block exceeds
+	// the attester slashing limit.
+	ErrExceedsBlockAttesterSlashingLimit = errors.New(
+		"block exceeds attester slashing limit")
+
+	// ErrProposerSlashingNotSlashable is returned when the headers of a
+	// proposer slashing do not constitute a slashable offense.
+	ErrProposerSlashingNotSlashable = errors.New(
+		"proposer slashing is not slashable")
+
+	// ErrAttesterSlashingNotSlashable is returned when the attestations of an
+	// attester slashing do not constitute a slashable offense.
+	ErrAttesterSlashingNotSlashable = errors.New(
+		"attester slashing is not slashable")
+
+	// ErrValidatorNotSlashable is returned when a slashing targets a validator
+	// that cannot be slashed at the current epoch.
+	ErrValidatorNotSlashable = errors.New("validator is not slashable")
+
+	// ErrSlashingInfoMismatch is returned when the slashing info of a block
+	// does not match the misbehavior evidence committed by the consensus
+	// engine.
+	ErrSlashingInfoMismatch = errors.New(
+		"slashing info does not match misbehavior evidence")
+
+	// ErrExceedsBlockVoluntaryExitLimit is returned when the block exceeds
+	// the voluntary exit limit.
+	ErrExceedsBlockVoluntaryExitLimit = errors.New(
+		"block exceeds voluntary exit limit")
+
+	// ErrValidatorNotActive is returned when a voluntary exit targets a
+	// validator that is not active at the current epoch.
+	ErrValidatorNotActive = errors.New("validator is not active")
+
+	// ErrValidatorAlreadyExiting is returned when a voluntary exit targets a
+	// validator that has already initiated its exit.
+	ErrValidatorAlreadyExiting = errors.New(
+		"validator has already initiated its exit")
+
+	// ErrVoluntaryExitTooEarly is returned when a voluntary exit is processed
+	// before its epoch, or before the validator has been active for the
+	// shard committee period.
+	ErrVoluntaryExitTooEarly = errors.New("voluntary exit is too early")
+
+	// ErrExceedsBlockAttestationLimit is returned when the block exceeds the
+	// attestation limit.
+	ErrExceedsBlockAttestationLimit = errors.New(
+		"block exceeds attestation limit",
+	)
+
+	// ErrInvalidAttestation is returned when an attestation does not vote for
+	// the parent of the block it is included in.
+	ErrInvalidAttestation = errors.New("invalid attestation")
+
+	// ErrAttestationsMismatch is returned when the attestations of a block do
+	// not match the votes committed by the consensus engine.
+	ErrAttestationsMismatch = errors.New(
+		"attestations do not match committed votes",
+	)
 )
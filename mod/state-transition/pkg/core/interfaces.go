@@ -70,6 +70,13 @@ type ReadOnlyBeaconState[
 	ReadOnlyWithdrawals[WithdrawalT]
 
 	GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	GetValidatorPower(math.ValidatorIndex) (math.Gwei, error)
+	GetDepositRequestsStartIndex() (uint64, error)
+	GetPendingConsolidations() (
+		map[math.ValidatorIndex]math.ValidatorIndex, error,
+	)
+	GetEpochParticipation(math.ValidatorIndex) (uint64, error)
+	GetInactivityScore(math.ValidatorIndex) (uint64, error)
 	GetSlot() (math.Slot, error)
 	GetFork() (ForkT, error)
 	GetGenesisValidatorsRoot() (common.Root, error)
@@ -105,6 +112,13 @@ type WriteOnlyBeaconState[
 	SetLatestBlockHeader(BeaconBlockHeaderT) error
 	IncreaseBalance(math.ValidatorIndex, math.Gwei) error
 	DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+	SetValidatorPower(math.ValidatorIndex, math.Gwei) error
+	SetDepositRequestsStartIndex(uint64) error
+	AddPendingConsolidation(source, target math.ValidatorIndex) error
+	RemovePendingConsolidation(source math.ValidatorIndex) error
+	SetEpochParticipation(math.ValidatorIndex, uint64) error
+	ResetEpochParticipation() error
+	SetInactivityScore(math.ValidatorIndex, uint64) error
 	UpdateSlashingAtIndex(uint64, math.Gwei) error
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
@@ -160,6 +174,8 @@ type ReadOnlyValidators[ValidatorT any] interface {
 // WriteOnlyEth1Data has write access to eth1 data.
 type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	SetEth1Data(Eth1DataT) error
+	AddEth1DataVote(Eth1DataT) error
+	ResetEth1DataVotes() error
 	SetEth1DepositIndex(uint64) error
 	SetLatestExecutionPayloadHeader(
 		ExecutionPayloadHeaderT,
@@ -169,6 +185,7 @@ type WriteOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 // ReadOnlyEth1Data has read access to eth1 data.
 type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 	GetEth1Data() (Eth1DataT, error)
+	GetEth1DataVotes() ([]Eth1DataT, error)
 	GetEth1DepositIndex() (uint64, error)
 	GetLatestExecutionPayloadHeader() (
 		ExecutionPayloadHeaderT, error,
@@ -59,10 +59,43 @@ type KVStore[
 	SetEth1DepositIndex(
 		index uint64,
 	) error
+	// GetDepositRequestsStartIndex retrieves the index of the first deposit
+	// processed as an execution layer request.
+	GetDepositRequestsStartIndex() (uint64, error)
+	// SetDepositRequestsStartIndex sets the index of the first deposit
+	// processed as an execution layer request.
+	SetDepositRequestsStartIndex(index uint64) error
+	// GetPendingConsolidations retrieves the pending consolidations, keyed by
+	// source validator index.
+	GetPendingConsolidations() (
+		map[math.ValidatorIndex]math.ValidatorIndex, error,
+	)
+	// AddPendingConsolidation records a pending consolidation.
+	AddPendingConsolidation(source, target math.ValidatorIndex) error
+	// RemovePendingConsolidation removes a pending consolidation.
+	RemovePendingConsolidation(source math.ValidatorIndex) error
+	// GetEpochParticipation retrieves the number of blocks of the current
+	// epoch that included the vote of a validator.
+	GetEpochParticipation(idx math.ValidatorIndex) (uint64, error)
+	// SetEpochParticipation sets the number of blocks of the current epoch
+	// that included the vote of a validator.
+	SetEpochParticipation(idx math.ValidatorIndex, participation uint64) error
+	// ResetEpochParticipation clears the participation of every validator.
+	ResetEpochParticipation() error
+	// GetInactivityScore retrieves the inactivity score of a validator.
+	GetInactivityScore(idx math.ValidatorIndex) (uint64, error)
+	// SetInactivityScore sets the inactivity score of a validator.
+	SetInactivityScore(idx math.ValidatorIndex, score uint64) error
 	// GetBalance retrieves the balance of a validator.
 	GetBalance(idx math.ValidatorIndex) (math.Gwei, error)
 	// SetBalance sets the balance of a validator.
 	SetBalance(idx math.ValidatorIndex, balance math.Gwei) error
+	// GetValidatorPower retrieves the voting power last reported to the
+	// consensus engine for a validator.
+	GetValidatorPower(idx math.ValidatorIndex) (math.Gwei, error)
+	// SetValidatorPower sets the voting power reported to the consensus
+	// engine for a validator.
+	SetValidatorPower(idx math.ValidatorIndex, power math.Gwei) error
 	// GetSlot retrieves the current slot.
 	GetSlot() (math.Slot, error)
 	// SetSlot sets the current slot.
@@ -87,6 +120,13 @@ type KVStore[
 	GetEth1Data() (Eth1DataT, error)
 	// SetEth1Data sets the eth1 data.
 	SetEth1Data(data Eth1DataT) error
+	// GetEth1DataVotes retrieves the eth1 data votes of the current voting
+	// period.
+	GetEth1DataVotes() ([]Eth1DataT, error)
+	// AddEth1DataVote appends an eth1 data vote.
+	AddEth1DataVote(vote Eth1DataT) error
+	// ResetEth1DataVotes clears the eth1 data votes.
+	ResetEth1DataVotes() error
 	// GetValidators retrieves all validators.
 	GetValidators() (ValidatorsT, error)
 	// GetBalances retrieves all balances.
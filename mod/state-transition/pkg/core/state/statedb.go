@@ -24,6 +24,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // StateDB is the underlying struct behind the BeaconState interface.
@@ -285,7 +286,7 @@ func (s *StateDB[
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
-	_, BeaconStateMarshallableT, _, _, _, _, _, _, _, _,
+	_, BeaconStateMarshallableT, Eth1DataT, _, _, _, _, _, _, _,
 ]) GetMarshallable() (BeaconStateMarshallableT, error) {
 	var empty BeaconStateMarshallableT
 
@@ -378,9 +379,56 @@ func (s *StateDB[
 		return empty, err
 	}
 
+	// The participation and the voting powers are part of the state from
+	// Deneb+ onwards, with an entry for every validator, along with the eth1
+	// data votes.
+	forkVersion := s.cs.ActiveForkVersionForSlot(slot)
+	var epochParticipation, inactivityScores, validatorPowers []uint64
+	var eth1DataVotes []Eth1DataT
+	if forkVersion >= version.DenebPlus {
+		if eth1DataVotes, err = s.GetEth1DataVotes(); err != nil {
+			return empty, err
+		}
+
+		epochParticipation = make([]uint64, len(validators))
+		inactivityScores = make([]uint64, len(validators))
+		validatorPowers = make([]uint64, len(validators))
+		var power math.Gwei
+		for i := range validators {
+			idx := math.ValidatorIndex(i)
+			epochParticipation[i], err = s.GetEpochParticipation(idx)
+			if err != nil {
+				return empty, err
+			}
+			inactivityScores[i], err = s.GetInactivityScore(idx)
+			if err != nil {
+				return empty, err
+			}
+			if power, err = s.GetValidatorPower(idx); err != nil {
+				return empty, err
+			}
+			validatorPowers[i] = power.Unwrap()
+		}
+	}
+
+	// The state of the execution requests is part of the state from Electra
+	// onwards.
+	var depositRequestsStartIndex uint64
+	var pendingConsolidations map[math.ValidatorIndex]math.ValidatorIndex
+	if forkVersion >= version.Electra {
+		depositRequestsStartIndex, err = s.GetDepositRequestsStartIndex()
+		if err != nil {
+			return empty, err
+		}
+		pendingConsolidations, err = s.GetPendingConsolidations()
+		if err != nil {
+			return empty, err
+		}
+	}
+
 	// TODO: Properly move BeaconState into full generics.
 	return (*new(BeaconStateMarshallableT)).New(
-		s.cs.ActiveForkVersionForSlot(slot),
+		forkVersion,
 		genesisValidatorsRoot,
 		slot,
 		fork,
@@ -397,6 +445,12 @@ func (s *StateDB[
 		nextWithdrawalValidatorIndex,
 		slashings,
 		totalSlashings,
+		epochParticipation,
+		inactivityScores,
+		validatorPowers,
+		eth1DataVotes,
+		depositRequestsStartIndex,
+		pendingConsolidations,
 	)
 }
 
@@ -55,6 +55,12 @@ type BeaconStateMarshallable[
 		nextWithdrawalIndex uint64,
 		nextWithdrawalValidatorIndex math.U64,
 		slashings []uint64, totalSlashing math.U64,
+		epochParticipation []uint64,
+		inactivityScores []uint64,
+		validatorPowers []uint64,
+		eth1DataVotes []Eth1DataT,
+		depositRequestsStartIndex uint64,
+		pendingConsolidations map[math.ValidatorIndex]math.ValidatorIndex,
 	) (T, error)
 }
 
@@ -34,13 +34,19 @@ import (
 // StateProcessor is a basic Processor, which takes care of the
 // main state transition for the beacon chain.
 type StateProcessor[
+	AttestationDataT AttestationData,
+	AttesterSlashingT AttesterSlashing[ForkDataT],
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttestationDataT, AttesterSlashingT, DepositT, BeaconBlockBodyT,
+		Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ExecutionRequestsT, ProposerSlashingT, SlashingInfoT, VoluntaryExitT,
+		WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttestationDataT, AttesterSlashingT, BeaconBlockBodyT, DepositT,
+		Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ExecutionRequestsT, ProposerSlashingT, SlashingInfoT, VoluntaryExitT,
+		WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconStateT BeaconState[
@@ -49,27 +55,37 @@ type StateProcessor[
 		ExecutionPayloadHeaderT, ForkT, KVStoreT,
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
+	ConsolidationRequestT ConsolidationRequest,
 	ContextT Context,
 	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		GetDepositRoot() common.Root
+		HashTreeRoot() common.Root
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ExecutionRequestsT ExecutionRequests[
+		ConsolidationRequestT, DepositT, WithdrawalRequestT,
+	],
 	ForkT interface {
 		New(common.Version, common.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
+	ProposerSlashingT ProposerSlashing[ForkDataT],
+	SlashingInfoT SlashingInfo,
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	ValidatorsT interface {
 		~[]ValidatorT
 		HashTreeRoot() common.Root
 	},
+	VoluntaryExitT VoluntaryExit[ForkDataT],
 	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalRequestT WithdrawalRequest,
 	WithdrawalsT interface {
 		~[]WithdrawalT
 		Len() int
@@ -89,14 +105,18 @@ type StateProcessor[
 
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
+	AttestationDataT AttestationData,
+	AttesterSlashingT AttesterSlashing[ForkDataT],
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttestationDataT, AttesterSlashingT, DepositT, BeaconBlockBodyT,
+		Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ExecutionRequestsT, ProposerSlashingT, SlashingInfoT, VoluntaryExitT,
+		WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT,
+		AttestationDataT, AttesterSlashingT, BeaconBlockBodyT, DepositT,
+		Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ExecutionRequestsT, ProposerSlashingT, SlashingInfoT, VoluntaryExitT,
 		WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
@@ -104,27 +124,37 @@ func NewStateProcessor[
 		BeaconStateT, BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
 		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
 	],
+	ConsolidationRequestT ConsolidationRequest,
 	ContextT Context,
 	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		GetDepositRoot() common.Root
+		HashTreeRoot() common.Root
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ExecutionRequestsT ExecutionRequests[
+		ConsolidationRequestT, DepositT, WithdrawalRequestT,
+	],
 	ForkT interface {
 		New(common.Version, common.Version, math.Epoch) ForkT
 	},
 	ForkDataT ForkData[ForkDataT],
 	KVStoreT any,
+	ProposerSlashingT ProposerSlashing[ForkDataT],
+	SlashingInfoT SlashingInfo,
 	ValidatorT Validator[ValidatorT, WithdrawalCredentialsT],
 	ValidatorsT interface {
 		~[]ValidatorT
 		HashTreeRoot() common.Root
 	},
+	VoluntaryExitT VoluntaryExit[ForkDataT],
 	WithdrawalT Withdrawal[WithdrawalT],
+	WithdrawalRequestT WithdrawalRequest,
 	WithdrawalsT interface {
 		~[]WithdrawalT
 		Len() int
@@ -138,16 +168,20 @@ func NewStateProcessor[
 	],
 	signer crypto.BLSSigner,
 ) *StateProcessor[
-	BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-	BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
-	ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
-	ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
+	AttestationDataT, AttesterSlashingT, BeaconBlockT, BeaconBlockBodyT,
+	BeaconBlockHeaderT, BeaconStateT, ConsolidationRequestT, ContextT, DepositT,
+	Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT, ExecutionRequestsT,
+	ForkT, ForkDataT, KVStoreT, ProposerSlashingT, SlashingInfoT, ValidatorT,
+	ValidatorsT, VoluntaryExitT, WithdrawalT, WithdrawalRequestT, WithdrawalsT,
+	WithdrawalCredentialsT,
 ] {
 	return &StateProcessor[
-		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
-		BeaconStateT, ContextT, DepositT, Eth1DataT, ExecutionPayloadT,
-		ExecutionPayloadHeaderT, ForkT, ForkDataT, KVStoreT, ValidatorT,
-		ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
+		AttestationDataT, AttesterSlashingT, BeaconBlockT, BeaconBlockBodyT,
+		BeaconBlockHeaderT, BeaconStateT, ConsolidationRequestT, ContextT,
+		DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+		ExecutionRequestsT, ForkT, ForkDataT, KVStoreT, ProposerSlashingT,
+		SlashingInfoT, ValidatorT, ValidatorsT, VoluntaryExitT, WithdrawalT,
+		WithdrawalRequestT, WithdrawalsT, WithdrawalCredentialsT,
 	]{
 		cs:              cs,
 		executionEngine: executionEngine,
@@ -157,8 +191,8 @@ func NewStateProcessor[
 
 // Transition is the main function for processing a state transition.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _,
 ]) Transition(
 	ctx ContextT,
 	st BeaconStateT,
@@ -183,7 +217,8 @@ func (sp *StateProcessor[
 }
 
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.U64,
 ) (transition.ValidatorUpdates, error) {
@@ -229,7 +264,8 @@ func (sp *StateProcessor[
 
 // processSlot is run when a slot is missed.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processSlot(
 	st BeaconStateT,
 ) error {
@@ -272,7 +308,8 @@ func (sp *StateProcessor[
 // ProcessBlock processes the block, it optionally verifies the
 // state root.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _,
 ]) ProcessBlock(
 	ctx ContextT,
 	st BeaconStateT,
@@ -297,10 +334,15 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessProposerSlashings
-	// phase0.ProcessAttesterSlashings
+	// process the proposer and attester slashings.
+	if err := sp.processSlashingOperations(ctx, st, blk); err != nil {
+		return err
+	}
+
+	// process the votes of the consensus engine for the parent block.
+	if err := sp.processAttestations(ctx, st, blk); err != nil {
+		return err
+	}
 
 	// process the randao reveal.
 	if err := sp.processRandaoReveal(
@@ -309,9 +351,10 @@ func (sp *StateProcessor[
 		return err
 	}
 
-	// TODO:
-	//
-	// phase0.ProcessEth1Vote
+	// process the eth1 data of the block.
+	if err := sp.processEth1Data(st, blk.GetBody()); err != nil {
+		return err
+	}
 
 	// process the deposits and ensure they match the local state.
 	if err := sp.processOperations(st, blk); err != nil {
@@ -339,16 +382,29 @@ func (sp *StateProcessor[
 
 // processEpoch processes the epoch and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processEpoch(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
 	if err := sp.processRewardsAndPenalties(st); err != nil {
 		return nil, err
+	} else if err = sp.processParticipationUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processRegistryUpdates(st); err != nil {
+		return nil, err
+	} else if err = sp.processSlashings(st); err != nil {
+		return nil, err
+	} else if err = sp.processPendingConsolidations(st); err != nil {
+		return nil, err
+	} else if err = sp.processEffectiveBalanceUpdates(st); err != nil {
+		return nil, err
 	} else if err = sp.processSlashingsReset(st); err != nil {
 		return nil, err
 	} else if err = sp.processRandaoMixesReset(st); err != nil {
 		return nil, err
+	} else if err = sp.processEth1DataReset(st); err != nil {
+		return nil, err
 	}
 	return sp.processSyncCommitteeUpdates(st)
 }
@@ -356,8 +412,8 @@ func (sp *StateProcessor[
 // processBlockHeader processes the header and ensures it matches the local
 // state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT,
-	_, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, BeaconBlockT, _, BeaconBlockHeaderT, BeaconStateT, _, _, _, _, _, _,
+	_, _, _, _, _, _, ValidatorT, _, _, _, _, _, _,
 ]) processBlockHeader(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -437,30 +493,13 @@ func (sp *StateProcessor[
 	return nil
 }
 
-// getAttestationDeltas as defined in the Ethereum 2.0 specification.
-// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
-//
-//nolint:lll
-func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
-]) getAttestationDeltas(
-	st BeaconStateT,
-) ([]math.Gwei, []math.Gwei, error) {
-	// TODO: implement this function forreal
-	validators, err := st.GetValidators()
-	if err != nil {
-		return nil, nil, err
-	}
-	placeholder := make([]math.Gwei, len(validators))
-	return placeholder, placeholder, nil
-}
-
 // processRewardsAndPenalties as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#process_rewards_and_penalties
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processRewardsAndPenalties(
 	st BeaconStateT,
 ) error {
@@ -21,29 +21,65 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
-	"github.com/sourcegraph/conc/iter"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
-// processSyncCommitteeUpdates processes the sync committee updates.
+// processSyncCommitteeUpdates returns the validator updates for the consensus
+// engine. Only validators whose voting power differs from the power last
+// reported for them are included, and the reported power is recorded.
+//
+// The reported powers are only part of the beacon state from DenebPlus
+// onwards, so before that the power of every validator taking part in
+// consensus is reported, and the record is rewritten from the state. The
+// first DenebPlus epoch boundary then diffs against powers derived from the
+// state, which are committed to from there on.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
 ]) processSyncCommitteeUpdates(
 	st BeaconStateT,
 ) (transition.ValidatorUpdates, error) {
-	vals, err := st.GetValidatorsByEffectiveBalance()
+	// The updates take effect from the next epoch onwards.
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return nil, err
+	}
+	epoch++
+	reportAll := sp.cs.ActiveForkVersionForEpoch(epoch) < version.DenebPlus
+
+	totalValidators, err := st.GetTotalValidators()
 	if err != nil {
 		return nil, err
 	}
 
-	return iter.MapErr(
-		vals,
-		func(val *ValidatorT) (*transition.ValidatorUpdate, error) {
-			v := (*val)
-			return &transition.ValidatorUpdate{
-				Pubkey:           v.GetPubkey(),
-				EffectiveBalance: v.GetEffectiveBalance(),
-			}, nil
-		},
+	var (
+		val     ValidatorT
+		power   math.Gwei
+		updates transition.ValidatorUpdates
 	)
+	for i := range totalValidators {
+		idx := math.ValidatorIndex(i)
+		if val, err = st.ValidatorByIndex(idx); err != nil {
+			return nil, err
+		}
+		if power, err = st.GetValidatorPower(idx); err != nil {
+			return nil, err
+		}
+
+		newPower := sp.validatorPower(val, epoch)
+		if newPower == power && (!reportAll || newPower == 0) {
+			continue
+		}
+
+		if err = st.SetValidatorPower(idx, newPower); err != nil {
+			return nil, err
+		}
+		updates = append(updates, &transition.ValidatorUpdate{
+			Pubkey:           val.GetPubkey(),
+			EffectiveBalance: newPower,
+		})
+	}
+	return updates, nil
 }
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processVoluntaryExits processes the voluntary exits included in the block.
+func (sp *StateProcessor[
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _,
+]) processVoluntaryExits(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	// Voluntary exits are only included in block bodies from DenebPlus
+	// onwards.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.DenebPlus {
+		return nil
+	}
+
+	exits := blk.GetBody().GetVoluntaryExits()
+	if uint64(len(exits)) > constants.MaxVoluntaryExitsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockVoluntaryExitLimit, "expected: %d, got: %d",
+			constants.MaxVoluntaryExitsPerBlock, len(exits),
+		)
+	}
+	for _, exit := range exits {
+		if err := sp.processVoluntaryExit(st, exit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processVoluntaryExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#voluntary-exits
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	VoluntaryExitT, _, _, _, _,
+]) processVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	if err := sp.VerifyVoluntaryExit(st, exit); err != nil {
+		return err
+	}
+	return sp.initiateValidatorExit(st, exit.GetValidatorIndex())
+}
+
+// VerifyVoluntaryExit verifies that the voluntary exit can be processed on
+// top of the given state, including the signature of the exiting validator
+// over the voluntary exit domain.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	VoluntaryExitT, _, _, _, _,
+]) VerifyVoluntaryExit(
+	st BeaconStateT,
+	exit VoluntaryExitT,
+) error {
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	idx := exit.GetValidatorIndex()
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !val.IsActive(epoch):
+		return errors.Wrapf(ErrValidatorNotActive, "index: %d", idx)
+	case val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch):
+		return errors.Wrapf(ErrValidatorAlreadyExiting, "index: %d", idx)
+	case epoch < exit.GetEpoch():
+		return errors.Wrapf(
+			ErrVoluntaryExitTooEarly, "exit epoch: %d, current epoch: %d",
+			exit.GetEpoch(), epoch,
+		)
+	case epoch < val.GetActivationEpoch()+
+		math.Epoch(sp.cs.ShardCommitteePeriod()):
+		return errors.Wrapf(
+			ErrVoluntaryExitTooEarly,
+			"activation epoch: %d, current epoch: %d",
+			val.GetActivationEpoch(), epoch,
+		)
+	}
+
+	// Verify the signature of the exiting validator.
+	fd, err := sp.forkDataAtEpoch(st, exit.GetEpoch())
+	if err != nil {
+		return err
+	}
+	return exit.VerifySignature(
+		fd,
+		sp.cs.DomainTypeVoluntaryExit(),
+		val.GetPubkey(),
+		sp.signer.VerifySignature,
+	)
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// testVoluntaryExit returns a voluntary exit of the validator at the given
+// index.
+func testVoluntaryExit(
+	epoch math.Epoch, index math.ValidatorIndex,
+) *types.SignedVoluntaryExit {
+	return types.NewSignedVoluntaryExit(
+		types.NewVoluntaryExit(epoch, index), crypto.BLSSignature{0x01},
+	)
+}
+
+// testVoluntaryExits returns the given number of voluntary exits.
+func testVoluntaryExits(n uint64) []*types.SignedVoluntaryExit {
+	exits := make([]*types.SignedVoluntaryExit, n)
+	for i := range exits {
+		exits[i] = testVoluntaryExit(2, math.ValidatorIndex(i))
+	}
+	return exits
+}
+
+// setupExits returns a state processor and a beacon state at the start of
+// the first epoch at which the genesis validators are allowed to exit.
+func setupExits(t *testing.T) (*testStateProcessor, *testBeaconState) {
+	t.Helper()
+	sp, st := setupTransition(t)
+	processEpochs(t, sp, st, 2)
+	return sp, st
+}
+
+// transitionWithExits processes a block carrying the given voluntary exits.
+func transitionWithExits(
+	sp *testStateProcessor,
+	st *testBeaconState,
+	blk *types.BeaconBlock,
+	exits ...*types.SignedVoluntaryExit,
+) error {
+	blk.Body.SetVoluntaryExits(exits)
+	_, err := sp.Transition(transitionContext(), st, blk)
+	return err
+}
+
+func TestTransitionVoluntaryExit(t *testing.T) {
+	sp, st := setupExits(t)
+
+	blk := newTestBlock(t, st)
+	require.NoError(t, transitionWithExits(
+		sp, st, blk, testVoluntaryExit(2, 1),
+	))
+
+	// The exit takes effect after the seed lookahead, and the stake can be
+	// withdrawn once the withdrawability delay has passed.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(4), val.GetExitEpoch())
+	require.Equal(t, math.Epoch(8), val.GetWithdrawableEpoch())
+	requireValidatorEpochs(t, st, 2, 0, farFuture)
+
+	// The validator leaves the consensus engine at its exit epoch.
+	updates := processEpochs(t, sp, st, 2)
+	require.Equal(t, []transition.ValidatorUpdates{
+		nil, {validatorUpdate(1, 0)},
+	}, updates)
+}
+
+func TestTransitionVoluntaryExitFullWithdrawal(t *testing.T) {
+	sp, st := setupExits(t)
+
+	blk := newTestBlock(t, st)
+	require.NoError(t, transitionWithExits(
+		sp, st, blk, testVoluntaryExit(2, 1),
+	))
+
+	// Nothing is withdrawn before the withdrawable epoch.
+	processEpochs(t, sp, st, 5)
+	withdrawals, err := st.ExpectedWithdrawals()
+	require.NoError(t, err)
+	for _, wd := range withdrawals {
+		require.Zero(t, wd.GetAmount())
+	}
+
+	// The full balance of the exited validator is withdrawn by the sweep.
+	processEpochs(t, sp, st, 1)
+	withdrawals, err = st.ExpectedWithdrawals()
+	require.NoError(t, err)
+	require.Len(t, withdrawals, testNumValidators)
+	for _, wd := range withdrawals {
+		if wd.GetValidatorIndex() == 1 {
+			require.Equal(t, testBalance, wd.GetAmount())
+		} else {
+			require.Zero(t, wd.GetAmount())
+		}
+	}
+
+	blk = newTestBlock(t, st)
+	_, err = sp.Transition(transitionContext(), st, blk)
+	require.NoError(t, err)
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Zero(t, balance)
+}
+
+func TestTransitionVoluntaryExitInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		exits []*types.SignedVoluntaryExit
+		err   error
+	}{
+		{
+			name:  "future epoch",
+			exits: []*types.SignedVoluntaryExit{testVoluntaryExit(3, 1)},
+			err:   core.ErrVoluntaryExitTooEarly,
+		},
+		{
+			name: "already exiting",
+			exits: []*types.SignedVoluntaryExit{
+				testVoluntaryExit(2, 1), testVoluntaryExit(2, 1),
+			},
+			err: core.ErrValidatorAlreadyExiting,
+		},
+		{
+			name:  "too many exits",
+			exits: testVoluntaryExits(constants.MaxVoluntaryExitsPerBlock + 1),
+			err:   core.ErrExceedsBlockVoluntaryExitLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sp, st := setupExits(t)
+			blk := newTestBlock(t, st)
+			require.ErrorIs(t, transitionWithExits(
+				sp, st, blk, tc.exits...,
+			), tc.err)
+		})
+	}
+}
+
+func TestTransitionVoluntaryExitTooEarly(t *testing.T) {
+	// The genesis validators have not been active for the shard committee
+	// period yet.
+	sp, st := setupTransition(t)
+	blk := newTestBlock(t, st)
+	require.ErrorIs(t, transitionWithExits(
+		sp, st, blk, testVoluntaryExit(0, 1),
+	), core.ErrVoluntaryExitTooEarly)
+}
+
+func TestTransitionVoluntaryExitNotActive(t *testing.T) {
+	sp, st := setupExits(t)
+	addTestValidator(t, st, testNumValidators, testBalance)
+
+	blk := newTestBlock(t, st)
+	require.ErrorIs(t, transitionWithExits(
+		sp, st, blk, testVoluntaryExit(2, testNumValidators),
+	), core.ErrValidatorNotActive)
+}
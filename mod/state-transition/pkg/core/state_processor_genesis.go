@@ -23,8 +23,11 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
@@ -39,8 +42,9 @@ const (
 //
 //nolint:gocognit,funlen // todo fix.
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, DepositT,
-	Eth1DataT, _, ExecutionPayloadHeaderT, ForkT, _, _, ValidatorT, _, _, _, _,
+	_, _, _, BeaconBlockBodyT, BeaconBlockHeaderT, BeaconStateT, _, _, DepositT,
+	Eth1DataT, _, ExecutionPayloadHeaderT, _, ForkT, _, _, _, _, ValidatorT, _,
+	_, _, _, _, _,
 ]) InitializePreminedBeaconStateFromEth1(
 	st BeaconStateT,
 	deposits []DepositT,
@@ -71,9 +75,14 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
-	if err := st.SetEth1Data(eth1Data.New(
-		common.Root{},
-		0,
+	depositRoot, err := sp.genesisDepositRoot(deposits)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = st.SetEth1Data(eth1Data.New(
+		depositRoot,
+		math.U64(len(deposits)),
 		executionPayloadHeader.GetBlockHash(),
 	)); err != nil {
 		return nil, err
@@ -83,14 +92,14 @@ func (sp *StateProcessor[
 	// uint32 better.
 	bodyRoot := blkBody.Empty(
 		version.ToUint32(genesisVersion)).HashTreeRoot()
-	if err := st.SetLatestBlockHeader(blkHeader.New(
+	if err = st.SetLatestBlockHeader(blkHeader.New(
 		0, 0, common.Root{}, common.Root{}, bodyRoot,
 	)); err != nil {
 		return nil, err
 	}
 
 	for i := range sp.cs.EpochsPerHistoricalVector() {
-		if err := st.UpdateRandaoMixAtIndex(
+		if err = st.UpdateRandaoMixAtIndex(
 			i,
 			common.Bytes32(executionPayloadHeader.GetBlockHash()),
 		); err != nil {
@@ -98,13 +107,13 @@ func (sp *StateProcessor[
 		}
 	}
 
+	// The genesis deposits are trusted, so their proofs are not verified.
 	for _, deposit := range deposits {
-		if err := sp.processDeposit(st, deposit); err != nil {
+		if err = sp.processDeposit(st, deposit); err != nil {
 			return nil, err
 		}
 	}
 
-	// TODO: process activations.
 	validators, err := st.GetValidators()
 	if err != nil {
 		return nil, err
@@ -121,6 +130,14 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// The genesis validators root is computed over the validators as
+	// deposited, so activations are processed afterwards.
+	if version.ToUint32(genesisVersion) >= version.DenebPlus {
+		if err = sp.activateGenesisValidators(st); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = st.SetLatestExecutionPayloadHeader(
 		executionPayloadHeader,
 	); err != nil {
@@ -159,3 +176,30 @@ func (sp *StateProcessor[
 	}
 	return updates, nil
 }
+
+// genesisDepositRoot returns the root of the deposit tree built from the
+// genesis deposits, with the number of deposits mixed in.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, DepositT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _,
+]) genesisDepositRoot(
+	deposits []DepositT,
+) (common.Root, error) {
+	if len(deposits) == 0 {
+		return merkle.NewHasher[common.Root](sha256.Hash).MixIn(
+			common.Root(zero.Hashes[constants.DepositContractDepth]), 0,
+		), nil
+	}
+
+	leaves := make([]common.Root, len(deposits))
+	for i, deposit := range deposits {
+		leaves[i] = deposit.GetDataRoot()
+	}
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		leaves, constants.DepositContractDepth,
+	)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return tree.HashTreeRoot(), nil
+}
@@ -25,14 +25,15 @@ import (
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"golang.org/x/sync/errgroup"
 )
 
 // processExecutionPayload processes the execution payload and ensures it
 // matches the local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, ContextT,
-	_, _, _, ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _,
+	ExecutionPayloadHeaderT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) processExecutionPayload(
 	ctx ContextT,
 	st BeaconStateT,
@@ -79,8 +80,8 @@ func (sp *StateProcessor[
 // state
 // and the execution engine.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT,
-	_, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _,
 ]) validateExecutionPayload(
 	ctx context.Context,
 	st BeaconStateT,
@@ -110,12 +111,27 @@ func (sp *StateProcessor[
 		)
 	}
 
+	// From Electra the execution layer requests are passed to the execution
+	// client, which verifies them against the payload.
+	var (
+		forkVersion       = sp.cs.ActiveForkVersionForSlot(blk.GetSlot())
+		executionRequests [][]byte
+	)
+	if forkVersion >= version.Electra {
+		executionRequests, err = body.GetExecutionRequests().EncodeRequests()
+		if err != nil {
+			return err
+		}
+	}
+
 	parentBeaconBlockRoot := blk.GetParentBlockRoot()
 	if err = sp.executionEngine.VerifyAndNotifyNewPayload(
 		ctx, engineprimitives.BuildNewPayloadRequest(
 			payload,
 			body.GetBlobKzgCommitments().ToVersionedHashes(),
 			&parentBeaconBlockRoot,
+			executionRequests,
+			forkVersion,
 			optimisticEngine,
 		),
 	); err != nil {
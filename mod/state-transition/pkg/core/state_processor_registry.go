@@ -0,0 +1,462 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processRegistryUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#registry-updates
+//
+// Validators are queued for activation once their effective balance clears
+// the ejection balance, and ejected once it falls to it. The activation queue
+// is drained up to the churn limit into an active set bounded by the
+// validator set cap, evicting the validator with the lowest effective
+// balance when a queued validator outweighs it. As CometBFT finalizes every
+// block, the current epoch stands in for the finalized checkpoint.
+//
+// The registry lifecycle applies from DenebPlus onwards.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
+]) processRegistryUpdates(
+	st BeaconStateT,
+) error {
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if sp.cs.ActiveForkVersionForEpoch(epoch+1) < version.DenebPlus {
+		return nil
+	}
+
+	// Validators registered before DenebPlus are already part of the
+	// consensus engine's validator set, so they are activated right away.
+	if sp.cs.ActiveForkVersionForEpoch(epoch) < version.DenebPlus {
+		if err = sp.activateLegacyValidators(st, epoch+1); err != nil {
+			return err
+		}
+	}
+
+	// The validators are updated in place as they are written back, so that
+	// they reflect the state throughout the epoch, and the exit queue is
+	// carried through the exits initiated within the epoch.
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		farFuture = math.Epoch(constants.FarFutureEpoch)
+		ejection  = math.Gwei(sp.cs.EjectionBalance())
+		exits     = sp.newExitQueue(vals, epoch)
+	)
+	for i, val := range vals {
+		idx := math.ValidatorIndex(i)
+		if val.GetActivationEligibilityEpoch() == farFuture &&
+			sp.meetsActivationBalance(val) {
+			val.SetActivationEligibilityEpoch(epoch + 1)
+			if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+				return err
+			}
+		}
+
+		if val.IsActive(epoch) && val.GetEffectiveBalance() <= ejection {
+			if err = sp.exitValidator(st, idx, val, exits); err != nil {
+				return err
+			}
+		}
+	}
+
+	return sp.processActivationQueue(st, vals, epoch, exits)
+}
+
+// processActivationQueue activates queued validators up to the churn limit,
+// keeping the active set within the validator set cap.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorsT, _, _, _, _, _,
+]) processActivationQueue(
+	st BeaconStateT,
+	vals ValidatorsT,
+	epoch math.Epoch,
+	exits *exitQueue,
+) error {
+	var (
+		err       error
+		queue     []math.ValidatorIndex
+		set       []math.ValidatorIndex
+		farFuture = math.Epoch(constants.FarFutureEpoch)
+	)
+	for i, val := range vals {
+		switch {
+		case val.GetExitEpoch() != farFuture:
+			// Exiting validators are neither in the set nor queued.
+		case val.GetActivationEpoch() != farFuture:
+			set = append(set, math.ValidatorIndex(i))
+		case val.IsEligibleForActivation(epoch):
+			queue = append(queue, math.ValidatorIndex(i))
+		}
+	}
+
+	// The queue is ordered by eligibility, ties are broken by index.
+	slices.SortStableFunc(queue, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			vals[a].GetActivationEligibilityEpoch(),
+			vals[b].GetActivationEligibilityEpoch(),
+		)
+	})
+
+	queue = queue[:min(uint64(len(queue)), exits.churnLimit)]
+	for _, idx := range queue {
+		if uint64(len(set)) < sp.cs.ValidatorSetCap() {
+			if err = sp.activateValidator(
+				st, idx, vals[idx], epoch,
+			); err != nil {
+				return err
+			}
+			set = append(set, idx)
+			continue
+		}
+
+		// The active set is full, the queued validator replaces the
+		// weakest validator in the set if it outweighs it, and stays in
+		// the queue otherwise.
+		lowest := sp.lowestEffectiveBalance(vals, set)
+		if len(set) == 0 || vals[idx].GetEffectiveBalance() <=
+			vals[set[lowest]].GetEffectiveBalance() {
+			continue
+		}
+		if err = sp.exitValidator(
+			st, set[lowest], vals[set[lowest]], exits,
+		); err != nil {
+			return err
+		}
+		if err = sp.activateValidator(
+			st, idx, vals[idx], epoch,
+		); err != nil {
+			return err
+		}
+		set[lowest] = idx
+	}
+
+	// Evict the weakest validators if the set outgrew the cap.
+	for uint64(len(set)) > sp.cs.ValidatorSetCap() {
+		lowest := sp.lowestEffectiveBalance(vals, set)
+		if err = sp.exitValidator(
+			st, set[lowest], vals[set[lowest]], exits,
+		); err != nil {
+			return err
+		}
+		set = slices.Delete(set, lowest, lowest+1)
+	}
+	return nil
+}
+
+// lowestEffectiveBalance returns the position in the set of the validator
+// with the lowest effective balance. Among equal balances, the validator
+// with the highest index is returned, so the most recent one is evicted.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorsT, _, _,
+	_, _, _,
+]) lowestEffectiveBalance(
+	vals ValidatorsT,
+	set []math.ValidatorIndex,
+) int {
+	lowest := 0
+	for i, idx := range set {
+		balance := vals[idx].GetEffectiveBalance()
+		lowestBalance := vals[set[lowest]].GetEffectiveBalance()
+		if balance < lowestBalance ||
+			(balance == lowestBalance && idx > set[lowest]) {
+			lowest = i
+		}
+	}
+	return lowest
+}
+
+// activateValidator schedules the activation of a queued validator.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
+]) activateValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+	epoch math.Epoch,
+) error {
+	val.SetActivationEpoch(sp.activationExitEpoch(epoch))
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// activateLegacyValidators activates at the given epoch every validator that
+// was registered without going through the activation queue.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) activateLegacyValidators(
+	st BeaconStateT,
+	epoch math.Epoch,
+) error {
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	for i, val := range vals {
+		if val.IsSlashed() || val.GetExitEpoch() != farFuture ||
+			val.GetActivationEpoch() != farFuture {
+			continue
+		}
+		val.SetActivationEligibilityEpoch(epoch)
+		val.SetActivationEpoch(epoch)
+		if err = st.UpdateValidatorAtIndex(
+			math.ValidatorIndex(i), val,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// activateGenesisValidators activates the genesis validators with enough
+// effective balance, up to the validator set cap. The validators that do not
+// fit in the active set are queued for activation.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) activateGenesisValidators(
+	st BeaconStateT,
+) error {
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+
+	var queue []math.ValidatorIndex
+	for i, val := range vals {
+		if sp.meetsActivationBalance(val) {
+			queue = append(queue, math.ValidatorIndex(i))
+		}
+	}
+
+	// The validators with the highest effective balance make the cut, ties
+	// are broken by index.
+	slices.SortStableFunc(queue, func(a, b math.ValidatorIndex) int {
+		return cmp.Compare(
+			vals[b].GetEffectiveBalance(), vals[a].GetEffectiveBalance(),
+		)
+	})
+
+	genesisEpoch := math.Epoch(constants.GenesisEpoch)
+	for i, idx := range queue {
+		val := vals[idx]
+		val.SetActivationEligibilityEpoch(genesisEpoch)
+		if uint64(i) < sp.cs.ValidatorSetCap() {
+			val.SetActivationEpoch(genesisEpoch)
+		}
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// initiateValidatorExit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#initiate_validator_exit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) initiateValidatorExit(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+) error {
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+
+	// Return if the validator already initiated an exit.
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	vals, err := st.GetValidators()
+	if err != nil {
+		return err
+	}
+	return sp.exitValidator(st, idx, val, sp.newExitQueue(vals, epoch))
+}
+
+// exitQueue is the exit queue of an epoch. It is computed once and carried
+// through the exits initiated at the epoch transition.
+type exitQueue struct {
+	// epoch is the epoch the next exit is scheduled at.
+	epoch math.Epoch
+	// churn is the number of exits scheduled at the epoch.
+	churn uint64
+	// churnLimit is the maximum number of exits scheduled at an epoch.
+	churnLimit uint64
+}
+
+// next schedules an exit and returns its epoch.
+func (q *exitQueue) next() math.Epoch {
+	if q.churn >= q.churnLimit {
+		q.epoch++
+		q.churn = 0
+	}
+	q.churn++
+	return q.epoch
+}
+
+// newExitQueue returns the exit queue of the validators at the given epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorsT, _, _,
+	_, _, _,
+]) newExitQueue(
+	vals ValidatorsT,
+	epoch math.Epoch,
+) *exitQueue {
+	farFuture := math.Epoch(constants.FarFutureEpoch)
+	q := &exitQueue{
+		epoch:      sp.activationExitEpoch(epoch),
+		churnLimit: sp.validatorChurnLimit(vals, epoch),
+	}
+	for _, val := range vals {
+		if val.GetExitEpoch() != farFuture {
+			q.epoch = max(q.epoch, val.GetExitEpoch())
+		}
+	}
+	for _, val := range vals {
+		if val.GetExitEpoch() == q.epoch {
+			q.churn++
+		}
+	}
+	return q
+}
+
+// exitValidator schedules the exit of the validator in the exit queue,
+// unless it already initiated an exit.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
+]) exitValidator(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+	q *exitQueue,
+) error {
+	if val.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	// Set the validator exit epoch and withdrawable epoch.
+	exitEpoch := q.next()
+	val.SetExitEpoch(exitEpoch)
+	val.SetWithdrawableEpoch(
+		exitEpoch + math.Epoch(sp.cs.MinValidatorWithdrawabilityDelay()),
+	)
+	return st.UpdateValidatorAtIndex(idx, val)
+}
+
+// validatorChurnLimit as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorsT, _, _,
+	_, _, _,
+]) validatorChurnLimit(
+	vals ValidatorsT,
+	epoch math.Epoch,
+) uint64 {
+	var active uint64
+	for _, val := range vals {
+		if val.IsActive(epoch) {
+			active++
+		}
+	}
+	return max(
+		sp.cs.MinPerEpochChurnLimit(),
+		active/sp.cs.ChurnLimitQuotient(),
+	)
+}
+
+// activationExitEpoch as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#compute_activation_exit_epoch
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) activationExitEpoch(
+	epoch math.Epoch,
+) math.Epoch {
+	return epoch + 1 + math.Epoch(sp.cs.MaxSeedLookahead())
+}
+
+// meetsActivationBalance returns true if the effective balance of the
+// validator is high enough to not be ejected right away.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_, _, _,
+]) meetsActivationBalance(
+	val ValidatorT,
+) bool {
+	return val.GetEffectiveBalance() >= math.Gwei(
+		sp.cs.EjectionBalance()+sp.cs.EffectiveBalanceIncrement(),
+	)
+}
+
+// validatorPower returns the voting power of a validator in the consensus
+// engine at the given epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_, _, _,
+]) validatorPower(
+	val ValidatorT,
+	epoch math.Epoch,
+) math.Gwei {
+	// Slashed validators are removed from the active set.
+	if val.IsSlashed() {
+		return 0
+	}
+	// Before DenebPlus, every registered validator takes part in consensus.
+	if sp.cs.ActiveForkVersionForEpoch(epoch) >= version.DenebPlus &&
+		!val.IsActive(epoch) {
+		return 0
+	}
+	return val.GetEffectiveBalance()
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// registryChainSpec returns the test chain spec with an ejection balance of
+// half the maximum effective balance and the given validator set cap.
+func registryChainSpec(validatorSetCap uint64) common.ChainSpec {
+	data := newTestSpecData()
+	data.EjectionBalance = 16e9
+	data.ValidatorSetCap = validatorSetCap
+	return chain.NewChainSpec(data)
+}
+
+// genesisDeposits returns one genesis deposit per given amount.
+func genesisDeposits(amounts ...math.Gwei) []*types.Deposit {
+	deposits := make([]*types.Deposit, len(amounts))
+	for i, amount := range amounts {
+		deposits[i] = testDeposit(i, amount, uint64(i))
+	}
+	return deposits
+}
+
+// addTestValidator registers the validator at the given index with the given
+// balance, as a deposit would.
+func addTestValidator(
+	t *testing.T, st *testBeaconState, index int, amount math.Gwei,
+) {
+	t.Helper()
+	deposit := testDeposit(index, amount, 0)
+	require.NoError(t, st.AddValidator(types.NewValidatorFromDeposit(
+		deposit.Pubkey, deposit.Credentials, amount, 1e9, testBalance,
+	)))
+	require.NoError(t, st.IncreaseBalance(math.ValidatorIndex(index), amount))
+}
+
+// processEpochs processes the slots up to the end of the given epoch and
+// returns the validator updates of every epoch boundary.
+func processEpochs(
+	t *testing.T, sp *testStateProcessor, st *testBeaconState, epochs int,
+) []transition.ValidatorUpdates {
+	t.Helper()
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+
+	updates := make([]transition.ValidatorUpdates, 0, epochs)
+	for range epochs {
+		slot = (slot/32 + 1) * 32
+		var epochUpdates transition.ValidatorUpdates
+		epochUpdates, err = sp.ProcessSlots(st, slot)
+		require.NoError(t, err)
+		updates = append(updates, epochUpdates)
+	}
+	return updates
+}
+
+func requireValidatorEpochs(
+	t *testing.T,
+	st *testBeaconState,
+	index math.ValidatorIndex,
+	activation, exit math.Epoch,
+) {
+	t.Helper()
+	val, err := st.ValidatorByIndex(index)
+	require.NoError(t, err)
+	require.Equal(t, activation, val.GetActivationEpoch())
+	require.Equal(t, exit, val.GetExitEpoch())
+}
+
+const farFuture = math.Epoch(constants.FarFutureEpoch)
+
+func TestGenesisActivations(t *testing.T) {
+	// The weakest genesis validator does not make the cut.
+	_, st, updates := setupGenesis(t, registryChainSpec(2), genesisDeposits(
+		24e9, testBalance, 28e9, 16e9,
+	))
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(1, testBalance),
+		validatorUpdate(2, 28e9),
+	}, updates)
+	requireValidatorEpochs(t, st, 0, farFuture, farFuture)
+	requireValidatorEpochs(t, st, 1, 0, farFuture)
+	requireValidatorEpochs(t, st, 2, 0, farFuture)
+
+	// Validators at the ejection balance are not queued.
+	val, err := st.ValidatorByIndex(3)
+	require.NoError(t, err)
+	require.Equal(t, farFuture, val.GetActivationEligibilityEpoch())
+}
+
+func TestProcessRegistryUpdatesActivation(t *testing.T) {
+	sp, st, _ := setupGenesis(
+		t, registryChainSpec(4), genesisDeposits(testBalance, testBalance),
+	)
+	addTestValidator(t, st, 2, testBalance)
+
+	// The validator is queued at the first epoch boundary, activated at the
+	// second one and takes part in consensus from the activation epoch.
+	updates := processEpochs(t, sp, st, 3)
+	require.Equal(t, []transition.ValidatorUpdates{
+		nil, nil, {validatorUpdate(2, testBalance)},
+	}, updates)
+	val, err := st.ValidatorByIndex(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(1), val.GetActivationEligibilityEpoch())
+	requireValidatorEpochs(t, st, 2, 3, farFuture)
+}
+
+func TestProcessRegistryUpdatesChurnLimit(t *testing.T) {
+	data := newTestSpecData()
+	data.MinPerEpochChurnLimit = 1
+	sp, st, _ := setupGenesis(
+		t, chain.NewChainSpec(data), genesisDeposits(testBalance),
+	)
+	addTestValidator(t, st, 1, testBalance)
+	addTestValidator(t, st, 2, testBalance)
+
+	// A single validator is activated per epoch.
+	processEpochs(t, sp, st, 3)
+	requireValidatorEpochs(t, st, 1, 3, farFuture)
+	requireValidatorEpochs(t, st, 2, 4, farFuture)
+}
+
+func TestProcessRegistryUpdatesEviction(t *testing.T) {
+	sp, st, _ := setupGenesis(
+		t, registryChainSpec(2), genesisDeposits(testBalance, 24e9),
+	)
+	addTestValidator(t, st, 2, 28e9)
+	addTestValidator(t, st, 3, 20e9)
+
+	// The stronger validator replaces the weakest one in the active set,
+	// while the weaker validator stays in the queue.
+	updates := processEpochs(t, sp, st, 3)
+	require.Equal(t, []transition.ValidatorUpdates{
+		nil, nil, {validatorUpdate(1, 0), validatorUpdate(2, 28e9)},
+	}, updates)
+	requireValidatorEpochs(t, st, 1, 0, 3)
+	requireValidatorEpochs(t, st, 2, 3, farFuture)
+	requireValidatorEpochs(t, st, 3, farFuture, farFuture)
+
+	// The queued validator is activated once it outweighs the weakest
+	// validator in the active set.
+	updates = processEpochs(t, sp, st, 1)
+	require.Equal(t, []transition.ValidatorUpdates{nil}, updates)
+	requireValidatorEpochs(t, st, 3, farFuture, farFuture)
+	require.NoError(t, st.IncreaseBalance(3, 12e9))
+	updates = processEpochs(t, sp, st, 3)
+	require.Equal(t, []transition.ValidatorUpdates{
+		nil, nil, {validatorUpdate(2, 0), validatorUpdate(3, 32e9)},
+	}, updates)
+	requireValidatorEpochs(t, st, 2, 3, 7)
+	requireValidatorEpochs(t, st, 3, 7, farFuture)
+}
+
+func TestProcessRegistryUpdatesEjection(t *testing.T) {
+	sp, st, _ := setupGenesis(
+		t, registryChainSpec(4), genesisDeposits(testBalance, testBalance),
+	)
+	require.NoError(t, st.DecreaseBalance(1, 17e9))
+
+	// The effective balance drops at the first epoch boundary, the exit is
+	// initiated at the second one and takes effect at the exit epoch.
+	updates := processEpochs(t, sp, st, 3)
+	require.Equal(t, []transition.ValidatorUpdates{
+		{validatorUpdate(1, 15e9)}, nil, {validatorUpdate(1, 0)},
+	}, updates)
+	requireValidatorEpochs(t, st, 1, 0, 3)
+
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.Equal(t, math.Epoch(7), val.GetWithdrawableEpoch())
+}
+
+func TestProcessRegistryUpdatesForkActivation(t *testing.T) {
+	data := newTestSpecData()
+	data.DenebPlusForkEpoch = 2
+	sp, st, updates := setupGenesis(
+		t, chain.NewChainSpec(data), genesisDeposits(testBalance, 24e9),
+	)
+
+	// Before DenebPlus, registered validators take part in consensus without
+	// being activated.
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(0, testBalance),
+		validatorUpdate(1, 24e9),
+	}, updates)
+	requireValidatorEpochs(t, st, 0, farFuture, farFuture)
+
+	// Their voting power is reported at every epoch boundary before the fork,
+	// and they are activated as the fork starts, keeping their voting power.
+	epochUpdates := processEpochs(t, sp, st, 3)
+	require.Equal(t, []transition.ValidatorUpdates{
+		updates, nil, nil,
+	}, epochUpdates)
+	requireValidatorEpochs(t, st, 0, 2, farFuture)
+	requireValidatorEpochs(t, st, 1, 2, farFuture)
+
+	// The reported powers are committed to in the state from the fork on.
+	bsm, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{testBalance.Unwrap(), 24e9}, bsm.ValidatorPowers)
+}
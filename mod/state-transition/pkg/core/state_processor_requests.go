@@ -0,0 +1,289 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// ethSecp256k1CredentialPrefix is the prefix of withdrawal credentials that
+// withdraw to an execution address.
+const ethSecp256k1CredentialPrefix = 0x01
+
+// processExecutionRequests processes the execution layer requests of the
+// block, as defined in EIP-6110, EIP-7002 and EIP-7251. The execution layer
+// accepts requests without knowledge of the beacon state, so requests that
+// can not be honoured are ignored rather than invalidating the block.
+func (sp *StateProcessor[
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _,
+]) processExecutionRequests(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	// Execution layer requests are only included in block bodies from
+	// Electra onwards.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.Electra {
+		return nil
+	}
+
+	requests := blk.GetBody().GetExecutionRequests()
+	for _, dep := range requests.GetDeposits() {
+		if err := sp.processDepositRequest(st, dep); err != nil {
+			return err
+		}
+	}
+	for _, req := range requests.GetWithdrawals() {
+		if err := sp.processWithdrawalRequest(st, req); err != nil {
+			return err
+		}
+	}
+	for _, req := range requests.GetConsolidations() {
+		if err := sp.processConsolidationRequest(st, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processDepositRequest processes a deposit emitted by the deposit contract
+// as an execution layer request. Unlike deposits included by the proposer,
+// it carries no proof and does not advance the eth1 deposit index.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
+]) processDepositRequest(
+	st BeaconStateT,
+	dep DepositT,
+) error {
+	// The first deposit request marks where deposits stop being included by
+	// the proposer, see processOperations.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	if startIndex == constants.UnsetDepositRequestsStartIndex {
+		if err = st.SetDepositRequestsStartIndex(
+			dep.GetIndex().Unwrap(),
+		); err != nil {
+			return err
+		}
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
+	if err == nil {
+		return st.IncreaseBalance(idx, dep.GetAmount())
+	}
+
+	// The deposit contract does not verify signatures, so a new validator
+	// with an invalid signature is ignored.
+	if err = sp.verifyDepositSignature(st, dep); err != nil {
+		//nolint:nilerr // invalid deposit requests are ignored.
+		return nil
+	}
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// processWithdrawalRequest processes a withdrawal request triggered from the
+// execution layer by the withdrawal address of a validator. Only full exits
+// are supported: partial withdrawals require compounding withdrawal
+// credentials, and the balance beyond the maximum effective balance is
+// already withdrawn by the withdrawal sweep.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	WithdrawalRequestT, _, _,
+]) processWithdrawalRequest(
+	st BeaconStateT,
+	req WithdrawalRequestT,
+) error {
+	if req.GetAmount() != math.Gwei(constants.FullExitRequestAmount) {
+		return nil
+	}
+
+	idx, err := st.ValidatorIndexByPubkey(req.GetValidatorPubkey())
+	if err != nil {
+		//nolint:nilerr // requests for unknown validators are ignored.
+		return nil
+	}
+	val, err := st.ValidatorByIndex(idx)
+	if err != nil {
+		return err
+	}
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if !sp.isExitableByRequest(val, req.GetSourceAddress(), epoch) {
+		return nil
+	}
+	return sp.initiateValidatorExit(st, idx)
+}
+
+// processConsolidationRequest processes a consolidation request triggered
+// from the execution layer by the withdrawal address of the source
+// validator. The source validator is exited and its balance is moved to the
+// target validator once it is withdrawable, see
+// processPendingConsolidations.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, ConsolidationRequestT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _,
+]) processConsolidationRequest(
+	st BeaconStateT,
+	req ConsolidationRequestT,
+) error {
+	if req.GetSourcePubkey() == req.GetTargetPubkey() {
+		return nil
+	}
+
+	pending, err := st.GetPendingConsolidations()
+	if err != nil {
+		return err
+	}
+	if uint64(len(pending)) >= constants.PendingConsolidationsLimit {
+		return nil
+	}
+
+	sourceIdx, err := st.ValidatorIndexByPubkey(req.GetSourcePubkey())
+	if err != nil {
+		//nolint:nilerr // requests for unknown validators are ignored.
+		return nil
+	}
+	targetIdx, err := st.ValidatorIndexByPubkey(req.GetTargetPubkey())
+	if err != nil {
+		//nolint:nilerr // requests for unknown validators are ignored.
+		return nil
+	}
+	source, err := st.ValidatorByIndex(sourceIdx)
+	if err != nil {
+		return err
+	}
+	target, err := st.ValidatorByIndex(targetIdx)
+	if err != nil {
+		return err
+	}
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+	if !sp.isExitableByRequest(source, req.GetSourceAddress(), epoch) {
+		return nil
+	}
+
+	// The consolidated balance must remain withdrawable by the target, so it
+	// needs execution withdrawal credentials and may not be exiting.
+	if _, ok := executionAddress(target.GetWithdrawalCredentials()); !ok ||
+		!target.IsActive(epoch) ||
+		target.GetExitEpoch() != math.Epoch(constants.FarFutureEpoch) {
+		return nil
+	}
+
+	if err = sp.initiateValidatorExit(st, sourceIdx); err != nil {
+		return err
+	}
+	return st.AddPendingConsolidation(sourceIdx, targetIdx)
+}
+
+// isExitableByRequest returns true if the validator can be exited by an
+// execution layer request sent from the given address, i.e. if the address
+// is its withdrawal address and a voluntary exit of the validator would be
+// valid at the given epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+	_, _, _,
+]) isExitableByRequest(
+	val ValidatorT,
+	sourceAddress common.ExecutionAddress,
+	epoch math.Epoch,
+) bool {
+	address, ok := executionAddress(val.GetWithdrawalCredentials())
+	return ok && address == sourceAddress &&
+		val.IsActive(epoch) &&
+		val.GetExitEpoch() == math.Epoch(constants.FarFutureEpoch) &&
+		epoch >= val.GetActivationEpoch()+
+			math.Epoch(sp.cs.ShardCommitteePeriod())
+}
+
+// processPendingConsolidations moves the balance of each consolidated source
+// validator to its target validator before the source becomes withdrawable,
+// so that it is not withdrawn by the withdrawal sweep. Consolidations of
+// slashed source validators are dropped.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) processPendingConsolidations(
+	st BeaconStateT,
+) error {
+	pending, err := st.GetPendingConsolidations()
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	for _, sourceIdx := range slices.Sorted(maps.Keys(pending)) {
+		source, err := st.ValidatorByIndex(sourceIdx)
+		if err != nil {
+			return err
+		}
+		if !source.IsSlashed() {
+			if source.GetWithdrawableEpoch() > epoch+1 {
+				continue
+			}
+
+			var balance math.Gwei
+			if balance, err = st.GetBalance(sourceIdx); err != nil {
+				return err
+			}
+			amount := min(balance, source.GetEffectiveBalance())
+			if err = st.DecreaseBalance(sourceIdx, amount); err != nil {
+				return err
+			}
+			if err = st.IncreaseBalance(
+				pending[sourceIdx], amount,
+			); err != nil {
+				return err
+			}
+		}
+		if err = st.RemovePendingConsolidation(sourceIdx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executionAddress returns the execution address of execution withdrawal
+// credentials, and false for any other withdrawal credentials.
+func executionAddress[WithdrawalCredentialsT ~[32]byte](
+	credentials WithdrawalCredentialsT,
+) (common.ExecutionAddress, bool) {
+	if credentials[0] != ethSecp256k1CredentialPrefix {
+		return common.ExecutionAddress{}, false
+	}
+	return common.ExecutionAddress(credentials[12:]), true
+}
@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// testExecutionAddress returns the withdrawal address of the validator at
+// the given index.
+func testExecutionAddress(index int) common.ExecutionAddress {
+	return common.ExecutionAddress{byte(index + 1)}
+}
+
+// setupElectra returns a state processor and a beacon state with Electra
+// active from genesis, at the start of the first epoch at which the genesis
+// validators are allowed to exit.
+func setupElectra(t *testing.T) (*testStateProcessor, *testBeaconState) {
+	t.Helper()
+	data := newTestSpecData()
+	data.ElectraForkEpoch = 0
+	deposits := make([]*types.Deposit, testNumValidators)
+	for i := range deposits {
+		deposits[i] = testDeposit(i, testBalance, uint64(i))
+	}
+	sp, st, _ := setupGenesis(t, chain.NewChainSpec(data), deposits)
+
+	_, err := sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+	processEpochs(t, sp, st, 2)
+	return sp, st
+}
+
+// transitionWithRequests processes an Electra block carrying the given
+// execution layer requests.
+func transitionWithRequests(
+	t *testing.T,
+	sp *testStateProcessor,
+	st *testBeaconState,
+	requests *types.ExecutionRequests,
+) error {
+	t.Helper()
+	blk := newTestBlockWithVersion(t, st, version.Electra)
+	blk.Body.SetExecutionRequests(requests)
+	_, err := sp.Transition(transitionContext(), st, blk)
+	return err
+}
+
+func TestTransitionDepositRequests(t *testing.T) {
+	sp, st := setupElectra(t)
+	eth1DepositIndex, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+
+	require.NoError(t, transitionWithRequests(t, sp, st,
+		&types.ExecutionRequests{
+			Deposits: []*types.DepositRequest{
+				(*types.DepositRequest)(testDeposit(1, 1e9, 10)),
+				(*types.DepositRequest)(testDeposit(
+					testNumValidators, testBalance, 11,
+				)),
+			},
+		},
+	))
+
+	// The first deposit request marks where deposits stop being included by
+	// the proposer, and the eth1 deposit index is left untouched.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), startIndex)
+	bsm, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), bsm.DepositRequestsStartIndex)
+	index, err := st.GetEth1DepositIndex()
+	require.NoError(t, err)
+	require.Equal(t, eth1DepositIndex, index)
+
+	// The existing validator is topped up and the new one is registered.
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, testBalance+1e9, balance)
+	idx, err := st.ValidatorIndexByPubkey(testPubkey(testNumValidators))
+	require.NoError(t, err)
+	require.Equal(t, math.ValidatorIndex(testNumValidators), idx)
+	balance, err = st.GetBalance(idx)
+	require.NoError(t, err)
+	require.Equal(t, testBalance, balance)
+}
+
+func TestTransitionDepositRequestsStartIndex(t *testing.T) {
+	testCases := []struct {
+		name       string
+		startIndex bool
+		err        error
+	}{
+		{
+			name: "deposits expected",
+			err:  core.ErrDepositCountMismatch,
+		},
+		{
+			name:       "deposits processed as requests",
+			startIndex: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sp, st := setupElectra(t)
+			index, err := st.GetEth1DepositIndex()
+			require.NoError(t, err)
+			if tc.startIndex {
+				require.NoError(t, st.SetDepositRequestsStartIndex(index))
+			}
+
+			// The adopted eth1 data reports new deposits, which are only
+			// included by the proposer before the first deposit request.
+			eth1Data, err := st.GetEth1Data()
+			require.NoError(t, err)
+			eth1Data.DepositCount = math.U64(index + 2)
+			require.NoError(t, st.SetEth1Data(eth1Data))
+
+			blk := newTestBlockWithVersion(t, st, version.Electra)
+			_, err = sp.Transition(transitionContext(), st, blk)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestTransitionWithdrawalRequest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request *types.WithdrawalRequest
+		exit    math.Epoch
+	}{
+		{
+			name: "full exit",
+			request: types.NewWithdrawalRequest(
+				testExecutionAddress(1), testPubkey(1), 0,
+			),
+			exit: 4,
+		},
+		{
+			name: "partial withdrawal",
+			request: types.NewWithdrawalRequest(
+				testExecutionAddress(1), testPubkey(1), 1e9,
+			),
+			exit: farFuture,
+		},
+		{
+			name: "not the withdrawal address",
+			request: types.NewWithdrawalRequest(
+				testExecutionAddress(2), testPubkey(1), 0,
+			),
+			exit: farFuture,
+		},
+		{
+			name: "unknown validator",
+			request: types.NewWithdrawalRequest(
+				testExecutionAddress(1), crypto.BLSPubkey{0xff}, 0,
+			),
+			exit: farFuture,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sp, st := setupElectra(t)
+			require.NoError(t, transitionWithRequests(t, sp, st,
+				&types.ExecutionRequests{
+					Withdrawals: []*types.WithdrawalRequest{tc.request},
+				},
+			))
+			requireValidatorEpochs(t, st, 1, 0, tc.exit)
+		})
+	}
+}
+
+func TestTransitionConsolidationRequest(t *testing.T) {
+	sp, st := setupElectra(t)
+	require.NoError(t, transitionWithRequests(t, sp, st,
+		&types.ExecutionRequests{
+			Consolidations: []*types.ConsolidationRequest{
+				types.NewConsolidationRequest(
+					testExecutionAddress(1), testPubkey(1), testPubkey(2),
+				),
+			},
+		},
+	))
+
+	// The source validator exits and the consolidation waits for it to
+	// become withdrawable.
+	requireValidatorEpochs(t, st, 1, 0, 4)
+	pending, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Equal(t, map[math.ValidatorIndex]math.ValidatorIndex{1: 2}, pending)
+
+	// The pending consolidation is committed to in the beacon state.
+	bsm, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, []*types.PendingConsolidation{
+		types.NewPendingConsolidation(1, 2),
+	}, bsm.PendingConsolidations)
+	require.NoError(t, st.RemovePendingConsolidation(1))
+	require.NotEqual(t, bsm.HashTreeRoot(), st.HashTreeRoot())
+	require.NoError(t, st.AddPendingConsolidation(1, 2))
+
+	processEpochs(t, sp, st, 5)
+	balance, err := st.GetBalance(1)
+	require.NoError(t, err)
+	require.Equal(t, testBalance, balance)
+
+	// The balance is moved in the epoch before the source becomes
+	// withdrawable, so that the withdrawal sweep never sees it.
+	processEpochs(t, sp, st, 1)
+	balance, err = st.GetBalance(1)
+	require.NoError(t, err)
+	require.Zero(t, balance)
+	balance, err = st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(t, 2*testBalance, balance)
+	pending, err = st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
+
+func TestTransitionConsolidationRequestSlashedSource(t *testing.T) {
+	sp, st := setupElectra(t)
+	require.NoError(t, transitionWithRequests(t, sp, st,
+		&types.ExecutionRequests{
+			Consolidations: []*types.ConsolidationRequest{
+				types.NewConsolidationRequest(
+					testExecutionAddress(1), testPubkey(1), testPubkey(2),
+				),
+			},
+		},
+	))
+
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	val.SetSlashed(true)
+	require.NoError(t, st.UpdateValidatorAtIndex(1, val))
+
+	// The consolidation of a slashed validator is dropped.
+	processEpochs(t, sp, st, 1)
+	pending, err := st.GetPendingConsolidations()
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	balance, err := st.GetBalance(2)
+	require.NoError(t, err)
+	require.Equal(t, testBalance, balance)
+}
+
+func TestTransitionConsolidationRequestIgnored(t *testing.T) {
+	testCases := []struct {
+		name     string
+		requests *types.ExecutionRequests
+	}{
+		{
+			name: "same validator",
+			requests: &types.ExecutionRequests{
+				Consolidations: []*types.ConsolidationRequest{
+					types.NewConsolidationRequest(
+						testExecutionAddress(1), testPubkey(1), testPubkey(1),
+					),
+				},
+			},
+		},
+		{
+			name: "not the withdrawal address",
+			requests: &types.ExecutionRequests{
+				Consolidations: []*types.ConsolidationRequest{
+					types.NewConsolidationRequest(
+						testExecutionAddress(2), testPubkey(1), testPubkey(2),
+					),
+				},
+			},
+		},
+		{
+			name: "unknown target",
+			requests: &types.ExecutionRequests{
+				Consolidations: []*types.ConsolidationRequest{
+					types.NewConsolidationRequest(
+						testExecutionAddress(1), testPubkey(1),
+						crypto.BLSPubkey{0xff},
+					),
+				},
+			},
+		},
+		{
+			name: "exiting target",
+			requests: &types.ExecutionRequests{
+				Withdrawals: []*types.WithdrawalRequest{
+					types.NewWithdrawalRequest(
+						testExecutionAddress(2), testPubkey(2), 0,
+					),
+				},
+				Consolidations: []*types.ConsolidationRequest{
+					types.NewConsolidationRequest(
+						testExecutionAddress(1), testPubkey(1), testPubkey(2),
+					),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sp, st := setupElectra(t)
+			require.NoError(t, transitionWithRequests(t, sp, st, tc.requests))
+			requireValidatorEpochs(t, st, 1, 0, farFuture)
+			pending, err := st.GetPendingConsolidations()
+			require.NoError(t, err)
+			require.Empty(t, pending)
+		})
+	}
+}
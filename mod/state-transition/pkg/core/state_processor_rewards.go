@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"slices"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// processAttestations records the participation of the validators whose vote
+// for the parent block is included in the block.
+func (sp *StateProcessor[
+	AttestationDataT, _, BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processAttestations(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	// The votes of the consensus engine are only included in block bodies
+	// from DenebPlus onwards.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.DenebPlus {
+		return nil
+	}
+
+	attestations := blk.GetBody().GetAttestations()
+	if uint64(len(attestations)) > constants.MaxAttestationsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockAttestationLimit, "expected: %d, got: %d",
+			constants.MaxAttestationsPerBlock, len(attestations),
+		)
+	}
+	if !ctx.GetSkipValidateVotes() {
+		if err := sp.validateAttestations(
+			st, attestations, ctx.GetVotes(),
+		); err != nil {
+			return err
+		}
+	}
+
+	// The votes were cast for the parent block, whose root was recorded when
+	// the slot of the block was processed.
+	parentRoot, err := st.GetBlockRootAtIndex(
+		(blk.GetSlot().Unwrap() - 1) % sp.cs.SlotsPerHistoricalRoot(),
+	)
+	if err != nil {
+		return err
+	}
+
+	var participation uint64
+	for i, attestation := range attestations {
+		if attestation.GetSlot() != blk.GetSlot() ||
+			attestation.GetBeaconBlockRoot() != parentRoot {
+			return errors.Wrapf(
+				ErrInvalidAttestation,
+				"entry %d: expected slot %d and root %s, got %d and %s",
+				i, blk.GetSlot(), parentRoot,
+				attestation.GetSlot(), attestation.GetBeaconBlockRoot(),
+			)
+		}
+		if i > 0 && attestation.GetIndex() <= attestations[i-1].GetIndex() {
+			return errors.Wrapf(
+				ErrInvalidAttestation,
+				"entry %d: indices are not strictly increasing", i,
+			)
+		}
+
+		idx := math.ValidatorIndex(attestation.GetIndex())
+		participation, err = st.GetEpochParticipation(idx)
+		if err != nil {
+			return err
+		}
+		if err = st.SetEpochParticipation(idx, participation+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateAttestations ensures the attestations included by the proposer
+// match, entry by entry, the votes the consensus engine committed in the
+// block.
+func (sp *StateProcessor[
+	AttestationDataT, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
+]) validateAttestations(
+	st BeaconStateT,
+	attestations []AttestationDataT,
+	votes []transition.Vote,
+) error {
+	if len(attestations) != len(votes) {
+		return errors.Wrapf(
+			ErrAttestationsMismatch, "expected %d entries, got %d",
+			len(votes), len(attestations),
+		)
+	}
+
+	indices := make([]math.ValidatorIndex, len(votes))
+	for i, vote := range votes {
+		idx, err := st.ValidatorIndexByCometBFTAddress(vote.Address)
+		if err != nil {
+			return err
+		}
+		indices[i] = idx
+	}
+	slices.Sort(indices)
+
+	for i, idx := range indices {
+		if attestations[i].GetIndex() != math.U64(idx) {
+			return errors.Wrapf(
+				ErrAttestationsMismatch,
+				"entry %d: expected index %d, got %d",
+				i, idx, attestations[i].GetIndex(),
+			)
+		}
+	}
+	return nil
+}
+
+// AttestationDeltas returns the balance changes of every validator for its
+// participation in the votes of the current epoch. The state must be at the
+// last slot of the epoch, before the epoch is processed.
+//
+// A validator earns a base reward, proportional to its effective balance and
+// inversely proportional to the square root of the total active balance, if
+// its vote is included in every block of the epoch. The share of the base
+// reward of the blocks that miss its vote is deducted instead. A validator
+// that does not vote for longer than MinEpochsToInactivityPenalty epochs in
+// a row is also charged an inactivity penalty, which grows with the number
+// of epochs it has been inactive for.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) AttestationDeltas(
+	st BeaconStateT,
+) ([]transition.AttestationDelta, error) {
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	deltas := make([]transition.AttestationDelta, len(vals))
+	for i := range vals {
+		deltas[i].Index = math.ValidatorIndex(i)
+	}
+
+	// The participation is only tracked from DenebPlus onwards.
+	if !sp.tracksParticipation(epoch) {
+		return deltas, nil
+	}
+
+	totalBalance, err := st.GetTotalActiveBalances(sp.cs.SlotsPerEpoch())
+	if err != nil {
+		return nil, err
+	}
+	sqrtTotalBalance := integerSquareRoot(
+		max(totalBalance.Unwrap(), sp.cs.EffectiveBalanceIncrement()),
+	)
+
+	var participation, score uint64
+	slotsPerEpoch := sp.cs.SlotsPerEpoch()
+	for i, val := range vals {
+		if !isEligibleForRewards(val, epoch) {
+			continue
+		}
+
+		idx := math.ValidatorIndex(i)
+		participation, err = sp.validatorParticipation(st, idx, val)
+		if err != nil {
+			return nil, err
+		}
+		score, err = sp.nextInactivityScore(st, idx, participation)
+		if err != nil {
+			return nil, err
+		}
+
+		effectiveBalance := val.GetEffectiveBalance().Unwrap()
+		baseReward := effectiveBalance * sp.cs.BaseRewardFactor() /
+			sqrtTotalBalance
+		deltas[i].IdealReward = math.Gwei(baseReward)
+		if !val.IsSlashed() {
+			deltas[i].Reward = math.Gwei(
+				baseReward * participation / slotsPerEpoch,
+			)
+		}
+		deltas[i].Penalty = math.Gwei(
+			baseReward * (slotsPerEpoch - participation) / slotsPerEpoch,
+		)
+		if score > sp.cs.MinEpochsToInactivityPenalty() {
+			deltas[i].InactivityPenalty = math.Gwei(
+				effectiveBalance * score / sp.cs.InactivityPenaltyQuotient(),
+			)
+		}
+	}
+	return deltas, nil
+}
+
+// getAttestationDeltas as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_attestation_deltas
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) getAttestationDeltas(
+	st BeaconStateT,
+) ([]math.Gwei, []math.Gwei, error) {
+	deltas, err := sp.AttestationDeltas(st)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewards := make([]math.Gwei, len(deltas))
+	penalties := make([]math.Gwei, len(deltas))
+	for i, delta := range deltas {
+		rewards[i] = delta.Reward
+		penalties[i] = delta.Penalty + delta.InactivityPenalty
+	}
+	return rewards, penalties, nil
+}
+
+// processParticipationUpdates updates the inactivity scores of the validators
+// with their participation in the epoch, and resets the participation for
+// the next epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorsT, _, _, _, _, _,
+]) processParticipationUpdates(
+	st BeaconStateT,
+) error {
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	if sp.tracksParticipation(epoch) {
+		var vals ValidatorsT
+		if vals, err = st.GetValidators(); err != nil {
+			return err
+		}
+
+		var participation uint64
+		for i, val := range vals {
+			idx := math.ValidatorIndex(i)
+			var score uint64
+			if isEligibleForRewards(val, epoch) {
+				participation, err = sp.validatorParticipation(st, idx, val)
+				if err != nil {
+					return err
+				}
+				if score, err = sp.nextInactivityScore(
+					st, idx, participation,
+				); err != nil {
+					return err
+				}
+			}
+			if err = st.SetInactivityScore(idx, score); err != nil {
+				return err
+			}
+		}
+	}
+	return st.ResetEpochParticipation()
+}
+
+// tracksParticipation returns whether the participation of the validators
+// is tracked during the given epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) tracksParticipation(epoch math.Epoch) bool {
+	return epoch != math.Epoch(constants.GenesisEpoch) &&
+		sp.cs.ActiveForkVersionForEpoch(epoch) >= version.DenebPlus
+}
+
+// validatorParticipation returns the number of blocks of the current epoch
+// that included the vote of the validator. The votes of slashed validators
+// are not counted.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, _, _, _, _,
+]) validatorParticipation(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	val ValidatorT,
+) (uint64, error) {
+	if val.IsSlashed() {
+		return 0, nil
+	}
+	participation, err := st.GetEpochParticipation(idx)
+	if err != nil {
+		return 0, err
+	}
+	return min(participation, sp.cs.SlotsPerEpoch()), nil
+}
+
+// nextInactivityScore returns the inactivity score of the validator at the
+// end of the current epoch, given its participation in the epoch. The score
+// counts the consecutive epochs in which none of the votes of the validator
+// were included.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) nextInactivityScore(
+	st BeaconStateT,
+	idx math.ValidatorIndex,
+	participation uint64,
+) (uint64, error) {
+	if participation > 0 {
+		return 0, nil
+	}
+	score, err := st.GetInactivityScore(idx)
+	if err != nil {
+		return 0, err
+	}
+	return score + 1, nil
+}
+
+// isEligibleForRewards returns whether the validator is rewarded or
+// penalized for its participation in the given epoch, which is the case of
+// the active validators and of the slashed validators that cannot withdraw
+// yet.
+func isEligibleForRewards[ValidatorT interface {
+	IsActive(math.Epoch) bool
+	IsSlashed() bool
+	GetWithdrawableEpoch() math.Epoch
+}](val ValidatorT, epoch math.Epoch) bool {
+	return val.IsActive(epoch) ||
+		(val.IsSlashed() && epoch+1 < val.GetWithdrawableEpoch())
+}
+
+// integerSquareRoot as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#integer_squareroot
+//
+//nolint:lll
+func integerSquareRoot(n uint64) uint64 {
+	x := n
+	y := (x + 1) / 2
+	for y < x {
+		x = y
+		y = (x + n/x) / 2
+	}
+	return x
+}
@@ -0,0 +1,304 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	"github.com/stretchr/testify/require"
+)
+
+// testBaseReward is the base reward of a genesis validator, with a total
+// active balance of testNumValidators * testBalance.
+const testBaseReward = math.Gwei(5724348)
+
+// setupRewards returns a state processor and a beacon state at slot 1, with
+// rewards and penalties enabled and DenebPlus active from the given epoch.
+func setupRewards(
+	t *testing.T, denebPlusForkEpoch math.Epoch,
+) (*testStateProcessor, *testBeaconState) {
+	t.Helper()
+	data := newTestSpecData()
+	data.DenebPlusForkEpoch = denebPlusForkEpoch
+	data.BaseRewardFactor = 64
+	data.MinEpochsToInactivityPenalty = 4
+	deposits := make([]*types.Deposit, testNumValidators)
+	for i := range deposits {
+		deposits[i] = testDeposit(i, testBalance, uint64(i))
+	}
+	sp, st, _ := setupGenesis(t, chain.NewChainSpec(data), deposits)
+
+	_, err := sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+	return sp, st
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state,
+// as seen by the proposer of the next block before processing its slot.
+func latestBlockRoot(t *testing.T, st *testBeaconState) common.Root {
+	t.Helper()
+	header, err := st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	if (header.GetStateRoot() == common.Root{}) {
+		header.SetStateRoot(st.HashTreeRoot())
+	}
+	return header.HashTreeRoot()
+}
+
+// transitionWithVotes processes a block for the slot following the state,
+// carrying the votes of the given validators for the latest block.
+func transitionWithVotes(
+	t *testing.T,
+	sp *testStateProcessor,
+	st *testBeaconState,
+	voters ...int,
+) {
+	t.Helper()
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	root := latestBlockRoot(t, st)
+	_, err = sp.ProcessSlots(st, slot+1)
+	require.NoError(t, err)
+
+	attestations := make([]*types.AttestationData, len(voters))
+	votes := make([]transition.Vote, len(voters))
+	for i, voter := range voters {
+		attestations[i] = (&types.AttestationData{}).New(
+			slot+1, math.U64(voter), root,
+		)
+		votes[i] = transition.Vote{Address: testAddress(voter)}
+	}
+	blk := newTestBlock(t, st)
+	blk.Body.SetAttestations(attestations)
+
+	ctx := transitionContext()
+	ctx.Votes = votes
+	_, err = sp.Transition(ctx, st, blk)
+	require.NoError(t, err)
+}
+
+func requireBalance(
+	t *testing.T,
+	st *testBeaconState,
+	index math.ValidatorIndex,
+	expected math.Gwei,
+) {
+	t.Helper()
+	balance, err := st.GetBalance(index)
+	require.NoError(t, err)
+	require.Equal(t, expected, balance)
+}
+
+func TestAttestationDeltas(t *testing.T) {
+	sp, st := setupRewards(t, 0)
+
+	// No participation is tracked during the genesis epoch.
+	processEpochs(t, sp, st, 1)
+	requireBalance(t, st, 0, testBalance)
+
+	// Validator 3 misses every vote, and the others the vote for the block
+	// at the start of the epoch.
+	for range 31 {
+		transitionWithVotes(t, sp, st, 0, 1, 2)
+	}
+
+	deltas, err := sp.AttestationDeltas(st)
+	require.NoError(t, err)
+	require.Len(t, deltas, testNumValidators)
+	for i := range 3 {
+		require.Equal(t, transition.AttestationDelta{
+			Index:       math.ValidatorIndex(i),
+			Reward:      testBaseReward * 31 / 32,
+			Penalty:     testBaseReward / 32,
+			IdealReward: testBaseReward,
+		}, deltas[i])
+	}
+	require.Equal(t, transition.AttestationDelta{
+		Index:       3,
+		Penalty:     testBaseReward,
+		IdealReward: testBaseReward,
+	}, deltas[3])
+
+	// The deltas are applied at the epoch boundary, and the participation
+	// starts over for the next epoch.
+	_, err = sp.ProcessSlots(st, 2*32)
+	require.NoError(t, err)
+	requireBalance(
+		t, st, 0, testBalance+testBaseReward*31/32-testBaseReward/32,
+	)
+	requireBalance(t, st, 3, testBalance-testBaseReward)
+	transitionWithVotes(t, sp, st, 0)
+	participation, err := st.GetEpochParticipation(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), participation)
+	participation, err = st.GetEpochParticipation(1)
+	require.NoError(t, err)
+	require.Zero(t, participation)
+
+	// Only the validator that missed every vote is marked as inactive.
+	score, err := st.GetInactivityScore(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), score)
+	score, err = st.GetInactivityScore(0)
+	require.NoError(t, err)
+	require.Zero(t, score)
+
+	// Both are committed to in the beacon state.
+	bsm, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 0, 0, 0}, bsm.EpochParticipation)
+	require.Equal(t, []uint64{0, 0, 0, 1}, bsm.InactivityScores)
+	require.NoError(t, st.SetInactivityScore(3, 2))
+	require.NotEqual(t, bsm.HashTreeRoot(), st.HashTreeRoot())
+}
+
+func TestAttestationDeltasInactivityPenalty(t *testing.T) {
+	sp, st := setupRewards(t, 0)
+
+	// The inactivity penalty starts once a validator has missed every vote
+	// for MinEpochsToInactivityPenalty epochs in a row.
+	processEpochs(t, sp, st, 5)
+	requireBalance(t, st, 0, testBalance-4*testBaseReward)
+	score, err := st.GetInactivityScore(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), score)
+
+	_, err = sp.ProcessSlots(st, 6*32-1)
+	require.NoError(t, err)
+	deltas, err := sp.AttestationDeltas(st)
+	require.NoError(t, err)
+	require.Equal(t, transition.AttestationDelta{
+		Penalty:           testBaseReward,
+		InactivityPenalty: math.Gwei(uint64(testBalance) * 5 / (1 << 24)),
+		IdealReward:       testBaseReward,
+	}, deltas[0])
+
+	// A single included vote clears the inactivity score.
+	transitionWithVotes(t, sp, st, 0)
+	score, err = st.GetInactivityScore(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), score)
+	_, err = sp.ProcessSlots(st, 7*32)
+	require.NoError(t, err)
+	score, err = st.GetInactivityScore(0)
+	require.NoError(t, err)
+	require.Zero(t, score)
+}
+
+func TestAttestationDeltasBeforeDenebPlus(t *testing.T) {
+	sp, st := setupRewards(t, 4)
+
+	processEpochs(t, sp, st, 1)
+	_, err := sp.ProcessSlots(st, 2*32-1)
+	require.NoError(t, err)
+	deltas, err := sp.AttestationDeltas(st)
+	require.NoError(t, err)
+	for i, delta := range deltas {
+		require.Equal(t, transition.AttestationDelta{
+			Index: math.ValidatorIndex(i),
+		}, delta)
+	}
+
+	processEpochs(t, sp, st, 1)
+	requireBalance(t, st, 0, testBalance)
+
+	// The participation is only part of the state from DenebPlus onwards.
+	bsm, err := st.GetMarshallable()
+	require.NoError(t, err)
+	require.Nil(t, bsm.EpochParticipation)
+	processEpochs(t, sp, st, 2)
+	bsm, err = st.GetMarshallable()
+	require.NoError(t, err)
+	require.Equal(t, make([]uint64, testNumValidators), bsm.EpochParticipation)
+}
+
+func TestTransitionAttestationsMismatch(t *testing.T) {
+	testCases := []struct {
+		name         string
+		attestations func(root common.Root) []*types.AttestationData
+		err          error
+	}{
+		{
+			name: "missing vote",
+			attestations: func(root common.Root) []*types.AttestationData {
+				return []*types.AttestationData{
+					(&types.AttestationData{}).New(2, 0, root),
+				}
+			},
+			err: core.ErrAttestationsMismatch,
+		},
+		{
+			name: "wrong validator",
+			attestations: func(root common.Root) []*types.AttestationData {
+				return []*types.AttestationData{
+					(&types.AttestationData{}).New(2, 0, root),
+					(&types.AttestationData{}).New(2, 2, root),
+				}
+			},
+			err: core.ErrAttestationsMismatch,
+		},
+		{
+			name: "wrong root",
+			attestations: func(common.Root) []*types.AttestationData {
+				return []*types.AttestationData{
+					(&types.AttestationData{}).New(2, 0, common.Root{1}),
+					(&types.AttestationData{}).New(2, 1, common.Root{1}),
+				}
+			},
+			err: core.ErrInvalidAttestation,
+		},
+		{
+			name: "wrong slot",
+			attestations: func(root common.Root) []*types.AttestationData {
+				return []*types.AttestationData{
+					(&types.AttestationData{}).New(3, 0, root),
+					(&types.AttestationData{}).New(3, 1, root),
+				}
+			},
+			err: core.ErrInvalidAttestation,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sp, st := setupRewards(t, 0)
+			root := latestBlockRoot(t, st)
+			_, err := sp.ProcessSlots(st, 2)
+			require.NoError(t, err)
+
+			// Validators 0 and 1 voted for the parent block.
+			blk := newTestBlock(t, st)
+			blk.Body.SetAttestations(tc.attestations(root))
+			ctx := transitionContext()
+			ctx.Votes = []transition.Vote{
+				{Address: testAddress(1)},
+				{Address: testAddress(0)},
+			}
+
+			_, err = sp.Transition(ctx, st, blk)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -21,7 +21,12 @@
 package core
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // processSlashingsReset as defined in the Ethereum 2.0 specification.
@@ -29,7 +34,8 @@ import (
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processSlashingsReset(
 	st BeaconStateT,
 ) error {
@@ -43,41 +49,371 @@ func (sp *StateProcessor[
 	return st.UpdateSlashingAtIndex(index, 0)
 }
 
+// processSlashingOperations processes the proposer slashings, attester
+// slashings and consensus misbehavior evidence included in the block.
+func (sp *StateProcessor[
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _,
+]) processSlashingOperations(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) error {
+	body := blk.GetBody()
+	proposerSlashings := body.GetProposerSlashings()
+	if uint64(len(proposerSlashings)) >
+		constants.MaxProposerSlashingsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockProposerSlashingLimit, "expected: %d, got: %d",
+			constants.MaxProposerSlashingsPerBlock, len(proposerSlashings),
+		)
+	}
+	for _, ps := range proposerSlashings {
+		if err := sp.processProposerSlashing(st, blk, ps); err != nil {
+			return err
+		}
+	}
+
+	attesterSlashings := body.GetAttesterSlashings()
+	if uint64(len(attesterSlashings)) >
+		constants.MaxAttesterSlashingsPerBlock {
+		return errors.Wrapf(
+			ErrExceedsBlockAttesterSlashingLimit, "expected: %d, got: %d",
+			constants.MaxAttesterSlashingsPerBlock, len(attesterSlashings),
+		)
+	}
+	for _, as := range attesterSlashings {
+		if err := sp.processAttesterSlashing(st, blk, as); err != nil {
+			return err
+		}
+	}
+
+	// The misbehavior evidence reported by the consensus engine is only
+	// included in block bodies from DenebPlus onwards.
+	if sp.cs.ActiveForkVersionForSlot(blk.GetSlot()) < version.DenebPlus {
+		return nil
+	}
+	return sp.processSlashingInfo(ctx, st, blk, body.GetSlashingInfo())
+}
+
 // processProposerSlashing as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#proposer-slashings
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
+	ProposerSlashingT, _, _, _, _, _, _, _, _,
 ]) processProposerSlashing(
-	_ BeaconStateT,
-	// ps ProposerSlashing,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	ps ProposerSlashingT,
 ) error {
-	return nil
+	// Verify the headers are conflicting proposals of the same proposer.
+	if !ps.IsSlashable() {
+		return ErrProposerSlashingNotSlashable
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	proposer, err := st.ValidatorByIndex(ps.GetProposerIndex())
+	if err != nil {
+		return err
+	}
+	if !proposer.IsSlashable(epoch) {
+		return errors.Wrapf(
+			ErrValidatorNotSlashable, "index: %d", ps.GetProposerIndex(),
+		)
+	}
+
+	// Verify the signatures of both headers.
+	fd, err := sp.forkDataAtSlot(st, ps.GetSlot())
+	if err != nil {
+		return err
+	}
+	if err = ps.VerifySignatures(
+		fd,
+		sp.cs.DomainTypeProposer(),
+		proposer.GetPubkey(),
+		sp.signer.VerifySignature,
+	); err != nil {
+		return err
+	}
+
+	return sp.slashValidator(
+		st, ps.GetProposerIndex(), blk.GetProposerIndex(),
+	)
 }
 
 // processAttesterSlashing as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#attester-slashings
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, AttesterSlashingT, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _, _, _, _,
 ]) processAttesterSlashing(
-	_ BeaconStateT,
-	// as AttesterSlashing,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	as AttesterSlashingT,
+) error {
+	// Verify the attestations are conflicting votes of the same attester.
+	if !as.IsSlashable() {
+		return ErrAttesterSlashingNotSlashable
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	attester, err := st.ValidatorByIndex(as.GetValidatorIndex())
+	if err != nil {
+		return err
+	}
+	if !attester.IsSlashable(epoch) {
+		return errors.Wrapf(
+			ErrValidatorNotSlashable, "index: %d", as.GetValidatorIndex(),
+		)
+	}
+
+	// Verify the signatures of both attestations.
+	fd, err := sp.forkDataAtSlot(st, as.GetSlot())
+	if err != nil {
+		return err
+	}
+	if err = as.VerifySignatures(
+		fd,
+		sp.cs.DomainTypeAttester(),
+		attester.GetPubkey(),
+		sp.signer.VerifySignature,
+	); err != nil {
+		return err
+	}
+
+	return sp.slashValidator(
+		st, as.GetValidatorIndex(), blk.GetProposerIndex(),
+	)
+}
+
+// processSlashingInfo slashes the validators reported as misbehaving by the
+// consensus engine. The evidence has already been verified by the consensus
+// engine, so validators that are no longer slashable are skipped rather than
+// failing the block.
+func (sp *StateProcessor[
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, ContextT, _, _, _, _, _, _, _, _,
+	_, SlashingInfoT, _, _, _, _, _, _, _,
+]) processSlashingInfo(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+	slashingInfo []SlashingInfoT,
 ) error {
+	if !ctx.GetSkipValidateMisbehaviors() {
+		if err := sp.validateSlashingInfo(
+			st, slashingInfo, ctx.GetMisbehaviors(),
+		); err != nil {
+			return err
+		}
+	}
+
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range slashingInfo {
+		idx := math.ValidatorIndex(info.GetIndex())
+		val, err := st.ValidatorByIndex(idx)
+		if err != nil {
+			return err
+		}
+		if !val.IsSlashable(epoch) {
+			continue
+		}
+		if err = sp.slashValidator(
+			st, idx, blk.GetProposerIndex(),
+		); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// validateSlashingInfo ensures the slashing info included by the proposer
+// matches, entry by entry, the misbehavior evidence the consensus engine
+// committed in the block.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, SlashingInfoT,
+	_, _, _, _, _, _, _,
+]) validateSlashingInfo(
+	st BeaconStateT,
+	slashingInfo []SlashingInfoT,
+	misbehaviors []transition.Misbehavior,
+) error {
+	if len(slashingInfo) != len(misbehaviors) {
+		return errors.Wrapf(
+			ErrSlashingInfoMismatch, "expected %d entries, got %d",
+			len(misbehaviors), len(slashingInfo),
+		)
+	}
+
+	for i, misbehavior := range misbehaviors {
+		idx, err := st.ValidatorIndexByCometBFTAddress(misbehavior.Address)
+		if err != nil {
+			return err
+		}
+		if slashingInfo[i].GetIndex() != math.U64(idx) ||
+			slashingInfo[i].GetSlot() != misbehavior.Height {
+			return errors.Wrapf(
+				ErrSlashingInfoMismatch,
+				"entry %d: expected index %d at slot %d, got %d at slot %d",
+				i, idx, misbehavior.Height,
+				slashingInfo[i].GetIndex(), slashingInfo[i].GetSlot(),
+			)
+		}
+	}
+	return nil
+}
+
+// slashValidator as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slash_validator
+//
+// NOTE: The whistleblower is always the block proposer.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) slashValidator(
+	st BeaconStateT,
+	slashedIndex math.ValidatorIndex,
+	proposerIndex math.ValidatorIndex,
+) error {
+	epoch, err := sp.currentEpoch(st)
+	if err != nil {
+		return err
+	}
+
+	if err = sp.initiateValidatorExit(st, slashedIndex); err != nil {
+		return err
+	}
+
+	val, err := st.ValidatorByIndex(slashedIndex)
+	if err != nil {
+		return err
+	}
+
+	val.SetSlashed(true)
+	val.SetWithdrawableEpoch(max(
+		val.GetWithdrawableEpoch(),
+		epoch+math.Epoch(sp.cs.EpochsPerSlashingsVector()),
+	))
+	if err = st.UpdateValidatorAtIndex(slashedIndex, val); err != nil {
+		return err
+	}
+
+	// Record the slashed balance in the slashings vector.
+	effectiveBalance := val.GetEffectiveBalance()
+	index := epoch.Unwrap() % sp.cs.EpochsPerSlashingsVector()
+	slashing, err := st.GetSlashingAtIndex(index)
+	if err != nil {
+		return err
+	}
+	if err = st.UpdateSlashingAtIndex(
+		index, slashing+effectiveBalance,
+	); err != nil {
+		return err
+	}
+
+	// Apply the initial slashing penalty.
+	if err = st.DecreaseBalance(
+		slashedIndex,
+		effectiveBalance/math.Gwei(sp.cs.MinSlashingPenaltyQuotient()),
+	); err != nil {
+		return err
+	}
+
+	// Reward the proposer, who is also the whistleblower.
+	whistleblowerReward := effectiveBalance /
+		math.Gwei(sp.cs.WhistleblowerRewardQuotient())
+	proposerReward := whistleblowerReward /
+		math.Gwei(sp.cs.ProposerRewardQuotient())
+	if err = st.IncreaseBalance(proposerIndex, proposerReward); err != nil {
+		return err
+	}
+	return st.IncreaseBalance(
+		proposerIndex, whistleblowerReward-proposerReward,
+	)
+}
+
+// currentEpoch returns the epoch of the current state slot.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) currentEpoch(
+	st BeaconStateT,
+) (math.Epoch, error) {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return 0, err
+	}
+	return sp.cs.SlotToEpoch(slot), nil
+}
+
+// forkDataAtSlot returns the fork data of the fork active at the given slot.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ForkDataT, _, _, _, _,
+	_, _, _, _, _, _,
+]) forkDataAtSlot(
+	st BeaconStateT,
+	slot math.Slot,
+) (ForkDataT, error) {
+	var fd ForkDataT
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return fd, err
+	}
+	return fd.New(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForSlot(slot),
+		), genesisValidatorsRoot,
+	), nil
+}
+
+// forkDataAtEpoch returns the fork data of the fork active at the given
+// epoch.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ForkDataT, _, _, _, _,
+	_, _, _, _, _, _,
+]) forkDataAtEpoch(
+	st BeaconStateT,
+	epoch math.Epoch,
+) (ForkDataT, error) {
+	var fd ForkDataT
+	genesisValidatorsRoot, err := st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return fd, err
+	}
+	return fd.New(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	), nil
+}
+
 // processSlashings as defined in the Ethereum 2.0 specification.
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#slashings
 //
 // processSlashings processes the slashings and ensures they match the local
 // state.
 //
-//nolint:lll,unused // will be used later
+//nolint:lll
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
 ]) processSlashings(
 	st BeaconStateT,
 ) error {
@@ -108,7 +444,7 @@ func (sp *StateProcessor[
 	}
 
 	//nolint:mnd // this is in the spec
-	slashableEpoch := (sp.cs.SlotToEpoch(slot).Unwrap() + sp.cs.EpochsPerSlashingsVector()) / 2
+	slashableEpoch := sp.cs.SlotToEpoch(slot).Unwrap() + sp.cs.EpochsPerSlashingsVector()/2
 
 	// Iterate through the validators and slash if needed.
 	for _, val := range vals {
@@ -127,10 +463,9 @@ func (sp *StateProcessor[
 }
 
 // processSlash handles the logic for slashing a validator.
-//
-//nolint:unused // will be used later
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
 ]) processSlash(
 	st BeaconStateT,
 	val ValidatorT,
@@ -0,0 +1,473 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package core_test
+
+import (
+	"context"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/mocks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
+	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testKVStore = beacondb.KVStore[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	]
+
+	testBeaconState = statedb.StateDB[
+		*types.BeaconBlockHeader,
+		*types.BeaconState[
+			*types.BeaconBlockHeader,
+			*types.Eth1Data,
+			*types.ExecutionPayloadHeader,
+			*types.Fork,
+			*types.Validator,
+			types.BeaconBlockHeader,
+			types.Eth1Data,
+			types.ExecutionPayloadHeader,
+			types.Fork,
+			types.Validator,
+		],
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*testKVStore,
+		*types.Validator,
+		types.Validators,
+		*engineprimitives.Withdrawal,
+		types.WithdrawalCredentials,
+	]
+
+	testStateProcessor = core.StateProcessor[
+		*types.AttestationData,
+		*types.AttesterSlashing,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*testBeaconState,
+		*types.ConsolidationRequest,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.ExecutionRequests,
+		*types.Fork,
+		*types.ForkData,
+		*testKVStore,
+		*types.ProposerSlashing,
+		*types.SlashingInfo,
+		*types.Validator,
+		types.Validators,
+		*types.SignedVoluntaryExit,
+		*engineprimitives.Withdrawal,
+		*types.WithdrawalRequest,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	]
+)
+
+const (
+	testNumValidators = 4
+	testBalance       = math.Gwei(32e9)
+)
+
+// kvStoreProvider opens the same in-memory store for every context.
+type kvStoreProvider struct {
+	corestore.KVStoreWithBatch
+}
+
+func (p *kvStoreProvider) OpenKVStore(context.Context) corestore.KVStore {
+	return p.KVStoreWithBatch
+}
+
+type testSpecData = chain.SpecData[
+	common.DomainType,
+	math.Epoch,
+	common.ExecutionAddress,
+	math.Slot,
+	any,
+]
+
+// testChainSpec returns a chain spec with Deneb+ active from genesis.
+func testChainSpec() common.ChainSpec {
+	return chain.NewChainSpec(newTestSpecData())
+}
+
+// newTestSpecData returns the data of the test chain spec.
+func newTestSpecData() testSpecData {
+	return testSpecData{
+		MaxEffectiveBalance:              uint64(testBalance),
+		EffectiveBalanceIncrement:        1e9,
+		HysteresisQuotient:               4,
+		HysteresisDownwardMultiplier:     1,
+		HysteresisUpwardMultiplier:       5,
+		SlotsPerEpoch:                    32,
+		EpochsPerEth1VotingPeriod:        2,
+		MaxSeedLookahead:                 1,
+		MinValidatorWithdrawabilityDelay: 4,
+		ShardCommitteePeriod:             2,
+		MinPerEpochChurnLimit:            4,
+		ChurnLimitQuotient:               1 << 16,
+		ValidatorSetCap:                  testNumValidators,
+		SlotsPerHistoricalRoot:           8,
+		DomainTypeProposer:               common.DomainType{0x00},
+		DomainTypeAttester:               common.DomainType{0x01},
+		DomainTypeDeposit:                common.DomainType{0x03},
+		DepositEth1ChainID:               1,
+		DenebPlusForkEpoch:               0,
+		ElectraForkEpoch:                 math.Epoch(constants.FarFutureEpoch),
+		EpochsPerHistoricalVector:        8,
+		EpochsPerSlashingsVector:         8,
+		HistoricalRootsLimit:             8,
+		MaxDepositsPerBlock:              16,
+		ProportionalSlashingMultiplier:   1,
+		MinSlashingPenaltyQuotient:       32,
+		WhistleblowerRewardQuotient:      512,
+		ProposerRewardQuotient:           8,
+		MaxWithdrawalsPerPayload:         16,
+		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
+		MaxBlobsPerBlock:                 6,
+
+		// Rewards and penalties are only enabled by the tests covering them.
+		BaseRewardFactor:             0,
+		InactivityPenaltyQuotient:    1 << 24,
+		MinEpochsToInactivityPenalty: constants.FarFutureEpoch,
+	}
+}
+
+func testPubkey(index int) crypto.BLSPubkey {
+	return crypto.BLSPubkey{byte(index + 1)}
+}
+
+// testAddress returns the consensus address of the validator at the given
+// index.
+func testAddress(index int) []byte {
+	pubkey := testPubkey(index)
+	return cmtcrypto.AddressHash(pubkey[:]).Bytes()
+}
+
+// setupGenesis returns a state processor and a beacon state initialized
+// from the given genesis deposits, along with the genesis validator updates.
+func setupGenesis(
+	t *testing.T, cs common.ChainSpec, deposits []*types.Deposit,
+) (*testStateProcessor, *testBeaconState, transition.ValidatorUpdates) {
+	t.Helper()
+
+	// Signatures are not under test, every signature is accepted.
+	signer := mocks.NewBLSSigner(t)
+	signer.EXPECT().VerifySignature(
+		mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil)
+
+	sp := core.NewStateProcessor[
+		*types.AttestationData,
+		*types.AttesterSlashing,
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		*types.BeaconBlockHeader,
+		*testBeaconState,
+		*types.ConsolidationRequest,
+		*transition.Context,
+		*types.Deposit,
+		*types.Eth1Data,
+		*types.ExecutionPayload,
+		*types.ExecutionPayloadHeader,
+		*types.ExecutionRequests,
+		*types.Fork,
+		*types.ForkData,
+		*testKVStore,
+		*types.ProposerSlashing,
+		*types.SlashingInfo,
+		*types.Validator,
+		types.Validators,
+		*types.SignedVoluntaryExit,
+		*engineprimitives.Withdrawal,
+		*types.WithdrawalRequest,
+		engineprimitives.Withdrawals,
+		types.WithdrawalCredentials,
+	](cs, nil, signer)
+
+	kvStore := beacondb.New[
+		*types.BeaconBlockHeader,
+		*types.Eth1Data,
+		*types.ExecutionPayloadHeader,
+		*types.Fork,
+		*types.Validator,
+		types.Validators,
+	](
+		&kvStoreProvider{storev2.NewMemDB()},
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	).WithContext(context.Background())
+	st := new(testBeaconState).NewFromDB(kvStore, cs)
+
+	header, err := types.DefaultGenesisExecutionPayloadHeaderDeneb()
+	require.NoError(t, err)
+	updates, err := sp.InitializePreminedBeaconStateFromEth1(
+		st, deposits, header,
+		version.FromUint32[common.Version](
+			cs.ActiveForkVersionForEpoch(0),
+		),
+	)
+	require.NoError(t, err)
+	return sp, st, updates
+}
+
+// testDeposit returns a deposit of the given amount for the validator at the
+// given index.
+func testDeposit(
+	index int, amount math.Gwei, depositIndex uint64,
+) *types.Deposit {
+	return types.NewDeposit(
+		testPubkey(index),
+		types.NewCredentialsFromExecutionAddress(
+			common.ExecutionAddress{byte(index + 1)},
+		),
+		amount,
+		crypto.BLSSignature{},
+		depositIndex,
+	)
+}
+
+// setupTransition returns a state processor and a beacon state at slot 1,
+// initialized from genesis deposits for testNumValidators validators.
+func setupTransition(
+	t *testing.T,
+) (*testStateProcessor, *testBeaconState) {
+	t.Helper()
+	deposits := make([]*types.Deposit, testNumValidators)
+	for i := range deposits {
+		deposits[i] = testDeposit(i, testBalance, uint64(i))
+	}
+	sp, st, _ := setupGenesis(t, testChainSpec(), deposits)
+
+	_, err := sp.ProcessSlots(st, 1)
+	require.NoError(t, err)
+	return sp, st
+}
+
+// newTestBlock returns a Deneb+ block for the slot of the state proposed by
+// validator 0 that builds on the state.
+func newTestBlock(t *testing.T, st *testBeaconState) *types.BeaconBlock {
+	t.Helper()
+	return newTestBlockWithVersion(t, st, version.DenebPlus)
+}
+
+// newTestBlockWithVersion returns a block of the given fork version for the
+// slot of the state proposed by validator 0 that builds on the state.
+func newTestBlockWithVersion(
+	t *testing.T, st *testBeaconState, forkVersion uint32,
+) *types.BeaconBlock {
+	t.Helper()
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	parent, err := st.GetLatestBlockHeader()
+	require.NoError(t, err)
+	eth1Data, err := st.GetEth1Data()
+	require.NoError(t, err)
+	lph, err := st.GetLatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	withdrawals, err := st.ExpectedWithdrawals()
+	require.NoError(t, err)
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		slot, 0, parent.HashTreeRoot(), forkVersion,
+	)
+	require.NoError(t, err)
+	blk.Body.Eth1Data = eth1Data
+	blk.Body.ExecutionPayload = &types.ExecutionPayload{
+		ParentHash:    lph.GetBlockHash(),
+		Number:        slot,
+		ExtraData:     make([]byte, types.ExtraDataSize),
+		BaseFeePerGas: math.NewU256(0),
+		Withdrawals:   withdrawals,
+	}
+	return blk
+}
+
+// conflictingHeaders returns two distinct signed headers proposed by the
+// given validator for the same slot.
+func conflictingHeaders(
+	proposer math.ValidatorIndex,
+) (*types.SignedBeaconBlockHeader, *types.SignedBeaconBlockHeader) {
+	return types.NewSignedBeaconBlockHeader(
+			types.NewBeaconBlockHeader(
+				1, proposer, common.Root{}, common.Root{}, common.Root{0x01},
+			),
+			crypto.BLSSignature{0x01},
+		), types.NewSignedBeaconBlockHeader(
+			types.NewBeaconBlockHeader(
+				1, proposer, common.Root{}, common.Root{}, common.Root{0x02},
+			),
+			crypto.BLSSignature{0x02},
+		)
+}
+
+func transitionContext(
+	misbehaviors ...transition.Misbehavior,
+) *transition.Context {
+	return &transition.Context{
+		Context:                 context.Background(),
+		SkipPayloadVerification: true,
+		SkipValidateRandao:      true,
+		SkipValidateResult:      true,
+		Misbehaviors:            misbehaviors,
+	}
+}
+
+func requireSlashed(
+	t *testing.T, st *testBeaconState, index math.ValidatorIndex,
+) {
+	t.Helper()
+	val, err := st.ValidatorByIndex(index)
+	require.NoError(t, err)
+	require.True(t, val.IsSlashed())
+
+	// The offender pays the initial penalty to the proposer.
+	balance, err := st.GetBalance(index)
+	require.NoError(t, err)
+	require.Equal(t, testBalance-testBalance/32, balance)
+	balance, err = st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, testBalance+testBalance/512, balance)
+}
+
+func TestTransitionProposerSlashing(t *testing.T) {
+	sp, st := setupTransition(t)
+
+	blk := newTestBlock(t, st)
+	blk.Body.SetProposerSlashings([]*types.ProposerSlashing{
+		types.NewProposerSlashing(conflictingHeaders(2)),
+	})
+
+	_, err := sp.Transition(transitionContext(), st, blk)
+	require.NoError(t, err)
+	requireSlashed(t, st, 2)
+
+	// The other validators are untouched.
+	val, err := st.ValidatorByIndex(1)
+	require.NoError(t, err)
+	require.False(t, val.IsSlashed())
+}
+
+func TestTransitionProposerSlashingNotSlashable(t *testing.T) {
+	sp, st := setupTransition(t)
+
+	// Two copies of the same header are not a double proposal.
+	header, _ := conflictingHeaders(2)
+	blk := newTestBlock(t, st)
+	blk.Body.SetProposerSlashings([]*types.ProposerSlashing{
+		types.NewProposerSlashing(header, header),
+	})
+
+	_, err := sp.Transition(transitionContext(), st, blk)
+	require.ErrorIs(t, err, core.ErrProposerSlashingNotSlashable)
+}
+
+func TestTransitionSlashingInfo(t *testing.T) {
+	sp, st := setupTransition(t)
+
+	// The double proposal is reported by the consensus engine.
+	blk := newTestBlock(t, st)
+	blk.Body.SetSlashingInfo([]*types.SlashingInfo{
+		(&types.SlashingInfo{}).New(1, 3),
+	})
+	misbehavior := transition.Misbehavior{
+		Address: testAddress(3),
+		Height:  1,
+	}
+
+	_, err := sp.Transition(transitionContext(misbehavior), st, blk)
+	require.NoError(t, err)
+	requireSlashed(t, st, 3)
+}
+
+func TestTransitionSlashingInfoMismatch(t *testing.T) {
+	misbehavior := transition.Misbehavior{
+		Address: testAddress(3),
+		Height:  1,
+	}
+	tests := []struct {
+		name         string
+		info         []*types.SlashingInfo
+		misbehaviors []transition.Misbehavior
+	}{
+		{
+			name: "without evidence",
+			info: []*types.SlashingInfo{
+				(&types.SlashingInfo{}).New(1, 3),
+			},
+		},
+		{
+			name:         "missing entry",
+			misbehaviors: []transition.Misbehavior{misbehavior},
+		},
+		{
+			name: "wrong validator",
+			info: []*types.SlashingInfo{
+				(&types.SlashingInfo{}).New(1, 2),
+			},
+			misbehaviors: []transition.Misbehavior{misbehavior},
+		},
+		{
+			name: "wrong height",
+			info: []*types.SlashingInfo{
+				(&types.SlashingInfo{}).New(2, 3),
+			},
+			misbehaviors: []transition.Misbehavior{misbehavior},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp, st := setupTransition(t)
+
+			blk := newTestBlock(t, st)
+			blk.Body.SetSlashingInfo(tt.info)
+
+			_, err := sp.Transition(
+				transitionContext(tt.misbehaviors...), st, blk,
+			)
+			require.ErrorIs(t, err, core.ErrSlashingInfoMismatch)
+		})
+	}
+}
@@ -23,15 +23,96 @@ package core
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/davecgh/go-spew/spew"
 )
 
+// processEth1Data processes the eth1 data of the block body, which carries
+// the deposit root and count that the deposits are verified against. Before
+// the Deneb+ fork the eth1 data of the block is adopted as is. From the
+// Deneb+ fork onwards it is a vote, and is only adopted once more than half
+// of the slots of the voting period have voted for it.
+func (sp *StateProcessor[
+	_, _, _, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _, _,
+]) processEth1Data(
+	st BeaconStateT,
+	body BeaconBlockBodyT,
+) error {
+	eth1Data := body.GetEth1Data()
+	index, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	// The deposit tree can only grow, so the block may never report fewer
+	// deposits than the state has already processed.
+	if eth1Data.GetDepositCount().Unwrap() < index {
+		return errors.Wrapf(
+			ErrDepositCountDecreased,
+			"expected at least %d, got %d",
+			index, eth1Data.GetDepositCount(),
+		)
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if sp.cs.ActiveForkVersionForSlot(slot) < version.DenebPlus {
+		return st.SetEth1Data(eth1Data)
+	}
+
+	if err = st.AddEth1DataVote(eth1Data); err != nil {
+		return err
+	}
+	votes, err := st.GetEth1DataVotes()
+	if err != nil {
+		return err
+	}
+	var (
+		root  = eth1Data.HashTreeRoot()
+		count uint64
+	)
+	for _, vote := range votes {
+		if vote.HashTreeRoot() == root {
+			count++
+		}
+	}
+	if count*2 > sp.cs.EpochsPerEth1VotingPeriod()*sp.cs.SlotsPerEpoch() {
+		return st.SetEth1Data(eth1Data)
+	}
+	return nil
+}
+
+// processEth1DataReset clears the eth1 data votes at the end of each voting
+// period.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _,
+]) processEth1DataReset(
+	st BeaconStateT,
+) error {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	epoch := sp.cs.SlotToEpoch(slot)
+	if (epoch.Unwrap()+1)%sp.cs.EpochsPerEth1VotingPeriod() != 0 {
+		return nil
+	}
+	return st.ResetEth1DataVotes()
+}
+
 // processOperations processes the operations and ensures they match the
 // local state.
 func (sp *StateProcessor[
-	BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _,
 ]) processOperations(
 	st BeaconStateT,
 	blk BeaconBlockT,
@@ -47,53 +128,103 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
-	depositCount := min(
-		sp.cs.MaxDepositsPerBlock(),
-		eth1Data.GetDepositCount().Unwrap()-index,
+	// Deposits from the first deposit request onwards are processed as
+	// execution layer requests instead.
+	startIndex, err := st.GetDepositRequestsStartIndex()
+	if err != nil {
+		return err
+	}
+	var (
+		depositLimit = min(eth1Data.GetDepositCount().Unwrap(), startIndex)
+		depositCount uint64
 	)
-	_ = depositCount
-	// TODO: Update eth1data count and check this.
-	// if uint64(len(deposits)) != depositCount {
-	// 	return errors.New("deposit count mismatch")
-	// }
-	return sp.processDeposits(st, deposits)
+	if index < depositLimit {
+		depositCount = min(sp.cs.MaxDepositsPerBlock(), depositLimit-index)
+	}
+	if uint64(len(deposits)) != depositCount {
+		return errors.Wrapf(
+			ErrDepositCountMismatch,
+			"expected %d, got %d", depositCount, len(deposits),
+		)
+	}
+	if err = sp.processDeposits(st, deposits); err != nil {
+		return err
+	}
+	if err = sp.processVoluntaryExits(st, blk); err != nil {
+		return err
+	}
+	return sp.processExecutionRequests(st, blk)
 }
 
 // processDeposits processes the deposits and ensures  they match the
 // local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
 ]) processDeposits(
 	st BeaconStateT,
 	deposits []DepositT,
 ) error {
+	eth1Data, err := st.GetEth1Data()
+	if err != nil {
+		return err
+	}
+
 	// Ensure the deposits match the local state.
 	for _, dep := range deposits {
-		if err := sp.processDeposit(st, dep); err != nil {
+		if err = sp.verifyDepositProof(
+			st, dep, eth1Data.GetDepositRoot(),
+		); err != nil {
+			return err
+		}
+		if err = sp.processDeposit(st, dep); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// verifyDepositProof verifies the merkle proof of the deposit against the
+// deposit root, at the next deposit index of the state.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
+]) verifyDepositProof(
+	st BeaconStateT,
+	dep DepositT,
+	depositRoot common.Root,
+) error {
+	depositIndex, err := st.GetEth1DepositIndex()
+	if err != nil {
+		return err
+	}
+
+	// The proof has an additional element for the mixed in length of the
+	// deposit tree.
+	if !merkle.IsValidMerkleBranch(
+		dep.GetDataRoot(),
+		dep.GetProof(),
+		constants.DepositContractDepth+1,
+		depositIndex,
+		depositRoot,
+	) {
+		return errors.Wrapf(
+			ErrInvalidDepositProof,
+			"deposit index %d, state deposit index %d",
+			dep.GetIndex(), depositIndex,
+		)
+	}
+	return nil
+}
+
 // processDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
 ]) processDeposit(
 	st BeaconStateT,
 	dep DepositT,
 ) error {
-	// TODO: fill this in properly
-	// if !sp.isValidMerkleBranch(
-	// 	leaf,
-	// 	dep.Credentials,
-	// 	32 + 1,
-	// 	dep.Index,
-	// 	st.root,
-	// ) {
-	// 	return errors.New("invalid merkle branch")
-	// }
-
 	depositIndex, err := st.GetEth1DepositIndex()
 	if err != nil {
 		return err
@@ -110,7 +241,8 @@ func (sp *StateProcessor[
 
 // applyDeposit processes the deposit and ensures it matches the local state.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
 ]) applyDeposit(
 	st BeaconStateT,
 	dep DepositT,
@@ -118,16 +250,9 @@ func (sp *StateProcessor[
 	idx, err := st.ValidatorIndexByPubkey(dep.GetPubkey())
 	// If the validator already exists, we update the balance.
 	if err == nil {
-		var val ValidatorT
-		val, err = st.ValidatorByIndex(idx)
-		if err != nil {
-			return err
-		}
-
-		// TODO: Modify balance here and then effective balance once per epoch.
-		val.SetEffectiveBalance(min(val.GetEffectiveBalance()+dep.GetAmount(),
-			math.Gwei(sp.cs.MaxEffectiveBalance())))
-		return st.UpdateValidatorAtIndex(idx, val)
+		// The effective balance follows the balance once per epoch, see
+		// processEffectiveBalanceUpdates.
+		return st.IncreaseBalance(idx, dep.GetAmount())
 	}
 
 	// If the validator does not exist, we add the validator.
@@ -137,10 +262,28 @@ func (sp *StateProcessor[
 
 // createValidator creates a validator if the deposit is valid.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, ForkDataT, _, _, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _,
 ]) createValidator(
 	st BeaconStateT,
 	dep DepositT,
+) error {
+	if err := sp.verifyDepositSignature(st, dep); err != nil {
+		return err
+	}
+
+	// Add the validator to the registry.
+	return sp.addValidatorToRegistry(st, dep)
+}
+
+// verifyDepositSignature verifies the signature of the deposit over the
+// deposit domain, which proves possession of the validator key.
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, ForkDataT, _, _,
+	_, _, _, _, _, _, _, _,
+]) verifyDepositSignature(
+	st BeaconStateT,
+	dep DepositT,
 ) error {
 	var (
 		genesisValidatorsRoot common.Root
@@ -170,7 +313,7 @@ func (sp *StateProcessor[
 
 	// Verify that the message was signed correctly.
 	var d ForkDataT
-	if err = dep.VerifySignature(
+	return dep.VerifySignature(
 		d.New(
 			version.FromUint32[common.Version](
 				sp.cs.ActiveForkVersionForEpoch(epoch),
@@ -178,17 +321,13 @@ func (sp *StateProcessor[
 		),
 		sp.cs.DomainTypeDeposit(),
 		sp.signer.VerifySignature,
-	); err != nil {
-		return err
-	}
-
-	// Add the validator to the registry.
-	return sp.addValidatorToRegistry(st, dep)
+	)
 }
 
 // addValidatorToRegistry adds a validator to the registry.
 func (sp *StateProcessor[
-	_, _, _, BeaconStateT, _, DepositT, _, _, _, _, _, _, ValidatorT, _, _, _, _,
+	_, _, _, _, _, BeaconStateT, _, _, DepositT, _, _, _, _, _, _, _, _, _,
+	ValidatorT, _, _, _, _, _, _,
 ]) addValidatorToRegistry(
 	st BeaconStateT,
 	dep DepositT,
@@ -225,7 +364,8 @@ func (sp *StateProcessor[
 //
 //nolint:lll
 func (sp *StateProcessor[
-	_, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, BeaconBlockBodyT, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _,
+	_, _, _, _, _, _, _, _,
 ]) processWithdrawals(
 	st BeaconStateT,
 	body BeaconBlockBodyT,
@@ -308,3 +448,58 @@ func (sp *StateProcessor[
 
 	return st.SetNextWithdrawalValidatorIndex(nextValidatorIndex)
 }
+
+// processEffectiveBalanceUpdates as defined in the Ethereum 2.0 specification.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#effective-balances-updates
+//
+// The effective balance of a validator only follows its balance once the
+// balance moves past the hysteresis thresholds around it.
+//
+//nolint:lll
+func (sp *StateProcessor[
+	_, _, _, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT,
+	_, _, _, _, _, _,
+]) processEffectiveBalanceUpdates(
+	st BeaconStateT,
+) error {
+	totalValidators, err := st.GetTotalValidators()
+	if err != nil {
+		return err
+	}
+
+	var (
+		val     ValidatorT
+		balance math.Gwei
+
+		increment           = math.Gwei(sp.cs.EffectiveBalanceIncrement())
+		hysteresisIncrement = increment /
+			math.Gwei(sp.cs.HysteresisQuotient())
+		downwardThreshold = hysteresisIncrement *
+			math.Gwei(sp.cs.HysteresisDownwardMultiplier())
+		upwardThreshold = hysteresisIncrement *
+			math.Gwei(sp.cs.HysteresisUpwardMultiplier())
+		maxEffectiveBalance = math.Gwei(sp.cs.MaxEffectiveBalance())
+	)
+	for i := range totalValidators {
+		idx := math.ValidatorIndex(i)
+		if val, err = st.ValidatorByIndex(idx); err != nil {
+			return err
+		}
+		if balance, err = st.GetBalance(idx); err != nil {
+			return err
+		}
+
+		effectiveBalance := val.GetEffectiveBalance()
+		if balance+downwardThreshold >= effectiveBalance &&
+			effectiveBalance+upwardThreshold >= balance {
+			continue
+		}
+		val.SetEffectiveBalance(
+			min(balance-balance%increment, maxEffectiveBalance),
+		)
+		if err = st.UpdateValidatorAtIndex(idx, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// validatorUpdate returns the update reporting the given power for the
+// validator at the given index.
+func validatorUpdate(
+	index int, power math.Gwei,
+) *transition.ValidatorUpdate {
+	return &transition.ValidatorUpdate{
+		Pubkey:           testPubkey(index),
+		EffectiveBalance: power,
+	}
+}
+
+func requireEffectiveBalance(
+	t *testing.T,
+	st *testBeaconState,
+	index math.ValidatorIndex,
+	expected math.Gwei,
+) {
+	t.Helper()
+	val, err := st.ValidatorByIndex(index)
+	require.NoError(t, err)
+	require.Equal(t, expected, val.GetEffectiveBalance())
+}
+
+func TestProcessEffectiveBalanceUpdates(t *testing.T) {
+	sp, st := setupTransition(t)
+
+	// The hysteresis increment is a quarter of the 1 ETH increment, so the
+	// effective balance drops once the balance falls 0.25 ETH below it.
+	require.NoError(t, st.DecreaseBalance(1, 2e8))
+	require.NoError(t, st.DecreaseBalance(2, 3e8))
+	require.NoError(t, st.DecreaseBalance(3, 3e9))
+
+	updates, err := sp.ProcessSlots(st, 32)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(2, 31e9),
+		validatorUpdate(3, 29e9),
+	}, updates)
+	requireEffectiveBalance(t, st, 1, testBalance)
+	requireEffectiveBalance(t, st, 2, 31e9)
+	requireEffectiveBalance(t, st, 3, 29e9)
+
+	// The effective balance rises once the balance is 1.25 ETH above it.
+	require.NoError(t, st.IncreaseBalance(3, 12e8))
+	updates, err = sp.ProcessSlots(st, 64)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	requireEffectiveBalance(t, st, 3, 29e9)
+
+	require.NoError(t, st.IncreaseBalance(3, 1e8))
+	updates, err = sp.ProcessSlots(st, 96)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(3, 30e9),
+	}, updates)
+	requireEffectiveBalance(t, st, 3, 30e9)
+}
+
+func TestProcessEffectiveBalanceUpdatesCapped(t *testing.T) {
+	sp, st := setupTransition(t)
+
+	require.NoError(t, st.IncreaseBalance(1, 10e9))
+	updates, err := sp.ProcessSlots(st, 32)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	requireEffectiveBalance(t, st, 1, testBalance)
+}
+
+func TestDepositTopUp(t *testing.T) {
+	sp, st, updates := setupGenesis(t, testChainSpec(), []*types.Deposit{
+		testDeposit(0, 16e9, 0),
+		testDeposit(1, testBalance, 1),
+		testDeposit(0, 16e9, 2),
+	})
+
+	// The top-up only increases the balance, the effective balance follows
+	// at the next epoch boundary.
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(0, 16e9),
+		validatorUpdate(1, testBalance),
+	}, updates)
+	balance, err := st.GetBalance(0)
+	require.NoError(t, err)
+	require.Equal(t, testBalance, balance)
+	requireEffectiveBalance(t, st, 0, 16e9)
+
+	updates, err = sp.ProcessSlots(st, 32)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(0, testBalance),
+	}, updates)
+	requireEffectiveBalance(t, st, 0, testBalance)
+}
+
+func TestSlashedValidatorUpdates(t *testing.T) {
+	sp, st := setupTransition(t)
+
+	blk := newTestBlock(t, st)
+	blk.Body.SetProposerSlashings([]*types.ProposerSlashing{
+		types.NewProposerSlashing(conflictingHeaders(2)),
+	})
+	_, err := sp.Transition(transitionContext(), st, blk)
+	require.NoError(t, err)
+
+	// The slashed validator is removed from the active set once.
+	updates, err := sp.ProcessSlots(st, 32)
+	require.NoError(t, err)
+	require.Equal(t, transition.ValidatorUpdates{
+		validatorUpdate(2, 0),
+	}, updates)
+
+	updates, err = sp.ProcessSlots(st, 64)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
+
+func TestProcessEth1DataVoting(t *testing.T) {
+	sp, st := setupTransition(t)
+	adopted, err := st.GetEth1Data()
+	require.NoError(t, err)
+	vote := &types.Eth1Data{
+		DepositRoot:  common.Root{0x01},
+		DepositCount: adopted.DepositCount,
+	}
+
+	// The vote is only adopted once more than half of the 64 slots of the
+	// voting period voted for it.
+	var (
+		slot     math.Slot
+		eth1Data *types.Eth1Data
+	)
+	for i := range 33 {
+		if i > 0 {
+			slot, err = st.GetSlot()
+			require.NoError(t, err)
+			_, err = sp.ProcessSlots(st, slot+1)
+			require.NoError(t, err)
+		}
+		blk := newTestBlock(t, st)
+		blk.Body.Eth1Data = &types.Eth1Data{
+			DepositRoot:  vote.DepositRoot,
+			DepositCount: vote.DepositCount,
+		}
+		_, err = sp.Transition(transitionContext(), st, blk)
+		require.NoError(t, err)
+
+		eth1Data, err = st.GetEth1Data()
+		require.NoError(t, err)
+		if i < 32 {
+			require.Equal(t, adopted, eth1Data)
+		} else {
+			require.Equal(t, vote, eth1Data)
+		}
+	}
+	votes, err := st.GetEth1DataVotes()
+	require.NoError(t, err)
+	require.Len(t, votes, 33)
+
+	// The votes are cleared at the end of the voting period.
+	_, err = sp.ProcessSlots(st, 64)
+	require.NoError(t, err)
+	votes, err = st.GetEth1DataVotes()
+	require.NoError(t, err)
+	require.Empty(t, votes)
+}
@@ -31,19 +31,63 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
+// AttestationData is the interface for the vote of a validator for the parent
+// block, as committed by the consensus engine.
+type AttestationData interface {
+	// GetSlot returns the slot of the block including the vote.
+	GetSlot() math.U64
+	// GetIndex returns the index of the validator that cast the vote.
+	GetIndex() math.U64
+	// GetBeaconBlockRoot returns the root of the block the vote was cast
+	// for, i.e. the parent of the block including the vote.
+	GetBeaconBlockRoot() common.Root
+}
+
+// AttesterSlashing is the interface for the evidence of a validator having
+// signed two conflicting attestations.
+type AttesterSlashing[ForkDataT any] interface {
+	// GetValidatorIndex returns the index of the offending attester.
+	GetValidatorIndex() math.ValidatorIndex
+	// GetSlot returns the slot of the conflicting attestations.
+	GetSlot() math.Slot
+	// IsSlashable returns true if the attestations constitute a slashable
+	// offense.
+	IsSlashable() bool
+	// VerifySignatures verifies the signatures of both attestations.
+	VerifySignatures(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
 // BeaconBlock represents a generic interface for a beacon block.
 type BeaconBlock[
+	AttestationDataT any,
+	AttesterSlashingT any,
 	DepositT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
-		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
+		AttestationDataT, AttesterSlashingT, BeaconBlockBodyT, DepositT,
+		Eth1DataT,
+		ExecutionPayloadT, ExecutionPayloadHeaderT, ExecutionRequestsT,
+		ProposerSlashingT, SlashingInfoT, VoluntaryExitT, WithdrawalsT,
 	],
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ExecutionRequestsT any,
+	ProposerSlashingT any,
+	SlashingInfoT any,
+	VoluntaryExitT any,
 	WithdrawalsT any,
 ] interface {
 	IsNil() bool
@@ -62,12 +106,19 @@ type BeaconBlock[
 // BeaconBlockBody represents a generic interface for the body of a beacon
 // block.
 type BeaconBlockBody[
+	AttestationDataT any,
+	AttesterSlashingT any,
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
+	ExecutionRequestsT any,
+	ProposerSlashingT any,
+	SlashingInfoT any,
+	VoluntaryExitT any,
 	WithdrawalsT any,
 ] interface {
 	constraints.EmptyWithVersion[BeaconBlockBodyT]
@@ -77,10 +128,27 @@ type BeaconBlockBody[
 	GetExecutionPayload() ExecutionPayloadT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetEth1Data returns the eth1 data voted for by the block.
+	GetEth1Data() Eth1DataT
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetProposerSlashings returns the list of proposer slashings.
+	GetProposerSlashings() []ProposerSlashingT
+	// GetAttesterSlashings returns the list of attester slashings.
+	GetAttesterSlashings() []AttesterSlashingT
+	// GetSlashingInfo returns the slashing info derived from consensus
+	// misbehavior evidence.
+	GetSlashingInfo() []SlashingInfoT
+	// GetAttestations returns the votes for the parent block committed by
+	// the consensus engine.
+	GetAttestations() []AttestationDataT
+	// GetVoluntaryExits returns the list of voluntary exits.
+	GetVoluntaryExits() []VoluntaryExitT
+	// GetExecutionRequests returns the execution requests of the payload,
+	// from the Electra fork onwards.
+	GetExecutionRequests() ExecutionRequestsT
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -101,6 +169,20 @@ type BeaconBlockHeader[BeaconBlockHeaderT any] interface {
 	SetStateRoot(common.Root)
 }
 
+// ConsolidationRequest is the interface for a request to consolidate the
+// balance of a validator into another one, triggered from the execution
+// layer.
+type ConsolidationRequest interface {
+	// GetSourceAddress returns the address that sent the request.
+	GetSourceAddress() common.ExecutionAddress
+	// GetSourcePubkey returns the public key of the validator that is
+	// consolidated.
+	GetSourcePubkey() crypto.BLSPubkey
+	// GetTargetPubkey returns the public key of the validator the balance
+	// is consolidated into.
+	GetTargetPubkey() crypto.BLSPubkey
+}
+
 // Context defines an interface for managing state transition context.
 type Context interface {
 	context.Context
@@ -118,6 +200,18 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetSkipValidateMisbehaviors returns whether to skip checking the
+	// slashing info of the block against the misbehavior evidence.
+	GetSkipValidateMisbehaviors() bool
+	// GetMisbehaviors returns the misbehavior evidence the consensus engine
+	// committed in the block.
+	GetMisbehaviors() []transition.Misbehavior
+	// GetSkipValidateVotes returns whether to skip checking the attestations
+	// of the block against the committed votes.
+	GetSkipValidateVotes() bool
+	// GetVotes returns the votes for the parent block the consensus engine
+	// committed in the block.
+	GetVotes() []transition.Vote
 }
 
 // Deposit is the interface for a deposit.
@@ -127,6 +221,14 @@ type Deposit[
 ] interface {
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
+	// GetIndex returns the index of the deposit in the deposit contract.
+	GetIndex() math.U64
+	// GetDataRoot returns the hash tree root of the deposit data, which is
+	// the leaf inserted into the deposit contract tree.
+	GetDataRoot() common.Root
+	// GetProof returns the merkle proof of the deposit data against the
+	// deposit root.
+	GetProof() []common.Root
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetWithdrawalCredentials returns the withdrawal credentials.
@@ -191,6 +293,22 @@ type ExecutionEngine[
 	) error
 }
 
+// ExecutionRequests is the interface for the requests triggered from the
+// execution layer by an execution payload.
+type ExecutionRequests[
+	ConsolidationRequestT, DepositT, WithdrawalRequestT any,
+] interface {
+	// GetDeposits returns the deposit requests as deposits without a proof.
+	GetDeposits() []DepositT
+	// GetWithdrawals returns the withdrawal requests.
+	GetWithdrawals() []WithdrawalRequestT
+	// GetConsolidations returns the consolidation requests.
+	GetConsolidations() []ConsolidationRequestT
+	// EncodeRequests encodes the requests for the execution client, as per
+	// EIP-7685.
+	EncodeRequests() ([][]byte, error)
+}
+
 // ForkData is the interface for the fork data.
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
@@ -202,6 +320,36 @@ type ForkData[ForkDataT any] interface {
 	) common.Root
 }
 
+// ProposerSlashing is the interface for the evidence of a validator having
+// proposed two conflicting blocks.
+type ProposerSlashing[ForkDataT any] interface {
+	// GetProposerIndex returns the index of the offending proposer.
+	GetProposerIndex() math.ValidatorIndex
+	// GetSlot returns the slot of the conflicting headers.
+	GetSlot() math.Slot
+	// IsSlashable returns true if the headers constitute a slashable offense.
+	IsSlashable() bool
+	// VerifySignatures verifies the signatures of both headers.
+	VerifySignatures(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
+// SlashingInfo is the interface for the misbehavior evidence reported by the
+// consensus engine.
+type SlashingInfo interface {
+	// GetSlot returns the slot at which the misbehavior occurred.
+	GetSlot() math.Slot
+	// GetIndex returns the index of the offending validator.
+	GetIndex() math.U64
+}
+
 // Validator represents an interface for a validator with generic type
 // ValidatorT.
 type Validator[
@@ -229,12 +377,59 @@ type Validator[
 	SetEffectiveBalance(math.Gwei)
 	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
 	GetWithdrawableEpoch() math.Epoch
+	// IsSlashable returns true if the validator can be slashed at the given
+	// epoch.
+	IsSlashable(math.Epoch) bool
+	// SetSlashed sets the slashed status of the validator.
+	SetSlashed(bool)
+	// SetWithdrawableEpoch sets the epoch when the validator can withdraw.
+	SetWithdrawableEpoch(math.Epoch)
+	// IsActive returns true if the validator is active at the given epoch.
+	IsActive(math.Epoch) bool
+	// IsEligibleForActivation returns true if the validator can be activated
+	// once the given epoch is finalized.
+	IsEligibleForActivation(math.Epoch) bool
+	// GetActivationEligibilityEpoch returns the epoch when the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// SetActivationEligibilityEpoch sets the epoch when the validator became
+	// eligible for activation.
+	SetActivationEligibilityEpoch(math.Epoch)
+	// GetActivationEpoch returns the epoch when the validator activates.
+	GetActivationEpoch() math.Epoch
+	// SetActivationEpoch sets the epoch when the validator activates.
+	SetActivationEpoch(math.Epoch)
+	// GetExitEpoch returns the epoch when the validator exits.
+	GetExitEpoch() math.Epoch
+	// SetExitEpoch sets the epoch when the validator exits.
+	SetExitEpoch(math.Epoch)
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
 }
 
 type Validators interface {
 	HashTreeRoot() common.Root
 }
 
+// VoluntaryExit is the interface for a signed voluntary exit.
+type VoluntaryExit[ForkDataT any] interface {
+	// GetEpoch returns the earliest epoch at which the exit can be processed.
+	GetEpoch() math.Epoch
+	// GetValidatorIndex returns the index of the exiting validator.
+	GetValidatorIndex() math.ValidatorIndex
+	// VerifySignature verifies the signature of the exiting validator.
+	VerifySignature(
+		forkData ForkDataT,
+		domainType common.DomainType,
+		pubkey crypto.BLSPubkey,
+		signatureVerificationFn func(
+			pubkey crypto.BLSPubkey,
+			message []byte, signature crypto.BLSSignature,
+		) error,
+	) error
+}
+
 // Withdrawal is the interface for a withdrawal.
 type Withdrawal[WithdrawalT any] interface {
 	// Equals returns true if the withdrawal is equal to the other.
@@ -248,3 +443,14 @@ type Withdrawal[WithdrawalT any] interface {
 	// GetAddress returns the address of the withdrawal.
 	GetAddress() common.ExecutionAddress
 }
+
+// WithdrawalRequest is the interface for a withdrawal request of a
+// validator, triggered from the execution layer.
+type WithdrawalRequest interface {
+	// GetSourceAddress returns the address that sent the request.
+	GetSourceAddress() common.ExecutionAddress
+	// GetValidatorPubkey returns the public key of the validator.
+	GetValidatorPubkey() crypto.BLSPubkey
+	// GetAmount returns the amount to withdraw.
+	GetAmount() math.Gwei
+}
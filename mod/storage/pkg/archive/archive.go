@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package archive
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Archive keeps the historical beacon states of a node running in archive
+// mode. A snapshot of the state is persisted every SnapshotInterval slots and
+// the states in between are regenerated by replaying the blocks from the
+// block store on top of the closest preceding snapshot.
+type Archive[
+	BeaconBlockT BeaconBlock,
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT constraints.SSZMarshaler,
+	BlockStoreT BlockStore[BeaconBlockT],
+] struct {
+	// config is the configuration of the archive.
+	config Config
+	// store persists the state snapshots.
+	store *KVStore
+	// blockStore provides the blocks replayed on top of the snapshots.
+	blockStore BlockStoreT
+	// sp is the state processor used to replay the blocks.
+	sp StateProcessor[BeaconBlockT, BeaconStateT]
+	// newState builds a writable state from an encoded state.
+	newState StateFactory[BeaconStateT]
+	// cache holds the encodings of the recently materialized states.
+	cache *lru.Cache[math.Slot, []byte]
+}
+
+// New creates a new archive.
+func New[
+	BeaconBlockT BeaconBlock,
+	BeaconStateT BeaconState[BeaconStateMarshallableT],
+	BeaconStateMarshallableT constraints.SSZMarshaler,
+	BlockStoreT BlockStore[BeaconBlockT],
+](
+	config Config,
+	store *KVStore,
+	blockStore BlockStoreT,
+	sp StateProcessor[BeaconBlockT, BeaconStateT],
+	newState StateFactory[BeaconStateT],
+) (*Archive[
+	BeaconBlockT, BeaconStateT, BeaconStateMarshallableT, BlockStoreT,
+], error) {
+	a := &Archive[
+		BeaconBlockT, BeaconStateT, BeaconStateMarshallableT, BlockStoreT,
+	]{
+		config:     config,
+		store:      store,
+		blockStore: blockStore,
+		sp:         sp,
+		newState:   newState,
+	}
+	if !config.Enabled {
+		return a, nil
+	}
+
+	if config.SnapshotInterval == 0 {
+		return nil, ErrInvalidSnapshotInterval
+	}
+
+	var err error
+	if a.cache, err = lru.New[math.Slot, []byte](config.CacheSize); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Enabled returns whether the archive is enabled.
+func (a *Archive[_, _, _, _]) Enabled() bool {
+	return a.config.Enabled
+}
+
+// Snapshot persists the given state if archiving is enabled and the state's
+// slot falls on the snapshot interval.
+func (a *Archive[_, BeaconStateT, _, _]) Snapshot(st BeaconStateT) error {
+	if !a.config.Enabled {
+		return nil
+	}
+
+	slot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+	if slot.Unwrap()%a.config.SnapshotInterval != 0 {
+		return nil
+	}
+
+	bz, err := a.encode(st)
+	if err != nil {
+		return err
+	}
+	return a.store.Set(slot, bz)
+}
+
+// StateAtSlot returns the state at the end of the given slot. The returned
+// state is independent from the archive and may be freely modified.
+func (a *Archive[_, BeaconStateT, _, _]) StateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconStateT, error) {
+	var st BeaconStateT
+	if !a.config.Enabled {
+		return st, ErrArchiveDisabled
+	}
+
+	if bz, ok := a.cache.Get(slot); ok {
+		return a.newState(bz)
+	}
+
+	base, bz, err := a.store.Floor(slot)
+	if err != nil {
+		return st, err
+	}
+
+	// Start from a more recent materialized state if one is available.
+	if cached, cachedBz, ok := a.closestCached(base, slot); ok {
+		base, bz = cached, cachedBz
+	}
+
+	if st, err = a.newState(bz); err != nil {
+		return st, err
+	}
+	if base == slot {
+		return st, nil
+	}
+
+	if err = a.replay(ctx, st, base+1, slot); err != nil {
+		return st, err
+	}
+
+	if bz, err = a.encode(st); err != nil {
+		return st, err
+	}
+	a.cache.Add(slot, bz)
+	return st, nil
+}
+
+// replay applies the blocks of the slots [start, end] to the given state.
+func (a *Archive[_, BeaconStateT, _, _]) replay(
+	ctx context.Context,
+	st BeaconStateT,
+	start, end math.Slot,
+) error {
+	// The payloads, RANDAO reveals, slashing info and attestations were
+	// verified when the blocks were first processed, only the resulting
+	// state roots are checked again.
+	tCtx := &transition.Context{
+		Context:                  ctx,
+		OptimisticEngine:         true,
+		SkipPayloadVerification:  true,
+		SkipValidateRandao:       true,
+		SkipValidateMisbehaviors: true,
+		SkipValidateVotes:        true,
+	}
+	for slot := start; slot <= end; slot++ {
+		blk, err := a.blockStore.Get(slot)
+		if err != nil {
+			return errors.Wrapf(
+				ErrMissingReplayBlock, "slot %d: %s", slot, err,
+			)
+		}
+		if _, err = a.sp.Transition(tCtx, st, blk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closestCached returns the most recent cached state in the (start, end]
+// slot range.
+func (a *Archive[_, _, _, _]) closestCached(
+	start, end math.Slot,
+) (math.Slot, []byte, bool) {
+	var (
+		closest math.Slot
+		found   bool
+	)
+	for _, slot := range a.cache.Keys() {
+		if slot > start && slot <= end && (!found || slot > closest) {
+			closest, found = slot, true
+		}
+	}
+	if !found {
+		return 0, nil, false
+	}
+	bz, ok := a.cache.Get(closest)
+	return closest, bz, ok
+}
+
+// Close closes the store of the archive, if archiving is enabled.
+func (a *Archive[_, _, _, _]) Close() error {
+	if !a.config.Enabled {
+		return nil
+	}
+	return a.store.Close()
+}
+
+// encode returns the SSZ encoding of the given state.
+func (a *Archive[_, BeaconStateT, _, _]) encode(
+	st BeaconStateT,
+) ([]byte, error) {
+	m, err := st.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	return m.MarshalSSZ()
+}
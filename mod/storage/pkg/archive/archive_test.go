@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package archive_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/archive"
+	"github.com/stretchr/testify/require"
+)
+
+// testState is a minimal state holding its slot and the sum of the slots
+// of the blocks applied to it, encoded as two little endian uint64s.
+type testState struct {
+	slot math.Slot
+	sum  uint64
+}
+
+func (s *testState) GetSlot() (math.Slot, error) { return s.slot, nil }
+
+func (s *testState) GetMarshallable() (*testState, error) { return s, nil }
+
+func (s *testState) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(
+		binary.LittleEndian.AppendUint64(nil, s.slot.Unwrap()), s.sum,
+	), nil
+}
+
+func newTestState(bz []byte) (*testState, error) {
+	return &testState{
+		slot: math.Slot(binary.LittleEndian.Uint64(bz[:8])),
+		sum:  binary.LittleEndian.Uint64(bz[8:]),
+	}, nil
+}
+
+type testBlock struct {
+	slot math.Slot
+}
+
+func (b *testBlock) GetSlot() math.Slot { return b.slot }
+
+type testBlockStore map[math.Slot]*testBlock
+
+func (s testBlockStore) Get(slot math.Slot) (*testBlock, error) {
+	blk, ok := s[slot]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return blk, nil
+}
+
+// testProcessor applies a block by moving the state to the block's slot and
+// adding the slot to the sum, counting the applied blocks.
+type testProcessor struct {
+	applied int
+}
+
+func (p *testProcessor) Transition(
+	_ *transition.Context, st *testState, blk *testBlock,
+) (transition.ValidatorUpdates, error) {
+	p.applied++
+	st.slot = blk.slot
+	st.sum += blk.slot.Unwrap()
+	return nil, nil
+}
+
+type kvStoreService struct {
+	corestore.KVStoreWithBatch
+}
+
+func (s *kvStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return s.KVStoreWithBatch
+}
+
+type testCloser struct {
+	closed bool
+}
+
+func (c *testCloser) Close() error {
+	c.closed = true
+	return nil
+}
+
+type testArchive = archive.Archive[
+	*testBlock, *testState, *testState, testBlockStore,
+]
+
+// newTestArchive returns an archive of a chain with a block at each of the
+// given slots, snapshotting every interval slots from a genesis state at
+// slot 0.
+func newTestArchive(
+	t *testing.T, interval uint64, slots ...math.Slot,
+) (*testArchive, *testProcessor, testBlockStore, *testCloser) {
+	t.Helper()
+	closer := new(testCloser)
+	blocks := make(testBlockStore)
+	sp := new(testProcessor)
+	a, err := archive.New[*testBlock, *testState, *testState](
+		archive.Config{
+			Enabled:          true,
+			SnapshotInterval: interval,
+			CacheSize:        archive.DefaultCacheSize,
+		},
+		archive.NewStore(
+			&kvStoreService{storev2.NewMemDB()}, closer,
+		),
+		blocks,
+		sp,
+		newTestState,
+	)
+	require.NoError(t, err)
+
+	st := new(testState)
+	require.NoError(t, a.Snapshot(st))
+	for _, slot := range slots {
+		blocks[slot] = &testBlock{slot: slot}
+		_, err = sp.Transition(nil, st, blocks[slot])
+		require.NoError(t, err)
+		require.NoError(t, a.Snapshot(st))
+	}
+	sp.applied = 0
+	return a, sp, blocks, closer
+}
+
+// sumUpTo returns the sum of the slots in [1, slot].
+func sumUpTo(slot math.Slot) uint64 {
+	return slot.Unwrap() * (slot.Unwrap() + 1) / 2
+}
+
+func TestArchive_StateAtSlot(t *testing.T) {
+	a, sp, _, _ := newTestArchive(t, 4, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	for slot := range math.Slot(10) {
+		st, err := a.StateAtSlot(context.Background(), slot)
+		require.NoError(t, err)
+		require.Equal(t, slot, st.slot)
+		require.Equal(t, sumUpTo(slot), st.sum)
+	}
+	// Each slot is replayed once, from the cached state of the previous
+	// slot or from a snapshot.
+	require.Equal(t, 7, sp.applied)
+}
+
+func TestArchive_StateAtSlotReplay(t *testing.T) {
+	a, sp, _, _ := newTestArchive(t, 4, 1, 2, 3, 4, 5, 6, 7, 8)
+	ctx := context.Background()
+
+	// Slot 7 is replayed on top of the snapshot at slot 4.
+	st, err := a.StateAtSlot(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, sumUpTo(7), st.sum)
+	require.Equal(t, 3, sp.applied)
+
+	// The materialized state is cached.
+	sp.applied = 0
+	_, err = a.StateAtSlot(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, 0, sp.applied)
+
+	// Snapshots are returned without replaying.
+	st, err = a.StateAtSlot(ctx, 8)
+	require.NoError(t, err)
+	require.Equal(t, sumUpTo(8), st.sum)
+	require.Equal(t, 0, sp.applied)
+
+	// Returned states are independent from the archive.
+	st.sum = 0
+	st, err = a.StateAtSlot(ctx, 8)
+	require.NoError(t, err)
+	require.Equal(t, sumUpTo(8), st.sum)
+}
+
+func TestArchive_StateAtSlotMissedSlots(t *testing.T) {
+	// Slots 3 and 6 are missed, snapshots are taken at slots 0, 2 and 4.
+	a, _, _, _ := newTestArchive(t, 2, 1, 2, 4, 5, 7)
+
+	st, err := a.StateAtSlot(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1+2+4+5), st.sum)
+
+	_, err = a.StateAtSlot(context.Background(), 6)
+	require.ErrorIs(t, err, archive.ErrMissingReplayBlock)
+}
+
+func TestArchive_StateAtSlotErrors(t *testing.T) {
+	a, _, blocks, _ := newTestArchive(t, 4, 1, 2, 3)
+	delete(blocks, 2)
+
+	_, err := a.StateAtSlot(context.Background(), 3)
+	require.ErrorIs(t, err, archive.ErrMissingReplayBlock)
+
+	disabled, err := archive.New[*testBlock, *testState, *testState](
+		archive.DefaultConfig(), nil, blocks, nil, newTestState,
+	)
+	require.NoError(t, err)
+	require.False(t, disabled.Enabled())
+	require.NoError(t, disabled.Snapshot(new(testState)))
+	_, err = disabled.StateAtSlot(context.Background(), 0)
+	require.ErrorIs(t, err, archive.ErrArchiveDisabled)
+	require.NoError(t, disabled.Close())
+
+	_, err = archive.New[*testBlock, *testState, *testState](
+		archive.Config{Enabled: true}, nil, blocks, nil, newTestState,
+	)
+	require.ErrorIs(t, err, archive.ErrInvalidSnapshotInterval)
+}
+
+func TestKVStore_Floor(t *testing.T) {
+	store := archive.NewStore(&kvStoreService{storev2.NewMemDB()}, nil)
+	require.NoError(t, store.Set(4, []byte{4}))
+	require.NoError(t, store.Set(8, []byte{8}))
+
+	_, _, err := store.Floor(3)
+	require.ErrorIs(t, err, archive.ErrSnapshotNotFound)
+
+	for _, tc := range []struct {
+		slot, floor math.Slot
+	}{{4, 4}, {7, 4}, {8, 8}, {100, 8}} {
+		slot, bz, floorErr := store.Floor(tc.slot)
+		require.NoError(t, floorErr)
+		require.Equal(t, tc.floor, slot)
+		require.Equal(t, []byte{byte(tc.floor)}, bz)
+	}
+	require.NoError(t, store.Close())
+}
+
+func TestArchive_Close(t *testing.T) {
+	a, _, _, closer := newTestArchive(t, 4)
+	require.NoError(t, a.Close())
+	require.True(t, closer.closed)
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package archive
+
+const (
+	// DefaultSnapshotInterval is the default number of slots between two
+	// archived beacon state snapshots.
+	DefaultSnapshotInterval = 32
+	// DefaultCacheSize is the default number of materialized beacon states
+	// kept in memory.
+	DefaultCacheSize = 16
+)
+
+// Config is the configuration for the beacon state archive.
+type Config struct {
+	// Enabled enables archiving of historical beacon states.
+	Enabled bool `mapstructure:"enabled"`
+	// SnapshotInterval is the number of slots between two snapshots. The
+	// states in between are regenerated by replaying blocks.
+	SnapshotInterval uint64 `mapstructure:"snapshot-interval"`
+	// CacheSize is the number of recently materialized states kept in memory.
+	CacheSize int `mapstructure:"cache-size"`
+}
+
+// DefaultConfig returns the default configuration for the archive.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:          false,
+		SnapshotInterval: DefaultSnapshotInterval,
+		CacheSize:        DefaultCacheSize,
+	}
+}
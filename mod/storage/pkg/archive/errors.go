@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package archive
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrArchiveDisabled is returned when a historical state is requested
+	// from a node that is not running in archive mode.
+	ErrArchiveDisabled = errors.New("archive mode is disabled")
+	// ErrSnapshotNotFound is returned when no snapshot exists at or before
+	// the requested slot.
+	ErrSnapshotNotFound = errors.New("no archived snapshot found")
+	// ErrMissingReplayBlock is returned when a block needed to regenerate a
+	// state is not in the block store.
+	ErrMissingReplayBlock = errors.New("block required for replay not found")
+	// ErrInvalidSnapshotInterval is returned when the snapshot interval is
+	// zero.
+	ErrInvalidSnapshotInterval = errors.New("snapshot interval must be non-zero")
+)
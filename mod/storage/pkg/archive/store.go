@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package archive
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+)
+
+const StoreName = "archive"
+
+// KVStore persists the SSZ encoded beacon state snapshots of the archive,
+// keyed by slot.
+type KVStore struct {
+	snapshots sdkcollections.Map[math.Slot, []byte]
+	// closer closes the database backing the store.
+	closer io.Closer
+	mu     sync.RWMutex
+}
+
+// NewStore creates a new archive store on top of the given database, which
+// is closed when the store is closed.
+func NewStore(kvsp store.KVStoreService, closer io.Closer) *KVStore {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	return &KVStore{
+		closer: closer,
+		snapshots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix(StoreName),
+			StoreName,
+			encoding.U64Key,
+			sdkcollections.BytesValue,
+		),
+	}
+}
+
+// Set stores the snapshot of the state at the given slot.
+func (kv *KVStore) Set(slot math.Slot, snapshot []byte) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	return kv.snapshots.Set(context.TODO(), slot, snapshot)
+}
+
+// Floor returns the most recent snapshot taken at or before the given slot,
+// along with the slot it was taken at.
+func (kv *KVStore) Floor(slot math.Slot) (math.Slot, []byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	iter, err := kv.snapshots.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[math.Slot]).
+			EndInclusive(slot).
+			Descending(),
+	)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil, errors.Wrapf(ErrSnapshotNotFound, "slot %d", slot)
+	}
+	entry, err := iter.KeyValue()
+	if err != nil {
+		return 0, nil, err
+	}
+	return entry.Key, entry.Value, nil
+}
+
+// Close closes the database backing the store.
+func (kv *KVStore) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.closer == nil {
+		return nil
+	}
+	return kv.closer.Close()
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package archive
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// BeaconBlock is the interface for a beacon block replayed by the archive.
+type BeaconBlock interface {
+	GetSlot() math.Slot
+}
+
+// BeaconState is the interface for a beacon state that can be archived.
+type BeaconState[BeaconStateMarshallableT constraints.SSZMarshaler] interface {
+	GetSlot() (math.Slot, error)
+	GetMarshallable() (BeaconStateMarshallableT, error)
+}
+
+// BlockStore is the interface for the store the replayed blocks are read
+// from.
+type BlockStore[BeaconBlockT any] interface {
+	Get(slot math.Slot) (BeaconBlockT, error)
+}
+
+// StateProcessor is the interface for the state processor used to replay
+// blocks on top of a snapshot.
+type StateProcessor[BeaconBlockT, BeaconStateT any] interface {
+	Transition(
+		*transition.Context, BeaconStateT, BeaconBlockT,
+	) (transition.ValidatorUpdates, error)
+}
+
+// StateFactory builds a writable beacon state from the SSZ encoding of an
+// archived state. Every call must return a state that is independent from
+// the ones previously returned.
+type StateFactory[BeaconStateT any] func(bz []byte) (BeaconStateT, error)
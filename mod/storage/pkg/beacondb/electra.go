@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetDepositRequestsStartIndex returns the index of the first deposit
+// processed as an execution layer request, or
+// constants.UnsetDepositRequestsStartIndex if none has been processed yet.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetDepositRequestsStartIndex() (uint64, error) {
+	index, err := kv.depositRequestsStartIndex.Get(kv.ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return constants.UnsetDepositRequestsStartIndex, nil
+	}
+	return index, err
+}
+
+// SetDepositRequestsStartIndex sets the index of the first deposit processed
+// as an execution layer request.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetDepositRequestsStartIndex(index uint64) error {
+	return kv.depositRequestsStartIndex.Set(kv.ctx, index)
+}
+
+// GetPendingConsolidations returns the pending consolidations, as a map from
+// the index of each source validator to the index of its target validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetPendingConsolidations() (
+	map[math.ValidatorIndex]math.ValidatorIndex, error,
+) {
+	iter, err := kv.pendingConsolidations.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	consolidations := make(map[math.ValidatorIndex]math.ValidatorIndex)
+	for ; iter.Valid(); iter.Next() {
+		entry, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		consolidations[math.ValidatorIndex(entry.Key)] =
+			math.ValidatorIndex(entry.Value)
+	}
+	return consolidations, nil
+}
+
+// AddPendingConsolidation records a pending consolidation of the source
+// validator into the target validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AddPendingConsolidation(
+	source, target math.ValidatorIndex,
+) error {
+	return kv.pendingConsolidations.Set(
+		kv.ctx, source.Unwrap(), target.Unwrap(),
+	)
+}
+
+// RemovePendingConsolidation removes the pending consolidation of the source
+// validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) RemovePendingConsolidation(source math.ValidatorIndex) error {
+	return kv.pendingConsolidations.Remove(kv.ctx, source.Unwrap())
+}
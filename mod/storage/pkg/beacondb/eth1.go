@@ -20,6 +20,10 @@
 
 package beacondb
 
+import (
+	sdkcollections "cosmossdk.io/collections"
+)
+
 // GetLatestExecutionPayloadHeader retrieves the latest execution payload
 // header from the BeaconStore.
 func (kv *KVStore[
@@ -89,3 +93,72 @@ func (kv *KVStore[
 ) error {
 	return kv.eth1Data.Set(kv.ctx, data)
 }
+
+// GetEth1DataVotes retrieves the eth1 data votes of the current voting
+// period, in the order in which they were cast.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEth1DataVotes() ([]Eth1DataT, error) {
+	iter, err := kv.eth1DataVotes.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	votes := []Eth1DataT{}
+	for ; iter.Valid(); iter.Next() {
+		var vote Eth1DataT
+		if vote, err = iter.Value(); err != nil {
+			return nil, err
+		}
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+// AddEth1DataVote appends an eth1 data vote to the votes of the current
+// voting period.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) AddEth1DataVote(vote Eth1DataT) error {
+	next, err := kv.nextEth1DataVoteIndex()
+	if err != nil {
+		return err
+	}
+	return kv.eth1DataVotes.Set(kv.ctx, next, vote)
+}
+
+// nextEth1DataVoteIndex returns the key of the next eth1 data vote. The
+// iterator is closed before the vote is written, as it may hold the store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) nextEth1DataVoteIndex() (uint64, error) {
+	iter, err := kv.eth1DataVotes.Iterate(
+		kv.ctx, new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	last, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}
+
+// ResetEth1DataVotes clears the eth1 data votes, at the start of a new
+// voting period.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ResetEth1DataVotes() error {
+	return kv.eth1DataVotes.Clear(kv.ctx, nil)
+}
@@ -44,6 +44,12 @@ const (
 	NextWithdrawalIndexPrefix
 	NextWithdrawalValidatorIndexPrefix
 	ForkPrefix
+	ValidatorPowerPrefix
+	DepositRequestsStartIndexPrefix
+	PendingConsolidationsPrefix
+	EpochParticipationPrefix
+	InactivityScoresPrefix
+	Eth1DataVotesPrefix
 )
 
 //nolint:lll
@@ -71,4 +77,10 @@ const (
 	NextWithdrawalIndexPrefixHumanReadable              = "NextWithdrawalIndexPrefix"
 	NextWithdrawalValidatorIndexPrefixHumanReadable     = "NextWithdrawalValidatorIndexPrefix"
 	ForkPrefixHumanReadable                             = "ForkPrefix"
+	ValidatorPowerPrefixHumanReadable                   = "ValidatorPowerPrefix"
+	DepositRequestsStartIndexPrefixHumanReadable        = "DepositRequestsStartIndexPrefix"
+	PendingConsolidationsPrefixHumanReadable            = "PendingConsolidationsPrefix"
+	EpochParticipationPrefixHumanReadable               = "EpochParticipationPrefix"
+	InactivityScoresPrefixHumanReadable                 = "InactivityScoresPrefix"
+	Eth1DataVotesPrefixHumanReadable                    = "Eth1DataVotesPrefix"
 )
@@ -73,8 +73,14 @@ type KVStore[
 	// Eth1
 	// eth1Data stores the latest eth1 data.
 	eth1Data sdkcollections.Item[Eth1DataT]
+	// eth1DataVotes stores the eth1 data votes of the current voting period,
+	// keyed by the order in which they were cast.
+	eth1DataVotes sdkcollections.Map[uint64, Eth1DataT]
 	// eth1DepositIndex is the index of the latest eth1 deposit.
 	eth1DepositIndex sdkcollections.Item[uint64]
+	// depositRequestsStartIndex is the index of the first deposit processed
+	// as an execution layer request.
+	depositRequestsStartIndex sdkcollections.Item[uint64]
 	// latestExecutionPayloadVersion stores the latest execution payload
 	// version.
 	latestExecutionPayloadVersion sdkcollections.Item[uint32]
@@ -93,6 +99,18 @@ type KVStore[
 	]
 	// balances stores the list of balances.
 	balances sdkcollections.Map[uint64, uint64]
+	// validatorPowers stores the voting power last reported to the consensus
+	// engine for each validator.
+	validatorPowers sdkcollections.Map[uint64, uint64]
+	// pendingConsolidations stores the target validator index of each
+	// pending consolidation, keyed by the source validator index.
+	pendingConsolidations sdkcollections.Map[uint64, uint64]
+	// epochParticipation stores the number of blocks of the current epoch
+	// that included the vote of each validator.
+	epochParticipation sdkcollections.Map[uint64, uint64]
+	// inactivityScores stores the number of consecutive epochs in which
+	// each validator did not vote.
+	inactivityScores sdkcollections.Map[uint64, uint64]
 	// nextWithdrawalIndex stores the next global withdrawal index.
 	nextWithdrawalIndex sdkcollections.Item[uint64]
 	// nextWithdrawalValidatorIndex stores the next withdrawal validator index
@@ -182,12 +200,27 @@ func New[
 			keys.Eth1DataPrefixHumanReadable,
 			encoding.SSZValueCodec[Eth1DataT]{},
 		),
+		eth1DataVotes: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.Eth1DataVotesPrefix}),
+			keys.Eth1DataVotesPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			encoding.SSZValueCodec[Eth1DataT]{},
+		),
 		eth1DepositIndex: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.Eth1DepositIndexPrefix}),
 			keys.Eth1DepositIndexPrefixHumanReadable,
 			sdkcollections.Uint64Value,
 		),
+		depositRequestsStartIndex: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix(
+				[]byte{keys.DepositRequestsStartIndexPrefix},
+			),
+			keys.DepositRequestsStartIndexPrefixHumanReadable,
+			sdkcollections.Uint64Value,
+		),
 		latestExecutionPayloadVersion: sdkcollections.NewItem(
 			schemaBuilder,
 			sdkcollections.NewPrefix(
@@ -225,6 +258,34 @@ func New[
 			sdkcollections.Uint64Key,
 			sdkcollections.Uint64Value,
 		),
+		validatorPowers: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.ValidatorPowerPrefix}),
+			keys.ValidatorPowerPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		pendingConsolidations: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.PendingConsolidationsPrefix}),
+			keys.PendingConsolidationsPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		epochParticipation: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.EpochParticipationPrefix}),
+			keys.EpochParticipationPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
+		inactivityScores: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{keys.InactivityScoresPrefix}),
+			keys.InactivityScoresPrefixHumanReadable,
+			sdkcollections.Uint64Key,
+			sdkcollections.Uint64Value,
+		),
 		randaoMix: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte{keys.RandaoMixPrefix}),
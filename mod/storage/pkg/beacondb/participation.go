@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package beacondb
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// GetEpochParticipation returns the number of blocks of the current epoch
+// that included the vote of a validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetEpochParticipation(idx math.ValidatorIndex) (uint64, error) {
+	participation, err := kv.epochParticipation.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return participation, err
+}
+
+// SetEpochParticipation sets the number of blocks of the current epoch that
+// included the vote of a validator.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetEpochParticipation(
+	idx math.ValidatorIndex,
+	participation uint64,
+) error {
+	return kv.epochParticipation.Set(kv.ctx, idx.Unwrap(), participation)
+}
+
+// ResetEpochParticipation clears the participation of every validator, at
+// the start of a new epoch.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ResetEpochParticipation() error {
+	return kv.epochParticipation.Clear(kv.ctx, nil)
+}
+
+// GetInactivityScore returns the number of consecutive epochs in which a
+// validator did not vote.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetInactivityScore(idx math.ValidatorIndex) (uint64, error) {
+	score, err := kv.inactivityScores.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return score, err
+}
+
+// SetInactivityScore sets the number of consecutive epochs in which a
+// validator did not vote. A zero score removes the record.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetInactivityScore(idx math.ValidatorIndex, score uint64) error {
+	if score == 0 {
+		return kv.inactivityScores.Remove(kv.ctx, idx.Unwrap())
+	}
+	return kv.inactivityScores.Set(kv.ctx, idx.Unwrap(), score)
+}
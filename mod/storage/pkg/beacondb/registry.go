@@ -21,7 +21,9 @@
 package beacondb
 
 import (
+	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -231,6 +233,38 @@ func (kv *KVStore[
 	return kv.balances.Set(kv.ctx, idx.Unwrap(), balance.Unwrap())
 }
 
+// GetValidatorPower returns the voting power last reported to the consensus
+// engine for a validator, or zero if none was reported.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetValidatorPower(
+	idx math.ValidatorIndex,
+) (math.Gwei, error) {
+	power, err := kv.validatorPowers.Get(kv.ctx, idx.Unwrap())
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return math.Gwei(power), nil
+}
+
+// SetValidatorPower records the voting power reported to the consensus engine
+// for a validator. A zero power removes the record.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) SetValidatorPower(
+	idx math.ValidatorIndex,
+	power math.Gwei,
+) error {
+	if power == 0 {
+		return kv.validatorPowers.Remove(kv.ctx, idx.Unwrap())
+	}
+	return kv.validatorPowers.Set(kv.ctx, idx.Unwrap(), power.Unwrap())
+}
+
 // GetBalances returns the balancse of all validator.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -29,13 +29,17 @@ import (
 
 const (
 	blockRootsIndexName       = "block_roots"
+	executionHashesIndexName  = "execution_hashes"
 	executionNumbersIndexName = "execution_numbers"
+	parentRootsIndexName      = "parent_roots"
 	stateRootsIndexName       = "state_roots"
 )
 
 type indexes[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
 	BlockRoots       *sdkindexes.Unique[[]byte, math.Slot, BeaconBlockT]
+	ExecutionHashes  *sdkindexes.Unique[[]byte, math.Slot, BeaconBlockT]
 	ExecutionNumbers *sdkindexes.Unique[math.U64, math.Slot, BeaconBlockT]
+	ParentRoots      *sdkindexes.Multi[[]byte, math.Slot, BeaconBlockT]
 	StateRoots       *sdkindexes.Unique[[]byte, math.Slot, BeaconBlockT]
 }
 
@@ -46,7 +50,9 @@ func (i indexes[BeaconBlockT]) IndexesList() []sdkcollections.Index[
 ] {
 	return []sdkcollections.Index[math.Slot, BeaconBlockT]{
 		i.BlockRoots,
+		i.ExecutionHashes,
 		i.ExecutionNumbers,
+		i.ParentRoots,
 		i.StateRoots,
 	}
 }
@@ -66,6 +72,17 @@ func newIndexes[BeaconBlockT BeaconBlock[BeaconBlockT]](
 				return root[:], nil
 			},
 		),
+		ExecutionHashes: sdkindexes.NewUnique(
+			sb,
+			sdkcollections.NewPrefix(executionHashesIndexName),
+			executionHashesIndexName,
+			sdkcollections.BytesKey,
+			encoding.U64Key,
+			func(_ math.Slot, blk BeaconBlockT) ([]byte, error) {
+				hash := blk.GetExecutionHash()
+				return hash[:], nil
+			},
+		),
 		ExecutionNumbers: sdkindexes.NewUnique(
 			sb,
 			sdkcollections.NewPrefix(executionNumbersIndexName),
@@ -76,6 +93,17 @@ func newIndexes[BeaconBlockT BeaconBlock[BeaconBlockT]](
 				return blk.GetExecutionNumber(), nil
 			},
 		),
+		ParentRoots: sdkindexes.NewMulti(
+			sb,
+			sdkcollections.NewPrefix(parentRootsIndexName),
+			parentRootsIndexName,
+			sdkcollections.BytesKey,
+			encoding.U64Key,
+			func(_ math.Slot, blk BeaconBlockT) ([]byte, error) {
+				root := blk.GetParentBlockRoot()
+				return root[:], nil
+			},
+		),
 		StateRoots: sdkindexes.NewUnique(
 			sb,
 			sdkcollections.NewPrefix(stateRootsIndexName),
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+
+	sdkcollections "cosmossdk.io/collections"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Walk calls walkFn for each block in the [start, end) slot range in
+// ascending order of slots. Missed slots are skipped. The walk stops once
+// walkFn returns true or an error. The store must not be written to from
+// walkFn.
+func (kv *KVStore[BeaconBlockT]) Walk(
+	start, end math.Slot,
+	walkFn func(slot math.Slot, blk BeaconBlockT) (bool, error),
+) error {
+	if start >= end {
+		return nil
+	}
+	return kv.walk(
+		new(sdkcollections.Range[math.Slot]).
+			StartInclusive(start).
+			EndExclusive(end),
+		walkFn,
+	)
+}
+
+// WalkReverse calls walkFn for each block in the [start, end) slot range in
+// descending order of slots. Missed slots are skipped. The walk stops once
+// walkFn returns true or an error. The store must not be written to from
+// walkFn.
+func (kv *KVStore[BeaconBlockT]) WalkReverse(
+	start, end math.Slot,
+	walkFn func(slot math.Slot, blk BeaconBlockT) (bool, error),
+) error {
+	if start >= end {
+		return nil
+	}
+	return kv.walk(
+		new(sdkcollections.Range[math.Slot]).
+			StartInclusive(start).
+			EndExclusive(end).
+			Descending(),
+		walkFn,
+	)
+}
+
+// walk calls walkFn for each block in the given range.
+func (kv *KVStore[BeaconBlockT]) walk(
+	ranger sdkcollections.Ranger[math.Slot],
+	walkFn func(slot math.Slot, blk BeaconBlockT) (bool, error),
+) error {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	iter, err := kv.blocks.Iterate(context.TODO(), ranger)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		slot, err := iter.Key()
+		if err != nil {
+			return err
+		}
+		// The block is decoded lazily, so the codec must be set to the fork
+		// of the slot before reading the value.
+		kv.blockCodec.SetActiveForkVersion(
+			kv.cs.ActiveForkVersionForSlot(slot),
+		)
+		blk, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		if stop, err := walkFn(slot, blk); err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
@@ -74,3 +74,37 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
 	}
 	return slot, nil
 }
+
+// GetSlotByExecutionHash retrieves the slot by a given execution block hash
+// from the store.
+func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionHash(
+	executionHash common.ExecutionHash,
+) (math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	slot, err := kv.blocks.Indexes.ExecutionHashes.MatchExact(
+		context.TODO(), executionHash[:],
+	)
+	if err != nil {
+		return 0, err
+	}
+	return slot, nil
+}
+
+// GetSlotsByParentRoot retrieves the slots of the blocks with the given
+// parent block root from the store, in ascending order.
+func (kv *KVStore[BeaconBlockT]) GetSlotsByParentRoot(
+	parentRoot common.Root,
+) ([]math.Slot, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	iter, err := kv.blocks.Indexes.ParentRoots.MatchExact(
+		context.TODO(), parentRoot[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+	return iter.PrimaryKeys()
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package block
+
+import (
+	"encoding/binary"
+	"io"
+
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// SnapshotName is the name of the block store extension of the state
+	// sync snapshots.
+	SnapshotName = "beacon_blocks"
+	// SnapshotFormat is the format of the block store snapshot payloads.
+	SnapshotFormat uint32 = 1
+
+	// slotSize is the size of the slot prefix of a snapshot payload.
+	slotSize = 8
+)
+
+// SnapshotName implements snapshottypes.ExtensionSnapshotter.
+func (kv *KVStore[BeaconBlockT]) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements snapshottypes.ExtensionSnapshotter.
+func (kv *KVStore[BeaconBlockT]) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements snapshottypes.ExtensionSnapshotter.
+func (kv *KVStore[BeaconBlockT]) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension writes the latest block at or before the snapshot height
+// to the snapshot, so that a node restored from it can serve the block its
+// state was built from. A payload is the slot of the block followed by its
+// SSZ encoding.
+func (kv *KVStore[BeaconBlockT]) SnapshotExtension(
+	height uint64,
+	payloadWriter snapshottypes.ExtensionPayloadWriter,
+) error {
+	var payload []byte
+	if err := kv.WalkReverse(
+		0, math.Slot(height)+1,
+		func(slot math.Slot, blk BeaconBlockT) (bool, error) {
+			bz, err := blk.MarshalSSZ()
+			if err != nil {
+				return false, err
+			}
+			payload = binary.BigEndian.AppendUint64(nil, slot.Unwrap())
+			payload = append(payload, bz...)
+			return true, nil
+		},
+	); err != nil {
+		return err
+	}
+	if payload == nil {
+		return nil
+	}
+	return payloadWriter(payload)
+}
+
+// RestoreExtension writes the blocks of a snapshot to the store.
+func (kv *KVStore[BeaconBlockT]) RestoreExtension(
+	_ uint64,
+	format uint32,
+	payloadReader snapshottypes.ExtensionPayloadReader,
+) error {
+	if format != SnapshotFormat {
+		return errors.Wrapf(
+			snapshottypes.ErrUnknownFormat, "format %d", format,
+		)
+	}
+
+	var blk BeaconBlockT
+	for {
+		payload, err := payloadReader()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if len(payload) < slotSize {
+			return ErrInvalidSnapshotPayload
+		}
+
+		slot := math.Slot(binary.BigEndian.Uint64(payload))
+		if blk, err = blk.NewFromSSZ(
+			payload[slotSize:],
+			kv.cs.ActiveForkVersionForSlot(slot),
+		); err != nil {
+			return err
+		}
+		if blk.GetSlot() != slot {
+			return errors.Wrapf(
+				ErrInvalidSnapshotPayload,
+				"block slot %d, payload slot %d", blk.GetSlot(), slot,
+			)
+		}
+		if err = kv.Set(blk); err != nil {
+			return err
+		}
+	}
+}
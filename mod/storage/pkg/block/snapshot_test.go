@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package block_test
+
+import (
+	"io"
+	"testing"
+
+	"cosmossdk.io/log"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("beacon")
+
+// newMultiStore returns an empty multi store with a single IAVL store.
+func newMultiStore(t *testing.T) *rootmulti.Store {
+	t.Helper()
+	ms := rootmulti.NewStore(
+		dbm.NewMemDB(), log.NewNopLogger(), storemetrics.NewNoOpMetrics(),
+	)
+	ms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	return ms
+}
+
+// newSnapshotManager returns a snapshot manager of the given multi store,
+// with the given block store as extension.
+func newSnapshotManager(
+	t *testing.T,
+	ms *rootmulti.Store,
+	store *block.KVStore[*testBlock],
+) *snapshots.Manager {
+	t.Helper()
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(
+		snapshotStore,
+		snapshottypes.NewSnapshotOptions(0, 0),
+		ms,
+		nil,
+		log.NewNopLogger(),
+	)
+	require.NoError(t, manager.RegisterExtensions(store))
+	return manager
+}
+
+func TestKVStore_SnapshotRestore(t *testing.T) {
+	// The application store is built block by block, overwriting and
+	// deleting keys, so the shape of its IAVL tree depends on its history.
+	source := newMultiStore(t)
+	for i := range byte(3) {
+		kv := source.GetKVStore(testStoreKey)
+		kv.Set([]byte{0x01}, []byte{i})
+		kv.Set([]byte{0x02, i}, []byte{i})
+		if i > 0 {
+			kv.Delete([]byte{0x02, i - 1})
+		}
+		source.Commit()
+	}
+	sourceStore, blks := newTestStore(t, 1, 2, 3)
+	sourceManager := newSnapshotManager(t, source, sourceStore)
+
+	snapshot, err := sourceManager.Create(3)
+	require.NoError(t, err)
+
+	target := newMultiStore(t)
+	targetStore, _ := newTestStore(t)
+	targetManager := newSnapshotManager(t, target, targetStore)
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := range snapshot.Chunks {
+		var chunk []byte
+		chunk, err = sourceManager.LoadChunk(3, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+
+	// The restored store reproduces the application hash exactly.
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+
+	// Only the block at the snapshot height is carried over.
+	blk, err := targetStore.Get(3)
+	require.NoError(t, err)
+	require.Equal(t, blks[2], blk)
+	_, err = targetStore.Get(2)
+	require.Error(t, err)
+	slot, err := targetStore.GetSlotByBlockRoot(blks[2].HashTreeRoot())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(3), slot)
+}
+
+func TestKVStore_SnapshotExtension(t *testing.T) {
+	store, blks := newTestStore(t, 1, 2, 4)
+	snapshotPayloads := func(height uint64) [][]byte {
+		var payloads [][]byte
+		require.NoError(t, store.SnapshotExtension(
+			height, func(payload []byte) error {
+				payloads = append(payloads, payload)
+				return nil
+			},
+		))
+		return payloads
+	}
+
+	// The latest block at or before the height is written, a missed slot
+	// falls back to the block before it.
+	require.Len(t, snapshotPayloads(4), 1)
+	require.Equal(t, snapshotPayloads(2), snapshotPayloads(3))
+	require.Empty(t, snapshotPayloads(0))
+
+	restored, _ := newTestStore(t)
+	payloads := snapshotPayloads(3)
+	require.NoError(t, restored.RestoreExtension(
+		3, block.SnapshotFormat, payloadReader(payloads),
+	))
+	blk, err := restored.Get(2)
+	require.NoError(t, err)
+	require.Equal(t, blks[1], blk)
+
+	require.ErrorIs(t, restored.RestoreExtension(
+		3, block.SnapshotFormat+1, payloadReader(payloads),
+	), snapshottypes.ErrUnknownFormat)
+	require.ErrorIs(t, restored.RestoreExtension(
+		3, block.SnapshotFormat, payloadReader([][]byte{{0x01}}),
+	), block.ErrInvalidSnapshotPayload)
+}
+
+// payloadReader returns a reader of the given payloads.
+func payloadReader(
+	payloads [][]byte,
+) snapshottypes.ExtensionPayloadReader {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package block_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	sdkcollections "cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a minimal block, encoded as its slot followed by its parent
+// root. All other fields are derived from the slot.
+type testBlock struct {
+	slot       math.Slot
+	parentRoot common.Root
+}
+
+func newTestBlock(slot math.Slot, parent *testBlock) *testBlock {
+	blk := &testBlock{slot: slot}
+	if parent != nil {
+		blk.parentRoot = parent.HashTreeRoot()
+	}
+	return blk
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return append(
+		binary.LittleEndian.AppendUint64(nil, b.slot.Unwrap()),
+		b.parentRoot[:]...,
+	), nil
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	b.slot = math.Slot(binary.LittleEndian.Uint64(bz[:8]))
+	copy(b.parentRoot[:], bz[8:])
+	return nil
+}
+
+func (*testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	blk := new(testBlock)
+	return blk, blk.UnmarshalSSZ(bz)
+}
+
+func (*testBlock) Version() uint32 { return version.Deneb }
+
+func (b *testBlock) GetSlot() math.U64 { return b.slot }
+
+func (b *testBlock) HashTreeRoot() common.Root {
+	return common.Root{byte(b.slot), 0xbb}
+}
+
+func (b *testBlock) GetExecutionNumber() math.U64 { return b.slot + 100 }
+
+func (b *testBlock) GetExecutionHash() common.ExecutionHash {
+	return common.ExecutionHash{byte(b.slot), 0xee}
+}
+
+func (b *testBlock) GetParentBlockRoot() common.Root { return b.parentRoot }
+
+func (*testBlock) SetStateRoot(common.Root) {}
+
+func (b *testBlock) GetStateRoot() common.Root {
+	return common.Root{byte(b.slot), 0x55}
+}
+
+// testSpec is a chain spec with only the Deneb fork active.
+type testSpec struct {
+	common.ChainSpec
+}
+
+func (testSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+type kvStoreService struct {
+	corestore.KVStoreWithBatch
+}
+
+func (s *kvStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return s.KVStoreWithBatch
+}
+
+// newTestStore returns a store holding the blocks at the given slots, each
+// built on top of the previous one. Slots not given are missed.
+func newTestStore(
+	t *testing.T,
+	slots ...math.Slot,
+) (*block.KVStore[*testBlock], []*testBlock) {
+	t.Helper()
+	store := block.NewStore[*testBlock](
+		&kvStoreService{storev2.NewMemDB()},
+		testSpec{},
+		noop.NewLogger[any](),
+	)
+	var (
+		blks   []*testBlock
+		parent *testBlock
+	)
+	for _, slot := range slots {
+		blk := newTestBlock(slot, parent)
+		require.NoError(t, store.Set(blk))
+		blks = append(blks, blk)
+		parent = blk
+	}
+	return store, blks
+}
+
+// walkSlots returns the slots visited by the given walk, stopping after
+// limit blocks.
+func walkSlots(
+	t *testing.T,
+	walk func(
+		start, end math.Slot,
+		walkFn func(math.Slot, *testBlock) (bool, error),
+	) error,
+	start, end math.Slot,
+	limit int,
+) []math.Slot {
+	t.Helper()
+	var slots []math.Slot
+	require.NoError(t, walk(start, end, func(
+		slot math.Slot, blk *testBlock,
+	) (bool, error) {
+		require.Equal(t, slot, blk.GetSlot())
+		slots = append(slots, slot)
+		return len(slots) == limit, nil
+	}))
+	return slots
+}
+
+func TestKVStore_Walk(t *testing.T) {
+	store, _ := newTestStore(t, 1, 2, 4, 7, 8)
+
+	cases := []struct {
+		name       string
+		start, end math.Slot
+		limit      int
+		asc, desc  []math.Slot
+	}{
+		{
+			name: "all", start: 0, end: 10, limit: -1,
+			asc: []math.Slot{1, 2, 4, 7, 8}, desc: []math.Slot{8, 7, 4, 2, 1},
+		},
+		{
+			name: "end exclusive", start: 2, end: 8, limit: -1,
+			asc: []math.Slot{2, 4, 7}, desc: []math.Slot{7, 4, 2},
+		},
+		{
+			name: "missed slots only", start: 5, end: 7, limit: -1,
+		},
+		{
+			name: "limit", start: 0, end: 10, limit: 2,
+			asc: []math.Slot{1, 2}, desc: []math.Slot{8, 7},
+		},
+		{
+			name: "empty range", start: 4, end: 4, limit: -1,
+		},
+		{
+			name: "inverted range", start: 8, end: 1, limit: -1,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.asc, walkSlots(
+				t, store.Walk, tc.start, tc.end, tc.limit,
+			))
+			require.Equal(t, tc.desc, walkSlots(
+				t, store.WalkReverse, tc.start, tc.end, tc.limit,
+			))
+		})
+	}
+}
+
+func TestKVStore_WalkError(t *testing.T) {
+	store, _ := newTestStore(t, 1, 2, 3)
+	errStop := errors.New("stop")
+
+	var visited int
+	err := store.Walk(0, 4, func(math.Slot, *testBlock) (bool, error) {
+		visited++
+		return false, errStop
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, 1, visited)
+}
+
+func TestKVStore_GetSlotsByParentRoot(t *testing.T) {
+	store, blks := newTestStore(t, 1, 3, 4)
+
+	// Fork off two more children of the block at slot 3.
+	for _, slot := range []math.Slot{6, 5} {
+		require.NoError(t, store.Set(newTestBlock(slot, blks[1])))
+	}
+
+	slots, err := store.GetSlotsByParentRoot(blks[0].HashTreeRoot())
+	require.NoError(t, err)
+	require.Equal(t, []math.Slot{3}, slots)
+
+	slots, err = store.GetSlotsByParentRoot(blks[1].HashTreeRoot())
+	require.NoError(t, err)
+	require.Equal(t, []math.Slot{4, 5, 6}, slots)
+
+	slots, err = store.GetSlotsByParentRoot(blks[2].HashTreeRoot())
+	require.NoError(t, err)
+	require.Empty(t, slots)
+
+	// Pruned blocks are removed from the index.
+	require.NoError(t, store.Prune(0, 5))
+	slots, err = store.GetSlotsByParentRoot(blks[1].HashTreeRoot())
+	require.NoError(t, err)
+	require.Equal(t, []math.Slot{5, 6}, slots)
+}
+
+func TestKVStore_Indexes(t *testing.T) {
+	store, blks := newTestStore(t, 1, 2)
+
+	slot, err := store.GetSlotByBlockRoot(blks[1].HashTreeRoot())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	slot, err = store.GetSlotByExecutionHash(blks[1].GetExecutionHash())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	_, err = store.GetSlotByExecutionHash(common.ExecutionHash{0xff})
+	require.ErrorIs(t, err, sdkcollections.ErrNotFound)
+}
@@ -33,6 +33,8 @@ type BeaconBlock[T any] interface {
 	GetSlot() math.U64
 	HashTreeRoot() common.Root
 	GetExecutionNumber() math.U64
+	GetExecutionHash() common.ExecutionHash
+	GetParentBlockRoot() common.Root
 	SetStateRoot(root common.Root)
 	GetStateRoot() common.Root
 }
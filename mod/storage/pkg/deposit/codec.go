@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import "github.com/davecgh/go-spew/spew"
+
+// depositCodec encodes deposits without their merkle proofs, which are
+// generated from the deposit tree whenever the deposits are read for a block.
+type depositCodec[DepositT Deposit[DepositT]] struct{}
+
+// Encode marshals the provided deposit without its proof.
+func (depositCodec[DepositT]) Encode(value DepositT) ([]byte, error) {
+	return value.MarshalSSZWithoutProof()
+}
+
+// Decode unmarshals the provided bytes into a deposit without a proof.
+func (depositCodec[DepositT]) Decode(bz []byte) (DepositT, error) {
+	var v DepositT
+	v = (v).Empty()
+	return v, v.UnmarshalSSZWithoutProof(bz)
+}
+
+// EncodeJSON is not implemented and will panic if called.
+func (depositCodec[DepositT]) EncodeJSON(_ DepositT) ([]byte, error) {
+	panic("not implemented")
+}
+
+// DecodeJSON is not implemented and will panic if called.
+func (depositCodec[DepositT]) DecodeJSON(_ []byte) (DepositT, error) {
+	panic("not implemented")
+}
+
+// Stringify returns the string representation of the provided deposit.
+func (depositCodec[DepositT]) Stringify(value DepositT) string {
+	return spew.Sdump(value)
+}
+
+// ValueType returns the name of the interface that this codec is intended for.
+func (depositCodec[DepositT]) ValueType() string {
+	return "Deposit"
+}
@@ -22,21 +22,63 @@ package deposit
 
 import (
 	"context"
-	"errors"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix = "deposit"
+
+	// KeyDepositTreePrefix is the prefix of the deposit tree leaves.
+	KeyDepositTreePrefix = "tree"
+
+	// KeyLastSyncedBlockPrefix is the prefix of the last execution block
+	// whose deposit logs have been synced.
+	KeyLastSyncedBlockPrefix = "synced"
+
+	// KeyLastDepositBlockPrefix is the prefix of the execution block from
+	// which the last contiguous deposit was synced.
+	KeyLastDepositBlockPrefix = "depositblock"
+
+	// KeySyncTargetBlockPrefix is the prefix of the last execution block
+	// whose deposit logs should be synced.
+	KeySyncTargetBlockPrefix = "target"
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
+//
+// The store also maintains the incremental deposit tree over the contiguous
+// range of deposits starting at index 0. The leaves are persisted separately
+// from the deposits, since the latter are pruned once processed.
 type KVStore[DepositT Deposit[DepositT]] struct {
-	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	store  sdkcollections.Map[uint64, DepositT]
+	leaves sdkcollections.Map[uint64, []byte]
+	// lastSyncedBlock is the last execution block whose deposit logs have
+	// been synced into the store.
+	lastSyncedBlock sdkcollections.Item[uint64]
+	// lastDepositBlock is the first block of the synced range that contained
+	// the last contiguous deposit.
+	lastDepositBlock sdkcollections.Item[uint64]
+	// syncTargetBlock is the last execution block whose deposit logs should
+	// be synced into the store.
+	syncTargetBlock sdkcollections.Item[uint64]
+	// tree is the deposit tree, lazily loaded from the persisted leaves. It
+	// is nil while the tree is empty.
+	tree *merkle.Tree[common.Root]
+	// treeSize is the number of leaves in the deposit tree.
+	treeSize uint64
+	// treeLoaded indicates whether the tree has been loaded from the leaves.
+	treeLoaded bool
+	mu         sync.RWMutex
 }
 
 // NewStore creates a new deposit store.
@@ -50,7 +92,32 @@ func NewStore[DepositT Deposit[DepositT]](
 			sdkcollections.NewPrefix([]byte(KeyDepositPrefix)),
 			KeyDepositPrefix,
 			sdkcollections.Uint64Key,
-			encoding.SSZValueCodec[DepositT]{},
+			depositCodec[DepositT]{},
+		),
+		leaves: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyDepositTreePrefix)),
+			KeyDepositTreePrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		lastSyncedBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLastSyncedBlockPrefix)),
+			KeyLastSyncedBlockPrefix,
+			sdkcollections.Uint64Value,
+		),
+		lastDepositBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeyLastDepositBlockPrefix)),
+			KeyLastDepositBlockPrefix,
+			sdkcollections.Uint64Value,
+		),
+		syncTargetBlock: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte(KeySyncTargetBlockPrefix)),
+			KeySyncTargetBlockPrefix,
+			sdkcollections.Uint64Value,
 		),
 	}
 }
@@ -78,14 +145,119 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
-// EnqueueDeposit pushes the deposit to the queue.
-func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
+// GetDepositsWithProofs returns up to numView deposits starting from the
+// given index, each carrying its merkle proof against the deposit tree as it
+// was when it held the first depositCount deposits. It also returns the root
+// of that tree.
+func (kv *KVStore[DepositT]) GetDepositsWithProofs(
+	startIndex uint64,
+	numView uint64,
+	depositCount uint64,
+) ([]DepositT, common.Root, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	tree, err := kv.treeAt(depositCount)
+	if err != nil {
+		return nil, common.Root{}, err
+	}
+
+	deposits := []DepositT{}
+	for i := startIndex; i < min(startIndex+numView, depositCount); i++ {
+		var deposit DepositT
+		if deposit, err = kv.store.Get(context.TODO(), i); err != nil {
+			return nil, common.Root{}, err
+		}
+		var proof []common.Root
+		if proof, err = tree.MerkleProofWithMixin(i); err != nil {
+			return nil, common.Root{}, err
+		}
+		deposit.SetProof(proof)
+		deposits = append(deposits, deposit)
+	}
+	return deposits, rootOf(tree), nil
+}
+
+// GetDepositCount returns the number of contiguous deposits, starting at
+// index 0, that have been added to the deposit tree.
+func (kv *KVStore[DepositT]) GetDepositCount() (uint64, error) {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	if err := kv.loadTree(); err != nil {
+		return 0, err
+	}
+	return kv.treeSize, nil
 }
 
-// EnqueueDeposits pushes multiple deposits to the queue.
+// GetDepositRoot returns the root of the deposit tree as it was when it held
+// the first depositCount deposits, with the number of deposits mixed in.
+func (kv *KVStore[DepositT]) GetDepositRoot(
+	depositCount uint64,
+) (common.Root, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	tree, err := kv.treeAt(depositCount)
+	if err != nil {
+		return common.Root{}, err
+	}
+	return rootOf(tree), nil
+}
+
+// GetLastSyncedBlock returns the last execution block whose deposit logs
+// have been synced. It returns 0 if no block has been synced yet.
+func (kv *KVStore[DepositT]) GetLastSyncedBlock() (uint64, error) {
+	blockNum, err := kv.lastSyncedBlock.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return blockNum, err
+}
+
+// SetLastSyncedBlock sets the last execution block whose deposit logs have
+// been synced.
+func (kv *KVStore[DepositT]) SetLastSyncedBlock(blockNum uint64) error {
+	return kv.lastSyncedBlock.Set(context.TODO(), blockNum)
+}
+
+// GetLastDepositBlock returns the first block of the synced range that
+// contained the last contiguous deposit. It returns 0 if no deposit has been
+// synced yet.
+func (kv *KVStore[DepositT]) GetLastDepositBlock() (uint64, error) {
+	blockNum, err := kv.lastDepositBlock.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return blockNum, err
+}
+
+// SetLastDepositBlock sets the first block of the synced range that contained
+// the last contiguous deposit.
+func (kv *KVStore[DepositT]) SetLastDepositBlock(blockNum uint64) error {
+	return kv.lastDepositBlock.Set(context.TODO(), blockNum)
+}
+
+// GetSyncTargetBlock returns the last execution block whose deposit logs
+// should be synced. It returns 0 if no target has been set yet.
+func (kv *KVStore[DepositT]) GetSyncTargetBlock() (uint64, error) {
+	blockNum, err := kv.syncTargetBlock.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return 0, nil
+	}
+	return blockNum, err
+}
+
+// SetSyncTargetBlock sets the last execution block whose deposit logs should
+// be synced.
+func (kv *KVStore[DepositT]) SetSyncTargetBlock(blockNum uint64) error {
+	return kv.syncTargetBlock.Set(context.TODO(), blockNum)
+}
+
+// EnqueueDeposit pushes the deposit to the queue.
+func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
+	return kv.EnqueueDeposits([]DepositT{deposit})
+}
+
+// EnqueueDeposits pushes multiple deposits to the queue and extends the
+// deposit tree with the newly contiguous deposits.
 func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
@@ -94,7 +266,7 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 			return err
 		}
 	}
-	return nil
+	return kv.extendTree()
 }
 
 // setDeposit sets the deposit in the store.
@@ -102,14 +274,143 @@ func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
 	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
 }
 
+// loadTree loads the deposit tree from the persisted leaves, if it has not
+// been loaded yet.
+func (kv *KVStore[DepositT]) loadTree() error {
+	if kv.treeLoaded {
+		return nil
+	}
+
+	iter, err := kv.leaves.Iterate(context.TODO(), nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	leaves := []common.Root{}
+	for ; iter.Valid(); iter.Next() {
+		var leaf []byte
+		if leaf, err = iter.Value(); err != nil {
+			return err
+		}
+		leaves = append(leaves, common.Root(leaf))
+	}
+
+	kv.treeSize = uint64(len(leaves))
+	if len(leaves) > 0 {
+		if kv.tree, err = merkle.NewTreeFromLeavesWithDepth(
+			leaves, constants.DepositContractDepth,
+		); err != nil {
+			return err
+		}
+	}
+	kv.treeLoaded = true
+	return nil
+}
+
+// extendTree appends the leaves of the stored deposits that directly follow
+// the last leaf of the deposit tree.
+func (kv *KVStore[DepositT]) extendTree() error {
+	if err := kv.loadTree(); err != nil {
+		return err
+	}
+
+	for index := kv.treeSize; ; index++ {
+		deposit, err := kv.store.Get(context.TODO(), index)
+		if errors.Is(err, sdkcollections.ErrNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		leaf := deposit.GetDataRoot()
+		if err = kv.leaves.Set(context.TODO(), index, leaf[:]); err != nil {
+			return err
+		}
+		if kv.tree == nil {
+			kv.tree, err = merkle.NewTreeFromLeavesWithDepth(
+				[]common.Root{leaf}, constants.DepositContractDepth,
+			)
+		} else {
+			//#nosec:G701 // the deposit tree holds at most 2^32 leaves.
+			err = kv.tree.Insert(leaf, int(index))
+		}
+		if err != nil {
+			return err
+		}
+		kv.treeSize++
+	}
+}
+
+// treeAt returns the deposit tree as it was when it held the first
+// depositCount deposits. It returns nil if the tree was empty.
+func (kv *KVStore[DepositT]) treeAt(
+	depositCount uint64,
+) (*merkle.Tree[common.Root], error) {
+	if err := kv.loadTree(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case depositCount > kv.treeSize:
+		return nil, errors.Wrapf(
+			ErrDepositTreeBehind,
+			"requested %d deposits, have %d", depositCount, kv.treeSize,
+		)
+	case depositCount == kv.treeSize:
+		return kv.tree, nil
+	case depositCount == 0:
+		//nolint:nilnil // the empty tree is nil.
+		return nil, nil
+	}
+
+	// Rebuild the tree over the first depositCount leaves, which only happens
+	// when the deposit tree is ahead of the deposits being looked at.
+	leaves := make([]common.Root, 0, depositCount)
+	iter, err := kv.leaves.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).EndExclusive(depositCount),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var leaf []byte
+		if leaf, err = iter.Value(); err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, common.Root(leaf))
+	}
+	return merkle.NewTreeFromLeavesWithDepth(
+		leaves, constants.DepositContractDepth,
+	)
+}
+
+// rootOf returns the root of the given deposit tree, with the number of
+// leaves mixed in.
+func rootOf(tree *merkle.Tree[common.Root]) common.Root {
+	if tree == nil {
+		return emptyTreeRoot()
+	}
+	return tree.HashTreeRoot()
+}
+
+// emptyTreeRoot returns the root of the empty deposit tree.
+func emptyTreeRoot() common.Root {
+	return merkle.NewHasher[common.Root](sha256.Hash).MixIn(
+		common.Root(zero.Hashes[constants.DepositContractDepth]), 0,
+	)
+}
+
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	var ctx = context.TODO()
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	for i := range end {
+	for i := start; i < end; i++ {
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(ctx, start+i); err != nil {
+		if err := kv.store.Remove(ctx, i); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+// testDeposit is a minimal deposit, encoded as its index and amount.
+type testDeposit struct {
+	index  uint64
+	amount uint64
+	proof  []common.Root
+}
+
+func (*testDeposit) Empty() *testDeposit { return &testDeposit{} }
+
+func (d *testDeposit) MarshalSSZWithoutProof() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(
+		binary.LittleEndian.AppendUint64(nil, d.index), d.amount,
+	), nil
+}
+
+func (d *testDeposit) UnmarshalSSZWithoutProof(bz []byte) error {
+	d.index = binary.LittleEndian.Uint64(bz[:8])
+	d.amount = binary.LittleEndian.Uint64(bz[8:])
+	return nil
+}
+
+func (d *testDeposit) GetIndex() math.U64 { return math.U64(d.index) }
+
+func (d *testDeposit) GetDataRoot() common.Root {
+	return sha256.Hash(binary.LittleEndian.AppendUint64(nil, d.amount))
+}
+
+func (d *testDeposit) SetProof(proof []common.Root) { d.proof = proof }
+
+type kvStoreService struct {
+	corestore.KVStoreWithBatch
+}
+
+func (s *kvStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	return s.KVStoreWithBatch
+}
+
+func newTestStore() *deposit.KVStore[*testDeposit] {
+	return deposit.NewStore[*testDeposit](
+		&kvStoreService{storev2.NewMemDB()},
+	)
+}
+
+func newTestDeposits(start, end uint64) []*testDeposit {
+	deposits := make([]*testDeposit, 0, end-start)
+	for i := start; i < end; i++ {
+		deposits = append(deposits, &testDeposit{index: i, amount: 32 + i})
+	}
+	return deposits
+}
+
+func TestGetDepositsWithProofs(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, store.EnqueueDeposits(newTestDeposits(0, 5)))
+
+	// The proofs are against the tree over the requested number of
+	// deposits, which may be behind the latest deposits.
+	for _, count := range []uint64{5, 4} {
+		deposits, root, err := store.GetDepositsWithProofs(2, 16, count)
+		require.NoError(t, err)
+		require.Len(t, deposits, int(count-2))
+
+		expected, err := store.GetDepositRoot(count)
+		require.NoError(t, err)
+		require.Equal(t, expected, root)
+		for _, dep := range deposits {
+			require.True(t, merkle.IsValidMerkleBranch(
+				dep.GetDataRoot(),
+				dep.proof,
+				constants.DepositContractDepth+1,
+				dep.index,
+				root,
+			))
+		}
+	}
+
+	_, _, err := store.GetDepositsWithProofs(2, 16, 6)
+	require.ErrorIs(t, err, deposit.ErrDepositTreeBehind)
+
+	// The proofs are generated on read rather than persisted.
+	stored, err := store.GetDepositsByIndex(2, 1)
+	require.NoError(t, err)
+	require.Nil(t, stored[0].proof)
+}
+
+func TestGetDepositRoot(t *testing.T) {
+	store := newTestStore()
+	_, emptyRoot, err := store.GetDepositsWithProofs(0, 0, 0)
+	require.NoError(t, err)
+
+	// Record the root as the tree grows, one deposit at a time.
+	roots := []common.Root{emptyRoot}
+	for i, dep := range newTestDeposits(0, 5) {
+		require.NoError(t, store.EnqueueDeposit(dep))
+		var root common.Root
+		_, root, err = store.GetDepositsWithProofs(0, 0, uint64(i+1))
+		require.NoError(t, err)
+		roots = append(roots, root)
+	}
+
+	// The historical roots are rebuilt from the persisted leaves.
+	for count, expected := range roots {
+		var root common.Root
+		root, err = store.GetDepositRoot(uint64(count))
+		require.NoError(t, err)
+		require.Equal(t, expected, root, "deposit count %d", count)
+	}
+
+	_, err = store.GetDepositRoot(uint64(len(roots)))
+	require.ErrorIs(t, err, deposit.ErrDepositTreeBehind)
+}
+
+func TestGetDepositRootNonContiguous(t *testing.T) {
+	store := newTestStore()
+	deposits := newTestDeposits(0, 4)
+
+	// A gap keeps the later deposits out of the tree until it is filled.
+	require.NoError(t, store.EnqueueDeposits(
+		[]*testDeposit{deposits[0], deposits[2], deposits[3]},
+	))
+	count, err := store.GetDepositCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	require.NoError(t, store.EnqueueDeposit(deposits[1]))
+	count, err = store.GetDepositCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+}
+
+func TestPrune(t *testing.T) {
+	store := newTestStore()
+	require.NoError(t, store.EnqueueDeposits(newTestDeposits(0, 6)))
+
+	// Only the deposits in [start, end) are removed.
+	require.NoError(t, store.Prune(2, 4))
+	deposits, err := store.GetDepositsByIndex(0, 2)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	deposits, err = store.GetDepositsByIndex(2, 4)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+	deposits, err = store.GetDepositsByIndex(4, 2)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+
+	// The deposit tree is kept.
+	count, err := store.GetDepositCount()
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), count)
+}
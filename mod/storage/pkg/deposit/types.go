@@ -21,13 +21,22 @@
 package deposit
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // Deposit is a struct that represents a deposit.
 type Deposit[DepositT any] interface {
-	constraints.SSZMarshallable
 	constraints.Empty[DepositT]
+	// MarshalSSZWithoutProof marshals the deposit, leaving out its proof.
+	MarshalSSZWithoutProof() ([]byte, error)
+	// UnmarshalSSZWithoutProof unmarshals a deposit marshalled by
+	// MarshalSSZWithoutProof.
+	UnmarshalSSZWithoutProof([]byte) error
 	GetIndex() math.U64
+	// GetDataRoot returns the leaf of the deposit in the deposit tree.
+	GetDataRoot() common.Root
+	// SetProof sets the merkle proof of the deposit.
+	SetProof([]common.Root)
 }
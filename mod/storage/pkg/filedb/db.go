@@ -21,14 +21,40 @@
 package filedb
 
 import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/spf13/afero"
 )
 
+const (
+	// headerSize is the size of the header prepended to every value, made of
+	// the header magic and the CRC-32C checksum of the value.
+	headerSize = 8
+	// tmpSuffix is the suffix of the temporary files values are written to
+	// before they are renamed to the file of their key.
+	tmpSuffix = ".tmp"
+	// QuarantineDir is the directory corrupt entries are moved to.
+	QuarantineDir = "quarantine"
+)
+
+var (
+	// headerMagic identifies the files written with a checksum header. Files
+	// without it were written before checksums were introduced and hold the
+	// bare value.
+	headerMagic = []byte("BKFD")
+	// crcTable is the CRC-32C table of the value checksums.
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -38,6 +64,7 @@ type DB struct {
 	rootDir   string
 	extension string
 	dirPerms  os.FileMode
+	fsync     bool
 }
 
 // NewDB creates a new instance of the DB.
@@ -53,9 +80,32 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
-// Get retrieves the value for a key.
+// Get retrieves the value for a key. Values that do not match the checksum
+// they were written with are moved to the quarantine directory and
+// ErrCorruptEntry is returned. Values written without a checksum header are
+// rewritten with one.
 func (db *DB) Get(key []byte) ([]byte, error) {
-	return afero.ReadFile(db.fs, db.pathForKey(key))
+	path := db.pathForKey(key)
+	bz, err := afero.ReadFile(db.fs, path)
+	if err != nil {
+		return nil, err
+	}
+	value, legacy, err := decodeValue(bz)
+	if err != nil {
+		if qErr := db.quarantine(path); qErr != nil {
+			err = errors.Join(err, qErr)
+		}
+		return nil, errors.Wrapf(err, "key %s", key)
+	}
+	if legacy {
+		if err = db.writeValue(path, value); err != nil {
+			db.logger.Warn(
+				"failed to add checksum to legacy value",
+				"key", key, "error", err,
+			)
+		}
+	}
+	return value, nil
 }
 
 // Has returns true if the key exists in the database.
@@ -67,38 +117,264 @@ func (db *DB) Has(key []byte) (bool, error) {
 	return exists, nil
 }
 
-// Set stores the value for a key.
+// Set stores the value for a key. The value is written along with its
+// checksum to a temporary file, which is renamed to the file of the key once
+// it is complete, so that a crash never leaves a partially written value
+// behind. If fsync is enabled, the file and its directory are synced to disk
+// before Set returns.
 func (db *DB) Set(key []byte, value []byte) error {
-	if exists, err := afero.Exists(db.fs, db.pathForKey(key)); err != nil {
+	path := db.pathForKey(key)
+	if exists, err := afero.Exists(db.fs, path); err != nil {
 		return err
 	} else if exists {
 		db.logger.Warn("Overriding existing key", "key", key)
 	}
+	if err := db.writeValue(path, value); err != nil {
+		return err
+	}
+	db.logger.Debug("wrote value", "bytes", len(value), "path", path)
+	return nil
+}
 
-	if err := db.fs.MkdirAll(
-		filepath.Dir(db.pathForKey(key)), db.dirPerms,
-	); err != nil {
+// writeValue atomically writes the value along with its checksum to the file
+// at the given path.
+func (db *DB) writeValue(path string, value []byte) error {
+	dir := filepath.Dir(path)
+	if err := db.fs.MkdirAll(dir, db.dirPerms); err != nil {
 		return err
 	}
 
-	file, err := db.fs.Create(db.pathForKey(key))
+	file, err := afero.TempFile(db.fs, dir, filepath.Base(path)+".*"+tmpSuffix)
 	if err != nil {
 		return errors.Wrap(err, "failed to create file")
 	}
-	defer file.Close()
+	tmpPath := filepath.Join(dir, filepath.Base(file.Name()))
+	if err = db.writeFile(file, value); err != nil {
+		_ = db.fs.Remove(tmpPath)
+		return err
+	}
+
+	if err = db.fs.Rename(tmpPath, path); err != nil {
+		_ = db.fs.Remove(tmpPath)
+		return errors.Wrap(err, "failed to rename file")
+	}
+	if db.fsync {
+		return db.syncDir(dir)
+	}
+	return nil
+}
 
-	n, err := file.Write(value)
+// writeFile writes the value along with its checksum header to the file and
+// closes it.
+func (db *DB) writeFile(file afero.File, value []byte) error {
+	_, err := file.Write(encodeHeader(value))
+	if err == nil {
+		_, err = file.Write(value)
+	}
 	if err != nil {
+		_ = file.Close()
 		return errors.Wrap(err, "failed to write to file")
 	}
-	db.logger.Debug("wrote %d bytes to %s", n, db.pathForKey(key))
+	if db.fsync {
+		if err = file.Sync(); err != nil {
+			_ = file.Close()
+			return errors.Wrap(err, "failed to sync file")
+		}
+	}
+	return file.Close()
+}
 
+// syncDir syncs the directory to disk, making the renames of its files
+// durable.
+func (db *DB) syncDir(dir string) error {
+	d, err := db.fs.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	if err = d.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync directory")
+	}
 	return nil
 }
 
-// Delete removes the value for a key.
+// Delete removes the value for a key. The directory of the key is removed
+// as well once it holds no other keys.
 func (db *DB) Delete(key []byte) error {
-	return db.fs.RemoveAll(db.pathForKey(key))
+	path := db.pathForKey(key)
+	if err := db.fs.RemoveAll(path); err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if dir == "." {
+		return nil
+	}
+	if empty, err := afero.IsEmpty(db.fs, dir); err != nil || !empty {
+		//nolint:nilerr // the directory may have been removed already.
+		return nil
+	}
+	return db.fs.Remove(dir)
+}
+
+// Keys returns the keys starting with the given prefix, ordered by key. Keys
+// map to file paths, so only the directory of the prefix is listed and files
+// without the extension of the database are ignored.
+func (db *DB) Keys(prefix []byte) ([][]byte, error) {
+	dir := filepath.Dir(string(prefix))
+	exists, err := afero.DirExists(db.fs, dir)
+	if err != nil || !exists {
+		return nil, err
+	}
+	entries, err := afero.ReadDir(db.fs, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ext := "." + db.extension
+	keys := make([][]byte, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		key := filepath.Join(dir, strings.TrimSuffix(name, ext))
+		if strings.HasPrefix(key, string(prefix)) {
+			keys = append(keys, []byte(key))
+		}
+	}
+	return keys, nil
+}
+
+// Dirs returns the top-level directories of the keys, ordered by name. The
+// quarantine directory is not included.
+func (db *DB) Dirs() ([]string, error) {
+	entries, err := afero.ReadDir(db.fs, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() != QuarantineDir {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+	return dirs, nil
+}
+
+// Recover prepares the database for use after an unclean shutdown. It
+// removes the temporary files of interrupted writes and moves the entries
+// with a truncated checksum header to the quarantine directory, where they
+// are kept for inspection. It returns the keys of the quarantined entries.
+// Only the headers are read, the values are verified against their checksum
+// when they are read.
+func (db *DB) Recover() ([][]byte, error) {
+	var (
+		quarantined [][]byte
+		ext         = "." + db.extension
+	)
+	err := afero.Walk(db.fs, ".", func(
+		path string, info fs.FileInfo, err error,
+	) error {
+		switch {
+		case errors.Is(err, fs.ErrNotExist) && path == ".":
+			// Nothing has been written yet.
+			return nil
+		case err != nil:
+			return err
+		case info.IsDir() && path == QuarantineDir:
+			return filepath.SkipDir
+		case info.IsDir():
+			return nil
+		case strings.HasSuffix(path, tmpSuffix):
+			return db.fs.Remove(path)
+		case !strings.HasSuffix(path, ext):
+			return nil
+		}
+
+		var valid bool
+		valid, err = db.hasValidHeader(path, info.Size())
+		if err != nil || valid {
+			return err
+		}
+
+		key := []byte(strings.TrimSuffix(path, ext))
+		if err = db.quarantine(path); err != nil {
+			return err
+		}
+		quarantined = append(quarantined, key)
+		return nil
+	})
+	return quarantined, err
+}
+
+// quarantine moves the file at the given path to the quarantine directory.
+func (db *DB) quarantine(path string) error {
+	dst := filepath.Join(QuarantineDir, path)
+	if err := db.fs.MkdirAll(filepath.Dir(dst), db.dirPerms); err != nil {
+		return err
+	}
+	if err := db.fs.Rename(path, dst); err != nil {
+		return errors.Wrap(err, "failed to quarantine file")
+	}
+	return nil
+}
+
+// hasValidHeader returns whether the file at the given path, of the given
+// size, starts with a complete checksum header or holds a legacy value.
+func (db *DB) hasValidHeader(path string, size int64) (bool, error) {
+	f, err := db.fs.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	header := make([]byte, min(size, headerSize))
+	if _, err = io.ReadFull(f, header); err != nil {
+		return false, err
+	}
+	if !hasHeaderMagic(header) {
+		return true, nil
+	}
+	return size >= headerSize, nil
+}
+
+// hasHeaderMagic returns whether the file contents start with the header
+// magic.
+func hasHeaderMagic(bz []byte) bool {
+	return bytes.HasPrefix(bz, headerMagic)
+}
+
+// encodeHeader returns the checksum header of the value.
+func encodeHeader(value []byte) []byte {
+	header := make([]byte, headerSize)
+	copy(header, headerMagic)
+	binary.BigEndian.PutUint32(
+		header[len(headerMagic):], crc32.Checksum(value, crcTable),
+	)
+	return header
+}
+
+// decodeValue returns the value of the file contents after verifying it
+// against its checksum header. Contents without the header magic are
+// returned as is and reported as legacy.
+func decodeValue(bz []byte) ([]byte, bool, error) {
+	if !hasHeaderMagic(bz) {
+		return bz, true, nil
+	}
+	if len(bz) < headerSize {
+		return nil, false, errors.Wrap(
+			ErrCorruptEntry, "truncated checksum header",
+		)
+	}
+	value := bz[headerSize:]
+	checksum := binary.BigEndian.Uint32(bz[len(headerMagic):headerSize])
+	if crc32.Checksum(value, crcTable) != checksum {
+		return nil, false, errors.Wrap(ErrCorruptEntry, "checksum mismatch")
+	}
+	return value, false, nil
 }
 
 // pathForKey returns the path for a key.
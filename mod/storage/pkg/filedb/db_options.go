@@ -54,6 +54,15 @@ func WithFileExtension(extension string) Option {
 	}
 }
 
+// WithFsync sets whether values are synced to disk before they are
+// reported as written.
+func WithFsync(fsync bool) Option {
+	return func(db *DB) error {
+		db.fsync = fsync
+		return nil
+	}
+}
+
 // WithLogger sets the logger for the database.
 func WithLogger(logger log.Logger[any]) Option {
 	return func(db *DB) error {
@@ -21,6 +21,8 @@
 package filedb_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -111,6 +113,36 @@ func TestDB(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			name: "Keys",
+			setupFunc: func(db *file.DB) error {
+				for _, key := range []string{"1/b", "1/a", "2/c"} {
+					if err := db.Set([]byte(key), []byte("value")); err != nil {
+						return err
+					}
+				}
+				// Files without the extension of the database are not keys.
+				return os.WriteFile(
+					filepath.Join("/tmp/testdb", "1", "stray.tmp"), nil, 0600,
+				)
+			},
+			testFunc: func(t *testing.T, db *file.DB) {
+				t.Helper()
+				keys, err := db.Keys([]byte("1/"))
+				require.NoError(t, err)
+				require.Equal(t, [][]byte{[]byte("1/a"), []byte("1/b")}, keys)
+
+				keys, err = db.Keys([]byte("3/"))
+				require.NoError(t, err)
+				require.Empty(t, keys)
+
+				// Deleting the last key of a directory removes it.
+				require.NoError(t, db.Delete([]byte("2/c")))
+				_, err = os.Stat(filepath.Join("/tmp/testdb", "2"))
+				require.ErrorIs(t, err, os.ErrNotExist)
+				require.NoError(t, os.RemoveAll("/tmp/testdb/1"))
+			},
+		},
 		// If the key does not exist, `Has` will return false with error as nil
 		{
 			name: "HasNonExistingKey",
@@ -293,3 +325,129 @@ func TestDB_Set_MkDirError(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_Checksum(t *testing.T) {
+	for _, fsync := range []bool{false, true} {
+		dir := t.TempDir()
+		db := newTestDBAt(dir, file.WithFsync(fsync))
+		require.NoError(t, db.Set([]byte("1/key"), []byte("value")))
+
+		// The value is renamed into place, leaving no temporary file behind.
+		entries, err := os.ReadDir(filepath.Join(dir, "1"))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "key.txt", entries[0].Name())
+
+		value, err := db.Get([]byte("1/key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+
+		// A truncated value is detected and quarantined.
+		path := filepath.Join(dir, "1", "key.txt")
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0600))
+		_, err = db.Get([]byte("1/key"))
+		require.ErrorIs(t, err, file.ErrCorruptEntry)
+		_, err = os.Stat(filepath.Join(dir, file.QuarantineDir, "1", "key.txt"))
+		require.NoError(t, err)
+		exists, err := db.Has([]byte("1/key"))
+		require.NoError(t, err)
+		require.False(t, exists)
+
+		// A value written without a checksum header is read as is and
+		// rewritten with one.
+		require.NoError(t, os.WriteFile(path, []byte("value"), 0600))
+		value, err = db.Get([]byte("1/key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+		rewritten, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, bz, rewritten)
+		value, err = db.Get([]byte("1/key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), value)
+	}
+}
+
+func TestDB_Recover(t *testing.T) {
+	dir := t.TempDir()
+	db := newTestDBAt(dir)
+	require.NoError(t, db.Set([]byte("1/good"), []byte("value")))
+	require.NoError(t, db.Set([]byte("2/bad"), []byte("value")))
+	require.NoError(t, db.Set([]byte("2/flipped"), []byte("value")))
+
+	// Truncate the header of an entry, flip a bit in the value of another,
+	// write a legacy entry without a header and leave behind the temporary
+	// file of an interrupted write.
+	badPath := filepath.Join(dir, "2", "bad.txt")
+	bz, err := os.ReadFile(badPath)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(badPath, bz[:5], 0600))
+	flippedPath := filepath.Join(dir, "2", "flipped.txt")
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(flippedPath, bz, 0600))
+	legacyPath := filepath.Join(dir, "1", "legacy.txt")
+	require.NoError(t, os.WriteFile(legacyPath, []byte("value"), 0600))
+	tmpPath := filepath.Join(dir, "1", "next.txt.123.tmp")
+	require.NoError(t, os.WriteFile(tmpPath, []byte("val"), 0600))
+
+	quarantined, err := db.Recover()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("2/bad")}, quarantined)
+
+	// The corrupt entry is kept in quarantine and the temporary file is
+	// removed.
+	_, err = os.Stat(filepath.Join(dir, file.QuarantineDir, "2", "bad.txt"))
+	require.NoError(t, err)
+	_, err = os.Stat(tmpPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	exists, err := db.Has([]byte("2/bad"))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	value, err := db.Get([]byte("1/good"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	value, err = db.Get([]byte("1/legacy"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// Values are only verified against their checksum when read.
+	_, err = db.Get([]byte("2/flipped"))
+	require.ErrorIs(t, err, file.ErrCorruptEntry)
+	_, err = os.Stat(
+		filepath.Join(dir, file.QuarantineDir, "2", "flipped.txt"),
+	)
+	require.NoError(t, err)
+
+	// The quarantine is not scanned again.
+	quarantined, err = db.Recover()
+	require.NoError(t, err)
+	require.Empty(t, quarantined)
+
+	dirs, err := db.Dirs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, dirs)
+}
+
+func TestDB_Recover_Empty(t *testing.T) {
+	db := newTestDBAt(filepath.Join(t.TempDir(), "missing"))
+	quarantined, err := db.Recover()
+	require.NoError(t, err)
+	require.Empty(t, quarantined)
+
+	dirs, err := db.Dirs()
+	require.NoError(t, err)
+	require.Empty(t, dirs)
+}
+
+// newTestDBAt returns a new file DB rooted at the given directory.
+func newTestDBAt(dir string, opts ...file.Option) *file.DB {
+	return file.NewDB(append([]file.Option{
+		file.WithRootDirectory(dir),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	}, opts...)...)
+}
@@ -23,6 +23,7 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -53,6 +54,40 @@ func NewRangeDB(db db.DB) *RangeDB {
 	}
 }
 
+// RebuildIndex restores the first non-nil index from the indexes stored in
+// the database, which is required after a restart since the index is only
+// kept in memory. The database must list the directories of its keys, as DB
+// does.
+func (db *RangeDB) RebuildIndex() error {
+	lister, ok := db.DB.(interface{ Dirs() ([]string, error) })
+	if !ok {
+		return ErrIndexRebuildUnsupported
+	}
+	dirs, err := lister.Dirs()
+	if err != nil {
+		return err
+	}
+
+	// With nothing stored, the first index set becomes the first non-nil
+	// index.
+	first := uint64(math.MaxUint64)
+	for _, dir := range dirs {
+		index, parseErr := strconv.ParseUint(dir, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		keys, keysErr := db.DB.Keys(db.indexPrefix(index))
+		if keysErr != nil {
+			return keysErr
+		}
+		if len(keys) > 0 {
+			first = min(first, index)
+		}
+	}
+	db.firstNonNilIndex = first
+	return nil
+}
+
 // Get retrieves the value associated with the given index and key.
 // It prefixes the key with the index and a slash before querying the underlying
 // database.
@@ -60,6 +95,24 @@ func (db *RangeDB) Get(index uint64, key []byte) ([]byte, error) {
 	return db.DB.Get(db.prefix(index, key))
 }
 
+// GetAll retrieves all values stored under the given index, ordered by key.
+// It returns an empty slice if nothing is stored under the index.
+func (db *RangeDB) GetAll(index uint64) ([][]byte, error) {
+	keys, err := db.DB.Keys(db.indexPrefix(index))
+	if err != nil {
+		return nil, err
+	}
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, err := db.DB.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
 // Has checks if the given index and key exist in the database.
 // It prefixes the key with the index and a slash before querying the underlying
 // database.
@@ -86,18 +139,19 @@ func (db *RangeDB) Delete(index uint64, key []byte) error {
 }
 
 // DeleteRange removes all values associated with the given index from the
-// filesystem. It is INCLUSIVE of the `from` index and EXCLUSIVE of
+// database. It is INCLUSIVE of the `from` index and EXCLUSIVE of
 // the `to“ index.
 func (db *RangeDB) DeleteRange(from, to uint64) error {
-	f, ok := db.DB.(*DB)
-	if !ok {
-		return errors.New("rangedb: delete range not supported for this db")
-	}
 	for ; from < to; from++ {
-		path := strconv.FormatUint(from, 10) + "/"
-		if err := f.fs.RemoveAll(path); err != nil {
+		keys, err := db.DB.Keys(db.indexPrefix(from))
+		if err != nil {
 			return err
 		}
+		for _, key := range keys {
+			if err = db.DB.Delete(key); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -119,7 +173,12 @@ func (db *RangeDB) Prune(start, end uint64) error {
 
 // prefix prefixes the given key with the index and a slash.
 func (db *RangeDB) prefix(index uint64, key []byte) []byte {
-	return []byte(fmt.Sprintf("%d/%s", index, hex.FromBytes(key).Unwrap()))
+	return append(db.indexPrefix(index), hex.FromBytes(key).Unwrap()...)
+}
+
+// indexPrefix returns the prefix of the keys stored under the given index.
+func (db *RangeDB) indexPrefix(index uint64) []byte {
+	return []byte(fmt.Sprintf("%d/", index))
 }
 
 // ExtractIndex extracts the index from a prefixed key.
@@ -21,6 +21,7 @@
 package filedb_test
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -66,6 +67,34 @@ func TestRangeDB(t *testing.T) {
 				require.True(t, exists)
 			},
 		},
+		{
+			name: "GetAll",
+			setupFunc: func(rdb *file.RangeDB) error {
+				if err := rdb.Set(
+					10, []byte("testKey1"), []byte("testValue1"),
+				); err != nil {
+					return err
+				}
+				if err := rdb.Set(
+					10, []byte("testKey2"), []byte("testValue2"),
+				); err != nil {
+					return err
+				}
+				return rdb.Set(11, []byte("testKey3"), []byte("testValue3"))
+			},
+			testFunc: func(t *testing.T, rdb *file.RangeDB) {
+				t.Helper()
+				values, err := rdb.GetAll(10)
+				require.NoError(t, err)
+				require.Equal(t, [][]byte{
+					[]byte("testValue1"), []byte("testValue2"),
+				}, values)
+
+				values, err = rdb.GetAll(12)
+				require.NoError(t, err)
+				require.Empty(t, values)
+			},
+		},
 		{
 			name: "Set",
 			setupFunc: func(_ *file.RangeDB) error {
@@ -194,13 +223,13 @@ func TestExtractIndex(t *testing.T) {
 
 // =========================== PRUNING =====================================
 
-func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
+func TestRangeDB_DeleteRange_KeysError(t *testing.T) {
 	tests := []struct {
 		name string
 		db   *mocks.DB
 	}{
 		{
-			name: "DeleteRangeNotSupported",
+			name: "DeleteRangeKeysError",
 			db:   new(mocks.DB),
 		},
 	}
@@ -208,16 +237,14 @@ func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Helper()
-			tt.db.On("DeleteRange", mock.Anything, mock.Anything).
-				Return(errors.New("rangedb: delete range not supported for this db"))
+			tt.db.On("Keys", mock.Anything).
+				Return(nil, errors.New("rangedb: keys not available"))
 
 			rdb := file.NewRangeDB(tt.db)
 
 			err := rdb.DeleteRange(1, 4)
 			require.Error(t, err)
-			require.Equal(t,
-				"rangedb: delete range not supported for this db",
-				err.Error())
+			require.Equal(t, "rangedb: keys not available", err.Error())
 		})
 	}
 }
@@ -277,6 +304,38 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_RebuildIndex(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, populateTestDB(file.NewRangeDB(newTestDBAt(dir)), 5, 8))
+
+	// A restarted database starts pruning from the first stored index.
+	rdb := file.NewRangeDB(newTestDBAt(dir))
+	require.Zero(t, getFirstNonNilIndex(rdb))
+	require.NoError(t, rdb.RebuildIndex())
+	require.Equal(t, uint64(5), getFirstNonNilIndex(rdb))
+
+	require.NoError(t, rdb.Prune(0, 7))
+	requireNotExist(t, rdb, 5, 6)
+	requireExist(t, rdb, 7, 8)
+
+	// Directories emptied without being removed are skipped.
+	require.NoError(t, rdb.Delete(7, []byte("key")))
+	require.NoError(t, rdb.RebuildIndex())
+	require.Equal(t, uint64(8), getFirstNonNilIndex(rdb))
+
+	// An empty database takes the first index set.
+	rdb = file.NewRangeDB(newTestDBAt(t.TempDir()))
+	require.NoError(t, rdb.RebuildIndex())
+	require.Equal(t, uint64(math.MaxUint64), getFirstNonNilIndex(rdb))
+	require.NoError(t, populateTestDB(rdb, 3, 4))
+	require.Equal(t, uint64(3), getFirstNonNilIndex(rdb))
+}
+
+func TestRangeDB_RebuildIndex_Unsupported(t *testing.T) {
+	rdb := file.NewRangeDB(new(mocks.DB))
+	require.ErrorIs(t, rdb.RebuildIndex(), file.ErrIndexRebuildUnsupported)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -26,6 +26,8 @@ type DB interface {
 	Has(key []byte) (bool, error)
 	Set(key []byte, value []byte) error
 	Delete(key []byte) error
+	// Keys returns the keys starting with the given prefix, ordered by key.
+	Keys(prefix []byte) ([][]byte, error)
 
 	// TODO: add Batch and full DB stuff.
 }
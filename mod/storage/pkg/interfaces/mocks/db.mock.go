@@ -177,6 +177,64 @@ func (_c *DB_Has_Call) RunAndReturn(run func([]byte) (bool, error)) *DB_Has_Call
 	return _c
 }
 
+// Keys provides a mock function with given fields: prefix
+func (_m *DB) Keys(prefix []byte) ([][]byte, error) {
+	ret := _m.Called(prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 [][]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([][]byte, error)); ok {
+		return rf(prefix)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) [][]byte); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DB_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type DB_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+//   - prefix []byte
+func (_e *DB_Expecter) Keys(prefix interface{}) *DB_Keys_Call {
+	return &DB_Keys_Call{Call: _e.mock.On("Keys", prefix)}
+}
+
+func (_c *DB_Keys_Call) Run(run func(prefix []byte)) *DB_Keys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte))
+	})
+	return _c
+}
+
+func (_c *DB_Keys_Call) Return(_a0 [][]byte, _a1 error) *DB_Keys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DB_Keys_Call) RunAndReturn(run func([]byte) ([][]byte, error)) *DB_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function with given fields: key, value
 func (_m *DB) Set(key []byte, value []byte) error {
 	ret := _m.Called(key, value)
@@ -52,11 +52,11 @@ func TestDBManager_Start(t *testing.T) {
 	p1 := pruner.NewPruner[
 		manager.BeaconBlock,
 		*mocks.Prunable,
-	](logger, mockPrunable, "pruner1", ch, pruneParamsFn)
+	](logger, mockPrunable, "pruner1", ch, pruneParamsFn, nil, 0)
 	p2 := pruner.NewPruner[
 		manager.BeaconBlock,
 		*mocks.Prunable,
-	](logger, mockPrunable, "pruner2", ch, pruneParamsFn)
+	](logger, mockPrunable, "pruner2", ch, pruneParamsFn, nil, 0)
 
 	m, err := manager.NewDBManager(logger, p1, p2)
 	require.NoError(t, err)
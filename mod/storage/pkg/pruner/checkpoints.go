@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package pruner
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
+)
+
+const (
+	// cursorsDir is the directory of the prune cursors.
+	cursorsDir = "cursors"
+	// targetsDir is the directory of the retained prune targets.
+	targetsDir = "targets"
+
+	// cursorSize is the size of an encoded cursor.
+	cursorSize = 8
+	// targetSize is the size of an encoded target range.
+	targetSize = 16
+)
+
+// Target is a range of a prunable that can be pruned once the slot at which
+// it was recorded leaves the retention window.
+type Target struct {
+	// Slot is the slot at which the target was recorded.
+	Slot uint64
+	// Start is the first index of the range.
+	Start uint64
+	// End is the index past the end of the range.
+	End uint64
+}
+
+// Checkpoints persists the progress of the pruners, so that pruning resumes
+// where it left off after a restart. For each prunable, it keeps the cursor up
+// to which the prunable has been pruned and the targets that are still within
+// the retention window.
+type Checkpoints struct {
+	db db.DB
+}
+
+// NewCheckpoints creates new checkpoints persisted in the given database.
+func NewCheckpoints(db db.DB) *Checkpoints {
+	return &Checkpoints{db: db}
+}
+
+// Cursor returns the index up to which the named prunable has been pruned,
+// and false if it has never been pruned.
+func (c *Checkpoints) Cursor(name string) (uint64, bool, error) {
+	key := []byte(path.Join(cursorsDir, name))
+	exists, err := c.db.Has(key)
+	if err != nil || !exists {
+		return 0, false, err
+	}
+	bz, err := c.db.Get(key)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(bz) != cursorSize {
+		return 0, false, errors.Wrapf(ErrInvalidCheckpoint, "cursor %s", name)
+	}
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+// SetCursor sets the index up to which the named prunable has been pruned.
+func (c *Checkpoints) SetCursor(name string, cursor uint64) error {
+	return c.db.Set(
+		[]byte(path.Join(cursorsDir, name)),
+		binary.BigEndian.AppendUint64(nil, cursor),
+	)
+}
+
+// AddTarget records a target of the named prunable.
+func (c *Checkpoints) AddTarget(name string, target Target) error {
+	bz := make([]byte, 0, targetSize)
+	bz = binary.BigEndian.AppendUint64(bz, target.Start)
+	bz = binary.BigEndian.AppendUint64(bz, target.End)
+	return c.db.Set(c.targetKey(name, target.Slot), bz)
+}
+
+// Targets returns the targets of the named prunable, ordered by slot.
+func (c *Checkpoints) Targets(name string) ([]Target, error) {
+	keys, err := c.db.Keys(c.targetsPrefix(name))
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]Target, 0, len(keys))
+	for _, key := range keys {
+		slot, parseErr := strconv.ParseUint(path.Base(string(key)), 10, 64)
+		if parseErr != nil {
+			return nil, errors.Wrapf(ErrInvalidCheckpoint, "target %s", key)
+		}
+		bz, getErr := c.db.Get(key)
+		if getErr != nil {
+			return nil, getErr
+		}
+		if len(bz) != targetSize {
+			return nil, errors.Wrapf(ErrInvalidCheckpoint, "target %s", key)
+		}
+		targets = append(targets, Target{
+			Slot:  slot,
+			Start: binary.BigEndian.Uint64(bz[:cursorSize]),
+			End:   binary.BigEndian.Uint64(bz[cursorSize:]),
+		})
+	}
+	slices.SortFunc(targets, func(a, b Target) int {
+		return cmp.Compare(a.Slot, b.Slot)
+	})
+	return targets, nil
+}
+
+// DeleteTarget removes the target of the named prunable recorded at the given
+// slot.
+func (c *Checkpoints) DeleteTarget(name string, slot uint64) error {
+	return c.db.Delete(c.targetKey(name, slot))
+}
+
+// targetKey returns the key of the target recorded at the given slot.
+func (c *Checkpoints) targetKey(name string, slot uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", c.targetsPrefix(name), slot))
+}
+
+// targetsPrefix returns the prefix of the keys of the targets of the named
+// prunable.
+func (c *Checkpoints) targetsPrefix(name string) []byte {
+	return []byte(path.Join(targetsDir, name) + "/")
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package pruner
+
+import (
+	"math"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// PolicyKeepLastSlots keeps the data of the most recent slots.
+	PolicyKeepLastSlots = "keep-last-slots"
+	// PolicyKeepEpochs keeps the data of the most recent epochs.
+	PolicyKeepEpochs = "keep-epochs"
+	// PolicyArchive keeps all data.
+	PolicyArchive = "archive"
+
+	// ArchiveWindow is the retention window of the archive policy, under
+	// which nothing is pruned.
+	ArchiveWindow = math.MaxUint64
+
+	// defaultBlockRetentionSlots is the number of slots of blocks kept by
+	// default.
+	defaultBlockRetentionSlots = 8192
+)
+
+// Config is the retention policy of a prunable store.
+type Config struct {
+	// Policy is the retention policy, one of "keep-last-slots",
+	// "keep-epochs" or "archive".
+	Policy string `mapstructure:"policy"`
+	// Slots is the number of most recent slots kept by the keep-last-slots
+	// policy.
+	Slots uint64 `mapstructure:"slots"`
+	// Epochs is the number of most recent epochs kept by the keep-epochs
+	// policy.
+	Epochs uint64 `mapstructure:"epochs"`
+}
+
+// Window returns the number of most recent slots whose data is kept by the
+// policy, which is ArchiveWindow under the archive policy.
+func (c Config) Window(slotsPerEpoch uint64) (uint64, error) {
+	switch c.Policy {
+	case PolicyKeepLastSlots:
+		return c.Slots, nil
+	case PolicyKeepEpochs:
+		if slotsPerEpoch != 0 && c.Epochs > (ArchiveWindow-1)/slotsPerEpoch {
+			return 0, errors.Wrapf(
+				ErrInvalidRetention, "%d epochs overflow", c.Epochs,
+			)
+		}
+		return c.Epochs * slotsPerEpoch, nil
+	case PolicyArchive:
+		return ArchiveWindow, nil
+	default:
+		return 0, errors.Wrapf(
+			ErrInvalidRetention, "unknown policy %q", c.Policy,
+		)
+	}
+}
+
+// RetentionConfig is the retention policy of each prunable store of the node.
+type RetentionConfig struct {
+	// Blocks is the retention policy of the block store.
+	Blocks Config `mapstructure:"blocks"`
+	// Blobs is the retention policy of the blob sidecars. Blob sidecars
+	// within the data availability window of the chain are always kept.
+	Blobs Config `mapstructure:"blobs"`
+	// Deposits is the retention policy of the deposits, which are kept from
+	// the slot of the block that processed them.
+	Deposits Config `mapstructure:"deposits"`
+}
+
+// DefaultRetentionConfig returns the default retention policy of the stores.
+func DefaultRetentionConfig() RetentionConfig {
+	return RetentionConfig{
+		Blocks: Config{
+			Policy: PolicyKeepLastSlots,
+			Slots:  defaultBlockRetentionSlots,
+		},
+		Blobs: Config{
+			Policy: PolicyKeepEpochs,
+			Epochs: 0,
+		},
+		Deposits: Config{
+			Policy: PolicyKeepLastSlots,
+			Slots:  0,
+		},
+	}
+}
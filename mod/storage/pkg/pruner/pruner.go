@@ -48,9 +48,13 @@ type pruner[
 	name         string
 	feed         chan *asynctypes.Event[BeaconBlockT]
 	pruneRangeFn func(*asynctypes.Event[BeaconBlockT]) (uint64, uint64)
+	checkpoints  *Checkpoints
+	window       uint64
 }
 
-// NewPruner creates a new Pruner.
+// NewPruner creates a new Pruner. The range returned by pruneRangeFn for a
+// finalized block is pruned once the slot of the block leaves the retention
+// window, the given number of most recent slots.
 func NewPruner[
 	BeaconBlockT BeaconBlock,
 	PrunableT Prunable,
@@ -60,6 +64,8 @@ func NewPruner[
 	name string,
 	feed chan *asynctypes.Event[BeaconBlockT],
 	pruneRangeFn func(*asynctypes.Event[BeaconBlockT]) (uint64, uint64),
+	checkpoints *Checkpoints,
+	window uint64,
 ) Pruner[PrunableT] {
 	return &pruner[BeaconBlockT, PrunableT]{
 		logger:       logger,
@@ -67,6 +73,8 @@ func NewPruner[
 		name:         name,
 		feed:         feed,
 		pruneRangeFn: pruneRangeFn,
+		checkpoints:  checkpoints,
+		window:       window,
 	}
 }
 
@@ -83,8 +91,7 @@ func (p *pruner[_, _]) start(ctx context.Context) {
 			return
 		case event := <-p.feed:
 			if event.Is(events.BeaconBlockFinalized) {
-				start, end := p.pruneRangeFn(event)
-				if err := p.prunable.Prune(start, end); err != nil {
+				if err := p.prune(event); err != nil {
 					p.logger.Error("‼️ error pruning index ‼️", "error", err)
 				}
 			}
@@ -92,7 +99,86 @@ func (p *pruner[_, _]) start(ctx context.Context) {
 	}
 }
 
+// prune records the range to prune for the finalized block and prunes the
+// ranges that have left the retention window.
+func (p *pruner[BeaconBlockT, _]) prune(
+	event *asynctypes.Event[BeaconBlockT],
+) error {
+	// Nothing is ever pruned under the archive policy.
+	if p.window == ArchiveWindow {
+		return nil
+	}
+
+	start, end := p.pruneRangeFn(event)
+	if err := p.checkpoints.AddTarget(p.name, Target{
+		Slot:  event.Data().GetSlot().Unwrap(),
+		Start: start,
+		End:   end,
+	}); err != nil {
+		return err
+	}
+	return Compact(p.prunable, p.checkpoints, p.name, p.window)
+}
+
 // Name returns the name of the Pruner.
 func (p *pruner[_, _]) Name() string {
 	return p.name
 }
+
+// Compact prunes the targets of the named prunable that have left the
+// retention window, the given number of most recent slots up to the slot of
+// the latest target. Pruning resumes from the cursor of the prunable, which is
+// advanced past the pruned targets.
+func Compact(
+	prunable Prunable,
+	checkpoints *Checkpoints,
+	name string,
+	window uint64,
+) error {
+	if window == ArchiveWindow {
+		return nil
+	}
+	targets, err := checkpoints.Targets(name)
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+
+	// The targets are ordered by slot, so the ones that have left the window
+	// come first.
+	head := targets[len(targets)-1].Slot
+	due := 0
+	for due < len(targets) && head-targets[due].Slot >= window {
+		due++
+	}
+	if due == 0 {
+		return nil
+	}
+
+	start, end := targets[0].Start, targets[0].End
+	for _, target := range targets[1:due] {
+		start = min(start, target.Start)
+		end = max(end, target.End)
+	}
+	cursor, ok, err := checkpoints.Cursor(name)
+	if err != nil {
+		return err
+	}
+	if ok {
+		start = cursor
+	}
+	if start < end {
+		if err = prunable.Prune(start, end); err != nil {
+			return err
+		}
+		if err = checkpoints.SetCursor(name, end); err != nil {
+			return err
+		}
+	}
+
+	for _, target := range targets[:due] {
+		if err = checkpoints.DeleteTarget(name, target.Slot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -34,16 +34,18 @@ import (
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func pruneRangeFn[BlockT pruner.BeaconBlock](
 	event *asynctypes.Event[BlockT],
 ) (uint64, uint64) {
 	slot := event.Data().GetSlot().Unwrap()
-	return slot, slot
+	return slot, slot + 1
 }
 
 func TestPruner(t *testing.T) {
@@ -81,7 +83,10 @@ func TestPruner(t *testing.T) {
 			testPruner := pruner.NewPruner[
 				pruner.BeaconBlock,
 				pruner.Prunable,
-			](logger, mockPrunable, "TestPruner", ch, pruneRangeFn)
+			](
+				logger, mockPrunable, "TestPruner", ch, pruneRangeFn,
+				newTestCheckpoints(t), 0,
+			)
 
 			ctx, cancel := context.WithCancel(context.Background())
 			// need to ensure goroutine is stopped
@@ -123,3 +128,113 @@ func TestPruner(t *testing.T) {
 		})
 	}
 }
+
+func TestCompact_RetentionWindow(t *testing.T) {
+	checkpoints := newTestCheckpoints(t)
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", mock.Anything, mock.Anything).Return(nil)
+
+	// Targets are only pruned once they are 2 slots behind the latest one.
+	for slot := range uint64(3) {
+		require.NoError(t, checkpoints.AddTarget(
+			"test", pruner.Target{Slot: slot, Start: 0, End: slot},
+		))
+		require.NoError(t, pruner.Compact(mockPrunable, checkpoints, "test", 2))
+	}
+	mockPrunable.AssertNotCalled(t, "Prune", mock.Anything, mock.Anything)
+
+	require.NoError(t, checkpoints.AddTarget(
+		"test", pruner.Target{Slot: 5, Start: 0, End: 5},
+	))
+	require.NoError(t, pruner.Compact(mockPrunable, checkpoints, "test", 2))
+	mockPrunable.AssertCalled(t, "Prune", uint64(0), uint64(2))
+
+	cursor, ok, err := checkpoints.Cursor("test")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), cursor)
+
+	targets, err := checkpoints.Targets("test")
+	require.NoError(t, err)
+	require.Equal(t, []pruner.Target{{Slot: 5, Start: 0, End: 5}}, targets)
+
+	// Nothing is pruned under the archive policy.
+	require.NoError(t, checkpoints.AddTarget(
+		"test", pruner.Target{Slot: 10, Start: 0, End: 10},
+	))
+	require.NoError(t, pruner.Compact(
+		mockPrunable, checkpoints, "test", pruner.ArchiveWindow,
+	))
+	mockPrunable.AssertNumberOfCalls(t, "Prune", 1)
+}
+
+func TestCompact_ResumesFromCursor(t *testing.T) {
+	dir := t.TempDir()
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", mock.Anything, mock.Anything).Return(nil)
+
+	checkpoints := pruner.NewCheckpoints(newTestDB(dir))
+	require.NoError(t, checkpoints.AddTarget(
+		"test", pruner.Target{Slot: 100, Start: 1, End: 100},
+	))
+	require.NoError(t, pruner.Compact(mockPrunable, checkpoints, "test", 0))
+	mockPrunable.AssertCalled(t, "Prune", uint64(1), uint64(100))
+
+	// After a restart, pruning starts from where it left off rather than
+	// from the start of the range.
+	checkpoints = pruner.NewCheckpoints(newTestDB(dir))
+	require.NoError(t, checkpoints.AddTarget(
+		"test", pruner.Target{Slot: 120, Start: 1, End: 120},
+	))
+	require.NoError(t, pruner.Compact(mockPrunable, checkpoints, "test", 0))
+	mockPrunable.AssertCalled(t, "Prune", uint64(100), uint64(120))
+
+	// Targets that were already pruned are skipped.
+	require.NoError(t, checkpoints.AddTarget(
+		"test", pruner.Target{Slot: 130, Start: 1, End: 110},
+	))
+	require.NoError(t, pruner.Compact(mockPrunable, checkpoints, "test", 0))
+	mockPrunable.AssertNumberOfCalls(t, "Prune", 2)
+}
+
+func TestConfig_Window(t *testing.T) {
+	window, err := pruner.Config{
+		Policy: pruner.PolicyKeepLastSlots, Slots: 10, Epochs: 3,
+	}.Window(32)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), window)
+
+	window, err = pruner.Config{
+		Policy: pruner.PolicyKeepEpochs, Slots: 10, Epochs: 3,
+	}.Window(32)
+	require.NoError(t, err)
+	require.Equal(t, uint64(96), window)
+
+	window, err = pruner.Config{Policy: pruner.PolicyArchive}.Window(32)
+	require.NoError(t, err)
+	require.Equal(t, uint64(pruner.ArchiveWindow), window)
+
+	_, err = pruner.Config{
+		Policy: pruner.PolicyKeepEpochs, Epochs: 1 << 60,
+	}.Window(32)
+	require.ErrorIs(t, err, pruner.ErrInvalidRetention)
+
+	_, err = pruner.Config{Policy: "keep-some"}.Window(32)
+	require.ErrorIs(t, err, pruner.ErrInvalidRetention)
+}
+
+// newTestCheckpoints returns checkpoints persisted in a temporary directory.
+func newTestCheckpoints(t *testing.T) *pruner.Checkpoints {
+	t.Helper()
+	return pruner.NewCheckpoints(newTestDB(t.TempDir()))
+}
+
+// newTestDB returns a file database rooted at the given directory.
+func newTestDB(dir string) *filedb.DB {
+	return filedb.NewDB(
+		filedb.WithRootDirectory(dir),
+		filedb.WithFileExtension("bin"),
+		filedb.WithDirectoryPermissions(0700),
+		filedb.WithLogger(log.NewNopLogger()),
+	)
+}
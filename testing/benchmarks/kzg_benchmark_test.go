@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package benchmarks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/ckzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+// kzgFilesDir is the directory of the trusted setup and the test blobs.
+const kzgFilesDir = "../files/"
+
+/* -------------------------------------------------------------------------- */
+/*                                Implementations                             */
+/* -------------------------------------------------------------------------- */
+
+// Benchmark function for verifying a single blob proof with every
+// implementation.
+func BenchmarkVerifyBlobProof(b *testing.B) {
+	args := loadBlobProofArgs(b)
+	for _, impl := range kzgImplementations() {
+		b.Run(impl, func(b *testing.B) {
+			verifier := newBlobProofVerifier(b, impl)
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if err := verifier.VerifyBlobProof(
+					args.Blobs[0], args.Proofs[0], args.Commitments[0],
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// Benchmark function for verifying a batch of blob proofs with every
+// implementation.
+func BenchmarkVerifyBlobProofBatch(b *testing.B) {
+	args := loadBlobProofArgs(b)
+	for _, impl := range kzgImplementations() {
+		b.Run(impl, func(b *testing.B) {
+			verifier := newBlobProofVerifier(b, impl)
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if err := verifier.VerifyBlobProofBatch(args); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// Benchmark function for creating the auto verifier, which benchmarks the
+// implementations at startup.
+func BenchmarkNewAutoBlobProofVerifier(b *testing.B) {
+	ts := loadTrustedSetup(b)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := kzg.NewBlobProofVerifier(
+			kzg.AutoImplementation, ts,
+		); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                 Verification Cache                         */
+/* -------------------------------------------------------------------------- */
+
+// Benchmark function for verifying the KZG proofs of the same sidecars
+// repeatedly, with and without the verification cache.
+func BenchmarkVerifyKZGProofs(b *testing.B) {
+	scs := sidecarsFromArgs(loadBlobProofArgs(b))
+	for _, bc := range []struct {
+		name                  string
+		verificationCacheSize int
+	}{
+		{"uncached", 0},
+		{"cached", kzg.DefaultConfig().VerificationCacheSize},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			verifier, err := blob.NewVerifier[
+				*ctypes.BeaconBlockHeader,
+				*datypes.BlobSidecar,
+				*datypes.BlobSidecars,
+			](
+				newBlobProofVerifier(b, gokzg.Implementation),
+				bc.verificationCacheSize,
+				noopSink{},
+			)
+			require.NoError(b, err)
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				if err = verifier.VerifyKZGProofs(scs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                   Helpers                                  */
+/* -------------------------------------------------------------------------- */
+
+// kzgImplementations returns the implementations that can be benchmarked.
+func kzgImplementations() []string {
+	return []string{
+		gokzg.Implementation,
+		ckzg.Implementation,
+		kzg.AutoImplementation,
+	}
+}
+
+// newBlobProofVerifier returns a verifier of the implementation, skipping the
+// benchmark if the implementation is not available in this build.
+func newBlobProofVerifier(
+	b *testing.B,
+	impl string,
+) kzg.BlobProofVerifier {
+	b.Helper()
+	verifier, err := kzg.NewBlobProofVerifier(impl, loadTrustedSetup(b))
+	require.NoError(b, err)
+
+	var blob eip4844.Blob
+	if err = verifier.VerifyBlobProof(
+		&blob, eip4844.KZGProof{}, eip4844.KZGCommitment{},
+	); errors.Is(err, ckzg.ErrCGONotEnabled) {
+		b.Skip(err)
+	}
+	return verifier
+}
+
+// loadTrustedSetup loads the trusted setup.
+func loadTrustedSetup(b *testing.B) *gokzg4844.JSONTrustedSetup {
+	b.Helper()
+	data, err := os.ReadFile(
+		filepath.Join(kzgFilesDir, "kzg-trusted-setup.json"),
+	)
+	require.NoError(b, err)
+
+	var ts gokzg4844.JSONTrustedSetup
+	require.NoError(b, json.Unmarshal(data, &ts))
+	return &ts
+}
+
+// loadBlobProofArgs loads the blobs, proofs and commitments of the batch
+// test data.
+func loadBlobProofArgs(b *testing.B) *kzgtypes.BlobProofArgs {
+	b.Helper()
+	data, err := os.ReadFile(
+		filepath.Join(kzgFilesDir, "test_data_batch.json"),
+	)
+	require.NoError(b, err)
+
+	var test struct {
+		Input struct {
+			Blobs       []string `json:"blobs"`
+			Proofs      []string `json:"proofs"`
+			Commitments []string `json:"commitments"`
+		} `json:"input"`
+	}
+	require.NoError(b, json.Unmarshal(data, &test))
+
+	args := &kzgtypes.BlobProofArgs{
+		Blobs:       make([]*eip4844.Blob, len(test.Input.Blobs)),
+		Proofs:      make([]eip4844.KZGProof, len(test.Input.Proofs)),
+		Commitments: make([]eip4844.KZGCommitment, len(test.Input.Commitments)),
+	}
+	for i := range test.Input.Blobs {
+		var blob eip4844.Blob
+		require.NoError(b, blob.UnmarshalJSON(
+			[]byte(`"`+test.Input.Blobs[i]+`"`),
+		))
+		args.Blobs[i] = &blob
+		require.NoError(b, args.Proofs[i].UnmarshalJSON(
+			[]byte(`"`+test.Input.Proofs[i]+`"`),
+		))
+		require.NoError(b, args.Commitments[i].UnmarshalJSON(
+			[]byte(`"`+test.Input.Commitments[i]+`"`),
+		))
+	}
+	return args
+}
+
+// sidecarsFromArgs returns the sidecars of the blobs, proofs and
+// commitments.
+func sidecarsFromArgs(args *kzgtypes.BlobProofArgs) *datypes.BlobSidecars {
+	scs := &datypes.BlobSidecars{}
+	for i, blob := range args.Blobs {
+		scs.Sidecars = append(scs.Sidecars, &datypes.BlobSidecar{
+			Index:         uint64(i),
+			Blob:          *blob,
+			KzgProof:      args.Proofs[i],
+			KzgCommitment: args.Commitments[i],
+		})
+	}
+	return scs
+}
+
+// noopSink is a telemetry sink that discards the metrics.
+type noopSink struct{}
+
+func (noopSink) IncrementCounter(string, ...string) {}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}